@@ -61,21 +61,24 @@ func (CheckTxType) EnumDescriptor() ([]byte, []int) {
 type EvidenceType int32
 
 const (
-	EvidenceType_UNKNOWN             EvidenceType = 0
-	EvidenceType_DUPLICATE_VOTE      EvidenceType = 1
-	EvidenceType_LIGHT_CLIENT_ATTACK EvidenceType = 2
+	EvidenceType_UNKNOWN              EvidenceType = 0
+	EvidenceType_DUPLICATE_VOTE       EvidenceType = 1
+	EvidenceType_LIGHT_CLIENT_ATTACK  EvidenceType = 2
+	EvidenceType_DUPLICATE_STATE_VOTE EvidenceType = 3
 )
 
 var EvidenceType_name = map[int32]string{
 	0: "UNKNOWN",
 	1: "DUPLICATE_VOTE",
 	2: "LIGHT_CLIENT_ATTACK",
+	3: "DUPLICATE_STATE_VOTE",
 }
 
 var EvidenceType_value = map[string]int32{
-	"UNKNOWN":             0,
-	"DUPLICATE_VOTE":      1,
-	"LIGHT_CLIENT_ATTACK": 2,
+	"UNKNOWN":              0,
+	"DUPLICATE_VOTE":       1,
+	"LIGHT_CLIENT_ATTACK":  2,
+	"DUPLICATE_STATE_VOTE": 3,
 }
 
 func (x EvidenceType) String() string {
@@ -1992,6 +1995,8 @@ type ResponseCheckTx struct {
 	GasUsed   int64   `protobuf:"varint,6,opt,name=gas_used,proto3" json:"gas_used,omitempty"`
 	Events    []Event `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
 	Codespace string  `protobuf:"bytes,8,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	Sender    string  `protobuf:"bytes,9,opt,name=sender,proto3" json:"sender,omitempty"`
+	Priority  int64   `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (m *ResponseCheckTx) Reset()         { *m = ResponseCheckTx{} }
@@ -2083,6 +2088,20 @@ func (m *ResponseCheckTx) GetCodespace() string {
 	return ""
 }
 
+func (m *ResponseCheckTx) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *ResponseCheckTx) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 type ResponseDeliverTx struct {
 	Code      uint32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Data      []byte  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
@@ -3417,195 +3436,197 @@ func init() {
 func init() { proto.RegisterFile("tendermint/abci/types.proto", fileDescriptor_252557cfdd89a31a) }
 
 var fileDescriptor_252557cfdd89a31a = []byte{
-	// 3005 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcd, 0x77, 0x23, 0xc5,
-	0xb5, 0x57, 0x4b, 0xb2, 0x3e, 0xae, 0xac, 0x0f, 0xd7, 0x98, 0x19, 0x8d, 0x66, 0xc6, 0x1e, 0x9a,
-	0x03, 0x0c, 0x03, 0xd8, 0x0f, 0xcf, 0xe1, 0xeb, 0x3d, 0xde, 0x03, 0x4b, 0x68, 0x90, 0x19, 0x63,
-	0x9b, 0xb2, 0x66, 0x78, 0x09, 0x61, 0x9a, 0x96, 0x54, 0x96, 0x9a, 0x91, 0xba, 0x9b, 0xee, 0x92,
-	0xb1, 0xd9, 0x42, 0x36, 0xac, 0xc8, 0x2e, 0x59, 0xf0, 0x77, 0x64, 0x91, 0x73, 0xb2, 0x66, 0xc9,
-	0x32, 0x2b, 0xc2, 0x81, 0x93, 0x4d, 0x96, 0xd9, 0xe4, 0x9c, 0x9c, 0x93, 0x93, 0x9c, 0xfa, 0xe8,
-	0x2f, 0x49, 0x2d, 0xc9, 0xcc, 0x32, 0xbb, 0xae, 0x5b, 0xf7, 0xde, 0xae, 0xaa, 0xae, 0xfa, 0xdd,
-	0xdf, 0xbd, 0x5d, 0x70, 0x8d, 0x12, 0xb3, 0x47, 0x9c, 0x91, 0x61, 0xd2, 0x6d, 0xbd, 0xd3, 0x35,
-	0xb6, 0xe9, 0xb9, 0x4d, 0xdc, 0x2d, 0xdb, 0xb1, 0xa8, 0x85, 0xca, 0x41, 0xe7, 0x16, 0xeb, 0xac,
-	0xdd, 0x08, 0x69, 0x77, 0x9d, 0x73, 0x9b, 0x5a, 0xdb, 0xb6, 0x63, 0x59, 0x27, 0x42, 0xbf, 0x76,
-	0x3d, 0xd4, 0xcd, 0xfd, 0x84, 0xbd, 0x45, 0x7a, 0xa5, 0xf1, 0x23, 0x72, 0xee, 0xf5, 0xde, 0x98,
-	0xb2, 0xb5, 0x75, 0x47, 0x1f, 0x79, 0xdd, 0x9b, 0x7d, 0xcb, 0xea, 0x0f, 0xc9, 0x36, 0x6f, 0x75,
-	0xc6, 0x27, 0xdb, 0xd4, 0x18, 0x11, 0x97, 0xea, 0x23, 0x5b, 0x2a, 0xac, 0xf7, 0xad, 0xbe, 0xc5,
-	0x1f, 0xb7, 0xd9, 0x93, 0x90, 0xaa, 0xbf, 0xc9, 0x41, 0x16, 0x93, 0x4f, 0xc7, 0xc4, 0xa5, 0x68,
-	0x07, 0xd2, 0xa4, 0x3b, 0xb0, 0xaa, 0xca, 0x4d, 0xe5, 0x56, 0x61, 0xe7, 0xfa, 0xd6, 0xc4, 0xe4,
-	0xb6, 0xa4, 0x5e, 0xb3, 0x3b, 0xb0, 0x5a, 0x09, 0xcc, 0x75, 0xd1, 0xcb, 0xb0, 0x72, 0x32, 0x1c,
-	0xbb, 0x83, 0x6a, 0x92, 0x1b, 0xdd, 0x88, 0x33, 0xba, 0xcb, 0x94, 0x5a, 0x09, 0x2c, 0xb4, 0xd9,
-	0xab, 0x0c, 0xf3, 0xc4, 0xaa, 0xa6, 0xe6, 0xbf, 0x6a, 0xcf, 0x3c, 0xe1, 0xaf, 0x62, 0xba, 0xa8,
-	0x0e, 0xe0, 0x12, 0xaa, 0x59, 0x36, 0x35, 0x2c, 0xb3, 0x9a, 0xe6, 0x96, 0x4f, 0xc6, 0x59, 0x1e,
-	0x13, 0x7a, 0xc8, 0x15, 0x5b, 0x09, 0x9c, 0x77, 0xbd, 0x06, 0xf3, 0x61, 0x98, 0x06, 0xd5, 0xba,
-	0x03, 0xdd, 0x30, 0xab, 0x2b, 0xf3, 0x7d, 0xec, 0x99, 0x06, 0x6d, 0x30, 0x45, 0xe6, 0xc3, 0xf0,
-	0x1a, 0x6c, 0xca, 0x9f, 0x8e, 0x89, 0x73, 0x5e, 0xcd, 0xcc, 0x9f, 0xf2, 0xfb, 0x4c, 0x89, 0x4d,
-	0x99, 0x6b, 0xa3, 0x26, 0x14, 0x3a, 0xa4, 0x6f, 0x98, 0x5a, 0x67, 0x68, 0x75, 0x1f, 0x55, 0xb3,
-	0xdc, 0x58, 0x8d, 0x33, 0xae, 0x33, 0xd5, 0x3a, 0xd3, 0x6c, 0x25, 0x30, 0x74, 0xfc, 0x16, 0x7a,
-	0x03, 0x72, 0xdd, 0x01, 0xe9, 0x3e, 0xd2, 0xe8, 0x59, 0x35, 0xc7, 0x7d, 0x6c, 0xc6, 0xf9, 0x68,
-	0x30, 0xbd, 0xf6, 0x59, 0x2b, 0x81, 0xb3, 0x5d, 0xf1, 0xc8, 0xe6, 0xdf, 0x23, 0x43, 0xe3, 0x94,
-	0x38, 0xcc, 0x3e, 0x3f, 0x7f, 0xfe, 0x6f, 0x0b, 0x4d, 0xee, 0x21, 0xdf, 0xf3, 0x1a, 0xe8, 0x4d,
-	0xc8, 0x13, 0xb3, 0x27, 0xa7, 0x01, 0xdc, 0xc5, 0xcd, 0xd8, 0xbd, 0x62, 0xf6, 0xbc, 0x49, 0xe4,
-	0x88, 0x7c, 0x46, 0xaf, 0x41, 0xa6, 0x6b, 0x8d, 0x46, 0x06, 0xad, 0x16, 0xb8, 0xf5, 0x46, 0xec,
-	0x04, 0xb8, 0x56, 0x2b, 0x81, 0xa5, 0x3e, 0x3a, 0x80, 0xd2, 0xd0, 0x70, 0xa9, 0xe6, 0x9a, 0xba,
-	0xed, 0x0e, 0x2c, 0xea, 0x56, 0x57, 0xb9, 0x87, 0xa7, 0xe3, 0x3c, 0xec, 0x1b, 0x2e, 0x3d, 0xf6,
-	0x94, 0x5b, 0x09, 0x5c, 0x1c, 0x86, 0x05, 0xcc, 0x9f, 0x75, 0x72, 0x42, 0x1c, 0xdf, 0x61, 0xb5,
-	0x38, 0xdf, 0xdf, 0x21, 0xd3, 0xf6, 0xec, 0x99, 0x3f, 0x2b, 0x2c, 0x40, 0x1f, 0xc2, 0xa5, 0xa1,
-	0xa5, 0xf7, 0x7c, 0x77, 0x5a, 0x77, 0x30, 0x36, 0x1f, 0x55, 0x4b, 0xdc, 0xe9, 0x73, 0xb1, 0x83,
-	0xb4, 0xf4, 0x9e, 0xe7, 0xa2, 0xc1, 0x0c, 0x5a, 0x09, 0xbc, 0x36, 0x9c, 0x14, 0xa2, 0x87, 0xb0,
-	0xae, 0xdb, 0xf6, 0xf0, 0x7c, 0xd2, 0x7b, 0x99, 0x7b, 0xbf, 0x1d, 0xe7, 0x7d, 0x97, 0xd9, 0x4c,
-	0xba, 0x47, 0xfa, 0x94, 0xb4, 0x9e, 0x85, 0x95, 0x53, 0x7d, 0x38, 0x26, 0xea, 0xb3, 0x50, 0x08,
-	0x1d, 0x75, 0x54, 0x85, 0xec, 0x88, 0xb8, 0xae, 0xde, 0x27, 0x1c, 0x19, 0xf2, 0xd8, 0x6b, 0xaa,
-	0x25, 0x58, 0x0d, 0x1f, 0x6f, 0x75, 0xe4, 0x1b, 0xb2, 0x83, 0xcb, 0x0c, 0x4f, 0x89, 0xe3, 0xb2,
-	0xd3, 0x2a, 0x0d, 0x65, 0x13, 0x3d, 0x05, 0x45, 0xbe, 0x7d, 0x34, 0xaf, 0x9f, 0xa1, 0x47, 0x1a,
-	0xaf, 0x72, 0xe1, 0x03, 0xa9, 0xb4, 0x09, 0x05, 0x7b, 0xc7, 0xf6, 0x55, 0x52, 0x5c, 0x05, 0xec,
-	0x1d, 0x5b, 0x2a, 0xa8, 0xff, 0x0d, 0x95, 0xc9, 0xd3, 0x8e, 0x2a, 0x90, 0x7a, 0x44, 0xce, 0xe5,
-	0xfb, 0xd8, 0x23, 0x5a, 0x97, 0xd3, 0xe2, 0xef, 0xc8, 0x63, 0x39, 0xc7, 0x2f, 0x52, 0xbe, 0xb1,
-	0x7f, 0xcc, 0xd1, 0x6b, 0x90, 0x66, 0xa8, 0x29, 0x01, 0xb0, 0xb6, 0x25, 0x20, 0x75, 0xcb, 0x83,
-	0xd4, 0xad, 0xb6, 0x07, 0xa9, 0xf5, 0xdc, 0xb7, 0xdf, 0x6f, 0x26, 0xbe, 0xfe, 0xf3, 0xa6, 0x82,
-	0xb9, 0x05, 0xba, 0xca, 0x4e, 0xa5, 0x6e, 0x98, 0x9a, 0xd1, 0x93, 0xef, 0xc9, 0xf2, 0xf6, 0x5e,
-	0x0f, 0xdd, 0x83, 0x4a, 0xd7, 0x32, 0x5d, 0x62, 0xba, 0x63, 0x57, 0x13, 0x90, 0x2d, 0x61, 0x6f,
-	0xfa, 0xd4, 0x34, 0x3c, 0xc5, 0x23, 0xae, 0x87, 0xcb, 0xdd, 0xa8, 0x00, 0x1d, 0x40, 0xf1, 0x54,
-	0x1f, 0x1a, 0x3d, 0x9d, 0x5a, 0x8e, 0xe6, 0x12, 0x2a, 0x61, 0xf0, 0xa9, 0x29, 0x4f, 0x0f, 0x3c,
-	0xad, 0x63, 0x42, 0xef, 0xdb, 0x3d, 0x9d, 0x92, 0x7a, 0xfa, 0xdb, 0xef, 0x37, 0x15, 0xbc, 0x7a,
-	0x1a, 0xea, 0x41, 0xcf, 0x40, 0x59, 0xb7, 0x6d, 0xcd, 0xa5, 0x3a, 0x25, 0x5a, 0xe7, 0x9c, 0x12,
-	0x97, 0x83, 0xe2, 0x2a, 0x2e, 0xea, 0xb6, 0x7d, 0xcc, 0xa4, 0x75, 0x26, 0x44, 0x4f, 0x43, 0x89,
-	0x01, 0xa0, 0xa1, 0x0f, 0xb5, 0x01, 0x31, 0xfa, 0x03, 0xca, 0xc1, 0x2f, 0x85, 0x8b, 0x52, 0xda,
-	0xe2, 0x42, 0xb4, 0x05, 0x97, 0x3c, 0xb5, 0xae, 0xe5, 0x10, 0x4f, 0x97, 0x61, 0x5d, 0x11, 0xaf,
-	0xc9, 0xae, 0x86, 0xe5, 0x10, 0xa1, 0xaf, 0xf6, 0xfc, 0x0d, 0xc4, 0xc1, 0x12, 0x21, 0x48, 0xf7,
-	0x74, 0xaa, 0xf3, 0x0f, 0xb0, 0x8a, 0xf9, 0x33, 0x93, 0xd9, 0x3a, 0x1d, 0xc8, 0x65, 0xe5, 0xcf,
-	0xe8, 0x32, 0x64, 0xa4, 0xeb, 0x14, 0x1f, 0x86, 0x6c, 0xb1, 0x6f, 0x6d, 0x3b, 0xd6, 0x29, 0xe1,
-	0xcb, 0x92, 0xc3, 0xa2, 0xa1, 0x7e, 0x99, 0x84, 0xb5, 0x29, 0x58, 0x65, 0x7e, 0x07, 0xba, 0x3b,
-	0xf0, 0xde, 0xc5, 0x9e, 0xd1, 0x2b, 0xcc, 0xaf, 0xde, 0x23, 0x8e, 0x0c, 0x67, 0xd5, 0xf0, 0xba,
-	0x8a, 0x50, 0xdd, 0xe2, 0xfd, 0x7c, 0x31, 0x13, 0x58, 0x6a, 0xa3, 0x43, 0xa8, 0x0c, 0x75, 0x97,
-	0x6a, 0x02, 0xa6, 0xb4, 0x50, 0x68, 0x9b, 0x06, 0xe7, 0x7d, 0xdd, 0x03, 0x36, 0x76, 0x48, 0xa4,
-	0xa3, 0xd2, 0x30, 0x22, 0x45, 0x18, 0xd6, 0x3b, 0xe7, 0x9f, 0xeb, 0x26, 0x35, 0x4c, 0xa2, 0xf9,
-	0x5f, 0xcc, 0xad, 0xa6, 0x6f, 0xa6, 0x6e, 0x15, 0x76, 0xae, 0x4e, 0x39, 0x6d, 0x9e, 0x1a, 0x3d,
-	0x62, 0x76, 0x89, 0x74, 0x77, 0xc9, 0x37, 0xf6, 0xf7, 0x81, 0xab, 0x62, 0x28, 0x45, 0x03, 0x03,
-	0x2a, 0x41, 0x92, 0x9e, 0xc9, 0x05, 0x48, 0xd2, 0x33, 0xf4, 0x5f, 0x90, 0x66, 0x93, 0xe4, 0x93,
-	0x2f, 0xcd, 0x88, 0xca, 0xd2, 0xae, 0x7d, 0x6e, 0x13, 0xcc, 0x35, 0x55, 0xd5, 0x3f, 0x45, 0x7e,
-	0xb0, 0x98, 0xf4, 0xaa, 0x3e, 0x07, 0xe5, 0x89, 0x68, 0x10, 0xfa, 0x7e, 0x4a, 0xf8, 0xfb, 0xa9,
-	0x65, 0x28, 0x46, 0xa0, 0x5f, 0xbd, 0x0c, 0xeb, 0xb3, 0x90, 0x5c, 0x1d, 0xf8, 0xf2, 0x08, 0x22,
-	0xa3, 0x97, 0x21, 0xe7, 0x43, 0xb9, 0x38, 0xc5, 0xd3, 0x6b, 0xe5, 0x29, 0x63, 0x5f, 0x95, 0x1d,
-	0x5f, 0x76, 0x0c, 0xf8, 0x7e, 0x48, 0xf2, 0x81, 0x67, 0x75, 0xdb, 0x6e, 0xe9, 0xee, 0x40, 0xfd,
-	0x18, 0xaa, 0x71, 0x30, 0x3d, 0x31, 0x8d, 0xb4, 0xbf, 0x0d, 0x2f, 0x43, 0xe6, 0xc4, 0x72, 0x46,
-	0x3a, 0xe5, 0xce, 0x8a, 0x58, 0xb6, 0xd8, 0xf6, 0x14, 0x90, 0x9d, 0xe2, 0x62, 0xd1, 0x50, 0x35,
-	0xb8, 0x1a, 0x0b, 0xd5, 0xcc, 0xc4, 0x30, 0x7b, 0x44, 0xac, 0x67, 0x11, 0x8b, 0x46, 0xe0, 0x48,
-	0x0c, 0x56, 0x34, 0xd8, 0x6b, 0x5d, 0x3e, 0x57, 0xee, 0x3f, 0x8f, 0x65, 0x4b, 0xfd, 0x4b, 0x0e,
-	0x72, 0x98, 0xb8, 0x36, 0xc3, 0x12, 0x54, 0x87, 0x3c, 0x39, 0xeb, 0x12, 0x41, 0xa2, 0x94, 0x58,
-	0x12, 0x22, 0xb4, 0x9b, 0x9e, 0x26, 0x63, 0x00, 0xbe, 0x19, 0xba, 0x23, 0x89, 0x62, 0x3c, 0xe7,
-	0x93, 0xe6, 0x61, 0xa6, 0xf8, 0x8a, 0xc7, 0x14, 0x53, 0xb1, 0x41, 0x5f, 0x58, 0x4d, 0x50, 0xc5,
-	0x3b, 0x92, 0x2a, 0xa6, 0x17, 0xbc, 0x2c, 0xc2, 0x15, 0x1b, 0x11, 0xae, 0xb8, 0xb2, 0x60, 0x9a,
-	0x31, 0x64, 0xb1, 0x11, 0x21, 0x8b, 0x99, 0x05, 0x4e, 0x62, 0xd8, 0xe2, 0x2b, 0x1e, 0x5b, 0xcc,
-	0x2e, 0x98, 0xf6, 0x04, 0x5d, 0xbc, 0x1b, 0xa5, 0x8b, 0xb9, 0x18, 0x9c, 0xf7, 0xac, 0x63, 0xf9,
-	0xe2, 0xff, 0x86, 0xf8, 0x62, 0x3e, 0x96, 0xac, 0x09, 0x27, 0x33, 0x08, 0x63, 0x23, 0x42, 0x18,
-	0x61, 0xc1, 0x1a, 0xc4, 0x30, 0xc6, 0xb7, 0xc2, 0x8c, 0xb1, 0x10, 0x4b, 0x3a, 0xe5, 0xa6, 0x99,
-	0x45, 0x19, 0x5f, 0xf7, 0x29, 0xe3, 0x6a, 0x2c, 0xe7, 0x95, 0x73, 0x98, 0xe4, 0x8c, 0x87, 0x53,
-	0x9c, 0x51, 0x70, 0xbc, 0x67, 0x62, 0x5d, 0x2c, 0x20, 0x8d, 0x87, 0x53, 0xa4, 0xb1, 0xb4, 0xc0,
-	0xe1, 0x02, 0xd6, 0xf8, 0xab, 0xd9, 0xac, 0x31, 0x9e, 0xd7, 0xc9, 0x61, 0x2e, 0x47, 0x1b, 0xb5,
-	0x18, 0xda, 0x58, 0xe1, 0xee, 0x9f, 0x8f, 0x75, 0x7f, 0x71, 0xde, 0xf8, 0x1c, 0x0b, 0xb3, 0x13,
-	0xc0, 0xc1, 0xa0, 0x8a, 0x38, 0x8e, 0xe5, 0x48, 0x4a, 0x26, 0x1a, 0xea, 0x2d, 0x16, 0xf8, 0x03,
-	0x90, 0x98, 0xc3, 0x31, 0x79, 0x48, 0x08, 0x01, 0x83, 0xfa, 0x0f, 0x25, 0xb0, 0xe5, 0xb1, 0x32,
-	0x4c, 0x1a, 0xf2, 0x92, 0x34, 0x84, 0xa8, 0x67, 0x32, 0x4a, 0x3d, 0x37, 0xa1, 0xc0, 0xa0, 0x7e,
-	0x82, 0x55, 0xea, 0xb6, 0xc7, 0x2a, 0xd1, 0x6d, 0x58, 0xe3, 0xb1, 0x5c, 0x10, 0x54, 0x89, 0xef,
-	0x69, 0x1e, 0xa6, 0xca, 0xac, 0x43, 0x6c, 0x4e, 0x01, 0xf4, 0x2f, 0xc2, 0xa5, 0x90, 0xae, 0x1f,
-	0x42, 0x04, 0x85, 0xaa, 0xf8, 0xda, 0xbb, 0x22, 0x96, 0xa0, 0xb7, 0xe0, 0x86, 0xa4, 0x09, 0x0e,
-	0x11, 0xa8, 0xa2, 0xb1, 0x6e, 0xd2, 0xf3, 0x5e, 0xd3, 0xe3, 0x20, 0x7f, 0x55, 0x90, 0x01, 0x87,
-	0x70, 0x04, 0xd9, 0xe7, 0x1a, 0x92, 0x30, 0xbd, 0x17, 0x2c, 0x71, 0xc0, 0x79, 0x11, 0xa4, 0xbb,
-	0x56, 0x8f, 0xc8, 0x10, 0xc1, 0x9f, 0x19, 0x0f, 0x1e, 0x5a, 0x7d, 0x19, 0x08, 0xd8, 0x23, 0xd3,
-	0xf2, 0x71, 0x34, 0x2f, 0x60, 0x52, 0xfd, 0x7d, 0x32, 0xf0, 0x17, 0xd0, 0xe0, 0x59, 0x8c, 0x55,
-	0xf9, 0xb9, 0x8c, 0x35, 0x1c, 0x5a, 0x53, 0x91, 0xd0, 0x8a, 0x3e, 0x84, 0xf5, 0x08, 0x99, 0xd5,
-	0xc6, 0x9c, 0xa8, 0xf2, 0x55, 0xb8, 0x00, 0xa7, 0x4d, 0x60, 0x74, 0x3a, 0xd5, 0x83, 0x3e, 0x82,
-	0x6b, 0x26, 0x39, 0x9b, 0x5a, 0x6b, 0xef, 0x1d, 0x64, 0x1a, 0x46, 0x04, 0xbf, 0x8b, 0xac, 0x3b,
-	0xbe, 0xc2, 0x7c, 0x44, 0x44, 0xc2, 0xbd, 0xfa, 0x77, 0x25, 0xd8, 0x97, 0x3e, 0x77, 0xfd, 0x79,
-	0x5f, 0x21, 0x88, 0xf1, 0x2b, 0x7c, 0x97, 0xc9, 0x18, 0x2f, 0x33, 0x99, 0x0c, 0x5f, 0xb3, 0x68,
-	0x26, 0x93, 0x15, 0x51, 0x9f, 0x37, 0xd0, 0x6b, 0x90, 0xe7, 0x25, 0x26, 0xcd, 0xb2, 0x5d, 0x19,
-	0x26, 0xae, 0x85, 0xa7, 0x25, 0x2a, 0x49, 0x5b, 0x47, 0x4c, 0xe7, 0xd0, 0x76, 0x71, 0xce, 0x96,
-	0x4f, 0x21, 0xfa, 0x92, 0x8f, 0xb0, 0xe8, 0xeb, 0x90, 0x67, 0xa3, 0x77, 0x6d, 0xbd, 0x4b, 0x38,
-	0xe4, 0xe7, 0x71, 0x20, 0x50, 0x1f, 0x02, 0x9a, 0x0e, 0x3a, 0xa8, 0x05, 0x19, 0x72, 0x4a, 0x4c,
-	0xca, 0x76, 0x0a, 0xa3, 0xa8, 0x97, 0x67, 0x50, 0x54, 0x62, 0xd2, 0x7a, 0x95, 0x7d, 0xb0, 0xbf,
-	0x7e, 0xbf, 0x59, 0x11, 0xda, 0x2f, 0x58, 0x23, 0x83, 0x92, 0x91, 0x4d, 0xcf, 0xb1, 0xb4, 0x57,
-	0xbf, 0x48, 0x32, 0xbe, 0x18, 0x09, 0x48, 0x33, 0xd7, 0xd6, 0x3b, 0xf6, 0xc9, 0x50, 0xae, 0xb0,
-	0xdc, 0x7a, 0x6f, 0x00, 0xf4, 0x75, 0x57, 0xfb, 0x4c, 0x37, 0x29, 0xe9, 0xc9, 0x45, 0x0f, 0x49,
-	0x50, 0x0d, 0x72, 0xac, 0x35, 0x76, 0x49, 0x4f, 0xa6, 0x39, 0x7e, 0x3b, 0x34, 0xcf, 0xec, 0xe3,
-	0xcd, 0x33, 0xba, 0xca, 0xb9, 0xc9, 0x55, 0xfe, 0x75, 0xe8, 0x64, 0x06, 0xd4, 0xfa, 0x3f, 0x6f,
-	0x1d, 0xfe, 0x96, 0x64, 0x19, 0x46, 0x94, 0x19, 0xa0, 0xff, 0x87, 0x2b, 0x13, 0x00, 0x25, 0x8f,
-	0xb5, 0x2b, 0x29, 0xe9, 0x62, 0x9c, 0x7a, 0x22, 0x8a, 0x53, 0xe2, 0x54, 0xbb, 0xa1, 0x69, 0xa5,
-	0x1e, 0x73, 0x5a, 0x0b, 0xf0, 0xa7, 0xf7, 0x78, 0xf8, 0x13, 0x8b, 0x9d, 0xe4, 0xa2, 0xf5, 0x80,
-	0x19, 0xd8, 0xa9, 0xee, 0xb1, 0x4c, 0x31, 0x4c, 0xa7, 0x66, 0x6e, 0xb2, 0xa7, 0xa0, 0xe8, 0x10,
-	0xca, 0x26, 0x16, 0xc9, 0xc5, 0x57, 0x85, 0x50, 0x06, 0xac, 0x23, 0x78, 0x62, 0x26, 0xad, 0x42,
-	0xaf, 0x42, 0x3e, 0x60, 0x64, 0x4a, 0x4c, 0x5a, 0xeb, 0xa7, 0x6a, 0x81, 0xae, 0xfa, 0x47, 0x25,
-	0x70, 0x19, 0x4d, 0xfe, 0x9a, 0x90, 0x71, 0x88, 0x3b, 0x1e, 0x8a, 0x74, 0xac, 0xb4, 0xf3, 0xe2,
-	0x72, 0x84, 0x8c, 0x49, 0xc7, 0x43, 0x8a, 0xa5, 0xb1, 0xfa, 0x10, 0x32, 0x42, 0x82, 0x0a, 0x90,
-	0xbd, 0x7f, 0x70, 0xef, 0xe0, 0xf0, 0x83, 0x83, 0x4a, 0x02, 0x01, 0x64, 0x76, 0x1b, 0x8d, 0xe6,
-	0x51, 0xbb, 0xa2, 0xa0, 0x3c, 0xac, 0xec, 0xd6, 0x0f, 0x71, 0xbb, 0x92, 0x64, 0x62, 0xdc, 0x7c,
-	0xb7, 0xd9, 0x68, 0x57, 0x52, 0x68, 0x0d, 0x8a, 0xe2, 0x59, 0xbb, 0x7b, 0x88, 0xdf, 0xdb, 0x6d,
-	0x57, 0xd2, 0x21, 0xd1, 0x71, 0xf3, 0xe0, 0xed, 0x26, 0xae, 0xac, 0xa8, 0x2f, 0xb1, 0x7c, 0x2f,
-	0x86, 0xc2, 0x05, 0x99, 0x9d, 0x12, 0xca, 0xec, 0xd4, 0xdf, 0x26, 0xa1, 0x16, 0xcf, 0xcb, 0xd0,
-	0xbb, 0x13, 0x13, 0xdf, 0xb9, 0x00, 0xa9, 0x9b, 0x98, 0x3d, 0x7a, 0x1a, 0x4a, 0x0e, 0x39, 0x21,
-	0xb4, 0x3b, 0x10, 0x3c, 0x91, 0x1d, 0xa9, 0xd4, 0xad, 0x22, 0x2e, 0x4a, 0x29, 0x37, 0x72, 0x85,
-	0xda, 0x27, 0xa4, 0x4b, 0x35, 0x91, 0x64, 0x8a, 0x03, 0x93, 0x67, 0x6a, 0x4c, 0x7a, 0x2c, 0x84,
-	0xea, 0xc7, 0x17, 0x5a, 0xcb, 0x3c, 0xac, 0xe0, 0x66, 0x1b, 0xff, 0xa2, 0x92, 0x42, 0x08, 0x4a,
-	0xfc, 0x51, 0x3b, 0x3e, 0xd8, 0x3d, 0x3a, 0x6e, 0x1d, 0xb2, 0xb5, 0xbc, 0x04, 0x65, 0x6f, 0x2d,
-	0x3d, 0xe1, 0x8a, 0xfa, 0x2f, 0x05, 0xca, 0x13, 0x87, 0x1b, 0xed, 0xc0, 0x8a, 0xc8, 0x35, 0xe2,
-	0xfe, 0x64, 0x70, 0x18, 0x91, 0x48, 0x20, 0x54, 0xd1, 0x1b, 0x90, 0x23, 0xb2, 0x88, 0x32, 0x0b,
-	0x44, 0xc4, 0xe1, 0xf4, 0xca, 0x2c, 0xd2, 0xd4, 0xb7, 0x40, 0x6f, 0x42, 0xde, 0x3f, 0x47, 0x32,
-	0xc1, 0x7d, 0x72, 0xda, 0xdc, 0x3f, 0x84, 0xd2, 0x3e, 0xb0, 0x41, 0xaf, 0x07, 0x84, 0x35, 0x1d,
-	0x07, 0x0d, 0x92, 0xa1, 0x4a, 0x63, 0x4f, 0x5f, 0x6d, 0x40, 0x21, 0x34, 0x1f, 0x74, 0x0d, 0xf2,
-	0x23, 0xfd, 0x4c, 0x16, 0xf3, 0x44, 0x79, 0x25, 0x37, 0xd2, 0xcf, 0x44, 0x1d, 0xef, 0x0a, 0x64,
-	0x59, 0x67, 0x5f, 0x17, 0x48, 0x99, 0xc2, 0x99, 0x91, 0x7e, 0xf6, 0x8e, 0xee, 0xaa, 0xbf, 0x53,
-	0xa0, 0x14, 0xad, 0x4c, 0xb1, 0xad, 0xe8, 0x58, 0x63, 0xb3, 0xc7, 0x9d, 0xac, 0x60, 0xd1, 0x60,
-	0xfc, 0xf9, 0xd3, 0xb1, 0xe5, 0x8c, 0x47, 0x61, 0x4a, 0x07, 0x42, 0xc4, 0x59, 0xdd, 0xb3, 0x50,
-	0x16, 0x74, 0xd8, 0x35, 0xfa, 0xa6, 0x4e, 0xc7, 0x8e, 0xa8, 0xc6, 0xad, 0xe2, 0x12, 0x17, 0x1f,
-	0x7b, 0x52, 0xa6, 0x28, 0xea, 0x8e, 0x81, 0xa2, 0x20, 0xce, 0x25, 0x2e, 0xf6, 0x15, 0xd5, 0xcf,
-	0x61, 0x85, 0xa3, 0x2e, 0x43, 0x21, 0x5e, 0x9f, 0x92, 0x4c, 0x9f, 0x3d, 0xa3, 0x8f, 0x00, 0x74,
-	0x4a, 0x1d, 0xa3, 0x33, 0x16, 0xf0, 0x9f, 0x9a, 0x99, 0x1d, 0x72, 0xfb, 0x5d, 0x4f, 0xaf, 0x7e,
-	0x5d, 0xc2, 0xf7, 0x7a, 0x60, 0x1a, 0x82, 0xf0, 0x90, 0x43, 0xf5, 0x00, 0x4a, 0x51, 0xdb, 0x70,
-	0x85, 0x79, 0x75, 0x46, 0x85, 0xd9, 0xe7, 0x65, 0x3e, 0xab, 0x4b, 0x89, 0x5a, 0x24, 0x6f, 0xa8,
-	0x5f, 0x29, 0x90, 0x6b, 0x9f, 0xc9, 0x33, 0x11, 0x53, 0x06, 0x0b, 0x4c, 0x93, 0xe1, 0xa2, 0x8f,
-	0xa8, 0xab, 0xa5, 0xfc, 0x6a, 0xdd, 0x5b, 0xfe, 0xa9, 0x4f, 0x2f, 0x9b, 0x96, 0x7b, 0x65, 0x4b,
-	0x89, 0x74, 0xbb, 0x90, 0xf7, 0xb7, 0x24, 0xaf, 0x9d, 0x5a, 0x9f, 0xc9, 0xe2, 0x51, 0x0a, 0x8b,
-	0x06, 0xda, 0x80, 0x82, 0xed, 0x58, 0x1a, 0x3d, 0x13, 0x9f, 0x5b, 0x7c, 0x49, 0x46, 0x38, 0xdb,
-	0x67, 0xbc, 0x3c, 0xf6, 0xa5, 0x02, 0x65, 0xdf, 0x87, 0x8c, 0x4d, 0xff, 0x03, 0x59, 0x7b, 0xdc,
-	0xd1, 0xbc, 0x55, 0x9a, 0x38, 0x80, 0x1e, 0x1f, 0x1d, 0x77, 0x86, 0x46, 0xf7, 0x1e, 0x39, 0x97,
-	0x71, 0x28, 0x63, 0x8f, 0x3b, 0xf7, 0xc4, 0x62, 0x8a, 0x61, 0x24, 0xe7, 0x0c, 0x23, 0x35, 0x39,
-	0x8c, 0x1f, 0x14, 0x40, 0xd3, 0x21, 0x0e, 0x1d, 0xc3, 0x5a, 0x10, 0x25, 0x3d, 0x8a, 0x20, 0x82,
-	0xcd, 0xcd, 0xf8, 0x10, 0x19, 0xc9, 0x2d, 0x2a, 0xa7, 0x51, 0xb1, 0x8b, 0xda, 0xb0, 0x4e, 0x07,
-	0x0e, 0x71, 0x07, 0xd6, 0xb0, 0xa7, 0xd9, 0x7c, 0x1a, 0x7c, 0xae, 0xc9, 0x25, 0xe7, 0x9a, 0xc0,
-	0xc8, 0xb7, 0xf7, 0x7b, 0x16, 0x9e, 0x2b, 0xd5, 0x86, 0x6a, 0x7b, 0xca, 0x4c, 0xce, 0x33, 0x6e,
-	0x48, 0xca, 0xe3, 0x0c, 0x49, 0xbd, 0x03, 0x95, 0xf7, 0xfd, 0xf7, 0xcb, 0x37, 0x4d, 0x0c, 0x53,
-	0x99, 0x1a, 0xe6, 0x29, 0xe4, 0x1e, 0x58, 0x54, 0x64, 0xe6, 0xff, 0x17, 0x46, 0x45, 0xef, 0xa7,
-	0x4a, 0xec, 0xb2, 0xcb, 0x91, 0x84, 0x40, 0xf1, 0x36, 0xac, 0x31, 0x6c, 0x20, 0x3d, 0x2d, 0xc8,
-	0xb2, 0xf9, 0x32, 0xe7, 0x70, 0x59, 0x74, 0xec, 0x7b, 0x29, 0xb6, 0xfa, 0x4f, 0x05, 0x72, 0x1e,
-	0x3c, 0xa3, 0x97, 0x42, 0x40, 0x51, 0x9a, 0x51, 0x33, 0xf4, 0x14, 0x83, 0x4a, 0x76, 0x74, 0xac,
-	0xc9, 0x8b, 0x8f, 0x35, 0xee, 0x97, 0x84, 0xf7, 0x4f, 0x29, 0x7d, 0xe1, 0x7f, 0x4a, 0x2f, 0x00,
-	0xa2, 0x16, 0xd5, 0x87, 0xda, 0xa9, 0x45, 0x0d, 0xb3, 0xaf, 0x89, 0x63, 0x21, 0x68, 0x7a, 0x85,
-	0xf7, 0x3c, 0xe0, 0x1d, 0x47, 0x4c, 0xae, 0xfe, 0x41, 0x81, 0x9c, 0xcf, 0x84, 0x2e, 0x5a, 0x98,
-	0xbe, 0x0c, 0x19, 0x19, 0xec, 0x45, 0x65, 0x5a, 0xb6, 0xfc, 0x7f, 0x24, 0xe9, 0xd0, 0x3f, 0x92,
-	0x1a, 0xe4, 0x46, 0x84, 0xea, 0x9c, 0x0e, 0x0a, 0xbc, 0xf6, 0xdb, 0xe8, 0x55, 0xa8, 0x2e, 0xa8,
-	0x6d, 0x3c, 0xd1, 0x9d, 0x55, 0xd7, 0xb8, 0xfd, 0x3a, 0x14, 0x42, 0x3f, 0x17, 0x18, 0xc6, 0x1e,
-	0x34, 0x3f, 0xa8, 0x24, 0x6a, 0xd9, 0xaf, 0xbe, 0xb9, 0x99, 0x3a, 0x20, 0x9f, 0xa1, 0x2a, 0x64,
-	0x71, 0xb3, 0xd1, 0x6a, 0x36, 0xee, 0x55, 0x94, 0x5a, 0xe1, 0xab, 0x6f, 0x6e, 0x66, 0x31, 0xe1,
-	0x35, 0xca, 0xdb, 0x2d, 0x58, 0x0d, 0x7f, 0xce, 0x28, 0xd1, 0x40, 0x50, 0x7a, 0xfb, 0xfe, 0xd1,
-	0xfe, 0x5e, 0x63, 0xb7, 0xdd, 0xd4, 0x1e, 0x1c, 0xb6, 0x9b, 0x15, 0x05, 0x5d, 0x81, 0x4b, 0xfb,
-	0x7b, 0xef, 0xb4, 0xda, 0x5a, 0x63, 0x7f, 0xaf, 0x79, 0xd0, 0xd6, 0x76, 0xdb, 0xed, 0xdd, 0xc6,
-	0xbd, 0x4a, 0x72, 0xe7, 0x0b, 0x80, 0xf2, 0x6e, 0xbd, 0xb1, 0xc7, 0x48, 0x92, 0xd1, 0xd5, 0x65,
-	0x0d, 0x38, 0xcd, 0x0b, 0x54, 0x73, 0x6f, 0x43, 0xd4, 0xe6, 0x97, 0xc0, 0xd1, 0x5d, 0x58, 0xe1,
-	0xb5, 0x2b, 0x34, 0xff, 0x7a, 0x44, 0x6d, 0x41, 0x4d, 0x9c, 0x0d, 0x86, 0x9f, 0xab, 0xb9, 0xf7,
-	0x25, 0x6a, 0xf3, 0x4b, 0xe4, 0x08, 0x43, 0x3e, 0x28, 0x1d, 0x2d, 0xbe, 0x3f, 0x51, 0x5b, 0xa2,
-	0x6c, 0xce, 0x7c, 0x06, 0x49, 0xea, 0xe2, 0xfb, 0x04, 0xb5, 0x25, 0x42, 0x15, 0xda, 0x87, 0xac,
-	0x97, 0xfe, 0x2f, 0xba, 0xe1, 0x50, 0x5b, 0x58, 0xd2, 0x66, 0x9f, 0x40, 0x94, 0x69, 0xe6, 0x5f,
-	0xd7, 0xa8, 0x2d, 0xa8, 0xcf, 0xa3, 0x3d, 0xc8, 0xc8, 0x94, 0x68, 0xc1, 0xad, 0x85, 0xda, 0xa2,
-	0x12, 0x35, 0x5b, 0xb4, 0xa0, 0xe6, 0xb6, 0xf8, 0x12, 0x4a, 0x6d, 0x89, 0x5f, 0x0f, 0xe8, 0x3e,
-	0x40, 0xa8, 0x28, 0xb3, 0xc4, 0xed, 0x92, 0xda, 0x32, 0xbf, 0x14, 0xd0, 0x21, 0xe4, 0xfc, 0xe4,
-	0x7b, 0xe1, 0x5d, 0x8f, 0xda, 0xe2, 0xda, 0x3e, 0x7a, 0x08, 0xc5, 0x68, 0x3a, 0xb8, 0xdc, 0x0d,
-	0x8e, 0xda, 0x92, 0x45, 0x7b, 0xe6, 0x3f, 0x9a, 0x1b, 0x2e, 0x77, 0xa3, 0xa3, 0xb6, 0x64, 0x0d,
-	0x1f, 0x7d, 0x02, 0x6b, 0xd3, 0xb9, 0xdb, 0xf2, 0x17, 0x3c, 0x6a, 0x17, 0xa8, 0xea, 0xa3, 0x11,
-	0xa0, 0x19, 0x39, 0xdf, 0x05, 0xee, 0x7b, 0xd4, 0x2e, 0x52, 0xe4, 0xaf, 0x37, 0xbf, 0xfd, 0x71,
-	0x43, 0xf9, 0xee, 0xc7, 0x0d, 0xe5, 0x87, 0x1f, 0x37, 0x94, 0xaf, 0x7f, 0xda, 0x48, 0x7c, 0xf7,
-	0xd3, 0x46, 0xe2, 0x4f, 0x3f, 0x6d, 0x24, 0x7e, 0xf9, 0x7c, 0xdf, 0xa0, 0x83, 0x71, 0x67, 0xab,
-	0x6b, 0x8d, 0xb6, 0xc3, 0x97, 0xd1, 0x66, 0x5d, 0x90, 0xeb, 0x64, 0x78, 0x84, 0xbb, 0xf3, 0xef,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x07, 0x1f, 0x0d, 0x40, 0x27, 0x00, 0x00,
+	// 3026 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcb, 0x73, 0xe3, 0xc6,
+	0xd1, 0x27, 0xf8, 0x66, 0xf3, 0xa9, 0x59, 0xed, 0x2e, 0x97, 0xbb, 0x2b, 0xed, 0x07, 0x97, 0xed,
+	0xf5, 0xda, 0x96, 0x3e, 0x6b, 0xcb, 0xaf, 0xef, 0xcb, 0xc3, 0x12, 0xcd, 0x35, 0xe5, 0x95, 0x25,
+	0x79, 0xc4, 0x5d, 0x27, 0x71, 0xbc, 0x30, 0x48, 0x8c, 0x44, 0x78, 0x49, 0x00, 0x06, 0x86, 0xb2,
+	0xe4, 0xab, 0x9d, 0x8b, 0x4f, 0xce, 0x2d, 0x39, 0xf8, 0xef, 0xc8, 0x21, 0x55, 0x39, 0xfb, 0xe8,
+	0x63, 0x4e, 0x8e, 0xcb, 0xae, 0x5c, 0x72, 0xcc, 0x25, 0x55, 0xa9, 0x4a, 0x25, 0x35, 0x0f, 0x80,
+	0x00, 0x49, 0x90, 0x94, 0xf7, 0x98, 0x1b, 0xa6, 0xa7, 0xbb, 0x31, 0x3d, 0xc0, 0xfc, 0xfa, 0x37,
+	0x3d, 0x03, 0xd7, 0x29, 0xb1, 0x0c, 0xe2, 0x0e, 0x4d, 0x8b, 0x6e, 0xea, 0xdd, 0x9e, 0xb9, 0x49,
+	0xcf, 0x1d, 0xe2, 0x6d, 0x38, 0xae, 0x4d, 0x6d, 0x54, 0x1d, 0x77, 0x6e, 0xb0, 0xce, 0xc6, 0xcd,
+	0x90, 0x76, 0xcf, 0x3d, 0x77, 0xa8, 0xbd, 0xe9, 0xb8, 0xb6, 0x7d, 0x2c, 0xf4, 0x1b, 0x37, 0x42,
+	0xdd, 0xdc, 0x4f, 0xd8, 0x5b, 0xe3, 0xc6, 0xb4, 0xf1, 0x63, 0x72, 0xee, 0xf7, 0xde, 0x9c, 0xb2,
+	0x75, 0x74, 0x57, 0x1f, 0xfa, 0xdd, 0xeb, 0x27, 0xb6, 0x7d, 0x32, 0x20, 0x9b, 0xbc, 0xd5, 0x1d,
+	0x1d, 0x6f, 0x52, 0x73, 0x48, 0x3c, 0xaa, 0x0f, 0x1d, 0xa9, 0xb0, 0x7a, 0x62, 0x9f, 0xd8, 0xfc,
+	0x71, 0x93, 0x3d, 0x09, 0xa9, 0xfa, 0xdb, 0x3c, 0xe4, 0x30, 0xf9, 0x78, 0x44, 0x3c, 0x8a, 0xb6,
+	0x20, 0x4d, 0x7a, 0x7d, 0xbb, 0xae, 0xdc, 0x52, 0x6e, 0x17, 0xb7, 0x6e, 0x6c, 0x4c, 0x04, 0xb7,
+	0x21, 0xf5, 0x5a, 0xbd, 0xbe, 0xdd, 0x4e, 0x60, 0xae, 0x8b, 0x5e, 0x86, 0xcc, 0xf1, 0x60, 0xe4,
+	0xf5, 0xeb, 0x49, 0x6e, 0x74, 0x33, 0xce, 0xe8, 0x1e, 0x53, 0x6a, 0x27, 0xb0, 0xd0, 0x66, 0xaf,
+	0x32, 0xad, 0x63, 0xbb, 0x9e, 0x9a, 0xff, 0xaa, 0x5d, 0xeb, 0x98, 0xbf, 0x8a, 0xe9, 0xa2, 0x1d,
+	0x00, 0x8f, 0x50, 0xcd, 0x76, 0xa8, 0x69, 0x5b, 0xf5, 0x34, 0xb7, 0xfc, 0x9f, 0x38, 0xcb, 0x23,
+	0x42, 0x0f, 0xb8, 0x62, 0x3b, 0x81, 0x0b, 0x9e, 0xdf, 0x60, 0x3e, 0x4c, 0xcb, 0xa4, 0x5a, 0xaf,
+	0xaf, 0x9b, 0x56, 0x3d, 0x33, 0xdf, 0xc7, 0xae, 0x65, 0xd2, 0x26, 0x53, 0x64, 0x3e, 0x4c, 0xbf,
+	0xc1, 0x42, 0xfe, 0x78, 0x44, 0xdc, 0xf3, 0x7a, 0x76, 0x7e, 0xc8, 0xef, 0x32, 0x25, 0x16, 0x32,
+	0xd7, 0x46, 0x2d, 0x28, 0x76, 0xc9, 0x89, 0x69, 0x69, 0xdd, 0x81, 0xdd, 0x7b, 0x5c, 0xcf, 0x71,
+	0x63, 0x35, 0xce, 0x78, 0x87, 0xa9, 0xee, 0x30, 0xcd, 0x76, 0x02, 0x43, 0x37, 0x68, 0xa1, 0x9f,
+	0x40, 0xbe, 0xd7, 0x27, 0xbd, 0xc7, 0x1a, 0x3d, 0xab, 0xe7, 0xb9, 0x8f, 0xf5, 0x38, 0x1f, 0x4d,
+	0xa6, 0xd7, 0x39, 0x6b, 0x27, 0x70, 0xae, 0x27, 0x1e, 0x59, 0xfc, 0x06, 0x19, 0x98, 0xa7, 0xc4,
+	0x65, 0xf6, 0x85, 0xf9, 0xf1, 0xbf, 0x29, 0x34, 0xb9, 0x87, 0x82, 0xe1, 0x37, 0xd0, 0xcf, 0xa1,
+	0x40, 0x2c, 0x43, 0x86, 0x01, 0xdc, 0xc5, 0xad, 0xd8, 0x7f, 0xc5, 0x32, 0xfc, 0x20, 0xf2, 0x44,
+	0x3e, 0xa3, 0xd7, 0x20, 0xdb, 0xb3, 0x87, 0x43, 0x93, 0xd6, 0x8b, 0xdc, 0x7a, 0x2d, 0x36, 0x00,
+	0xae, 0xd5, 0x4e, 0x60, 0xa9, 0x8f, 0xf6, 0xa1, 0x32, 0x30, 0x3d, 0xaa, 0x79, 0x96, 0xee, 0x78,
+	0x7d, 0x9b, 0x7a, 0xf5, 0x12, 0xf7, 0xf0, 0x74, 0x9c, 0x87, 0x3d, 0xd3, 0xa3, 0x47, 0xbe, 0x72,
+	0x3b, 0x81, 0xcb, 0x83, 0xb0, 0x80, 0xf9, 0xb3, 0x8f, 0x8f, 0x89, 0x1b, 0x38, 0xac, 0x97, 0xe7,
+	0xfb, 0x3b, 0x60, 0xda, 0xbe, 0x3d, 0xf3, 0x67, 0x87, 0x05, 0xe8, 0x7d, 0xb8, 0x34, 0xb0, 0x75,
+	0x23, 0x70, 0xa7, 0xf5, 0xfa, 0x23, 0xeb, 0x71, 0xbd, 0xc2, 0x9d, 0x3e, 0x17, 0x3b, 0x48, 0x5b,
+	0x37, 0x7c, 0x17, 0x4d, 0x66, 0xd0, 0x4e, 0xe0, 0x95, 0xc1, 0xa4, 0x10, 0x3d, 0x82, 0x55, 0xdd,
+	0x71, 0x06, 0xe7, 0x93, 0xde, 0xab, 0xdc, 0xfb, 0x9d, 0x38, 0xef, 0xdb, 0xcc, 0x66, 0xd2, 0x3d,
+	0xd2, 0xa7, 0xa4, 0x3b, 0x39, 0xc8, 0x9c, 0xea, 0x83, 0x11, 0x51, 0x9f, 0x85, 0x62, 0x68, 0xa9,
+	0xa3, 0x3a, 0xe4, 0x86, 0xc4, 0xf3, 0xf4, 0x13, 0xc2, 0x91, 0xa1, 0x80, 0xfd, 0xa6, 0x5a, 0x81,
+	0x52, 0x78, 0x79, 0xab, 0x43, 0x28, 0x86, 0x16, 0x2e, 0x33, 0x3c, 0x25, 0xae, 0xc7, 0x56, 0xab,
+	0x34, 0x94, 0x4d, 0xf4, 0x14, 0x94, 0xf9, 0xef, 0xa3, 0xf9, 0xfd, 0x0c, 0x3d, 0xd2, 0xb8, 0xc4,
+	0x85, 0x0f, 0xa5, 0xd2, 0x3a, 0x14, 0x9d, 0x2d, 0x27, 0x50, 0x49, 0x71, 0x15, 0x70, 0xb6, 0x1c,
+	0xa9, 0xa0, 0xfe, 0x1f, 0xd4, 0x26, 0x57, 0x3b, 0xaa, 0x41, 0xea, 0x31, 0x39, 0x97, 0xef, 0x63,
+	0x8f, 0x68, 0x55, 0x86, 0xc5, 0xdf, 0x51, 0xc0, 0x32, 0xc6, 0xcf, 0x52, 0x50, 0x9b, 0x5c, 0xe6,
+	0xe8, 0x35, 0x48, 0x33, 0xd4, 0x94, 0x00, 0xd8, 0xd8, 0x10, 0x90, 0xba, 0xe1, 0x43, 0xea, 0x46,
+	0xc7, 0x87, 0xd4, 0x9d, 0xfc, 0xd7, 0xdf, 0xae, 0x27, 0xbe, 0xfc, 0xcb, 0xba, 0x82, 0xb9, 0x05,
+	0xba, 0xc6, 0x56, 0xa5, 0x6e, 0x5a, 0x9a, 0x69, 0xc8, 0xf7, 0xe4, 0x78, 0x7b, 0xd7, 0x40, 0xf7,
+	0xa1, 0xd6, 0xb3, 0x2d, 0x8f, 0x58, 0xde, 0xc8, 0xd3, 0x04, 0x64, 0xd7, 0x53, 0x31, 0xab, 0xa6,
+	0xe9, 0x2b, 0x1e, 0x72, 0x3d, 0x5c, 0xed, 0x45, 0x05, 0x68, 0x1f, 0xca, 0xa7, 0xfa, 0xc0, 0x34,
+	0x74, 0x6a, 0xbb, 0x9a, 0x47, 0xa8, 0x84, 0xc1, 0xa7, 0xa6, 0x3c, 0x3d, 0xf4, 0xb5, 0x8e, 0x08,
+	0x7d, 0xe0, 0x18, 0x3a, 0x25, 0x3b, 0xe9, 0xaf, 0xbf, 0x5d, 0x57, 0x70, 0xe9, 0x34, 0xd4, 0x83,
+	0x9e, 0x81, 0xaa, 0xee, 0x38, 0x9a, 0x47, 0x75, 0x4a, 0xb4, 0xee, 0x39, 0x25, 0x1e, 0x07, 0xc5,
+	0x12, 0x2e, 0xeb, 0x8e, 0x73, 0xc4, 0xa4, 0x3b, 0x4c, 0x88, 0x9e, 0x86, 0x0a, 0x03, 0x40, 0x53,
+	0x1f, 0x68, 0x7d, 0x62, 0x9e, 0xf4, 0x29, 0x07, 0xbf, 0x14, 0x2e, 0x4b, 0x69, 0x9b, 0x0b, 0xd1,
+	0x06, 0x5c, 0xf2, 0xd5, 0x7a, 0xb6, 0x4b, 0x7c, 0x5d, 0x86, 0x75, 0x65, 0xbc, 0x22, 0xbb, 0x9a,
+	0xb6, 0x4b, 0x84, 0xbe, 0x6a, 0x40, 0x29, 0x0c, 0x96, 0x08, 0x41, 0xda, 0xd0, 0xa9, 0xce, 0x3f,
+	0x40, 0x09, 0xf3, 0x67, 0x26, 0x73, 0x74, 0xda, 0x97, 0xd3, 0xca, 0x9f, 0xd1, 0x15, 0xc8, 0x4a,
+	0xd7, 0x29, 0x3e, 0x0c, 0xd9, 0x62, 0xdf, 0xda, 0x71, 0xed, 0x53, 0xc2, 0xa7, 0x25, 0x8f, 0x45,
+	0x43, 0xfd, 0x3c, 0x09, 0x2b, 0x53, 0xb0, 0xca, 0xfc, 0xf6, 0x75, 0xaf, 0xef, 0xbf, 0x8b, 0x3d,
+	0xa3, 0x57, 0x98, 0x5f, 0xdd, 0x20, 0xae, 0x4c, 0x67, 0xf5, 0xf0, 0xbc, 0x8a, 0x54, 0xdd, 0xe6,
+	0xfd, 0x7c, 0x32, 0x13, 0x58, 0x6a, 0xa3, 0x03, 0xa8, 0x0d, 0x74, 0x8f, 0x6a, 0x02, 0xa6, 0xb4,
+	0x50, 0x6a, 0x9b, 0x06, 0xe7, 0x3d, 0xdd, 0x07, 0x36, 0xb6, 0x48, 0xa4, 0xa3, 0xca, 0x20, 0x22,
+	0x45, 0x18, 0x56, 0xbb, 0xe7, 0x9f, 0xea, 0x16, 0x35, 0x2d, 0xa2, 0x05, 0x5f, 0xcc, 0xab, 0xa7,
+	0x6f, 0xa5, 0x6e, 0x17, 0xb7, 0xae, 0x4d, 0x39, 0x6d, 0x9d, 0x9a, 0x06, 0xb1, 0x7a, 0x44, 0xba,
+	0xbb, 0x14, 0x18, 0x07, 0xff, 0x81, 0xa7, 0x62, 0xa8, 0x44, 0x13, 0x03, 0xaa, 0x40, 0x92, 0x9e,
+	0xc9, 0x09, 0x48, 0xd2, 0x33, 0xf4, 0xbf, 0x90, 0x66, 0x41, 0xf2, 0xe0, 0x2b, 0x33, 0xb2, 0xb2,
+	0xb4, 0xeb, 0x9c, 0x3b, 0x04, 0x73, 0x4d, 0x55, 0x85, 0xda, 0x64, 0xb2, 0x98, 0xf4, 0xaa, 0x3e,
+	0x07, 0xd5, 0x89, 0x6c, 0x10, 0xfa, 0x7e, 0x4a, 0xf8, 0xfb, 0xa9, 0x55, 0x28, 0x47, 0xa0, 0x5f,
+	0xbd, 0x02, 0xab, 0xb3, 0x90, 0x5c, 0xed, 0xc3, 0xea, 0x2c, 0x44, 0x46, 0x2f, 0x43, 0x3e, 0x80,
+	0x72, 0xb1, 0x8a, 0xa7, 0xe7, 0xca, 0x57, 0xc6, 0x81, 0x2a, 0x5b, 0xbe, 0x6c, 0x19, 0xf0, 0xff,
+	0x21, 0xc9, 0x07, 0x9e, 0xd3, 0x1d, 0xa7, 0xad, 0x7b, 0x7d, 0xf5, 0x43, 0xa8, 0xc7, 0xc1, 0xf4,
+	0x44, 0x18, 0xe9, 0xe0, 0x37, 0xbc, 0x02, 0xd9, 0x63, 0xdb, 0x1d, 0xea, 0x94, 0x3b, 0x2b, 0x63,
+	0xd9, 0x62, 0xbf, 0xa7, 0x80, 0xec, 0x14, 0x17, 0x8b, 0x86, 0xaa, 0xc1, 0xb5, 0x58, 0xa8, 0x66,
+	0x26, 0xa6, 0x65, 0x10, 0x31, 0x9f, 0x65, 0x2c, 0x1a, 0x63, 0x47, 0x62, 0xb0, 0xa2, 0xc1, 0x5e,
+	0xeb, 0xf1, 0x58, 0xb9, 0xff, 0x02, 0x96, 0x2d, 0xf5, 0xaf, 0x79, 0xc8, 0x63, 0xe2, 0x39, 0x0c,
+	0x4b, 0xd0, 0x0e, 0x14, 0xc8, 0x59, 0x8f, 0x08, 0x12, 0xa5, 0xc4, 0x92, 0x10, 0xa1, 0xdd, 0xf2,
+	0x35, 0x19, 0x03, 0x08, 0xcc, 0xd0, 0x5d, 0x49, 0x14, 0xe3, 0x39, 0x9f, 0x34, 0x0f, 0x33, 0xc5,
+	0x57, 0x7c, 0xa6, 0x98, 0x8a, 0x4d, 0xfa, 0xc2, 0x6a, 0x82, 0x2a, 0xde, 0x95, 0x54, 0x31, 0xbd,
+	0xe0, 0x65, 0x11, 0xae, 0xd8, 0x8c, 0x70, 0xc5, 0xcc, 0x82, 0x30, 0x63, 0xc8, 0x62, 0x33, 0x42,
+	0x16, 0xb3, 0x0b, 0x9c, 0xc4, 0xb0, 0xc5, 0x57, 0x7c, 0xb6, 0x98, 0x5b, 0x10, 0xf6, 0x04, 0x5d,
+	0xbc, 0x17, 0xa5, 0x8b, 0xf9, 0x18, 0x9c, 0xf7, 0xad, 0x63, 0xf9, 0xe2, 0x4f, 0x43, 0x7c, 0xb1,
+	0x10, 0x4b, 0xd6, 0x84, 0x93, 0x19, 0x84, 0xb1, 0x19, 0x21, 0x8c, 0xb0, 0x60, 0x0e, 0x62, 0x18,
+	0xe3, 0x1b, 0x61, 0xc6, 0x58, 0x8c, 0x25, 0x9d, 0xf2, 0xa7, 0x99, 0x45, 0x19, 0x5f, 0x0f, 0x28,
+	0x63, 0x29, 0x96, 0xf3, 0xca, 0x18, 0x26, 0x39, 0xe3, 0xc1, 0x14, 0x67, 0x14, 0x1c, 0xef, 0x99,
+	0x58, 0x17, 0x0b, 0x48, 0xe3, 0xc1, 0x14, 0x69, 0xac, 0x2c, 0x70, 0xb8, 0x80, 0x35, 0xfe, 0x7a,
+	0x36, 0x6b, 0x8c, 0xe7, 0x75, 0x72, 0x98, 0xcb, 0xd1, 0x46, 0x2d, 0x86, 0x36, 0xd6, 0xb8, 0xfb,
+	0xe7, 0x63, 0xdd, 0x5f, 0x9c, 0x37, 0x3e, 0x07, 0x2b, 0xbe, 0x71, 0x00, 0x1c, 0x0c, 0xaa, 0x88,
+	0xeb, 0xda, 0xae, 0xa4, 0x64, 0xa2, 0xa1, 0xde, 0x86, 0x52, 0xa0, 0x3a, 0x9f, 0x63, 0xf2, 0x94,
+	0x10, 0x02, 0x06, 0xf5, 0x9f, 0x0a, 0x94, 0xc2, 0x6b, 0x3e, 0x42, 0x1a, 0x0a, 0x92, 0x34, 0x84,
+	0xa8, 0x67, 0x32, 0x4a, 0x3d, 0xd7, 0xa1, 0xc8, 0xa0, 0x7e, 0x82, 0x55, 0xea, 0x8e, 0xcf, 0x2a,
+	0xd1, 0x1d, 0x58, 0xe1, 0xb9, 0x5c, 0x10, 0x54, 0x89, 0xef, 0x69, 0x9e, 0xa6, 0xaa, 0xac, 0x43,
+	0xfc, 0x9c, 0x5c, 0x8c, 0x5e, 0x84, 0x4b, 0x21, 0xdd, 0x20, 0x85, 0x08, 0x0a, 0x55, 0x0b, 0xb4,
+	0xb7, 0x45, 0x2e, 0x41, 0x6f, 0xc0, 0x4d, 0x49, 0x13, 0x5c, 0x22, 0x50, 0x45, 0x63, 0xdd, 0xc4,
+	0xf0, 0x5f, 0x63, 0x70, 0x90, 0xbf, 0x26, 0xc8, 0x80, 0x4b, 0x38, 0x82, 0xec, 0x71, 0x0d, 0x49,
+	0x98, 0xde, 0x81, 0x95, 0x29, 0xd0, 0x62, 0x13, 0xd0, 0xb3, 0x0d, 0x22, 0x53, 0x04, 0x7f, 0x66,
+	0x3c, 0x78, 0x60, 0x9f, 0xc8, 0x44, 0xc0, 0x1e, 0x99, 0x56, 0x80, 0xa3, 0x05, 0x01, 0x93, 0xea,
+	0x1f, 0x92, 0xb0, 0x32, 0x85, 0x5f, 0x33, 0x19, 0xab, 0xf2, 0x63, 0x19, 0x6b, 0x38, 0xb5, 0xa6,
+	0x22, 0xa9, 0x15, 0xbd, 0x0f, 0xab, 0x11, 0x32, 0xab, 0x8d, 0x38, 0x51, 0xad, 0x1b, 0x31, 0x58,
+	0x17, 0xc3, 0x69, 0x13, 0x18, 0x9d, 0x4e, 0xf5, 0xa0, 0x0f, 0xe0, 0xba, 0x45, 0xce, 0xa6, 0xe6,
+	0xda, 0x7f, 0x07, 0x99, 0x86, 0x11, 0xc1, 0xef, 0x22, 0xf3, 0x8e, 0xaf, 0x32, 0x1f, 0x11, 0x91,
+	0x70, 0xaf, 0xfe, 0x43, 0x81, 0x72, 0x04, 0xb9, 0x7f, 0xfc, 0x57, 0x18, 0xe7, 0xf8, 0x0c, 0xff,
+	0xcb, 0x44, 0xc3, 0xdf, 0xc9, 0x64, 0xf9, 0x9c, 0x45, 0x77, 0x32, 0x39, 0x91, 0xf5, 0x79, 0x03,
+	0xbd, 0x06, 0x05, 0x5e, 0x62, 0xd2, 0x6c, 0xc7, 0x93, 0x69, 0xe2, 0x7a, 0x38, 0x2c, 0x51, 0x49,
+	0xda, 0x38, 0x64, 0x3a, 0x07, 0x8e, 0x87, 0xf3, 0x8e, 0x7c, 0x0a, 0xd1, 0x97, 0x42, 0x84, 0x45,
+	0xdf, 0x80, 0x02, 0x1b, 0xbd, 0xe7, 0xe8, 0x3d, 0xc2, 0x21, 0xbf, 0x80, 0xc7, 0x02, 0xf5, 0x11,
+	0xa0, 0xe9, 0xa4, 0x83, 0xda, 0x90, 0x25, 0xa7, 0xc4, 0xa2, 0xec, 0x4f, 0x61, 0x14, 0xf5, 0xca,
+	0x0c, 0x8a, 0x4a, 0x2c, 0xba, 0x53, 0x67, 0x1f, 0xec, 0x6f, 0xdf, 0xae, 0xd7, 0x84, 0xf6, 0x0b,
+	0xf6, 0xd0, 0xa4, 0x64, 0xe8, 0xd0, 0x73, 0x2c, 0xed, 0xd9, 0x3f, 0x59, 0x9d, 0x48, 0x48, 0x33,
+	0xe7, 0xd6, 0x5f, 0xf6, 0xc9, 0xd0, 0x5e, 0x61, 0xb9, 0xf9, 0x5e, 0x03, 0x38, 0xd1, 0x3d, 0xed,
+	0x13, 0xdd, 0xa2, 0xc4, 0x90, 0x93, 0x1e, 0x92, 0xa0, 0x06, 0xe4, 0x59, 0x6b, 0xe4, 0x11, 0x43,
+	0x6e, 0x73, 0x82, 0x76, 0x28, 0xce, 0xdc, 0x93, 0xc5, 0x19, 0x9d, 0xe5, 0xfc, 0xc4, 0x2c, 0x87,
+	0xb8, 0x5c, 0x21, 0xcc, 0xe5, 0xd8, 0xd8, 0x1c, 0xd7, 0xb4, 0x5d, 0x93, 0x9e, 0xf3, 0x4f, 0x93,
+	0xc2, 0x41, 0x5b, 0xfd, 0x4d, 0x68, 0x35, 0x8f, 0xe9, 0xf8, 0x7f, 0xdd, 0xdc, 0xa9, 0x7f, 0x4f,
+	0x42, 0xcd, 0x9f, 0x87, 0x60, 0xcb, 0xf1, 0x0b, 0xb8, 0x3a, 0x01, 0x6a, 0x12, 0x0a, 0xbc, 0x7a,
+	0x72, 0x49, 0x6c, 0xbb, 0x1c, 0xc5, 0x36, 0x81, 0x04, 0x5e, 0x28, 0xac, 0xd4, 0x13, 0x86, 0xb5,
+	0x00, 0xb3, 0x8c, 0x27, 0xc3, 0xac, 0x58, 0xbc, 0x25, 0x17, 0xad, 0x21, 0xcc, 0xc0, 0x5b, 0x75,
+	0x17, 0x2a, 0xfe, 0x9c, 0x0b, 0x0a, 0x36, 0xf3, 0x27, 0x7b, 0x0a, 0xca, 0x2e, 0xa1, 0x2c, 0xb0,
+	0xc8, 0xfe, 0xbd, 0x24, 0x84, 0x32, 0xc9, 0x1d, 0xc2, 0xe5, 0x99, 0x54, 0x0c, 0xbd, 0x0a, 0x85,
+	0x31, 0x8b, 0x53, 0x62, 0xb6, 0xc2, 0xbe, 0x3a, 0x1e, 0xeb, 0xaa, 0x7f, 0x52, 0xe0, 0xf2, 0x4c,
+	0x32, 0x86, 0x5a, 0x90, 0x75, 0x89, 0x37, 0x1a, 0x88, 0x2d, 0x5c, 0x65, 0xeb, 0xc5, 0xe5, 0x48,
+	0x1c, 0x93, 0x8e, 0x06, 0x14, 0x4b, 0x63, 0xf5, 0x11, 0x64, 0x85, 0x04, 0x15, 0x21, 0xf7, 0x60,
+	0xff, 0xfe, 0xfe, 0xc1, 0x7b, 0xfb, 0xb5, 0x04, 0x02, 0xc8, 0x6e, 0x37, 0x9b, 0xad, 0xc3, 0x4e,
+	0x4d, 0x41, 0x05, 0xc8, 0x6c, 0xef, 0x1c, 0xe0, 0x4e, 0x2d, 0xc9, 0xc4, 0xb8, 0xf5, 0x76, 0xab,
+	0xd9, 0xa9, 0xa5, 0xd0, 0x0a, 0x94, 0xc5, 0xb3, 0x76, 0xef, 0x00, 0xbf, 0xb3, 0xdd, 0xa9, 0xa5,
+	0x43, 0xa2, 0xa3, 0xd6, 0xfe, 0x9b, 0x2d, 0x5c, 0xcb, 0xa8, 0x2f, 0xc1, 0x35, 0x7f, 0x1c, 0xd3,
+	0xdb, 0xd0, 0x60, 0x37, 0xa8, 0x84, 0x76, 0x83, 0xea, 0xef, 0x92, 0xd0, 0x88, 0xe7, 0x72, 0xe8,
+	0xed, 0x89, 0xc0, 0xb7, 0x2e, 0x40, 0x04, 0x27, 0xa2, 0x67, 0xd5, 0x21, 0x97, 0x1c, 0x13, 0xda,
+	0xeb, 0x0b, 0x6e, 0xc9, 0x96, 0x54, 0xea, 0x76, 0x19, 0x97, 0xa5, 0x94, 0x1b, 0x79, 0x42, 0xed,
+	0x23, 0xd2, 0xa3, 0x9a, 0x00, 0x33, 0xb1, 0x60, 0x0a, 0xb8, 0x2c, 0xa4, 0x47, 0x42, 0xa8, 0x7e,
+	0x78, 0xa1, 0xb9, 0x2c, 0x40, 0x06, 0xb7, 0x3a, 0xf8, 0x97, 0xb5, 0x14, 0x42, 0x50, 0xe1, 0x8f,
+	0xda, 0xd1, 0xfe, 0xf6, 0xe1, 0x51, 0xfb, 0x80, 0xcd, 0xe5, 0x25, 0xa8, 0xfa, 0x73, 0xe9, 0x0b,
+	0x33, 0xea, 0xbf, 0x15, 0xa8, 0x4e, 0x2c, 0x6e, 0xb4, 0x05, 0x19, 0xb1, 0x3f, 0x89, 0x3b, 0xfd,
+	0xe0, 0x30, 0x22, 0x94, 0x71, 0xa6, 0xeb, 0xd7, 0xe2, 0x89, 0x2c, 0xbc, 0xcc, 0x02, 0x11, 0xb1,
+	0x38, 0xfd, 0xd2, 0x8c, 0x34, 0x0d, 0x2c, 0x58, 0x1d, 0x3d, 0x58, 0x47, 0xf5, 0xd4, 0xf4, 0xae,
+	0x48, 0x98, 0x07, 0x8b, 0x50, 0xda, 0x8f, 0x6d, 0xd0, 0xeb, 0x63, 0x92, 0x9b, 0x8e, 0x83, 0x06,
+	0xc9, 0x6a, 0xa5, 0xb1, 0xaf, 0xaf, 0x36, 0xa1, 0x18, 0x8a, 0x07, 0x5d, 0x87, 0xc2, 0x50, 0x3f,
+	0x93, 0x05, 0x40, 0x51, 0x92, 0xc9, 0x0f, 0xf5, 0x33, 0x51, 0xfb, 0xbb, 0x0a, 0x39, 0xd6, 0x79,
+	0xa2, 0x0b, 0xa4, 0x4c, 0xe1, 0xec, 0x50, 0x3f, 0x7b, 0x4b, 0xf7, 0xd4, 0xdf, 0x2b, 0x50, 0x89,
+	0x56, 0xb3, 0xd8, 0xaf, 0xe8, 0xda, 0x23, 0xcb, 0xe0, 0x4e, 0x32, 0x58, 0x34, 0x18, 0xe7, 0xfe,
+	0x78, 0x64, 0xbb, 0xa3, 0x61, 0x98, 0x06, 0x82, 0x10, 0x71, 0x26, 0xf8, 0x2c, 0x54, 0x05, 0x85,
+	0xf6, 0xcc, 0x13, 0x4b, 0xa7, 0x23, 0x57, 0x54, 0xf0, 0x4a, 0xb8, 0xc2, 0xc5, 0x47, 0xbe, 0x94,
+	0x29, 0x8a, 0x5a, 0xe5, 0x58, 0x51, 0x90, 0xed, 0x0a, 0x17, 0x07, 0x8a, 0xea, 0xa7, 0x90, 0xe1,
+	0xa8, 0xcb, 0x50, 0x88, 0xd7, 0xb4, 0xe4, 0xee, 0x80, 0x3d, 0xa3, 0x0f, 0x00, 0x74, 0x4a, 0x5d,
+	0xb3, 0x3b, 0x12, 0xf0, 0x9f, 0x9a, 0xb9, 0xa3, 0xe4, 0xf6, 0xdb, 0xbe, 0xde, 0xce, 0x0d, 0x09,
+	0xdf, 0xab, 0x63, 0xd3, 0x10, 0x84, 0x87, 0x1c, 0xaa, 0xfb, 0x50, 0x89, 0xda, 0x86, 0xab, 0xd2,
+	0xa5, 0x19, 0x55, 0xe9, 0x80, 0xcb, 0x05, 0x4c, 0x30, 0x25, 0xea, 0x97, 0xbc, 0xa1, 0x7e, 0xa1,
+	0x40, 0xbe, 0x73, 0x26, 0xd7, 0x44, 0x4c, 0xe9, 0x6c, 0x6c, 0x9a, 0x0c, 0x17, 0x8a, 0x44, 0x2d,
+	0x2e, 0x15, 0x54, 0xf8, 0xde, 0x08, 0x56, 0x7d, 0x7a, 0xd9, 0xad, 0xbc, 0x5f, 0xea, 0x94, 0x48,
+	0xb7, 0x0d, 0x85, 0xe0, 0x97, 0x64, 0x2f, 0x75, 0xec, 0x4f, 0x64, 0xc1, 0x29, 0x85, 0x45, 0x03,
+	0xad, 0x41, 0xd1, 0x71, 0x6d, 0x8d, 0x9e, 0x89, 0xcf, 0x2d, 0xbe, 0x24, 0x23, 0xa9, 0x9d, 0x33,
+	0x5e, 0x52, 0xfb, 0x5c, 0x81, 0x6a, 0xe0, 0x43, 0xe6, 0xa6, 0xff, 0x87, 0x9c, 0x33, 0xea, 0x6a,
+	0xfe, 0x2c, 0x4d, 0x2c, 0x40, 0x9f, 0xc3, 0x8e, 0xba, 0x03, 0xb3, 0x77, 0x9f, 0x9c, 0xcb, 0x3c,
+	0x94, 0x75, 0x46, 0xdd, 0xfb, 0x62, 0x32, 0xc5, 0x30, 0x92, 0x73, 0x86, 0x91, 0x9a, 0x1c, 0xc6,
+	0x77, 0x0a, 0xa0, 0xe9, 0x14, 0x87, 0x8e, 0x60, 0x65, 0x9c, 0x25, 0x7d, 0x8a, 0x20, 0x92, 0xcd,
+	0xad, 0xf8, 0x14, 0x19, 0xd9, 0x8f, 0xd4, 0x4e, 0xa3, 0x62, 0x0f, 0x75, 0x60, 0x95, 0xf6, 0x5d,
+	0xe2, 0xf5, 0xed, 0x81, 0xa1, 0x39, 0x3c, 0x0c, 0x1e, 0x6b, 0x72, 0xc9, 0x58, 0x13, 0x18, 0x05,
+	0xf6, 0x41, 0xcf, 0xc2, 0x75, 0xa5, 0x3a, 0x50, 0xef, 0x4c, 0x99, 0xc9, 0x38, 0xe3, 0x86, 0xa4,
+	0x3c, 0xc9, 0x90, 0xd4, 0xbb, 0x50, 0x7b, 0x37, 0x78, 0xbf, 0x7c, 0xd3, 0xc4, 0x30, 0x95, 0xa9,
+	0x61, 0x9e, 0x42, 0xfe, 0xa1, 0x4d, 0xc5, 0x6e, 0xfe, 0x67, 0x61, 0x54, 0xf4, 0x0f, 0x62, 0x62,
+	0xa7, 0x5d, 0x8e, 0x64, 0x6c, 0xc2, 0xb6, 0xef, 0x0c, 0x1b, 0x88, 0xa1, 0x8d, 0x77, 0xe6, 0x7c,
+	0x9a, 0xf3, 0xb8, 0x2a, 0x3a, 0xf6, 0xfc, 0x6d, 0xb9, 0xfa, 0x2f, 0x05, 0xf2, 0x3e, 0x3c, 0xa3,
+	0x97, 0x42, 0x40, 0x51, 0x99, 0x51, 0x67, 0xf4, 0x15, 0xc7, 0xd5, 0xef, 0xe8, 0x58, 0x93, 0x17,
+	0x1f, 0x6b, 0xdc, 0x31, 0x86, 0x7f, 0x0e, 0x95, 0xbe, 0xf0, 0x39, 0xd4, 0x0b, 0x80, 0xa8, 0x4d,
+	0xf5, 0x81, 0x76, 0x6a, 0x53, 0xd3, 0x3a, 0xd1, 0xc4, 0xb2, 0x10, 0x34, 0xbd, 0xc6, 0x7b, 0x1e,
+	0xf2, 0x8e, 0x43, 0x26, 0x57, 0xff, 0xa8, 0x40, 0x3e, 0x60, 0x42, 0x17, 0x2d, 0x66, 0x5f, 0x81,
+	0xac, 0x4c, 0xf6, 0xa2, 0x9a, 0x2d, 0x5b, 0xc1, 0xb9, 0x4a, 0x3a, 0x74, 0xae, 0xd2, 0x80, 0xfc,
+	0x90, 0x50, 0x9d, 0xd3, 0x41, 0x81, 0xd7, 0x41, 0x1b, 0xbd, 0x0a, 0xf5, 0x05, 0xf5, 0x90, 0xcb,
+	0xbd, 0x59, 0xb5, 0x90, 0x3b, 0xaf, 0x43, 0x31, 0x74, 0x20, 0xc1, 0x30, 0x76, 0xbf, 0xf5, 0x5e,
+	0x2d, 0xd1, 0xc8, 0x7d, 0xf1, 0xd5, 0xad, 0xd4, 0x3e, 0xf9, 0x84, 0x15, 0x81, 0x70, 0xab, 0xd9,
+	0x6e, 0x35, 0xef, 0xd7, 0x94, 0x46, 0xf1, 0x8b, 0xaf, 0x6e, 0xe5, 0x30, 0xe1, 0x75, 0xcd, 0x3b,
+	0x5d, 0x28, 0x85, 0x3f, 0x67, 0x94, 0x68, 0x20, 0xa8, 0xbc, 0xf9, 0xe0, 0x70, 0x6f, 0xb7, 0xb9,
+	0xdd, 0x69, 0x69, 0x0f, 0x0f, 0x3a, 0xad, 0x9a, 0x82, 0xae, 0xc2, 0xa5, 0xbd, 0xdd, 0xb7, 0xda,
+	0x1d, 0xad, 0xb9, 0xb7, 0xdb, 0xda, 0xef, 0x68, 0xdb, 0x9d, 0xce, 0x76, 0xf3, 0x7e, 0x2d, 0x89,
+	0xea, 0xb0, 0x3a, 0x56, 0x3e, 0xea, 0x04, 0x26, 0xa9, 0xad, 0xcf, 0x00, 0xaa, 0xdb, 0x3b, 0xcd,
+	0x5d, 0x46, 0x9f, 0xcc, 0x9e, 0x2e, 0x2b, 0xca, 0x69, 0x5e, 0xee, 0x9a, 0x7b, 0xb7, 0xa2, 0x31,
+	0xbf, 0xa0, 0x8e, 0xee, 0x41, 0x86, 0x57, 0xc2, 0xd0, 0xfc, 0xcb, 0x16, 0x8d, 0x05, 0x15, 0x76,
+	0x36, 0x18, 0xbe, 0xe2, 0xe6, 0xde, 0xbe, 0x68, 0xcc, 0x2f, 0xb8, 0x23, 0x0c, 0x85, 0x71, 0x21,
+	0x6a, 0xf1, 0x6d, 0x8c, 0xc6, 0x12, 0x45, 0x78, 0xe6, 0x73, 0xbc, 0x7d, 0x5d, 0x7c, 0x3b, 0xa1,
+	0xb1, 0x44, 0x12, 0x43, 0x7b, 0x90, 0xf3, 0x8b, 0x09, 0x8b, 0xee, 0x4b, 0x34, 0x16, 0x16, 0xc8,
+	0xd9, 0x27, 0x10, 0x45, 0x9f, 0xf9, 0x97, 0x3f, 0x1a, 0x0b, 0xaa, 0xfd, 0x68, 0x17, 0xb2, 0x72,
+	0xb3, 0xb4, 0xe0, 0x0e, 0x44, 0x63, 0x51, 0xc1, 0x9b, 0x4d, 0xda, 0xb8, 0x82, 0xb7, 0xf8, 0x4a,
+	0x4b, 0x63, 0x89, 0x83, 0x0c, 0xf4, 0x00, 0x20, 0x54, 0xe2, 0x59, 0xe2, 0xae, 0x4a, 0x63, 0x99,
+	0x03, 0x0a, 0x74, 0x00, 0xf9, 0x60, 0x5b, 0xbe, 0xf0, 0xe6, 0x48, 0x63, 0xf1, 0x49, 0x01, 0x7a,
+	0x04, 0xe5, 0xe8, 0x46, 0x71, 0xb9, 0xfb, 0x20, 0x8d, 0x25, 0x8f, 0x00, 0x98, 0xff, 0xe8, 0xae,
+	0x71, 0xb9, 0xfb, 0x21, 0x8d, 0x25, 0x4f, 0x04, 0xd0, 0x47, 0xb0, 0x32, 0xbd, 0xab, 0x5b, 0xfe,
+	0xba, 0x48, 0xe3, 0x02, 0x67, 0x04, 0x68, 0x08, 0x68, 0xc6, 0x6e, 0xf0, 0x02, 0xb7, 0x47, 0x1a,
+	0x17, 0x39, 0x32, 0xd8, 0x69, 0x7d, 0xfd, 0xfd, 0x9a, 0xf2, 0xcd, 0xf7, 0x6b, 0xca, 0x77, 0xdf,
+	0xaf, 0x29, 0x5f, 0xfe, 0xb0, 0x96, 0xf8, 0xe6, 0x87, 0xb5, 0xc4, 0x9f, 0x7f, 0x58, 0x4b, 0xfc,
+	0xea, 0xf9, 0x13, 0x93, 0xf6, 0x47, 0xdd, 0x8d, 0x9e, 0x3d, 0xdc, 0x0c, 0x5f, 0x6d, 0x9b, 0x75,
+	0xdd, 0xae, 0x9b, 0xe5, 0xb9, 0xef, 0xee, 0x7f, 0x06, 0x00, 0xb8, 0xe1, 0x70, 0xf4, 0x8e, 0x27,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5891,6 +5912,18 @@ func (m *ResponseCheckTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Codespace) > 0 {
 		i -= len(m.Codespace)
 		copy(dAtA[i:], m.Codespace)
@@ -7804,6 +7837,13 @@ func (m *ResponseCheckTx) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovTypes(uint64(m.Priority))
+	}
 	return n
 }
 
@@ -12480,6 +12520,57 @@ func (m *ResponseCheckTx) Unmarshal(dAtA []byte) error {
 			}
 			m.Codespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
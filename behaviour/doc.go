@@ -4,6 +4,10 @@ Package Behaviour provides a mechanism for reactors to report behaviour of peers
 Instead of a reactor calling the switch directly it will call the behaviour module which will
 handle the stoping and marking peer as good on behalf of the reactor.
 
+The switch also records the reported behaviour into the trust metrics of the peers, if it has
+a trust metric store. The PEX reactor uses the resulting trust scores to pick the peers to
+dial and the inbound peers to evict.
+
 There are four different behaviours a reactor can report.
 
 1. bad message
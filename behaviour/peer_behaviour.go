@@ -6,9 +6,11 @@ import (
 
 // PeerBehaviour is a struct describing a behaviour a peer performed.
 // `peerID` identifies the peer and reason characterizes the specific
-// behaviour performed by the peer.
+// behaviour performed by the peer. `peer` is only set when the behaviour is
+// reported with the peer itself.
 type PeerBehaviour struct {
 	peerID p2p.ID
+	peer   p2p.Peer
 	reason interface{}
 }
 
@@ -21,6 +23,13 @@ func BadMessage(peerID p2p.ID, explanation string) PeerBehaviour {
 	return PeerBehaviour{peerID: peerID, reason: badMessage{explanation}}
 }
 
+// BadMessageFromPeer returns a badMessage PeerBehaviour of the given peer.
+// Unlike BadMessage, it can be reported before the peer is added to the
+// switch, e.g. from Reactor.Receive.
+func BadMessageFromPeer(peer p2p.Peer, explanation string) PeerBehaviour {
+	return PeerBehaviour{peerID: peer.ID(), peer: peer, reason: badMessage{explanation}}
+}
+
 type messageOutOfOrder struct {
 	explanation string
 }
@@ -27,7 +27,10 @@ func NewSwitchReporter(sw *p2p.Switch) *SwitchReporter {
 
 // Report reports the behaviour of a peer to the Switch.
 func (spbr *SwitchReporter) Report(behaviour PeerBehaviour) error {
-	peer := spbr.sw.Peers().Get(behaviour.peerID)
+	peer := behaviour.peer
+	if peer == nil {
+		peer = spbr.sw.Peers().Get(behaviour.peerID)
+	}
 	if peer == nil {
 		return errors.New("peer not found")
 	}
@@ -36,8 +39,10 @@ func (spbr *SwitchReporter) Report(behaviour PeerBehaviour) error {
 	case consensusVote, blockPart:
 		spbr.sw.MarkPeerAsGood(peer)
 	case badMessage:
+		spbr.sw.MarkPeerAsBad(peer)
 		spbr.sw.StopPeerForError(peer, reason.explanation)
 	case messageOutOfOrder:
+		spbr.sw.MarkPeerAsBad(peer)
 		spbr.sw.StopPeerForError(peer, reason.explanation)
 	default:
 		return errors.New("unknown reason reported")
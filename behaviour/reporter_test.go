@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	bh "github.com/tendermint/tendermint/behaviour"
+	"github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/mock"
 )
 
 // TestMockReporter tests the MockReporter's ability to store reported
@@ -203,3 +205,30 @@ func TestMockPeerBehaviourReporterConcurrency(t *testing.T) {
 		}
 	}
 }
+
+// TestSwitchReporterPeerNotAdded tests that a bad message reported with the
+// peer itself stops the peer, even if it wasn't added to the switch yet.
+func TestSwitchReporterPeerNotAdded(t *testing.T) {
+	sw := p2p.MakeSwitch(config.DefaultP2PConfig(), 1, "testing", "123.123.123", nil,
+		func(i int, sw *p2p.Switch) *p2p.Switch { return sw })
+	if err := sw.Start(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := sw.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	peer := mock.NewPeer(nil)
+	pr := bh.NewSwitchReporter(sw)
+
+	if err := pr.Report(bh.BadMessage(peer.ID(), "bad message")); err == nil {
+		t.Error("Expected the peer not to be found by its ID")
+	}
+	if err := pr.Report(bh.BadMessageFromPeer(peer, "bad message")); err != nil {
+		t.Error(err)
+	}
+	if peer.IsRunning() {
+		t.Error("Expected the peer to be stopped")
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/tendermint/tendermint/crypto"
 
+	"github.com/tendermint/tendermint/behaviour"
 	bc "github.com/tendermint/tendermint/blockchain"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/p2p"
@@ -62,6 +63,9 @@ type BlockchainReactor struct {
 
 	requestsCh <-chan BlockRequest
 	errorsCh   <-chan peerError
+
+	// reports the behaviour of the peers to the Switch
+	reporter behaviour.Reporter
 }
 
 // NewBlockchainReactor returns new reactor instance.
@@ -99,6 +103,13 @@ func NewBlockchainReactor(
 	return bcR
 }
 
+// SetSwitch implements Reactor by setting the Switch the behaviour of the
+// peers is reported to.
+func (bcR *BlockchainReactor) SetSwitch(sw *p2p.Switch) {
+	bcR.Switch = sw
+	bcR.reporter = behaviour.NewSwitchReporter(sw)
+}
+
 // SetLogger implements service.Service by setting the logger on reactor and pool.
 func (bcR *BlockchainReactor) SetLogger(l log.Logger) {
 	bcR.BaseService.Logger = l
@@ -208,18 +219,25 @@ func (bcR *BlockchainReactor) respondToPeer(msg *bcproto.BlockRequest,
 	return src.TrySend(BlockchainChannel, msgBytes)
 }
 
+// reportPeer reports the behaviour of a peer to the Switch.
+func (bcR *BlockchainReactor) reportPeer(pb behaviour.PeerBehaviour) {
+	if err := bcR.reporter.Report(pb); err != nil {
+		bcR.Logger.Debug("Error reporting peer", "err", err)
+	}
+}
+
 // Receive implements Reactor by handling 4 types of messages (look below).
 func (bcR *BlockchainReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 	msg, err := bc.DecodeMsg(msgBytes)
 	if err != nil {
 		bcR.Logger.Error("Error decoding message", "src", src, "chId", chID, "err", err)
-		bcR.Switch.StopPeerForError(src, err)
+		bcR.reportPeer(behaviour.BadMessageFromPeer(src, err.Error()))
 		return
 	}
 
 	if err = bc.ValidateMsg(msg); err != nil {
 		bcR.Logger.Error("Peer sent us invalid msg", "peer", src, "msg", msg, "err", err)
-		bcR.Switch.StopPeerForError(src, err)
+		bcR.reportPeer(behaviour.BadMessageFromPeer(src, err.Error()))
 		return
 	}
 
@@ -302,10 +320,7 @@ func (bcR *BlockchainReactor) poolRoutine(stateSynced bool) {
 					bcR.Logger.Debug("Send queue is full, drop block request", "peer", peer.ID(), "height", request.Height)
 				}
 			case err := <-bcR.errorsCh:
-				peer := bcR.Switch.Peers().Get(err.peerID)
-				if peer != nil {
-					bcR.Switch.StopPeerForError(peer, err)
-				}
+				bcR.reportPeer(behaviour.BadMessage(err.peerID, err.Error()))
 
 			case <-statusUpdateTicker.C:
 				// ask for status updates
@@ -378,19 +393,14 @@ FOR_LOOP:
 				chainID, firstID, firstStateID, first.Height, second.LastCommit)
 			if err != nil {
 				bcR.Logger.Error("Error in validation", "err", err)
+				explanation := fmt.Sprintf("blockchainReactor validation error: %v", err)
+				// NOTE: we've already removed the peers' requests, but we
+				// still need to clean up the rest.
 				peerID := bcR.pool.RedoRequest(first.Height)
-				peer := bcR.Switch.Peers().Get(peerID)
-				if peer != nil {
-					// NOTE: we've already removed the peer's request, but we
-					// still need to clean up the rest.
-					bcR.Switch.StopPeerForError(peer, fmt.Errorf("blockchainReactor validation error: %v", err))
-				}
+				bcR.reportPeer(behaviour.BadMessage(peerID, explanation))
 				peerID2 := bcR.pool.RedoRequest(second.Height)
-				peer2 := bcR.Switch.Peers().Get(peerID2)
-				if peer2 != nil && peer2 != peer {
-					// NOTE: we've already removed the peer's request, but we
-					// still need to clean up the rest.
-					bcR.Switch.StopPeerForError(peer2, fmt.Errorf("blockchainReactor validation error: %v", err))
+				if peerID2 != peerID {
+					bcR.reportPeer(behaviour.BadMessage(peerID2, explanation))
 				}
 				continue FOR_LOOP
 			} else {
@@ -468,13 +468,13 @@ func (r *BlockchainReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 	if err != nil {
 		r.logger.Error("error decoding message",
 			"src", src.ID(), "chId", chID, "msg", msg, "err", err)
-		_ = r.reporter.Report(behaviour.BadMessage(src.ID(), err.Error()))
+		_ = r.reporter.Report(behaviour.BadMessageFromPeer(src, err.Error()))
 		return
 	}
 
 	if err = bc.ValidateMsg(msg); err != nil {
 		r.logger.Error("peer sent us invalid msg", "peer", src, "msg", msg, "err", err)
-		_ = r.reporter.Report(behaviour.BadMessage(src.ID(), err.Error()))
+		_ = r.reporter.Report(behaviour.BadMessageFromPeer(src, err.Error()))
 		return
 	}
 
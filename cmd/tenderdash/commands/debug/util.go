@@ -27,7 +27,7 @@ func dumpStatus(rpc *rpchttp.HTTP, dir, filename string) error {
 // dumpNetInfo gets network information state dump from the Tendermint RPC and
 // writes it to file. It returns an error upon failure.
 func dumpNetInfo(rpc *rpchttp.HTTP, dir, filename string) error {
-	netInfo, err := rpc.NetInfo(context.Background())
+	netInfo, err := rpc.NetInfoDetailed(context.Background())
 	if err != nil {
 		return fmt.Errorf("failed to get node network information: %w", err)
 	}
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	tmos "github.com/tendermint/tendermint/libs/os"
+	"github.com/tendermint/tendermint/privval"
+)
+
+// EncryptPrivValidatorKeyCmd encrypts a plaintext private validator key file in place.
+var EncryptPrivValidatorKeyCmd = &cobra.Command{
+	Use:     "encrypt-priv-validator-key",
+	Aliases: []string{"encrypt_priv_validator_key"},
+	Short:   "Encrypt this node's private validator key file with a passphrase",
+	Long: `Encrypt this node's private validator key file with a passphrase.
+
+The passphrase is read from the ` + privval.KeyPassphraseEnv + ` environment variable,
+or else from the priv_validator_key_passphrase_file of the config, or else prompted for
+on the terminal. The node then needs the same passphrase to unlock the key on startup.`,
+	RunE:   encryptPrivValidatorKey,
+	PreRun: deprecateSnakeCase,
+}
+
+func encryptPrivValidatorKey(cmd *cobra.Command, args []string) error {
+	keyFilePath := config.PrivValidatorKeyFile()
+	if !tmos.FileExists(keyFilePath) {
+		return fmt.Errorf("private validator file %s does not exist", keyFilePath)
+	}
+	encrypted, err := privval.IsEncryptedKeyFile(keyFilePath)
+	if err != nil {
+		return err
+	}
+	if encrypted {
+		return fmt.Errorf("private validator file %s is already encrypted", keyFilePath)
+	}
+
+	passphrase, err := newKeyPassphrase()
+	if err != nil {
+		return err
+	}
+
+	// the state file isn't touched, only the key file is saved again
+	pv := privval.LoadFilePVEmptyState(keyFilePath, config.PrivValidatorStateFile())
+	if err := pv.Key.SetPassphrase(passphrase); err != nil {
+		return err
+	}
+	pv.Key.Save()
+
+	logger.Info("Encrypted private validator key", "keyFile", keyFilePath)
+	return nil
+}
+
+// newKeyPassphrase returns the passphrase to encrypt the private validator key with.
+func newKeyPassphrase() ([]byte, error) {
+	if passphrase, ok := os.LookupEnv(privval.KeyPassphraseEnv); ok {
+		return []byte(passphrase), nil
+	}
+	if passphraseFile := config.PrivValidatorKeyPassphraseFile(); passphraseFile != "" {
+		return privval.ReadKeyPassphraseFile(passphraseFile)
+	}
+	return privval.PromptNewKeyPassphrase()
+}
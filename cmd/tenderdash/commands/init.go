@@ -101,7 +101,8 @@ func initFilesSingleNodeWithConfig(config *cfg.Config) error {
 	privValStateFile := config.PrivValidatorStateFile()
 	var pv *privval.FilePV
 	if tmos.FileExists(privValKeyFile) {
-		pv = privval.LoadFilePV(privValKeyFile, privValStateFile)
+		pv = privval.LoadFilePVWithPassphrase(privValKeyFile, privValStateFile,
+			privval.DefaultKeyPassphrase(config.PrivValidatorKeyPassphraseFile()))
 		logger.Info("Found private validator", "keyFile", privValKeyFile,
 			"stateFile", privValStateFile)
 	} else {
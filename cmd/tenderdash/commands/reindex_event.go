@@ -0,0 +1,214 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/libs/tempfile"
+	nm "github.com/tendermint/tendermint/node"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/store"
+)
+
+const (
+	reindexProgressFile = "reindex-event.json"
+
+	// reindexProgressInterval is the number of blocks between two progress
+	// reports, which are saved to the progress file as well.
+	reindexProgressInterval = 100
+)
+
+var (
+	reindexStartHeight int64
+	reindexEndHeight   int64
+	reindexResume      bool
+)
+
+// ReIndexEventCmd reindexes the events of the stored blocks into the indexers
+// of the config.
+var ReIndexEventCmd = &cobra.Command{
+	Use:   "reindex-event",
+	Short: "Reindex the events of the stored blocks into the configured indexers",
+	Long: `Reindex the events of the stored blocks and their ABCI responses into the
+indexers of the tx_index.indexer config, e.g. after changing which events the
+application indexes, or to rebuild a corrupted index.
+
+The blocks from --start-height up to --end-height are reindexed, by default all
+the blocks of the block store. The progress is saved in the data directory every
+` + fmt.Sprint(reindexProgressInterval) + ` blocks, so that an interrupted reindexing continues with --resume.
+The node must not be running.`,
+	Example: `  tenderdash reindex-event
+  tenderdash reindex-event --start-height 2 --end-height 10
+  tenderdash reindex-event --resume`,
+	RunE: reindexEvent,
+}
+
+func init() {
+	addReindexEventFlags(ReIndexEventCmd)
+}
+
+func addReindexEventFlags(cmd *cobra.Command) {
+	cmd.Flags().Int64Var(&reindexStartHeight, "start-height", 0,
+		"height of the first block to reindex (default: the base of the block store)")
+	cmd.Flags().Int64Var(&reindexEndHeight, "end-height", 0,
+		"height of the last block to reindex (default: the height of the block store)")
+	cmd.Flags().BoolVar(&reindexResume, "resume", false,
+		"continue the last interrupted reindexing, can't be combined with the heights")
+}
+
+// reindexProgress is the progress of a reindexing, saved to resume it.
+type reindexProgress struct {
+	EndHeight  int64 `json:"end_height"`
+	LastHeight int64 `json:"last_height"`
+}
+
+func reindexEvent(cmd *cobra.Command, args []string) error {
+	// the heights of a resumed reindexing are the ones it was started with
+	if reindexResume && (cmd.Flags().Changed("start-height") || cmd.Flags().Changed("end-height")) {
+		return errors.New("--resume can't be combined with --start-height or --end-height")
+	}
+
+	blockStoreDB, err := nm.DefaultDBProvider(&nm.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return err
+	}
+	defer blockStoreDB.Close()
+	blockStore := store.NewBlockStore(blockStoreDB)
+
+	stateDB, err := nm.DefaultDBProvider(&nm.DBContext{ID: "state", Config: config})
+	if err != nil {
+		return err
+	}
+	defer stateDB.Close()
+	stateStore := sm.NewStore(stateDB)
+
+	state, err := stateStore.Load()
+	if err != nil {
+		return err
+	}
+	if state.IsEmpty() {
+		return errors.New("no state found, the node has not stored any block yet")
+	}
+
+	sinks, err := nm.IndexerSinks(config, state.ChainID, nm.DefaultDBProvider)
+	if err != nil {
+		return err
+	}
+	defer nm.CloseIndexerSinks(sinks)
+	if len(sinks) == 0 {
+		return errors.New("no indexer to reindex into, tx_index.indexer is null")
+	}
+
+	progressFile := filepath.Join(config.DBDir(), reindexProgressFile)
+	start, end := reindexStartHeight, reindexEndHeight
+	if reindexResume {
+		progress, err := loadReindexProgress(progressFile)
+		if err != nil {
+			return fmt.Errorf("can't resume reindexing: %w", err)
+		}
+		start, end = progress.LastHeight+1, progress.EndHeight
+	}
+
+	return reindexEvents(blockStore, stateStore, sinks, start, end, progressFile, logger)
+}
+
+// reindexEvents indexes the blocks from start to end into the sinks. A start
+// or end of 0 stands for the base or height of the block store. The progress
+// is saved to progressFile every reindexProgressInterval blocks, and the file
+// is removed once all the blocks are reindexed.
+func reindexEvents(
+	blockStore txindex.BlockStore,
+	stateStore sm.Store,
+	sinks []txindex.Sink,
+	start, end int64,
+	progressFile string,
+	logger log.Logger,
+) error {
+	base, height := blockStore.Base(), blockStore.Height()
+	if start == 0 {
+		start = base
+	}
+	if end == 0 {
+		end = height
+	}
+	switch {
+	case start < base:
+		return fmt.Errorf("start height %d is below the base %d of the block store", start, base)
+	case end > height:
+		return fmt.Errorf("end height %d is above the height %d of the block store", end, height)
+	case start > end:
+		return fmt.Errorf("start height %d is above the end height %d", start, end)
+	}
+
+	loader := txindex.NewBlockEventsLoader(blockStore, stateStore)
+	logger.Info("Reindexing events", "start", start, "end", end)
+	for h := start; h <= end; h++ {
+		if err := reindexBlock(loader, sinks, h); err != nil {
+			if h > start {
+				if err := saveReindexProgress(progressFile, reindexProgress{EndHeight: end, LastHeight: h - 1}); err != nil {
+					logger.Error("Failed to save the reindexing progress", "err", err)
+				}
+			}
+			return fmt.Errorf("reindexing block %d: %w", h, err)
+		}
+
+		if (h-start+1)%reindexProgressInterval == 0 && h < end {
+			logger.Info("Reindexed events", "height", h, "end", end,
+				"progress", fmt.Sprintf("%.1f%%", float64(h-start+1)*100/float64(end-start+1)))
+			if err := saveReindexProgress(progressFile, reindexProgress{EndHeight: end, LastHeight: h}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := os.Remove(progressFile); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	logger.Info("Reindexed events", "height", end, "end", end, "progress", "100.0%")
+	return nil
+}
+
+// reindexBlock indexes the block at the given height and its txs, with their
+// stored ABCI responses, into the sinks.
+func reindexBlock(loader *txindex.BlockEventsLoader, sinks []txindex.Sink, height int64) error {
+	header, batch, err := loader.Load(height)
+	if err != nil {
+		return err
+	}
+
+	for _, sink := range sinks {
+		if err := sink.BlockIndexer.Index(header); err != nil {
+			return fmt.Errorf("%s indexer failed to index block: %w", sink.Name, err)
+		}
+		if err := sink.TxIndexer.AddBatch(batch); err != nil {
+			return fmt.Errorf("%s indexer failed to index txs: %w", sink.Name, err)
+		}
+	}
+	return nil
+}
+
+func loadReindexProgress(progressFile string) (reindexProgress, error) {
+	var progress reindexProgress
+	bz, err := ioutil.ReadFile(progressFile)
+	if err != nil {
+		return progress, err
+	}
+	err = json.Unmarshal(bz, &progress)
+	return progress, err
+}
+
+func saveReindexProgress(progressFile string, progress reindexProgress) error {
+	bz, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(progressFile, bz, 0600)
+}
@@ -0,0 +1,129 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	blockidxkv "github.com/tendermint/tendermint/state/indexer/block/kv"
+	"github.com/tendermint/tendermint/state/mocks"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/state/txindex/kv"
+	"github.com/tendermint/tendermint/types"
+)
+
+// testBlockStore holds blocks with a tx each, from height 1.
+type testBlockStore struct {
+	height int64
+}
+
+func (bs testBlockStore) Base() int64   { return 1 }
+func (bs testBlockStore) Height() int64 { return bs.height }
+
+func (bs testBlockStore) LoadBlock(height int64) *types.Block {
+	if height < 1 || height > bs.height {
+		return nil
+	}
+	return &types.Block{
+		Header: types.Header{Height: height},
+		Data:   types.Data{Txs: types.Txs{testTx(height)}},
+	}
+}
+
+func testTx(height int64) types.Tx {
+	return types.Tx(fmt.Sprintf("tx%d", height))
+}
+
+func testABCIResponses(height int64) *tmstate.ABCIResponses {
+	return &tmstate.ABCIResponses{
+		DeliverTxs: []*abci.ResponseDeliverTx{{Code: abci.CodeTypeOK, Data: []byte{byte(height)}}},
+		BeginBlock: &abci.ResponseBeginBlock{},
+		EndBlock:   &abci.ResponseEndBlock{},
+	}
+}
+
+func TestReindexEvents(t *testing.T) {
+	dir, err := ioutil.TempDir("", "reindex_event_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	progressFile := filepath.Join(dir, reindexProgressFile)
+
+	store := dbm.NewMemDB()
+	txIndexer := kv.NewTxIndex(store)
+	blockIndexer := blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")))
+	sinks := []txindex.Sink{{Name: "kv", TxIndexer: txIndexer, BlockIndexer: blockIndexer}}
+
+	blockStore := testBlockStore{height: 5}
+	stateStore := &mocks.Store{}
+	// the ABCI responses of block 4 can't be loaded the first time
+	stateStore.On("LoadABCIResponses", int64(4)).Return(nil, errors.New("boom")).Once()
+	stateStore.On("LoadABCIResponses", mock.AnythingOfType("int64")).Return(
+		func(height int64) *tmstate.ABCIResponses { return testABCIResponses(height) }, nil)
+
+	// the heights must be in the block store
+	assert.Error(t, reindexEvents(blockStore, stateStore, sinks, 0, 6, progressFile, log.TestingLogger()))
+	assert.Error(t, reindexEvents(blockStore, stateStore, sinks, 3, 2, progressFile, log.TestingLogger()))
+
+	err = reindexEvents(blockStore, stateStore, sinks, 2, 0, progressFile, log.TestingLogger())
+	require.Error(t, err)
+
+	// the progress is saved up to the failing block
+	progress, err := loadReindexProgress(progressFile)
+	require.NoError(t, err)
+	assert.Equal(t, reindexProgress{EndHeight: 5, LastHeight: 3}, progress)
+	for height := int64(1); height <= 5; height++ {
+		ok, err := blockIndexer.Has(height)
+		require.NoError(t, err)
+		assert.Equal(t, height == 2 || height == 3, ok, height)
+	}
+
+	// resume
+	err = reindexEvents(blockStore, stateStore, sinks, progress.LastHeight+1, progress.EndHeight,
+		progressFile, log.TestingLogger())
+	require.NoError(t, err)
+
+	for height := int64(2); height <= 5; height++ {
+		ok, err := blockIndexer.Has(height)
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		txResult, err := txIndexer.Get(testTx(height).Hash())
+		require.NoError(t, err)
+		require.NotNil(t, txResult)
+		assert.Equal(t, height, txResult.Height)
+		assert.Equal(t, []byte{byte(height)}, txResult.Result.Data)
+	}
+
+	// the progress file is removed once done
+	_, err = os.Stat(progressFile)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestReindexEventResumeWithHeights(t *testing.T) {
+	defer func() {
+		reindexStartHeight, reindexEndHeight, reindexResume = 0, 0, false
+	}()
+
+	for _, flag := range []string{"start-height", "end-height"} {
+		cmd := &cobra.Command{}
+		addReindexEventFlags(cmd)
+		require.NoError(t, cmd.Flags().Set("resume", "true"))
+		require.NoError(t, cmd.Flags().Set(flag, "2"))
+
+		err := reindexEvent(cmd, nil)
+		require.Error(t, err, flag)
+		assert.Contains(t, err.Error(), "--resume can't be combined")
+	}
+}
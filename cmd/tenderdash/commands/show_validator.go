@@ -26,7 +26,8 @@ func showValidator(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("private validator file %s does not exist", keyFilePath)
 	}
 
-	pv := privval.LoadFilePV(keyFilePath, config.PrivValidatorStateFile())
+	pv := privval.LoadFilePVWithPassphrase(keyFilePath, config.PrivValidatorStateFile(),
+		privval.DefaultKeyPassphrase(config.PrivValidatorKeyPassphraseFile()))
 
 	pubKey, err := pv.GetPubKey(crypto.QuorumHash{})
 	if err != nil {
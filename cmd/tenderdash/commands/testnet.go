@@ -144,7 +144,8 @@ func testnetFiles(cmd *cobra.Command, args []string) error {
 
 		pvKeyFile := filepath.Join(nodeDir, config.BaseConfig.PrivValidatorKey)
 		pvStateFile := filepath.Join(nodeDir, config.BaseConfig.PrivValidatorState)
-		pv := privval.LoadFilePV(pvKeyFile, pvStateFile)
+		pv := privval.LoadFilePVWithPassphrase(pvKeyFile, pvStateFile,
+			privval.DefaultKeyPassphrase(config.PrivValidatorKeyPassphraseFile()))
 
 		pubKey, err := pv.GetPubKey(crypto.QuorumHash{})
 		if err != nil {
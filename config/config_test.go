@@ -62,6 +62,14 @@ func TestBaseConfigValidateBasic(t *testing.T) {
 	// tamper with log format
 	cfg.LogFormat = "invalid"
 	assert.Error(t, cfg.ValidateBasic())
+
+	cfg = TestBaseConfig()
+	cfg.PrivValidatorCoreRPCTimeout = 0
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg = TestBaseConfig()
+	cfg.PrivValidatorCoreRPCMaxRetries = -1
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestRPCConfigValidateBasic(t *testing.T) {
@@ -96,6 +104,7 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 		"MaxPacketMsgPayloadSize",
 		"SendRate",
 		"RecvRate",
+		"ValidatorP2PPort",
 	}
 
 	for _, fieldName := range fieldsToTest {
@@ -103,6 +112,18 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 		assert.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	// the validator P2P port is only needed to connect to the validators
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.ValidatorConnections = false
+	assert.NoError(t, cfg.ValidateBasic())
+
+	// tamper with transport
+	cfg.Transport = "stream"
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.Transport = "invalid"
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestMempoolConfigValidateBasic(t *testing.T) {
@@ -114,6 +135,8 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		"MaxTxsBytes",
 		"CacheSize",
 		"MaxTxBytes",
+		"TTLDuration",
+		"TTLNumBlocks",
 	}
 
 	for _, fieldName := range fieldsToTest {
@@ -121,6 +144,11 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		assert.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	cfg.Type = MempoolTypePriority
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.Type = "invalid"
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestStateSyncConfigValidateBasic(t *testing.T) {
@@ -165,6 +193,10 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 		"PeerQueryMaj23SleepDuration":          {func(c *ConsensusConfig) { c.PeerQueryMaj23SleepDuration = time.Second }, false},
 		"PeerQueryMaj23SleepDuration negative": {func(c *ConsensusConfig) { c.PeerQueryMaj23SleepDuration = -1 }, true},
 		"DoubleSignCheckHeight negative":       {func(c *ConsensusConfig) { c.DoubleSignCheckHeight = -1 }, true},
+		"ChainLockPollInterval":                {func(c *ConsensusConfig) { c.ChainLockPollInterval = 0 }, false},
+		"VoteBatchWindow":                      {func(c *ConsensusConfig) { c.VoteBatchWindow = 0 }, false},
+		"VoteBatchWindow negative":             {func(c *ConsensusConfig) { c.VoteBatchWindow = -1 }, true},
+		"ChainLockPollInterval negative":       {func(c *ConsensusConfig) { c.ChainLockPollInterval = -1 }, true},
 	}
 	for desc, tc := range testcases {
 		tc := tc // appease linter
@@ -182,6 +214,29 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestTxIndexConfigValidateBasic(t *testing.T) {
+	cfg := TestTxIndexConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.Indexer = []string{"invalid"}
+	assert.Error(t, cfg.ValidateBasic())
+
+	// the psql indexer needs a connection string
+	cfg.Indexer = []string{"psql"}
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.PsqlConn = "postgresql://localhost:5432/tenderdash"
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.Indexer = []string{"kv", "psql"}
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.Indexer = []string{"kv", "kv"}
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg.Indexer = []string{"kv", "null"}
+	assert.Error(t, cfg.ValidateBasic())
+}
+
 func TestInstrumentationConfigValidateBasic(t *testing.T) {
 	cfg := TestInstrumentationConfig()
 	assert.NoError(t, cfg.ValidateBasic())
@@ -60,6 +60,9 @@ type Metrics struct {
 
 	// Number of blockparts transmitted by peer.
 	BlockParts metrics.Counter
+
+	// Time to recover the threshold signatures of a commit, by quorum size.
+	ThresholdSigRecoverySeconds metrics.Histogram
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -186,6 +189,13 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "block_parts",
 			Help:      "Number of blockparts transmitted by peer.",
 		}, append(labels, "peer_id")).With(labelsAndValues...),
+		ThresholdSigRecoverySeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "threshold_sig_recovery_seconds",
+			Help:      "Time to recover the threshold signatures of a commit, by quorum size.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.001, 2, 12),
+		}, append(labels, "quorum_size")).With(labelsAndValues...),
 	}
 }
 
@@ -216,5 +226,7 @@ func NopMetrics() *Metrics {
 		FastSyncing:     discard.NewGauge(),
 		StateSyncing:    discard.NewGauge(),
 		BlockParts:      discard.NewCounter(),
+
+		ThresholdSigRecoverySeconds: discard.NewHistogram(),
 	}
 }
@@ -25,6 +25,7 @@ import (
 	abcicli "github.com/tendermint/tendermint/abci/client"
 	"github.com/tendermint/tendermint/abci/example/kvstore"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/behaviour"
 	cfg "github.com/tendermint/tendermint/config"
 	cstypes "github.com/tendermint/tendermint/consensus/types"
 	cryptoenc "github.com/tendermint/tendermint/crypto/encoding"
@@ -356,6 +357,150 @@ func TestReactorRecordsVotesAndBlockParts(t *testing.T) {
 	assert.Equal(t, true, ps.BlockPartsSent() > 0, "number of votes sent should have increased")
 }
 
+// newVoteBatchTestVotes returns a valid vote for the current height of cs, a
+// vote with the signature of another validator and a vote for a future height,
+// which isn't batch verified.
+func newVoteBatchTestVotes(cs *State, vss []*validatorStub) (valid, forged, future *types.Vote) {
+	hash := tmhash.Sum([]byte("block"))
+	header := types.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("part"))}
+	quorumType, quorumHash := cs.Validators.QuorumType, cs.Validators.QuorumHash
+
+	valid = signVote(vss[1], tmproto.PrevoteType, hash, cs.state.AppHash, quorumType, quorumHash, header)
+	forged = signVote(vss[2], tmproto.PrevoteType, hash, cs.state.AppHash, quorumType, quorumHash, header)
+	forged.BlockSignature = valid.BlockSignature
+
+	incrementHeight(vss[3])
+	future = signVote(vss[3], tmproto.PrevoteType, hash, cs.state.AppHash, quorumType, quorumHash, header)
+	return valid, forged, future
+}
+
+func TestReactorVerifyVoteBatch(t *testing.T) {
+	cs, vss := randState(4)
+	conR := NewReactor(cs, true)
+	conR.SetLogger(log.TestingLogger())
+	reporter := behaviour.NewMockReporter()
+	conR.reporter = reporter
+
+	valid, forged, future := newVoteBatchTestVotes(cs, vss)
+	var (
+		honestPeer = p2pmock.NewPeer(nil)
+		badPeer    = p2pmock.NewPeer(nil)
+	)
+	batch := []peerVote{{valid, honestPeer}, {forged, badPeer}, {future, honestPeer}}
+
+	votes := conR.verifyVoteBatch(batch)
+	assert.Equal(t, []peerVote{{valid, honestPeer}, {future, honestPeer}}, votes)
+	assert.Empty(t, reporter.GetBehaviours(honestPeer.ID()))
+	assert.Len(t, reporter.GetBehaviours(badPeer.ID()), 1)
+}
+
+func TestReactorVoteBatchRoutine(t *testing.T) {
+	cs, vss := randState(4)
+	require.Greater(t, cs.config.VoteBatchWindow, time.Duration(0))
+	conR := NewReactor(cs, true)
+	conR.SetLogger(log.TestingLogger())
+	reporter := behaviour.NewMockReporter()
+	conR.reporter = reporter
+	require.NoError(t, conR.Start())
+	defer conR.Stop() //nolint:errcheck // ignore for tests
+
+	valid, forged, _ := newVoteBatchTestVotes(cs, vss)
+	var (
+		honestPeer = p2pmock.NewPeer(nil)
+		badPeer    = p2pmock.NewPeer(nil)
+	)
+	conR.voteQueue <- peerVote{forged, badPeer}
+	conR.voteQueue <- peerVote{valid, honestPeer}
+
+	select {
+	case mi := <-cs.peerMsgQueue:
+		assert.Equal(t, msgInfo{&VoteMessage{valid}, honestPeer.ID()}, mi)
+	case <-time.After(time.Second):
+		t.Fatal("expected the valid vote to be passed to the consensus state")
+	}
+	select {
+	case mi := <-cs.peerMsgQueue:
+		t.Fatalf("expected no other messages, got %v", mi)
+	case <-time.After(50 * time.Millisecond):
+	}
+	assert.Len(t, reporter.GetBehaviours(badPeer.ID()), 1)
+	assert.Empty(t, reporter.GetBehaviours(honestPeer.ID()))
+}
+
+func TestReactorReceiveBlocksOnFullVoteQueue(t *testing.T) {
+	cs, vss := randState(4)
+	// start the reactor without the routine draining the vote queue
+	cs.config.VoteBatchWindow = 0
+	conR := NewReactor(cs, false)
+	conR.SetLogger(log.TestingLogger())
+	conR.reporter = behaviour.NewMockReporter()
+	require.NoError(t, conR.Start())
+	defer conR.Stop() //nolint:errcheck // ignore for tests
+	cs.config.VoteBatchWindow = time.Millisecond
+
+	valid, _, _ := newVoteBatchTestVotes(cs, vss)
+	peer := p2pmock.NewPeer(nil)
+	conR.InitPeer(peer)
+	for i := 0; i < cap(conR.voteQueue); i++ {
+		conR.voteQueue <- peerVote{valid, peer}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		conR.Receive(VoteChannel, peer, MustEncode(&VoteMessage{valid}))
+		close(done)
+	}()
+	select {
+	case <-done:
+		t.Fatal("expected Receive to block on the full vote queue")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// no vote is lost once the queue is drained
+	received := 0
+	for received < cap(conR.voteQueue)+1 {
+		select {
+		case <-conR.voteQueue:
+			received++
+		case <-time.After(time.Second):
+			t.Fatalf("expected %d votes, got %d", cap(conR.voteQueue)+1, received)
+		}
+	}
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected Receive to return once the vote is queued")
+	}
+}
+
+func TestReactorReceiveReturnsOnStopWithFullVoteQueue(t *testing.T) {
+	cs, vss := randState(4)
+	cs.config.VoteBatchWindow = 0
+	conR := NewReactor(cs, false)
+	conR.SetLogger(log.TestingLogger())
+	require.NoError(t, conR.Start())
+	cs.config.VoteBatchWindow = time.Millisecond
+
+	valid, _, _ := newVoteBatchTestVotes(cs, vss)
+	peer := p2pmock.NewPeer(nil)
+	conR.InitPeer(peer)
+	for i := 0; i < cap(conR.voteQueue); i++ {
+		conR.voteQueue <- peerVote{valid, peer}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		conR.Receive(VoteChannel, peer, MustEncode(&VoteMessage{valid}))
+		close(done)
+	}()
+	require.NoError(t, conR.Stop())
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected Receive to return once the reactor is stopped")
+	}
+}
+
 func TestReactorValidatorSetChanges(t *testing.T) {
 	nPeers := 7
 	nVals := 4
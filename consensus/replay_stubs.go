@@ -41,8 +41,16 @@ func (emptyMempool) TxsBytes() int64               { return 0 }
 func (emptyMempool) TxsFront() *clist.CElement    { return nil }
 func (emptyMempool) TxsWaitChan() <-chan struct{} { return nil }
 
-func (emptyMempool) InitWAL() error { return nil }
-func (emptyMempool) CloseWAL()      {}
+func (emptyMempool) InitWAL() error                   { return nil }
+func (emptyMempool) ReplayWAL(mempl.BlockStore) error { return nil }
+func (emptyMempool) CloseWAL()                        {}
+
+func (emptyMempool) GetUnconfirmedTx([mempl.TxKeySize]byte) (*mempl.UnconfirmedTx, bool) {
+	return nil, false
+}
+func (emptyMempool) UnconfirmedTxsAfter(int64, int) []*mempl.UnconfirmedTx {
+	return nil
+}
 
 //-----------------------------------------------------------------------------
 // mockProxyApp uses ABCIResponses to give the right results.
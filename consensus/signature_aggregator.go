@@ -0,0 +1,48 @@
+package consensus
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+// SignatureAggregator recovers the threshold signatures of commits on a bounded number
+// of workers, off the consensus goroutine, and records the recovery time by quorum size.
+type SignatureAggregator struct {
+	workers chan struct{}
+	metrics *Metrics
+}
+
+var _ types.SignatureAggregator = (*SignatureAggregator)(nil)
+
+// NewSignatureAggregator returns a SignatureAggregator running at most the given number
+// of recoveries at once.
+func NewSignatureAggregator(workers int, metrics *Metrics) *SignatureAggregator {
+	if workers < 1 {
+		workers = 1
+	}
+	return &SignatureAggregator{
+		workers: make(chan struct{}, workers),
+		metrics: metrics,
+	}
+}
+
+// RecoverThresholdSigs implements types.SignatureAggregator.
+func (a *SignatureAggregator) RecoverThresholdSigs(
+	req types.ThresholdRecoveryRequest,
+) <-chan types.ThresholdRecoveryResult {
+	result := make(chan types.ThresholdRecoveryResult, 1)
+	go func() {
+		a.workers <- struct{}{}
+		defer func() { <-a.workers }()
+
+		start := time.Now()
+		res := types.RecoverThresholdSigs(req)
+		a.metrics.ThresholdSigRecoverySeconds.
+			With("quorum_size", strconv.Itoa(req.QuorumSize)).
+			Observe(time.Since(start).Seconds())
+		result <- res
+	}()
+	return result
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"runtime"
 	"runtime/debug"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	cfg "github.com/tendermint/tendermint/config"
 	cstypes "github.com/tendermint/tendermint/consensus/types"
 	"github.com/tendermint/tendermint/crypto"
+	dashcore "github.com/tendermint/tendermint/dashcore/rpc"
 	tmevents "github.com/tendermint/tendermint/libs/events"
 	"github.com/tendermint/tendermint/libs/fail"
 	tmjson "github.com/tendermint/tendermint/libs/json"
@@ -73,6 +75,8 @@ type txNotifier interface {
 type evidencePool interface {
 	// reports conflicting votes to the evidence pool to be processed into evidence
 	ReportConflictingVotes(voteA, voteB *types.Vote)
+	// reports votes with conflicting state IDs to the evidence pool to be processed into evidence
+	ReportConflictingStateVotes(voteA, voteB *types.Vote)
 }
 
 // State handles execution of the consensus algorithm.
@@ -146,6 +150,9 @@ type State struct {
 	// for reporting metrics
 	metrics *Metrics
 
+	// recovers the threshold signatures of commits
+	sigAggregator types.SignatureAggregator
+
 	// proposer's latest available app protocol version that goes to block header
 	proposedAppVersion uint64
 }
@@ -215,6 +222,11 @@ func NewStateWithLogger(
 		option(cs)
 	}
 
+	if cs.sigAggregator == nil {
+		cs.sigAggregator = NewSignatureAggregator(runtime.NumCPU(), cs.metrics)
+	}
+	cs.Votes.SetSignatureAggregator(cs.sigAggregator)
+
 	return cs
 }
 
@@ -235,6 +247,11 @@ func StateMetrics(metrics *Metrics) StateOption {
 	return func(cs *State) { cs.metrics = metrics }
 }
 
+// StateSignatureAggregator sets the aggregator recovering the threshold signatures of commits.
+func StateSignatureAggregator(aggregator types.SignatureAggregator) StateOption {
+	return func(cs *State) { cs.sigAggregator = aggregator }
+}
+
 // String returns a string.
 func (cs *State) String() string {
 	// better not to access shared variables
@@ -716,6 +733,9 @@ func (cs *State) updateToState(state sm.State, commit *types.Commit, logger log.
 	cs.ValidBlockParts = nil
 	cs.Commit = nil
 	cs.Votes = cstypes.NewHeightVoteSet(state.ChainID, height, validators)
+	if cs.sigAggregator != nil {
+		cs.Votes.SetSignatureAggregator(cs.sigAggregator)
+	}
 	cs.CommitRound = -1
 	cs.LastValidators = state.LastValidators
 	cs.TriggeredTimeoutPrecommit = false
@@ -1185,11 +1205,7 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 
 	// Make proposal
 	propBlockID := types.BlockID{Hash: block.Hash(), PartSetHeader: blockParts.Header()}
-	proposedChainLockHeight := cs.state.LastCoreChainLockedBlockHeight
-	if cs.blockExec.NextCoreChainLock != nil && cs.blockExec.NextCoreChainLock.CoreBlockHeight > proposedChainLockHeight {
-		proposedChainLockHeight = cs.blockExec.NextCoreChainLock.CoreBlockHeight
-	}
-	proposal := types.NewProposal(height, proposedChainLockHeight, round, cs.ValidRound, propBlockID)
+	proposal := types.NewProposal(height, block.Header.CoreChainLockedHeight, round, cs.ValidRound, propBlockID)
 	p := proposal.ToProto()
 	validatorsAtProposalHeight := cs.state.ValidatorsAtHeight(p.Height)
 
@@ -1239,6 +1255,9 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 		}
 
 		cs.Logger.Debug("signed proposal", "height", height, "round", round, "proposal", proposal)
+	} else if dashcore.IsCoreUnavailable(err) {
+		cs.Logger.Error("propose step; dash core is unavailable, not proposing",
+			"height", height, "round", round, "err", err)
 	} else if !cs.replayMode {
 		cs.Logger.Error("propose step; failed signing proposal", "height", height, "round", round, "err", err)
 	}
@@ -2303,6 +2322,26 @@ func (cs *State) tryAddVote(vote *types.Vote, peerID p2p.ID) (bool, error) {
 				"VoteB", voteErr.VoteB,
 			)
 
+			return added, err
+		} else if voteErr, ok := err.(*types.ErrVoteConflictingStateVotes); ok {
+			if bytes.Equal(vote.ValidatorProTxHash, cs.privValidatorProTxHash) {
+				cs.Logger.Error(
+					"found conflicting state vote from ourselves; did you unsafe_reset a validator?",
+					"height", vote.Height,
+					"round", vote.Round,
+					"type", vote.Type,
+				)
+
+				return added, err
+			}
+
+			// report votes with conflicting state IDs to the evidence pool
+			cs.evpool.ReportConflictingStateVotes(voteErr.VoteA, voteErr.VoteB)
+			cs.Logger.Info("found and sent conflicting state votes to the evidence pool",
+				"VoteA", voteErr.VoteA,
+				"VoteB", voteErr.VoteB,
+			)
+
 			return added, err
 		} else if errors.Is(err, types.ErrVoteNonDeterministicSignature) {
 			cs.Logger.Debug("vote has non-deterministic signature", "err", err)
@@ -2610,6 +2649,12 @@ func (cs *State) signAddVote(msgType tmproto.SignedMsgType, hash []byte, header
 		return vote
 	}
 
+	if dashcore.IsCoreUnavailable(err) {
+		// the round goes on without our vote
+		cs.Logger.Error("dash core is unavailable, not voting",
+			"height", cs.Height, "round", cs.Round, "type", msgType, "err", err)
+		return nil
+	}
 	cs.Logger.Error("failed signing vote", "height", cs.Height, "round", cs.Round, "vote", vote, "err", err)
 	return nil
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"strings"
@@ -48,6 +49,7 @@ type HeightVoteSet struct {
 	round             int32                  // max tracked round
 	roundVoteSets     map[int32]RoundVoteSet // keys: [0...round]
 	peerCatchupRounds map[p2p.ID][]int32     // keys: peer.ID; values: at most 2 rounds
+	aggregator        types.SignatureAggregator
 }
 
 func NewHeightVoteSet(chainID string, height int64, valSet *types.ValidatorSet) *HeightVoteSet {
@@ -71,6 +73,19 @@ func (hvs *HeightVoteSet) Reset(height int64, valSet *types.ValidatorSet) {
 	hvs.round = 0
 }
 
+// SetSignatureAggregator sets the aggregator recovering the threshold signatures of the
+// precommits of all rounds.
+func (hvs *HeightVoteSet) SetSignatureAggregator(aggregator types.SignatureAggregator) {
+	hvs.mtx.Lock()
+	defer hvs.mtx.Unlock()
+	hvs.aggregator = aggregator
+	for _, rvs := range hvs.roundVoteSets {
+		if rvs.Precommits != nil {
+			rvs.Precommits.SetSignatureAggregator(aggregator)
+		}
+	}
+}
+
 func (hvs *HeightVoteSet) Height() int64 {
 	hvs.mtx.Lock()
 	defer hvs.mtx.Unlock()
@@ -108,6 +123,9 @@ func (hvs *HeightVoteSet) addRound(round int32) {
 	if hvs.valSet.HasPublicKeys {
 		prevotes := types.NewVoteSet(hvs.chainID, hvs.height, round, tmproto.PrevoteType, hvs.valSet)
 		precommits := types.NewVoteSet(hvs.chainID, hvs.height, round, tmproto.PrecommitType, hvs.valSet)
+		if hvs.aggregator != nil {
+			precommits.SetSignatureAggregator(hvs.aggregator)
+		}
 		hvs.roundVoteSets[round] = RoundVoteSet{
 			Prevotes:   prevotes,
 			Precommits: precommits,
@@ -141,10 +159,45 @@ func (hvs *HeightVoteSet) AddVote(vote *types.Vote, peerID p2p.ID) (added bool,
 			return
 		}
 	}
+	if conflicting := hvs.conflictingStateVote(vote); conflicting != nil {
+		// only authentic votes are evidence of a byzantine validator
+		if _, val := hvs.valSet.GetByIndex(vote.ValidatorIndex); val != nil {
+			_, _, err = vote.Verify(hvs.chainID, hvs.valSet.QuorumType, hvs.valSet.QuorumHash, val.PubKey, val.ProTxHash)
+			if err != nil {
+				return false, err
+			}
+			return false, types.NewConflictingStateVoteError(conflicting, vote)
+		}
+	}
 	added, err = voteSet.AddVote(vote)
 	return
 }
 
+// conflictingStateVote returns a vote of the same validator at this height that signed
+// a different state ID than the given vote, if any. The state signature doesn't depend
+// on the round and the type of the vote, so votes of all rounds and types are checked.
+func (hvs *HeightVoteSet) conflictingStateVote(vote *types.Vote) *types.Vote {
+	// votes for nil don't sign the state
+	if vote.BlockID.IsZero() {
+		return nil
+	}
+	for _, rvs := range hvs.roundVoteSets {
+		for _, voteSet := range []*types.VoteSet{rvs.Prevotes, rvs.Precommits} {
+			existing := voteSet.GetByIndex(vote.ValidatorIndex)
+			if existing == nil || existing.BlockID.IsZero() {
+				continue
+			}
+			if !bytes.Equal(existing.ValidatorProTxHash, vote.ValidatorProTxHash) {
+				continue
+			}
+			if !existing.StateID.Equals(vote.StateID) {
+				return existing
+			}
+		}
+	}
+	return nil
+}
+
 func (hvs *HeightVoteSet) Prevotes(round int32) *types.VoteSet {
 	hvs.mtx.Lock()
 	defer hvs.mtx.Unlock()
@@ -1,11 +1,14 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"testing"
 
 	"github.com/dashevo/dashd-go/btcjson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/tendermint/tendermint/crypto"
 
@@ -58,6 +61,38 @@ func TestPeerCatchupRounds(t *testing.T) {
 
 }
 
+func TestConflictingStateVotes(t *testing.T) {
+	valSet, privVals := types.GenerateValidatorSet(4)
+
+	hvs := NewHeightVoteSet(config.ChainID(), 1, valSet)
+
+	vote := makeVoteHR(t, 1, 0, 0, privVals, valSet.QuorumType, valSet.QuorumHash)
+	added, err := hvs.AddVote(vote, "peer1")
+	require.NoError(t, err)
+	require.True(t, added)
+
+	// the same validator signs another state in a later round
+	conflicting := vote.Copy()
+	conflicting.Round = 1
+	conflicting.StateID = types.StateID{LastAppHash: tmhash.Sum([]byte("otherapphash"))}
+	v := conflicting.ToProto()
+	require.NoError(t, privVals[0].SignVote(config.ChainID(), valSet.QuorumType, valSet.QuorumHash, v, nil))
+	conflicting.BlockSignature = v.BlockSignature
+	conflicting.StateSignature = v.StateSignature
+
+	added, err = hvs.AddVote(conflicting, "peer1")
+	assert.False(t, added)
+	var stateErr *types.ErrVoteConflictingStateVotes
+	require.True(t, errors.As(err, &stateErr), "unexpected error %v", err)
+	assert.Equal(t, vote, stateErr.VoteA)
+	assert.Equal(t, conflicting, stateErr.VoteB)
+
+	// a vote of another validator is not affected
+	added, err = hvs.AddVote(makeVoteHR(t, 1, 1, 1, privVals, valSet.QuorumType, valSet.QuorumHash), "peer1")
+	require.NoError(t, err)
+	assert.True(t, added)
+}
+
 func makeVoteHR(t *testing.T, height int64, valIndex, round int32, privVals []types.PrivValidator,
 	quorumType btcjson.LLMQType, quorumHash crypto.QuorumHash) *types.Vote {
 	privVal := privVals[valIndex]
@@ -67,7 +102,8 @@ func makeVoteHR(t *testing.T, height int64, valIndex, round int32, privVals []ty
 	}
 
 	randBytes1 := tmrand.Bytes(tmhash.Size)
-	randBytes2 := tmrand.Bytes(tmhash.Size)
+	// all votes of a height sign the same state
+	stateID := types.StateID{LastAppHash: tmhash.Sum([]byte(fmt.Sprintf("apphash%d", height)))}
 
 	vote := &types.Vote{
 		ValidatorProTxHash: proTxHash,
@@ -76,7 +112,7 @@ func makeVoteHR(t *testing.T, height int64, valIndex, round int32, privVals []ty
 		Round:              round,
 		Type:               tmproto.PrecommitType,
 		BlockID:            types.BlockID{Hash: randBytes1, PartSetHeader: types.PartSetHeader{}},
-		StateID:            types.StateID{LastAppHash: randBytes2},
+		StateID:            stateID,
 	}
 	chainID := config.ChainID()
 
@@ -0,0 +1,114 @@
+package bls12381
+
+import (
+	"fmt"
+
+	bls "github.com/dashpay/bls-signatures/go-bindings"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// BatchVerifier verifies the signatures of many digests at once. Signatures of the same
+// digest are checked with a single pairing, so verifying the votes of a quorum for the
+// same block costs about as much as verifying one of them.
+//
+// Every signature and public key is multiplied by a random scalar before they are
+// aggregated, so that invalid signatures can't cancel each other out in the aggregate.
+type BatchVerifier struct {
+	digests map[string]int
+	groups  []batchGroup
+	size    int
+}
+
+type batchGroup struct {
+	digest  []byte
+	pubKeys []*bls.PublicKey
+	sigs    []*bls.InsecureSignature
+}
+
+// NewBatchVerifier returns an empty BatchVerifier.
+func NewBatchVerifier() *BatchVerifier {
+	return &BatchVerifier{digests: make(map[string]int)}
+}
+
+// Add adds the signature of the given digest by the given public key to the batch.
+// It returns an error if the public key or the signature can't be deserialized.
+func (b *BatchVerifier) Add(pubKey crypto.PubKey, digest []byte, sig []byte) error {
+	if len(sig) != SignatureSize {
+		return fmt.Errorf("invalid signature size, expected %d bytes (got %d)", SignatureSize, len(sig))
+	}
+	publicKey, err := bls.PublicKeyFromBytes(pubKey.Bytes())
+	if err != nil {
+		return err
+	}
+	blsSignature, err := bls.InsecureSignatureFromBytes(sig)
+	if err != nil {
+		return err
+	}
+
+	i, ok := b.digests[string(digest)]
+	if !ok {
+		i = len(b.groups)
+		b.digests[string(digest)] = i
+		b.groups = append(b.groups, batchGroup{digest: digest})
+	}
+	b.groups[i].pubKeys = append(b.groups[i].pubKeys, publicKey)
+	b.groups[i].sigs = append(b.groups[i].sigs, blsSignature)
+	b.size++
+	return nil
+}
+
+// Len returns the number of signatures in the batch.
+func (b *BatchVerifier) Len() int {
+	return b.size
+}
+
+// Verify returns true if all the signatures of the batch are valid. It doesn't tell which
+// signature is invalid if one is; callers have to verify them one by one to find out.
+func (b *BatchVerifier) Verify() bool {
+	if b.size == 0 {
+		return true
+	}
+	var (
+		digests = make([][]byte, len(b.groups))
+		pubKeys = make([]*bls.PublicKey, len(b.groups))
+		sigs    = make([]*bls.InsecureSignature, 0, b.size)
+	)
+	for i, group := range b.groups {
+		groupPubKeys := make([]*bls.PublicKey, len(group.pubKeys))
+		for j := range group.sigs {
+			pubKey, sig, err := randomize(group.pubKeys[j], group.sigs[j])
+			if err != nil {
+				return false
+			}
+			groupPubKeys[j] = pubKey
+			sigs = append(sigs, sig)
+		}
+		pubKey, err := bls.PublicKeyAggregateInsecure(groupPubKeys)
+		if err != nil {
+			return false
+		}
+		digests[i] = group.digest
+		pubKeys[i] = pubKey
+	}
+	sig, err := bls.InsecureSignatureAggregate(sigs)
+	if err != nil {
+		return false
+	}
+	return sig.Verify(digests, pubKeys)
+}
+
+// randomize multiplies the public key and the signature by the same random scalar 1+r, by
+// evaluating the polynomial with both coefficients set to them at r.
+func randomize(pubKey *bls.PublicKey, sig *bls.InsecureSignature) (*bls.PublicKey, *bls.InsecureSignature, error) {
+	var r bls.Hash
+	copy(r[:], crypto.CRandBytes(len(r)))
+	randPubKey, err := bls.PublicKeyShare([]*bls.PublicKey{pubKey, pubKey}, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	randSig, err := bls.InsecureSignatureShare([]*bls.InsecureSignature{sig, sig}, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return randPubKey, randSig, nil
+}
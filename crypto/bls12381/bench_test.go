@@ -1,6 +1,7 @@
 package bls12381
 
 import (
+	"fmt"
 	"io"
 	"testing"
 
@@ -24,3 +25,54 @@ func BenchmarkVerification(b *testing.B) {
 	priv := GenPrivKey()
 	benchmarking.BenchmarkVerification(b, priv)
 }
+
+func benchmarkSignatureShares(b *testing.B, members int) ([][]byte, [][]byte) {
+	privKeys, proTxHashes, _ := CreatePrivLLMQDataDefaultThreshold(members)
+	threshold := members*2/3 + 1
+	msg := crypto.CRandBytes(32)
+	sigShares := make([][]byte, threshold)
+	blsIDs := make([][]byte, threshold)
+	for i := 0; i < threshold; i++ {
+		sig, err := privKeys[i].SignDigest(msg)
+		if err != nil {
+			b.Fatal(err)
+		}
+		sigShares[i] = sig
+		blsIDs[i] = proTxHashes[i].Bytes()
+	}
+	return sigShares, blsIDs
+}
+
+func BenchmarkRecoverThresholdSignature(b *testing.B) {
+	for _, members := range []int{10, 50, 100, 400} {
+		sigShares, blsIDs := benchmarkSignatureShares(b, members)
+		b.Run(fmt.Sprintf("quorum_%d", members), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := RecoverThresholdSignatureFromShares(sigShares, blsIDs); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkRecoverThresholdSignatureIncremental measures the recovery once the shares were
+// deserialized as they arrived, which is what is left to do when the quorum is reached.
+func BenchmarkRecoverThresholdSignatureIncremental(b *testing.B) {
+	for _, members := range []int{10, 50, 100, 400} {
+		sigShares, blsIDs := benchmarkSignatureShares(b, members)
+		shares := NewSignatureShares(len(sigShares))
+		for i := range sigShares {
+			if err := shares.Add(sigShares[i], blsIDs[i]); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.Run(fmt.Sprintf("quorum_%d", members), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := shares.Recover(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -338,8 +338,6 @@ func RecoverThresholdPublicKeyFromPublicKeys(publicKeys []crypto.PubKey, blsIds
 
 // RecoverThresholdSignatureFromShares BLS Ids are the Pro_tx_hashes from validators
 func RecoverThresholdSignatureFromShares(sigSharesData [][]byte, blsIds [][]byte) ([]byte, error) {
-	sigShares := make([]*bls.InsecureSignature, len(sigSharesData))
-	hashes := make([]bls.Hash, len(sigSharesData))
 	if len(sigSharesData) != len(blsIds) {
 		return nil, errors.New("the length of the signature shares must match the length of the blsIds")
 	}
@@ -348,28 +346,13 @@ func RecoverThresholdSignatureFromShares(sigSharesData [][]byte, blsIds [][]byte
 		return sigSharesData[0], nil
 	}
 	// Create and validate sigShares for each member and populate BLS-IDs from members into ids
+	sigShares := NewSignatureShares(len(sigSharesData))
 	for i, sigShareData := range sigSharesData {
-		sigShare, error := bls.InsecureSignatureFromBytes(sigShareData)
-		if error != nil {
-			return nil, error
-		}
-		sigShares[i] = sigShare
-	}
-
-	for i, blsID := range blsIds {
-		if len(blsID) != tmhash.Size {
-			return nil, fmt.Errorf("blsID incorrect size in signature recovery, expected 32 bytes (got %d)", len(blsID))
+		if err := sigShares.Add(sigShareData, blsIds[i]); err != nil {
+			return nil, err
 		}
-		var hash bls.Hash
-		copy(hash[:], ReverseBytes(blsID))
-		hashes[i] = hash
-	}
-
-	thresholdSignature, error := bls.InsecureSignatureRecover(sigShares, hashes)
-	if error != nil {
-		return nil, error
 	}
-	return thresholdSignature.Serialize(), error
+	return sigShares.Recover()
 }
 
 //-------------------------------------
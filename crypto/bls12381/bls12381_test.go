@@ -27,6 +27,32 @@ func TestSignAndValidateBLS12381(t *testing.T) {
 	assert.True(t, pubKey.VerifySignatureDigest(msg, sig))
 }
 
+func TestBatchVerifier(t *testing.T) {
+	sameDigest, otherDigest := crypto.CRandBytes(32), crypto.CRandBytes(32)
+	verifier := bls12381.NewBatchVerifier()
+	var sigs [][]byte
+	for i := 0; i < 4; i++ {
+		privKey := bls12381.GenPrivKey()
+		digest := sameDigest
+		if i == 3 {
+			digest = otherDigest
+		}
+		sig, err := privKey.SignDigest(digest)
+		require.NoError(t, err)
+		require.NoError(t, verifier.Add(privKey.PubKey(), digest, sig))
+		sigs = append(sigs, sig)
+	}
+	assert.Equal(t, 4, verifier.Len())
+	assert.True(t, verifier.Verify())
+
+	// a signature of another key
+	privKey := bls12381.GenPrivKey()
+	require.NoError(t, verifier.Add(privKey.PubKey(), sameDigest, sigs[0]))
+	assert.False(t, verifier.Verify())
+
+	assert.Error(t, bls12381.NewBatchVerifier().Add(privKey.PubKey(), sameDigest, sigs[0][1:]))
+}
+
 func TestBLSAddress(t *testing.T) {
 	decodedPrivateKeyBytes, err := base64.StdEncoding.DecodeString("RokcLOxJWTyBkh5HPbdIACng/B65M8a5PYH1Nw6xn70=")
 	require.Nil(t, err)
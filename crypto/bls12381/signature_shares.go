@@ -0,0 +1,97 @@
+package bls12381
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	bls "github.com/dashpay/bls-signatures/go-bindings"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// SignatureShares accumulates the signature shares of a threshold signature as they arrive.
+// Shares are deserialized when they are added, so that recovering the threshold signature
+// only has to interpolate them. It is safe for concurrent use.
+type SignatureShares struct {
+	mtx    sync.Mutex
+	data   [][]byte
+	shares []*bls.InsecureSignature
+	ids    []bls.Hash
+	seen   map[bls.Hash]struct{}
+}
+
+// NewSignatureShares returns an empty set of signature shares with room for the given
+// number of shares.
+func NewSignatureShares(capacity int) *SignatureShares {
+	return &SignatureShares{
+		data:   make([][]byte, 0, capacity),
+		shares: make([]*bls.InsecureSignature, 0, capacity),
+		ids:    make([]bls.Hash, 0, capacity),
+		seen:   make(map[bls.Hash]struct{}, capacity),
+	}
+}
+
+// Add deserializes the signature share of the member with the given BLS ID (its pro_tx_hash)
+// and adds it to the set. A second share of the same member is ignored.
+func (s *SignatureShares) Add(sigShareData []byte, blsID []byte) error {
+	sigShare, err := bls.InsecureSignatureFromBytes(sigShareData)
+	if err != nil {
+		return err
+	}
+	if len(blsID) != tmhash.Size {
+		return fmt.Errorf("blsID incorrect size in signature recovery, expected 32 bytes (got %d)", len(blsID))
+	}
+	var hash bls.Hash
+	copy(hash[:], ReverseBytes(blsID))
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if _, ok := s.seen[hash]; ok {
+		return nil
+	}
+	s.seen[hash] = struct{}{}
+	s.data = append(s.data, sigShareData)
+	s.shares = append(s.shares, sigShare)
+	s.ids = append(s.ids, hash)
+	return nil
+}
+
+// Len returns the number of shares in the set.
+func (s *SignatureShares) Len() int {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return len(s.shares)
+}
+
+// Copy returns a snapshot of the set. Shares added to either set later are not visible
+// in the other one.
+func (s *SignatureShares) Copy() *SignatureShares {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	c := NewSignatureShares(cap(s.shares))
+	c.data = append(c.data, s.data...)
+	c.shares = append(c.shares, s.shares...)
+	c.ids = append(c.ids, s.ids...)
+	for id := range s.seen {
+		c.seen[id] = struct{}{}
+	}
+	return c
+}
+
+// Recover recovers the threshold signature from the shares in the set. If there is only
+// one share, it is the threshold signature.
+func (s *SignatureShares) Recover() ([]byte, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	switch len(s.shares) {
+	case 0:
+		return nil, errors.New("no signature shares to recover the threshold signature from")
+	case 1:
+		return s.data[0], nil
+	}
+	thresholdSignature, err := bls.InsecureSignatureRecover(s.shares, s.ids)
+	if err != nil {
+		return nil, err
+	}
+	return thresholdSignature.Serialize(), nil
+}
@@ -1,12 +1,14 @@
 package dashcore
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/dashevo/dashd-go/btcjson"
 	rpc "github.com/dashevo/dashd-go/rpcclient"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
 )
 
 type Client interface {
@@ -33,21 +35,60 @@ type Client interface {
 		signature bytes.HexBytes,
 		quorumHash bytes.HexBytes,
 	) (bool, error)
+	// QuorumSelectQuorum returns the quorum that is responsible for signing the request
+	QuorumSelectQuorum(
+		quorumType btcjson.LLMQType,
+		requestID bytes.HexBytes,
+	) (*btcjson.QuorumSelectQuorumResult, error)
+	// GetBestChainLock returns the best chain lock known to dashd
+	GetBestChainLock() (*BestChainLockResult, error)
+	// Notifications returns the channel on which notifications pushed by dashd are delivered
+	Notifications() <-chan Notification
 	// Close Closes connection to dashd
 	Close() error
 	// Ping Sends ping to dashd
 	Ping() error
 }
 
+// BestChainLockResult models the data from the getbestchainlock command.
+type BestChainLockResult struct {
+	BlockHash  string `json:"blockhash"`
+	Height     uint32 `json:"height"`
+	Signature  string `json:"signature"`
+	KnownBlock bool   `json:"known_block"`
+}
+
 // RPCClient implements Client
 // Handles connection to the underlying dashd instance
 type RPCClient struct {
 	endpoint *rpc.Client
+
+	zmqEndpoint   string
+	zmqSubscriber *zmqSubscriber
+	notifications chan Notification
+	logger        log.Logger
+}
+
+type RPCClientOption func(rpcClient *RPCClient)
+
+// RPCClientWithZMQEndpoint is used to receive notifications from the ZMQ publisher
+// of dashd, e.g. tcp://127.0.0.1:29998
+func RPCClientWithZMQEndpoint(endpoint string) RPCClientOption {
+	return func(rpcClient *RPCClient) {
+		rpcClient.zmqEndpoint = endpoint
+	}
+}
+
+// RPCClientWithLogger is used to specify the logger
+func RPCClientWithLogger(logger log.Logger) RPCClientOption {
+	return func(rpcClient *RPCClient) {
+		rpcClient.logger = logger
+	}
 }
 
 // NewRPCClient returns an instance of Client.
 // it will start the endpoint (if not already started)
-func NewRPCClient(host string, username string, password string) (*RPCClient, error) {
+func NewRPCClient(host string, username string, password string, options ...RPCClientOption) (*RPCClient, error) {
 	if host == "" {
 		return nil, fmt.Errorf("unable to establish connection to the Dash Core node")
 	}
@@ -61,23 +102,49 @@ func NewRPCClient(host string, username string, password string) (*RPCClient, er
 		DisableTLS:   true, // Dash core does not provide TLS by default
 	}
 	// Notice the notification parameter is nil since notifications are
-	// not supported in HTTP POST mode.
+	// not supported in HTTP POST mode, they are received over ZMQ instead.
 	client, err := rpc.New(connCfg, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	dashCoreClient := RPCClient{endpoint: client}
+	dashCoreClient := RPCClient{
+		endpoint:      client,
+		notifications: make(chan Notification, notificationBufferSize),
+		logger:        log.NewNopLogger(),
+	}
+	for _, option := range options {
+		option(&dashCoreClient)
+	}
+
+	if dashCoreClient.zmqEndpoint != "" {
+		dashCoreClient.zmqSubscriber = newZMQSubscriber(
+			dashCoreClient.zmqEndpoint,
+			client,
+			dashCoreClient.notifications,
+			dashCoreClient.logger,
+		)
+		dashCoreClient.zmqSubscriber.start()
+	}
 
 	return &dashCoreClient, nil
 }
 
 // Close closes the underlying connection
 func (rpcClient *RPCClient) Close() error {
+	if rpcClient.zmqSubscriber != nil {
+		rpcClient.zmqSubscriber.stop()
+	}
 	rpcClient.endpoint.Shutdown()
 	return nil
 }
 
+// Notifications returns the channel of notifications received from the ZMQ publisher of dashd.
+// Nothing is delivered when no ZMQ endpoint is configured.
+func (rpcClient *RPCClient) Notifications() <-chan Notification {
+	return rpcClient.notifications
+}
+
 // Ping sends a ping request to the remote signer
 func (rpcClient *RPCClient) Ping() error {
 	err := rpcClient.endpoint.Ping()
@@ -146,3 +213,23 @@ func (rpcClient *RPCClient) QuorumVerify(
 		quorumHash.String(),
 	)
 }
+
+func (rpcClient *RPCClient) QuorumSelectQuorum(
+	quorumType btcjson.LLMQType,
+	requestID bytes.HexBytes,
+) (*btcjson.QuorumSelectQuorumResult, error) {
+	return rpcClient.endpoint.QuorumSelectQuorum(quorumType, requestID.String())
+}
+
+func (rpcClient *RPCClient) GetBestChainLock() (*BestChainLockResult, error) {
+	// dashd-go does not provide a typed request for getbestchainlock yet
+	res, err := rpcClient.endpoint.RawRequest("getbestchainlock", nil)
+	if err != nil {
+		return nil, err
+	}
+	var bestChainLock BestChainLockResult
+	if err := json.Unmarshal(res, &bestChainLock); err != nil {
+		return nil, err
+	}
+	return &bestChainLock, nil
+}
@@ -0,0 +1,73 @@
+package dashcore
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "dashcore"
+)
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Duration of Dash Core RPC calls in seconds, including retries.
+	RequestDuration metrics.Histogram
+	// Number of Dash Core RPC calls that failed after all retries.
+	RequestFailures metrics.Counter
+	// Number of retried Dash Core RPC calls.
+	RequestRetries metrics.Counter
+	// Whether the circuit breaker rejects calls to Dash Core.
+	CircuitBreakerOpen metrics.Gauge
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		RequestDuration: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "request_duration_seconds",
+			Help:      "Duration of Dash Core RPC calls in seconds, including retries.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.001, 4, 8),
+		}, append(labels, "method")).With(labelsAndValues...),
+		RequestFailures: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "request_failures",
+			Help:      "Number of Dash Core RPC calls that failed after all retries.",
+		}, append(labels, "method")).With(labelsAndValues...),
+		RequestRetries: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "request_retries",
+			Help:      "Number of retried Dash Core RPC calls.",
+		}, append(labels, "method")).With(labelsAndValues...),
+		CircuitBreakerOpen: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "circuit_breaker_open",
+			Help:      "Whether the circuit breaker rejects calls to Dash Core (1 if yes, 0 if no).",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		RequestDuration:    discard.NewHistogram(),
+		RequestFailures:    discard.NewCounter(),
+		RequestRetries:     discard.NewCounter(),
+		CircuitBreakerOpen: discard.NewGauge(),
+	}
+}
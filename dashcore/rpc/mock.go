@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"strconv"
+	"sync"
 
 	"github.com/tendermint/tendermint/types"
 
@@ -19,6 +20,10 @@ type MockClient struct {
 	llmqType btcjson.LLMQType
 	localPV  types.PrivValidator
 	canSign  bool
+
+	mtx           sync.Mutex
+	bestChainLock *types.CoreChainLock
+	notifications chan Notification
 }
 
 func NewMockClient(chainID string, llmqType btcjson.LLMQType, localPV types.PrivValidator, canSign bool) *MockClient {
@@ -30,6 +35,8 @@ func NewMockClient(chainID string, llmqType btcjson.LLMQType, localPV types.Priv
 		llmqType: llmqType,
 		localPV:  localPV,
 		canSign:  canSign,
+
+		notifications: make(chan Notification, notificationBufferSize),
 	}
 }
 
@@ -175,6 +182,72 @@ func (mc *MockClient) QuorumSign(
 	return &res, nil
 }
 
+func (mc *MockClient) QuorumSelectQuorum(
+	quorumType btcjson.LLMQType,
+	requestID bytes.HexBytes,
+) (*btcjson.QuorumSelectQuorumResult, error) {
+	quorumHash, err := mc.localPV.GetFirstQuorumHash()
+	if err != nil {
+		return nil, err
+	}
+	proTxHash, err := mc.localPV.GetProTxHash()
+	if err != nil {
+		panic(err)
+	}
+	return &btcjson.QuorumSelectQuorumResult{
+		QuorumHash:      quorumHash.String(),
+		RecoveryMembers: []string{proTxHash.String()},
+	}, nil
+}
+
+// SetBestChainLock sets the chain lock returned by GetBestChainLock
+func (mc *MockClient) SetBestChainLock(chainLock types.CoreChainLock) {
+	mc.mtx.Lock()
+	defer mc.mtx.Unlock()
+	mc.bestChainLock = &chainLock
+}
+
+func (mc *MockClient) GetBestChainLock() (*BestChainLockResult, error) {
+	mc.mtx.Lock()
+	defer mc.mtx.Unlock()
+	if mc.bestChainLock == nil {
+		return nil, errors.New("unable to find any chainlock")
+	}
+	return &BestChainLockResult{
+		BlockHash:  hex.EncodeToString(mc.bestChainLock.CoreBlockHash),
+		Height:     mc.bestChainLock.CoreBlockHeight,
+		Signature:  hex.EncodeToString(mc.bestChainLock.Signature),
+		KnownBlock: true,
+	}, nil
+}
+
+// Notifications returns the channel of notifications emitted with EmitNotification
+func (mc *MockClient) Notifications() <-chan Notification {
+	return mc.notifications
+}
+
+// EmitNotification sends a synthetic notification, as if it was pushed by dashd.
+// Chain lock notifications also update the best chain lock. Like the ones of
+// dashd, the notifications are dropped when nobody consumes them.
+func (mc *MockClient) EmitNotification(notification Notification) {
+	if n, ok := notification.(ChainLockNotification); ok {
+		mc.SetBestChainLock(n.ChainLock)
+	}
+	select {
+	case mc.notifications <- notification:
+	default:
+	}
+}
+
+// EmitMasternodeListDiff sends the notifications dashd pushes for a new block
+// with the given masternode list diff: a notification for each new quorum, and
+// one for the changes of the masternode list, if any.
+func (mc *MockClient) EmitMasternodeListDiff(baseHeight, height int64, diff *btcjson.ProTxDiffResult) {
+	for _, notification := range notificationsFromProTxDiff(baseHeight, height, diff) {
+		mc.EmitNotification(notification)
+	}
+}
+
 func (mc *MockClient) QuorumVerify(
 	quorumType btcjson.LLMQType,
 	requestID bytes.HexBytes,
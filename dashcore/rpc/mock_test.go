@@ -0,0 +1,42 @@
+package dashcore
+
+import (
+	"testing"
+
+	"github.com/dashevo/dashd-go/btcjson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestMockClientNotifications(t *testing.T) {
+	mc := NewMockClient("chain", btcjson.LLMQType_5_60, &types.MockPV{}, false)
+
+	chainLock := types.NewMockChainLock(1234)
+	mc.EmitNotification(ChainLockNotification{ChainLock: chainLock})
+	assert.Equal(t, ChainLockNotification{ChainLock: chainLock}, <-mc.Notifications())
+	res, err := mc.GetBestChainLock()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1234, res.Height)
+
+	quorumHash := crypto.RandQuorumHash()
+	diff := &btcjson.ProTxDiffResult{
+		DeletedMNs: []string{crypto.RandProTxHash().String()},
+		NewQuorums: []btcjson.ProTxDiffNewQuorum{{LlmqType: int(btcjson.LLMQType_5_60), QuorumHash: quorumHash.String()}},
+	}
+	mc.EmitMasternodeListDiff(10, 11, diff)
+	assert.Equal(t, QuorumCommittedNotification{
+		QuorumType: btcjson.LLMQType_5_60,
+		QuorumHash: quorumHash,
+		Height:     11,
+	}, <-mc.Notifications())
+	assert.Equal(t, MasternodeListDiffNotification{BaseHeight: 10, Height: 11, Diff: *diff}, <-mc.Notifications())
+
+	// the notifications are dropped when nobody consumes them
+	for i := 0; i < notificationBufferSize+1; i++ {
+		mc.EmitNotification(ChainLockNotification{ChainLock: chainLock})
+	}
+	assert.Len(t, mc.Notifications(), notificationBufferSize)
+}
@@ -0,0 +1,110 @@
+package dashcore
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/dashevo/dashd-go/btcjson"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
+	"github.com/tendermint/tendermint/types"
+)
+
+// notificationBufferSize is the capacity of the notifications channel.
+// Notifications are dropped when nobody consumes them.
+const notificationBufferSize = 100
+
+// Notification is an event pushed by Dash Core
+type Notification interface {
+	notification()
+}
+
+// ChainLockNotification is sent when Dash Core signs a new chain lock
+type ChainLockNotification struct {
+	ChainLock types.CoreChainLock
+}
+
+// QuorumCommittedNotification is sent when a new quorum is committed on the core chain
+type QuorumCommittedNotification struct {
+	QuorumType      btcjson.LLMQType
+	QuorumHash      crypto.QuorumHash
+	QuorumPublicKey string
+	// Height is the core block height at which the quorum was committed
+	Height int64
+}
+
+// MasternodeListDiffNotification is sent when the masternode list changes
+// between two core blocks
+type MasternodeListDiffNotification struct {
+	BaseHeight int64
+	Height     int64
+	Diff       btcjson.ProTxDiffResult
+}
+
+func (ChainLockNotification) notification()          {}
+func (QuorumCommittedNotification) notification()    {}
+func (MasternodeListDiffNotification) notification() {}
+
+// chainLockSigSize is the size of a serialized CChainLockSig:
+// 4 bytes height, 32 bytes block hash and the BLS signature.
+const chainLockSigSize = 4 + crypto.DefaultHashSize + bls12381.SignatureSize
+
+// chainLockFromRawChainLockSig decodes the payload of a rawchainlocksig ZMQ message,
+// which is the serialized block followed by the serialized chain lock signature.
+func chainLockFromRawChainLockSig(payload []byte) (*types.CoreChainLock, error) {
+	if len(payload) < chainLockSigSize {
+		return nil, fmt.Errorf("rawchainlocksig payload is too short: %d bytes", len(payload))
+	}
+	clsig := payload[len(payload)-chainLockSigSize:]
+
+	height := binary.LittleEndian.Uint32(clsig[:4])
+	// the block hash is serialized in internal byte order while everywhere else
+	// it is used the way Dash Core RPC displays it
+	blockHash := bls12381.ReverseBytes(clsig[4 : 4+crypto.DefaultHashSize])
+	signature := make([]byte, bls12381.SignatureSize)
+	copy(signature, clsig[4+crypto.DefaultHashSize:])
+
+	chainLock := &types.CoreChainLock{
+		CoreBlockHeight: height,
+		CoreBlockHash:   blockHash,
+		Signature:       signature,
+	}
+	return chainLock, chainLock.ValidateBasic()
+}
+
+// notificationsFromProTxDiff returns the notifications describing a masternode list diff
+func notificationsFromProTxDiff(baseHeight, height int64, diff *btcjson.ProTxDiffResult) []Notification {
+	var notifications []Notification
+	for _, quorum := range diff.NewQuorums {
+		quorumHash, err := decodeHash(quorum.QuorumHash)
+		if err != nil {
+			continue
+		}
+		notifications = append(notifications, QuorumCommittedNotification{
+			QuorumType:      btcjson.LLMQType(quorum.LlmqType),
+			QuorumHash:      quorumHash,
+			QuorumPublicKey: quorum.QuorumPublicKey,
+			Height:          height,
+		})
+	}
+	if len(diff.MnList) > 0 || len(diff.DeletedMNs) > 0 {
+		notifications = append(notifications, MasternodeListDiffNotification{
+			BaseHeight: baseHeight,
+			Height:     height,
+			Diff:       *diff,
+		})
+	}
+	return notifications
+}
+
+func decodeHash(s string) (crypto.QuorumHash, error) {
+	hash, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(hash) != crypto.DefaultHashSize {
+		return nil, fmt.Errorf("hash %s has wrong size", s)
+	}
+	return hash, nil
+}
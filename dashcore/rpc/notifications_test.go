@@ -0,0 +1,59 @@
+package dashcore
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/dashevo/dashd-go/btcjson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
+	"github.com/tendermint/tendermint/types"
+)
+
+// rawChainLockSig returns the payload of a rawchainlocksig ZMQ message
+// holding the chain lock.
+func rawChainLockSig(chainLock types.CoreChainLock) []byte {
+	payload := make([]byte, 80) // serialized block comes first
+	height := make([]byte, 4)
+	binary.LittleEndian.PutUint32(height, chainLock.CoreBlockHeight)
+	payload = append(payload, height...)
+	payload = append(payload, bls12381.ReverseBytes(chainLock.CoreBlockHash)...)
+	return append(payload, chainLock.Signature...)
+}
+
+func TestChainLockFromRawChainLockSig(t *testing.T) {
+	chainLock := types.NewMockChainLock(1234)
+	payload := rawChainLockSig(chainLock)
+
+	decoded, err := chainLockFromRawChainLockSig(payload)
+	require.NoError(t, err)
+	assert.Equal(t, chainLock, *decoded)
+
+	_, err = chainLockFromRawChainLockSig(payload[:chainLockSigSize-1])
+	assert.Error(t, err)
+}
+
+func TestNotificationsFromProTxDiff(t *testing.T) {
+	quorumHash := crypto.RandQuorumHash()
+	diff := &btcjson.ProTxDiffResult{
+		MnList: []btcjson.ProTxDiffMN{{ProRegTxHash: crypto.RandProTxHash().String()}},
+		NewQuorums: []btcjson.ProTxDiffNewQuorum{
+			{LlmqType: int(btcjson.LLMQType_5_60), QuorumHash: quorumHash.String()},
+			{LlmqType: int(btcjson.LLMQType_5_60), QuorumHash: "invalid"},
+		},
+	}
+
+	notifications := notificationsFromProTxDiff(10, 11, diff)
+	require.Len(t, notifications, 2)
+	assert.Equal(t, QuorumCommittedNotification{
+		QuorumType: btcjson.LLMQType_5_60,
+		QuorumHash: quorumHash,
+		Height:     11,
+	}, notifications[0])
+	assert.Equal(t, MasternodeListDiffNotification{BaseHeight: 10, Height: 11, Diff: *diff}, notifications[1])
+
+	assert.Empty(t, notificationsFromProTxDiff(10, 11, &btcjson.ProTxDiffResult{}))
+}
@@ -0,0 +1,495 @@
+package dashcore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/dashevo/dashd-go/btcjson"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+	tmsync "github.com/tendermint/tendermint/libs/sync"
+)
+
+// Names of the Client methods, used as metric labels and to configure
+// per-method timeouts.
+const (
+	MethodQuorumInfo         = "quorum_info"
+	MethodMasternodeStatus   = "masternode_status"
+	MethodGetNetworkInfo     = "get_network_info"
+	MethodMasternodeListJSON = "masternode_list_json"
+	MethodQuorumSign         = "quorum_sign"
+	MethodQuorumVerify       = "quorum_verify"
+	MethodQuorumSelectQuorum = "quorum_select_quorum"
+	MethodGetBestChainLock   = "get_best_chain_lock"
+	MethodPing               = "ping"
+)
+
+const (
+	defaultTimeout          = 5 * time.Second
+	defaultMaxRetries       = 3
+	defaultRetryBackoff     = 100 * time.Millisecond
+	maxRetryBackoff         = 2 * time.Second
+	defaultBreakerThreshold = 5
+	defaultBreakerCooldown  = 10 * time.Second
+)
+
+var errCircuitOpen = errors.New("circuit breaker is open")
+
+// ErrCoreUnavailable is returned by ResilientClient when Dash Core did not
+// answer a call, either because every attempt failed or timed out, or because
+// the circuit breaker rejected the call after too many consecutive failures.
+// Errors returned by Dash Core itself are passed through unchanged.
+type ErrCoreUnavailable struct {
+	Method string
+	Err    error
+}
+
+func (e *ErrCoreUnavailable) Error() string {
+	return fmt.Sprintf("dash core is unavailable (%s): %v", e.Method, e.Err)
+}
+
+func (e *ErrCoreUnavailable) Unwrap() error {
+	return e.Err
+}
+
+// IsCoreUnavailable returns true if err is, or wraps, an ErrCoreUnavailable
+func IsCoreUnavailable(err error) bool {
+	var coreErr *ErrCoreUnavailable
+	return errors.As(err, &coreErr)
+}
+
+// ResilientClient implements Client by wrapping another Client.
+// Every call is bounded by a per-method timeout and retried with exponential
+// backoff when Dash Core does not answer, except QuorumSign which is made once:
+// it is called from the consensus routine, which must not wait for retries
+// within a round. After a number of consecutive
+// failures the circuit breaker opens and calls fail fast with
+// ErrCoreUnavailable until the cooldown elapses; then a single trial call is
+// let through to decide whether to close the breaker again.
+type ResilientClient struct {
+	client Client
+
+	timeout        time.Duration
+	methodTimeouts map[string]time.Duration
+	maxRetries     int
+	methodRetries  map[string]int
+	retryBackoff   time.Duration
+	breaker        *circuitBreaker
+
+	metrics *Metrics
+	logger  log.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+var _ Client = (*ResilientClient)(nil)
+
+type ResilientClientOption func(client *ResilientClient)
+
+// ResilientClientWithTimeout is used to specify the timeout of a single
+// attempt of any call that has no timeout of its own
+func ResilientClientWithTimeout(timeout time.Duration) ResilientClientOption {
+	return func(client *ResilientClient) {
+		client.timeout = timeout
+	}
+}
+
+// ResilientClientWithMethodTimeout is used to specify the timeout of a single
+// attempt of the given method, e.g. MethodQuorumSign
+func ResilientClientWithMethodTimeout(method string, timeout time.Duration) ResilientClientOption {
+	return func(client *ResilientClient) {
+		client.methodTimeouts[method] = timeout
+	}
+}
+
+// ResilientClientWithRetries is used to specify how many times a call is
+// retried and how long to wait before the first retry. The wait doubles on
+// every retry.
+func ResilientClientWithRetries(maxRetries int, backoff time.Duration) ResilientClientOption {
+	return func(client *ResilientClient) {
+		client.maxRetries = maxRetries
+		client.retryBackoff = backoff
+	}
+}
+
+// ResilientClientWithMethodRetries is used to specify how many times a call of
+// the given method is retried. By default, MethodQuorumSign isn't retried.
+func ResilientClientWithMethodRetries(method string, maxRetries int) ResilientClientOption {
+	return func(client *ResilientClient) {
+		client.methodRetries[method] = maxRetries
+	}
+}
+
+// ResilientClientWithCircuitBreaker is used to specify after how many
+// consecutive failures the circuit breaker opens and for how long it stays
+// open. A threshold of 0 disables the circuit breaker.
+func ResilientClientWithCircuitBreaker(threshold int, cooldown time.Duration) ResilientClientOption {
+	return func(client *ResilientClient) {
+		client.breaker.threshold = threshold
+		client.breaker.cooldown = cooldown
+	}
+}
+
+// ResilientClientWithMetrics is used to specify the metrics
+func ResilientClientWithMetrics(metrics *Metrics) ResilientClientOption {
+	return func(client *ResilientClient) {
+		client.metrics = metrics
+	}
+}
+
+// ResilientClientWithLogger is used to specify the logger
+func ResilientClientWithLogger(logger log.Logger) ResilientClientOption {
+	return func(client *ResilientClient) {
+		client.logger = logger
+	}
+}
+
+// NewResilientClient returns a ResilientClient wrapping client
+func NewResilientClient(client Client, options ...ResilientClientOption) *ResilientClient {
+	ctx, cancel := context.WithCancel(context.Background())
+	rc := &ResilientClient{
+		client:         client,
+		timeout:        defaultTimeout,
+		methodTimeouts: make(map[string]time.Duration),
+		maxRetries:     defaultMaxRetries,
+		methodRetries:  map[string]int{MethodQuorumSign: 0},
+		retryBackoff:   defaultRetryBackoff,
+		breaker: &circuitBreaker{
+			threshold: defaultBreakerThreshold,
+			cooldown:  defaultBreakerCooldown,
+		},
+		metrics: NopMetrics(),
+		logger:  log.NewNopLogger(),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+	for _, option := range options {
+		option(rc)
+	}
+	return rc
+}
+
+// QuorumInfo implements Client
+func (rc *ResilientClient) QuorumInfo(
+	quorumType btcjson.LLMQType,
+	quorumHash crypto.QuorumHash,
+) (*btcjson.QuorumInfoResult, error) {
+	res, err := rc.call(MethodQuorumInfo, func() (interface{}, error) {
+		return rc.client.QuorumInfo(quorumType, quorumHash)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*btcjson.QuorumInfoResult), nil
+}
+
+// MasternodeStatus implements Client
+func (rc *ResilientClient) MasternodeStatus() (*btcjson.MasternodeStatusResult, error) {
+	res, err := rc.call(MethodMasternodeStatus, func() (interface{}, error) {
+		return rc.client.MasternodeStatus()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*btcjson.MasternodeStatusResult), nil
+}
+
+// GetNetworkInfo implements Client
+func (rc *ResilientClient) GetNetworkInfo() (*btcjson.GetNetworkInfoResult, error) {
+	res, err := rc.call(MethodGetNetworkInfo, func() (interface{}, error) {
+		return rc.client.GetNetworkInfo()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*btcjson.GetNetworkInfoResult), nil
+}
+
+// MasternodeListJSON implements Client
+func (rc *ResilientClient) MasternodeListJSON(filter string) (map[string]btcjson.MasternodelistResultJSON, error) {
+	res, err := rc.call(MethodMasternodeListJSON, func() (interface{}, error) {
+		return rc.client.MasternodeListJSON(filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(map[string]btcjson.MasternodelistResultJSON), nil
+}
+
+// QuorumSign implements Client
+func (rc *ResilientClient) QuorumSign(
+	quorumType btcjson.LLMQType,
+	requestID bytes.HexBytes,
+	messageHash bytes.HexBytes,
+	quorumHash bytes.HexBytes,
+) (*btcjson.QuorumSignResult, error) {
+	res, err := rc.call(MethodQuorumSign, func() (interface{}, error) {
+		return rc.client.QuorumSign(quorumType, requestID, messageHash, quorumHash)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*btcjson.QuorumSignResult), nil
+}
+
+// QuorumVerify implements Client
+func (rc *ResilientClient) QuorumVerify(
+	quorumType btcjson.LLMQType,
+	requestID bytes.HexBytes,
+	messageHash bytes.HexBytes,
+	signature bytes.HexBytes,
+	quorumHash bytes.HexBytes,
+) (bool, error) {
+	res, err := rc.call(MethodQuorumVerify, func() (interface{}, error) {
+		return rc.client.QuorumVerify(quorumType, requestID, messageHash, signature, quorumHash)
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.(bool), nil
+}
+
+// QuorumSelectQuorum implements Client
+func (rc *ResilientClient) QuorumSelectQuorum(
+	quorumType btcjson.LLMQType,
+	requestID bytes.HexBytes,
+) (*btcjson.QuorumSelectQuorumResult, error) {
+	res, err := rc.call(MethodQuorumSelectQuorum, func() (interface{}, error) {
+		return rc.client.QuorumSelectQuorum(quorumType, requestID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*btcjson.QuorumSelectQuorumResult), nil
+}
+
+// GetBestChainLock implements Client
+func (rc *ResilientClient) GetBestChainLock() (*BestChainLockResult, error) {
+	res, err := rc.call(MethodGetBestChainLock, func() (interface{}, error) {
+		return rc.client.GetBestChainLock()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*BestChainLockResult), nil
+}
+
+// Notifications implements Client
+func (rc *ResilientClient) Notifications() <-chan Notification {
+	return rc.client.Notifications()
+}
+
+// Ping implements Client
+func (rc *ResilientClient) Ping() error {
+	_, err := rc.call(MethodPing, func() (interface{}, error) {
+		return nil, rc.client.Ping()
+	})
+	return err
+}
+
+// Close aborts pending calls and closes the wrapped client
+func (rc *ResilientClient) Close() error {
+	rc.cancel()
+	return rc.client.Close()
+}
+
+// call invokes fn, retrying it while Dash Core does not answer, and records
+// the metrics of the call
+func (rc *ResilientClient) call(method string, fn func() (interface{}, error)) (interface{}, error) {
+	start := time.Now()
+	res, err := rc.retry(method, fn)
+	rc.metrics.RequestDuration.With("method", method).Observe(time.Since(start).Seconds())
+	if err != nil {
+		rc.metrics.RequestFailures.With("method", method).Add(1)
+	}
+	return res, err
+}
+
+func (rc *ResilientClient) retry(method string, fn func() (interface{}, error)) (interface{}, error) {
+	backoff := rc.retryBackoff
+	maxRetries := rc.methodMaxRetries(method)
+	for attempt := 0; ; attempt++ {
+		if !rc.breaker.allow() {
+			return nil, &ErrCoreUnavailable{Method: method, Err: errCircuitOpen}
+		}
+
+		res, err := rc.attempt(method, fn)
+		if err != nil && rc.ctx.Err() != nil {
+			// the client was closed
+			return nil, &ErrCoreUnavailable{Method: method, Err: rc.ctx.Err()}
+		}
+		if !isUnavailable(err) {
+			rc.breakerSuccess()
+			return res, err
+		}
+		rc.breakerFailure(method, err)
+
+		if attempt >= maxRetries {
+			return nil, &ErrCoreUnavailable{Method: method, Err: err}
+		}
+		rc.metrics.RequestRetries.With("method", method).Add(1)
+		rc.logger.Debug("dash core call failed, retrying",
+			"method", method, "attempt", attempt+1, "backoff", backoff, "err", err)
+
+		select {
+		case <-time.After(backoff):
+		case <-rc.ctx.Done():
+			return nil, &ErrCoreUnavailable{Method: method, Err: rc.ctx.Err()}
+		}
+		backoff *= 2
+		if backoff > maxRetryBackoff {
+			backoff = maxRetryBackoff
+		}
+	}
+}
+
+// attempt invokes fn once and gives up when the method timeout expires.
+// The wrapped client does not support cancellation, so an abandoned call keeps
+// running in the background and its result is discarded.
+func (rc *ResilientClient) attempt(method string, fn func() (interface{}, error)) (interface{}, error) {
+	ctx, cancel := context.WithTimeout(rc.ctx, rc.methodTimeout(method))
+	defer cancel()
+
+	type result struct {
+		res interface{}
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		res, err := fn()
+		done <- result{res, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.res, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (rc *ResilientClient) methodTimeout(method string) time.Duration {
+	if timeout, ok := rc.methodTimeouts[method]; ok {
+		return timeout
+	}
+	return rc.timeout
+}
+
+func (rc *ResilientClient) methodMaxRetries(method string) int {
+	if maxRetries, ok := rc.methodRetries[method]; ok {
+		return maxRetries
+	}
+	return rc.maxRetries
+}
+
+func (rc *ResilientClient) breakerSuccess() {
+	if rc.breaker.success() {
+		rc.metrics.CircuitBreakerOpen.Set(0)
+		rc.logger.Info("dash core is available again, circuit breaker closed")
+	}
+}
+
+func (rc *ResilientClient) breakerFailure(method string, err error) {
+	if rc.breaker.failure() {
+		rc.metrics.CircuitBreakerOpen.Set(1)
+		rc.logger.Error("dash core is unavailable, circuit breaker opened",
+			"method", method, "cooldown", rc.breaker.cooldown, "err", err)
+	}
+}
+
+// isUnavailable returns true if err means that Dash Core did not answer: the
+// call timed out or the connection failed. Any other error, e.g. one returned
+// by Dash Core or a malformed reply, is neither retried nor counted by the
+// circuit breaker.
+func isUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+type circuitBreakerState int
+
+const (
+	circuitBreakerClosed circuitBreakerState = iota
+	circuitBreakerOpen
+	circuitBreakerHalfOpen
+)
+
+// circuitBreaker counts consecutive failures and rejects calls for a cooldown
+// period once the threshold is reached
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mtx      tmsync.Mutex
+	state    circuitBreakerState
+	failures int
+	openedAt time.Time
+}
+
+// allow returns true if a call may be made. Once the cooldown elapsed a single
+// trial call is allowed.
+func (cb *circuitBreaker) allow() bool {
+	cb.mtx.Lock()
+	defer cb.mtx.Unlock()
+
+	switch cb.state {
+	case circuitBreakerOpen:
+		if time.Since(cb.openedAt) < cb.cooldown {
+			return false
+		}
+		cb.state = circuitBreakerHalfOpen
+		return true
+	case circuitBreakerHalfOpen:
+		// the trial call is in flight
+		return false
+	default:
+		return true
+	}
+}
+
+// success records a successful call and returns true if it closed the breaker
+func (cb *circuitBreaker) success() bool {
+	cb.mtx.Lock()
+	defer cb.mtx.Unlock()
+
+	closed := cb.state != circuitBreakerClosed
+	cb.state = circuitBreakerClosed
+	cb.failures = 0
+	return closed
+}
+
+// failure records a failed call and returns true if it opened the breaker
+func (cb *circuitBreaker) failure() bool {
+	cb.mtx.Lock()
+	defer cb.mtx.Unlock()
+
+	if cb.threshold <= 0 {
+		return false
+	}
+	cb.failures++
+	switch {
+	case cb.state == circuitBreakerHalfOpen:
+		// the trial call failed, keep rejecting calls
+		cb.state = circuitBreakerOpen
+		cb.openedAt = time.Now()
+		return false
+	case cb.state == circuitBreakerClosed && cb.failures >= cb.threshold:
+		cb.state = circuitBreakerOpen
+		cb.openedAt = time.Now()
+		return true
+	}
+	return false
+}
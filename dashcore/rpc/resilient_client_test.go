@@ -0,0 +1,160 @@
+package dashcore
+
+import (
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dashevo/dashd-go/btcjson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/bytes"
+)
+
+var errConnRefused = &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+
+// pingClient is a Client whose Ping behaves as told
+type pingClient struct {
+	Client
+	calls int32
+	ping  func(call int32) error
+}
+
+func (c *pingClient) Ping() error {
+	return c.ping(atomic.AddInt32(&c.calls, 1))
+}
+
+func (c *pingClient) Close() error {
+	return nil
+}
+
+// signClient is a Client whose QuorumSign sleeps for the given duration
+type signClient struct {
+	Client
+	calls int32
+	sleep time.Duration
+}
+
+func (c *signClient) QuorumSign(
+	quorumType btcjson.LLMQType,
+	requestID bytes.HexBytes,
+	messageHash bytes.HexBytes,
+	quorumHash bytes.HexBytes,
+) (*btcjson.QuorumSignResult, error) {
+	atomic.AddInt32(&c.calls, 1)
+	time.Sleep(c.sleep)
+	return &btcjson.QuorumSignResult{}, nil
+}
+
+func TestResilientClientRetry(t *testing.T) {
+	client := &pingClient{ping: func(call int32) error {
+		if call < 3 {
+			return errConnRefused
+		}
+		return nil
+	}}
+	rc := NewResilientClient(client, ResilientClientWithRetries(3, time.Millisecond))
+
+	require.NoError(t, rc.Ping())
+	assert.EqualValues(t, 3, client.calls)
+}
+
+func TestResilientClientCoreError(t *testing.T) {
+	rpcErr := &btcjson.RPCError{Code: btcjson.ErrRPCInvalidParameter, Message: "invalid quorum"}
+	client := &pingClient{ping: func(int32) error {
+		return rpcErr
+	}}
+	rc := NewResilientClient(client, ResilientClientWithRetries(3, time.Millisecond))
+
+	// dash core answered, so there is no retry
+	err := rc.Ping()
+	assert.Equal(t, rpcErr, err)
+	assert.False(t, IsCoreUnavailable(err))
+	assert.EqualValues(t, 1, client.calls)
+}
+
+func TestResilientClientMalformedReply(t *testing.T) {
+	client := &pingClient{ping: func(int32) error {
+		return errors.New("status code: 401, response: \"\"")
+	}}
+	rc := NewResilientClient(client,
+		ResilientClientWithRetries(3, time.Millisecond),
+		ResilientClientWithCircuitBreaker(1, time.Minute),
+	)
+
+	// dash core answered, so there is no retry and the breaker stays closed
+	for i := 0; i < 2; i++ {
+		err := rc.Ping()
+		assert.Error(t, err)
+		assert.False(t, IsCoreUnavailable(err))
+	}
+	assert.EqualValues(t, 2, client.calls)
+}
+
+func TestResilientClientTimeout(t *testing.T) {
+	client := &pingClient{ping: func(int32) error {
+		time.Sleep(100 * time.Millisecond)
+		return nil
+	}}
+	rc := NewResilientClient(client,
+		ResilientClientWithMethodTimeout(MethodPing, 10*time.Millisecond),
+		ResilientClientWithRetries(1, time.Millisecond),
+	)
+
+	err := rc.Ping()
+	assert.True(t, IsCoreUnavailable(err))
+	assert.EqualValues(t, 2, atomic.LoadInt32(&client.calls))
+}
+
+func TestResilientClientCircuitBreaker(t *testing.T) {
+	var available int32
+	client := &pingClient{ping: func(int32) error {
+		if atomic.LoadInt32(&available) == 1 {
+			return nil
+		}
+		return errConnRefused
+	}}
+	rc := NewResilientClient(client,
+		ResilientClientWithRetries(0, 0),
+		ResilientClientWithCircuitBreaker(2, 50*time.Millisecond),
+	)
+
+	assert.True(t, IsCoreUnavailable(rc.Ping()))
+	assert.True(t, IsCoreUnavailable(rc.Ping()))
+	assert.EqualValues(t, 2, client.calls)
+
+	// the breaker is open, calls fail without reaching dash core
+	err := rc.Ping()
+	assert.True(t, errors.Is(err, errCircuitOpen))
+	assert.EqualValues(t, 2, client.calls)
+
+	// after the cooldown a failed trial call keeps the breaker open
+	time.Sleep(60 * time.Millisecond)
+	assert.True(t, IsCoreUnavailable(rc.Ping()))
+	assert.EqualValues(t, 3, client.calls)
+	assert.True(t, errors.Is(rc.Ping(), errCircuitOpen))
+
+	// a successful trial call closes it
+	atomic.StoreInt32(&available, 1)
+	time.Sleep(60 * time.Millisecond)
+	require.NoError(t, rc.Ping())
+	require.NoError(t, rc.Ping())
+	assert.EqualValues(t, 5, client.calls)
+}
+
+func TestResilientClientQuorumSignNotRetried(t *testing.T) {
+	client := &signClient{sleep: 100 * time.Millisecond}
+	rc := NewResilientClient(client,
+		ResilientClientWithMethodTimeout(MethodQuorumSign, 10*time.Millisecond),
+		ResilientClientWithRetries(3, time.Millisecond),
+	)
+
+	start := time.Now()
+	_, err := rc.QuorumSign(btcjson.LLMQType_5_60, nil, nil, nil)
+	assert.True(t, IsCoreUnavailable(err))
+	assert.Less(t, int64(time.Since(start)), int64(100*time.Millisecond))
+	assert.EqualValues(t, 1, atomic.LoadInt32(&client.calls))
+}
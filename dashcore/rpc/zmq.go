@@ -0,0 +1,168 @@
+package dashcore
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/dashevo/dashd-go/btcjson"
+	"github.com/go-zeromq/zmq4"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	// dashd publishes the block followed by the chain lock signature
+	zmqTopicRawChainLockSig = "rawchainlocksig"
+	// dashd publishes the hash of every new tip
+	zmqTopicHashBlock = "hashblock"
+
+	zmqReconnectInterval = 5 * time.Second
+)
+
+// zmqRPCClient is the part of the RPC client of dashd the subscriber fetches the
+// masternode list diff of every new block with.
+type zmqRPCClient interface {
+	GetBlockHeaderVerbose(blockHash *chainhash.Hash) (*btcjson.GetBlockHeaderVerboseResult, error)
+	ProTxDiff(baseBlock, block int) (*btcjson.ProTxDiffResult, error)
+}
+
+// zmqSubscriber subscribes to the ZMQ publisher of dashd and turns the published
+// messages into notifications. Quorum and masternode list notifications are
+// built from the masternode list diff of every new block.
+type zmqSubscriber struct {
+	endpoint    string
+	endpointRPC zmqRPCClient
+	out         chan<- Notification
+	logger      log.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	lastHeight int64
+}
+
+func newZMQSubscriber(
+	endpoint string,
+	endpointRPC zmqRPCClient,
+	out chan<- Notification,
+	logger log.Logger,
+) *zmqSubscriber {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &zmqSubscriber{
+		endpoint:    endpoint,
+		endpointRPC: endpointRPC,
+		out:         out,
+		logger:      logger,
+		ctx:         ctx,
+		cancel:      cancel,
+		done:        make(chan struct{}),
+	}
+}
+
+func (s *zmqSubscriber) start() {
+	go s.run()
+}
+
+// stop stops the subscriber and waits until it has exited
+func (s *zmqSubscriber) stop() {
+	s.cancel()
+	<-s.done
+}
+
+func (s *zmqSubscriber) run() {
+	defer close(s.done)
+
+	for {
+		err := s.subscribe()
+		if s.ctx.Err() != nil {
+			return
+		}
+		s.logger.Error("dash core zmq subscription failed, reconnecting",
+			"endpoint", s.endpoint, "err", err)
+
+		select {
+		case <-time.After(zmqReconnectInterval):
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// subscribe connects to the publisher and handles messages until the
+// connection fails or the subscriber is stopped
+func (s *zmqSubscriber) subscribe() error {
+	sub := zmq4.NewSub(s.ctx)
+	defer sub.Close()
+
+	if err := sub.Dial(s.endpoint); err != nil {
+		return err
+	}
+	for _, topic := range []string{zmqTopicRawChainLockSig, zmqTopicHashBlock} {
+		if err := sub.SetOption(zmq4.OptionSubscribe, topic); err != nil {
+			return err
+		}
+	}
+
+	for {
+		msg, err := sub.Recv()
+		if err != nil {
+			return err
+		}
+		// dashd sends multipart messages: topic, body and sequence number
+		if len(msg.Frames) < 2 {
+			continue
+		}
+		if err := s.handle(string(msg.Frames[0]), msg.Frames[1]); err != nil {
+			s.logger.Error("unable to handle dash core zmq message",
+				"topic", string(msg.Frames[0]), "err", err)
+		}
+	}
+}
+
+func (s *zmqSubscriber) handle(topic string, body []byte) error {
+	switch topic {
+	case zmqTopicRawChainLockSig:
+		chainLock, err := chainLockFromRawChainLockSig(body)
+		if err != nil {
+			return err
+		}
+		s.publish(ChainLockNotification{ChainLock: *chainLock})
+	case zmqTopicHashBlock:
+		blockHash, err := chainhash.NewHashFromStr(hex.EncodeToString(body))
+		if err != nil {
+			return err
+		}
+		header, err := s.endpointRPC.GetBlockHeaderVerbose(blockHash)
+		if err != nil {
+			return err
+		}
+		height := int64(header.Height)
+		baseHeight := s.lastHeight
+		if baseHeight == 0 || baseHeight >= height {
+			baseHeight = height - 1
+		}
+		diff, err := s.endpointRPC.ProTxDiff(int(baseHeight), int(height))
+		if err != nil {
+			return err
+		}
+		s.lastHeight = height
+		for _, notification := range notificationsFromProTxDiff(baseHeight, height, diff) {
+			s.publish(notification)
+		}
+	}
+	return nil
+}
+
+// publish delivers the notification unless nobody keeps up with the channel
+func (s *zmqSubscriber) publish(notification Notification) {
+	select {
+	case s.out <- notification:
+	default:
+		s.logger.Error("dash core notification dropped, channel is full",
+			"notification", fmt.Sprintf("%T", notification))
+	}
+}
@@ -0,0 +1,134 @@
+package dashcore
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/dashevo/dashd-go/btcjson"
+	"github.com/go-zeromq/zmq4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/types"
+)
+
+// zmqTestRPCClient serves the header and the masternode list diff of a single
+// block.
+type zmqTestRPCClient struct {
+	blockHash chainhash.Hash
+	height    int32
+	diff      *btcjson.ProTxDiffResult
+}
+
+func (c zmqTestRPCClient) GetBlockHeaderVerbose(blockHash *chainhash.Hash) (*btcjson.GetBlockHeaderVerboseResult, error) {
+	if *blockHash != c.blockHash {
+		return nil, errors.New("block not found")
+	}
+	return &btcjson.GetBlockHeaderVerboseResult{Hash: blockHash.String(), Height: c.height}, nil
+}
+
+func (c zmqTestRPCClient) ProTxDiff(baseBlock, block int) (*btcjson.ProTxDiffResult, error) {
+	if block != int(c.height) {
+		return nil, errors.New("block not found")
+	}
+	return c.diff, nil
+}
+
+// hashBlock returns the payload of a hashblock ZMQ message for the block hash.
+func hashBlock(t *testing.T, blockHash chainhash.Hash) []byte {
+	body, err := hex.DecodeString(blockHash.String())
+	require.NoError(t, err)
+	return body
+}
+
+// receiveNotifications publishes the messages until the subscriber of the
+// publisher has sent n notifications, as the subscription takes some time to
+// reach the publisher, and returns them.
+func receiveNotifications(t *testing.T, pub zmq4.Socket, out <-chan Notification, n int, messages ...zmq4.Msg) []Notification {
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	timeout := time.After(5 * time.Second)
+
+	var notifications []Notification
+	for {
+		select {
+		case notification := <-out:
+			notifications = append(notifications, notification)
+			if len(notifications) == n {
+				return notifications
+			}
+		case <-ticker.C:
+			if len(notifications) == 0 {
+				for _, msg := range messages {
+					require.NoError(t, pub.Send(msg))
+				}
+			}
+		case <-timeout:
+			t.Fatalf("received %d notifications out of %d", len(notifications), n)
+		}
+	}
+}
+
+func TestZMQSubscriber(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pub := zmq4.NewPub(ctx)
+	defer pub.Close()
+	require.NoError(t, pub.Listen("tcp://127.0.0.1:0"))
+
+	out := make(chan Notification, 1)
+	sub := newZMQSubscriber("tcp://"+pub.Addr().String(), zmqTestRPCClient{}, out, log.TestingLogger())
+	sub.start()
+	defer sub.stop()
+
+	chainLock := types.NewMockChainLock(1234)
+	messages := []zmq4.Msg{
+		// the other topics, the unknown blocks and the malformed chain locks are skipped
+		zmq4.NewMsgFrom([]byte("rawtx"), make([]byte, 32), []byte{0, 0, 0, 0}),
+		zmq4.NewMsgFrom([]byte(zmqTopicHashBlock), hashBlock(t, chainhash.Hash{0xff}), []byte{1, 0, 0, 0}),
+		zmq4.NewMsgFrom([]byte(zmqTopicRawChainLockSig), []byte("invalid"), []byte{2, 0, 0, 0}),
+		zmq4.NewMsgFrom([]byte(zmqTopicRawChainLockSig), rawChainLockSig(chainLock), []byte{3, 0, 0, 0}),
+	}
+
+	notifications := receiveNotifications(t, pub, out, 1, messages...)
+	assert.Equal(t, ChainLockNotification{ChainLock: chainLock}, notifications[0])
+}
+
+func TestZMQSubscriberMasternodeListDiff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pub := zmq4.NewPub(ctx)
+	defer pub.Close()
+	require.NoError(t, pub.Listen("tcp://127.0.0.1:0"))
+
+	quorumHash := crypto.RandQuorumHash()
+	client := zmqTestRPCClient{
+		blockHash: chainhash.Hash{1, 2, 3},
+		height:    11,
+		diff: &btcjson.ProTxDiffResult{
+			MnList:     []btcjson.ProTxDiffMN{{ProRegTxHash: crypto.RandProTxHash().String()}},
+			NewQuorums: []btcjson.ProTxDiffNewQuorum{{LlmqType: int(btcjson.LLMQType_5_60), QuorumHash: quorumHash.String()}},
+		},
+	}
+	out := make(chan Notification, 2)
+	sub := newZMQSubscriber("tcp://"+pub.Addr().String(), client, out, log.TestingLogger())
+	sub.start()
+	defer sub.stop()
+
+	msg := zmq4.NewMsgFrom([]byte(zmqTopicHashBlock), hashBlock(t, client.blockHash), []byte{0, 0, 0, 0})
+	notifications := receiveNotifications(t, pub, out, 2, msg)
+	assert.Equal(t, QuorumCommittedNotification{
+		QuorumType: btcjson.LLMQType_5_60,
+		QuorumHash: quorumHash,
+		Height:     11,
+	}, notifications[0])
+	assert.Equal(t, MasternodeListDiffNotification{BaseHeight: 10, Height: 11, Diff: *client.diff}, notifications[1])
+}
@@ -185,6 +185,21 @@ func (evpool *Pool) ReportConflictingVotes(voteA, voteB *types.Vote) {
 	})
 }
 
+// ReportConflictingStateVotes takes two votes of a validator with conflicting state IDs and
+// forms duplicate state vote evidence, adding it eventually to the evidence pool. Like
+// conflicting votes, they are held in a buffer until consensus at that height has been reached.
+//
+// Votes are not verified.
+func (evpool *Pool) ReportConflictingStateVotes(voteA, voteB *types.Vote) {
+	evpool.mtx.Lock()
+	defer evpool.mtx.Unlock()
+	evpool.consensusBuffer = append(evpool.consensusBuffer, duplicateVoteSet{
+		VoteA:     voteA,
+		VoteB:     voteB,
+		StateVote: true,
+	})
+}
+
 // CheckEvidence takes an array of evidence from a block and verifies all the evidence there.
 // If it has already verified the evidence then it jumps to the next one. It ensures that no
 // evidence has already been committed or is being proposed twice. It also adds any
@@ -455,8 +470,8 @@ func (evpool *Pool) updateState(state sm.State) {
 }
 
 // processConsensusBuffer converts all the duplicate votes witnessed from consensus
-// into DuplicateVoteEvidence. It sets the evidence timestamp to the block height
-// from the most recently committed block.
+// into DuplicateVoteEvidence or DuplicateStateVoteEvidence. It sets the evidence
+// timestamp to the block height from the most recently committed block.
 // Evidence is then added to the pool so as to be ready to be broadcasted and proposed.
 func (evpool *Pool) processConsensusBuffer(state sm.State) {
 	evpool.mtx.Lock()
@@ -465,15 +480,10 @@ func (evpool *Pool) processConsensusBuffer(state sm.State) {
 
 		// Check the height of the conflicting votes and fetch the corresponding time and validator set
 		// to produce the valid evidence
-		var dve *types.DuplicateVoteEvidence
+		var ev types.Evidence
 		switch {
 		case voteSet.VoteA.Height == state.LastBlockHeight:
-			dve = types.NewDuplicateVoteEvidence(
-				voteSet.VoteA,
-				voteSet.VoteB,
-				state.LastBlockTime,
-				state.LastValidators,
-			)
+			ev = voteSet.evidence(state.LastBlockTime, state.LastValidators)
 
 		case voteSet.VoteA.Height < state.LastBlockHeight:
 			valSet, err := evpool.stateDB.LoadValidators(voteSet.VoteA.Height)
@@ -488,12 +498,7 @@ func (evpool *Pool) processConsensusBuffer(state sm.State) {
 				evpool.logger.Error("failed to load block time for conflicting votes", "height", voteSet.VoteA.Height)
 				continue
 			}
-			dve = types.NewDuplicateVoteEvidence(
-				voteSet.VoteA,
-				voteSet.VoteB,
-				blockMeta.Header.Time,
-				valSet,
-			)
+			ev = voteSet.evidence(blockMeta.Header.Time, valSet)
 
 		default:
 			// evidence pool shouldn't expect to get votes from consensus of a height that is above the current
@@ -505,34 +510,56 @@ func (evpool *Pool) processConsensusBuffer(state sm.State) {
 			continue
 		}
 
+		if ev == nil {
+			evpool.logger.Error("failed to form evidence from conflicting votes", "height", voteSet.VoteA.Height,
+				"VoteA", voteSet.VoteA, "VoteB", voteSet.VoteB)
+			continue
+		}
+
 		// check if we already have this evidence
-		if evpool.isPending(dve) {
-			evpool.logger.Debug("evidence already pending; ignoring", "evidence", dve)
+		if evpool.isPending(ev) {
+			evpool.logger.Debug("evidence already pending; ignoring", "evidence", ev)
 			continue
 		}
 
 		// check that the evidence is not already committed on chain
-		if evpool.isCommitted(dve) {
-			evpool.logger.Debug("evidence already committed; ignoring", "evidence", dve)
+		if evpool.isCommitted(ev) {
+			evpool.logger.Debug("evidence already committed; ignoring", "evidence", ev)
 			continue
 		}
 
-		if err := evpool.addPendingEvidence(dve); err != nil {
+		if err := evpool.addPendingEvidence(ev); err != nil {
 			evpool.logger.Error("failed to flush evidence from consensus buffer to pending list: %w", err)
 			continue
 		}
 
-		evpool.evidenceList.PushBack(dve)
+		evpool.evidenceList.PushBack(ev)
 
-		evpool.logger.Info("verified new evidence of byzantine behavior", "evidence", dve)
+		evpool.logger.Info("verified new evidence of byzantine behavior", "evidence", ev)
 	}
 	// reset consensus buffer
 	evpool.consensusBuffer = make([]duplicateVoteSet, 0)
 }
 
 type duplicateVoteSet struct {
-	VoteA *types.Vote
-	VoteB *types.Vote
+	VoteA     *types.Vote
+	VoteB     *types.Vote
+	StateVote bool // the votes conflict on the state ID instead of the block ID
+}
+
+// evidence forms the evidence of the conflicting votes given the time of the block and the
+// validator set at their height. It returns nil if the evidence can't be formed.
+func (dvs duplicateVoteSet) evidence(blockTime time.Time, valSet *types.ValidatorSet) types.Evidence {
+	if dvs.StateVote {
+		if ev := types.NewDuplicateStateVoteEvidence(dvs.VoteA, dvs.VoteB, blockTime, valSet); ev != nil {
+			return ev
+		}
+		return nil
+	}
+	if ev := types.NewDuplicateVoteEvidence(dvs.VoteA, dvs.VoteB, blockTime, valSet); ev != nil {
+		return ev
+	}
+	return nil
 }
 
 func bytesToEv(evBytes []byte) (types.Evidence, error) {
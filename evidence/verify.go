@@ -2,6 +2,7 @@ package evidence
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 
 	"github.com/tendermint/tendermint/types"
@@ -52,6 +53,18 @@ func (evpool *Pool) verify(evidence types.Evidence) error {
 			return err
 		}
 		return VerifyDuplicateVote(ev, state.ChainID, valSet)
+	case *types.DuplicateStateVoteEvidence:
+		valSet, err := evpool.stateDB.LoadValidators(evidence.Height())
+		if err != nil {
+			return err
+		}
+		return VerifyDuplicateStateVote(ev, state.ChainID, valSet)
+	case *types.LightClientAttackEvidence:
+		valSet, err := evpool.stateDB.LoadValidators(evidence.Height())
+		if err != nil {
+			return err
+		}
+		return VerifyLightClientAttack(ev, state.ChainID, &blockMeta.Header, valSet)
 	default:
 		return fmt.Errorf("unrecognized evidence type: %T", evidence)
 	}
@@ -129,6 +142,119 @@ func VerifyDuplicateVote(e *types.DuplicateVoteEvidence, chainID string, valSet
 	return nil
 }
 
+// VerifyDuplicateStateVote verifies DuplicateStateVoteEvidence against the state of full node. This
+// involves the following checks:
+//      - the validator is in the validator set at the height of the evidence
+//      - the height and validator address of the votes must be the same
+//      - both votes must be for a block, votes for nil don't sign the state
+//      - the state ID's must be different
+//      - The state signatures must both be valid
+func VerifyDuplicateStateVote(e *types.DuplicateStateVoteEvidence, chainID string, valSet *types.ValidatorSet) error {
+	_, val := valSet.GetByProTxHash(e.VoteA.ValidatorProTxHash)
+	if val == nil {
+		return fmt.Errorf("proTxHash %X was not a validator at height %d", e.VoteA.ValidatorProTxHash, e.Height())
+	}
+	pubKey := val.PubKey
+
+	// the state signature only covers the height, so round and type may differ
+	if e.VoteA.Height != e.VoteB.Height {
+		return fmt.Errorf("heights do not match: %d vs %d", e.VoteA.Height, e.VoteB.Height)
+	}
+
+	// ProTxHashes must be the same
+	if !bytes.Equal(e.VoteA.ValidatorProTxHash, e.VoteB.ValidatorProTxHash) {
+		return fmt.Errorf("validator proTxHashes do not match: %X vs %X",
+			e.VoteA.ValidatorProTxHash,
+			e.VoteB.ValidatorProTxHash,
+		)
+	}
+
+	if e.VoteA.BlockID.IsZero() || e.VoteB.BlockID.IsZero() {
+		return errors.New("one or both of the votes are for nil and carry no state signature")
+	}
+
+	// StateIDs must be different
+	if e.VoteA.StateID.Equals(e.VoteB.StateID) {
+		return fmt.Errorf(
+			"state IDs are the same (%v) - not a real duplicate state vote",
+			e.VoteA.StateID,
+		)
+	}
+
+	// validator voting power and total voting power must match
+	if val.VotingPower != e.ValidatorPower {
+		return fmt.Errorf("validator power from evidence and our validator set does not match (%d != %d)",
+			e.ValidatorPower, val.VotingPower)
+	}
+	if valSet.TotalVotingPower() != e.TotalVotingPower {
+		return fmt.Errorf("total voting power from the evidence and our validator set does not match (%d != %d)",
+			e.TotalVotingPower, valSet.TotalVotingPower())
+	}
+
+	// State signatures must be valid
+	if !pubKey.VerifySignatureDigest(
+		types.VoteStateSignID(chainID, e.VoteA.ToProto(), valSet.QuorumType, valSet.QuorumHash),
+		e.VoteA.StateSignature,
+	) {
+		return fmt.Errorf("verifying VoteA: %s", types.ErrVoteInvalidStateSignature.Error())
+	}
+	if !pubKey.VerifySignatureDigest(
+		types.VoteStateSignID(chainID, e.VoteB.ToProto(), valSet.QuorumType, valSet.QuorumHash),
+		e.VoteB.StateSignature,
+	) {
+		return fmt.Errorf("verifying VoteB: %s", types.ErrVoteInvalidStateSignature.Error())
+	}
+
+	return nil
+}
+
+// VerifyLightClientAttack verifies LightClientAttackEvidence against the state of the full node.
+// This involves the following checks:
+//      - the conflicting block is from the same chain and differs from the trusted header
+//      - the conflicting block was signed by the quorum that was active at its height
+//      - the threshold block and state signatures of the conflicting commit are valid
+//      - the total voting power matches the validator set at the height of the evidence
+func VerifyLightClientAttack(
+	e *types.LightClientAttackEvidence,
+	chainID string,
+	trustedHeader *types.Header,
+	valSet *types.ValidatorSet,
+) error {
+	conflictingBlock := e.ConflictingBlock
+	if conflictingBlock.ChainID != chainID {
+		return fmt.Errorf("conflicting block belongs to another chain %q, not %q", conflictingBlock.ChainID, chainID)
+	}
+
+	// the headers must be different
+	if bytes.Equal(trustedHeader.Hash(), conflictingBlock.Hash()) {
+		return fmt.Errorf("trusted header hash matches the conflicting block hash (%X) - not a real attack",
+			trustedHeader.Hash())
+	}
+
+	// the conflicting block must be signed by the quorum active at the height of the evidence
+	if !bytes.Equal(conflictingBlock.Commit.QuorumHash, valSet.QuorumHash) {
+		return fmt.Errorf("conflicting block is signed by quorum %X, the quorum at height %d is %X",
+			conflictingBlock.Commit.QuorumHash, e.Height(), valSet.QuorumHash)
+	}
+	if !bytes.Equal(conflictingBlock.ValidatorSet.Hash(), valSet.Hash()) {
+		return fmt.Errorf("validator set of the conflicting block (%X) doesn't match the validator set at height %d (%X)",
+			conflictingBlock.ValidatorSet.Hash(), e.Height(), valSet.Hash())
+	}
+
+	// the threshold signatures must be valid
+	commit := conflictingBlock.Commit
+	if err := valSet.VerifyCommit(chainID, commit.BlockID, commit.StateID, e.Height(), commit); err != nil {
+		return fmt.Errorf("invalid commit of the conflicting block: %w", err)
+	}
+
+	if valSet.TotalVotingPower() != e.TotalVotingPower {
+		return fmt.Errorf("total voting power from the evidence and our validator set does not match (%d != %d)",
+			e.TotalVotingPower, valSet.TotalVotingPower())
+	}
+
+	return nil
+}
+
 /*
 func getSignedHeader(blockStore BlockStore, height int64) (*types.SignedHeader, error) {
 	blockMeta := blockStore.LoadBlockMeta(height)
@@ -143,6 +143,159 @@ func TestVerifyDuplicateVoteEvidence(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestVerifyDuplicateStateVoteEvidence(t *testing.T) {
+	quorumHash := crypto.RandQuorumHash()
+	val := types.NewMockPVForQuorum(quorumHash)
+	val2 := types.NewMockPVForQuorum(quorumHash)
+	quorumType := btcjson.LLMQType_5_60
+	pubKey, err := val.GetPubKey(quorumHash)
+	require.NoError(t, err)
+	valSet := types.NewValidatorSet([]*types.Validator{val.ExtractIntoValidator(quorumHash)},
+		pubKey, quorumType, quorumHash, true)
+
+	blockID := makeBlockID([]byte("blockhash"), 1000, []byte("partshash"))
+	blockID2 := makeBlockID([]byte("blockhash2"), 1000, []byte("partshash"))
+
+	stateID := makeStateID([]byte("lastapphash"))
+	stateID2 := makeStateID([]byte("lastapphash2"))
+
+	const chainID = "mychain"
+
+	vote1 := makeVote(t, val, chainID, quorumType, quorumHash, 0, 10, 2, 1, blockID, stateID)
+	badVote := makeVote(t, val2, chainID, quorumType, quorumHash, 0, 10, 2, 1, blockID, stateID2)
+	badVote.ValidatorProTxHash = vote1.ValidatorProTxHash
+
+	cases := []voteData{
+		// different state ids
+		{vote1, makeVote(t, val, chainID, quorumType, quorumHash, 0, 10, 2, 1, blockID, stateID2), true},
+		{vote1, makeVote(t, val, chainID, quorumType, quorumHash, 0, 10, 2, 1, blockID2, stateID2), true},
+		// the state signature doesn't depend on round and step
+		{vote1, makeVote(t, val, chainID, quorumType, quorumHash, 0, 10, 3, 2, blockID, stateID2), true},
+		// same state id
+		{vote1, makeVote(t, val, chainID, quorumType, quorumHash, 0, 10, 2, 1, blockID2, stateID), false},
+		// vote for nil
+		{vote1, makeVote(t, val, chainID, quorumType, quorumHash, 0, 10, 2, 1, types.BlockID{}, types.StateID{}), false},
+		// wrong height
+		{vote1, makeVote(t, val, chainID, quorumType, quorumHash, 0, 11, 2, 1, blockID, stateID2), false},
+		// wrong validator
+		{vote1, makeVote(t, val2, chainID, quorumType, quorumHash, 0, 10, 2, 1, blockID, stateID2), false},
+		// signed by wrong key
+		{vote1, badVote, false},
+	}
+
+	for _, c := range cases {
+		ev := &types.DuplicateStateVoteEvidence{
+			VoteA:            c.vote1,
+			VoteB:            c.vote2,
+			ValidatorPower:   types.DefaultDashVotingPower,
+			TotalVotingPower: types.DefaultDashVotingPower,
+			Timestamp:        defaultEvidenceTime,
+		}
+		if c.valid {
+			assert.Nil(t, evidence.VerifyDuplicateStateVote(ev, chainID, valSet), "evidence should be valid")
+		} else {
+			assert.NotNil(t, evidence.VerifyDuplicateStateVote(ev, chainID, valSet), "evidence should be invalid")
+		}
+	}
+
+	// evidence with a different validator power should fail
+	ev := types.NewDuplicateStateVoteEvidence(
+		vote1,
+		makeVote(t, val, chainID, quorumType, quorumHash, 0, 10, 2, 1, blockID, stateID2),
+		defaultEvidenceTime,
+		valSet,
+	)
+	require.NotNil(t, ev)
+	require.NoError(t, ev.ValidateBasic())
+	assert.NoError(t, evidence.VerifyDuplicateStateVote(ev, chainID, valSet))
+	ev.ValidatorPower++
+	assert.Error(t, evidence.VerifyDuplicateStateVote(ev, chainID, valSet))
+}
+
+func TestVerifyLightClientAttackEvidence(t *testing.T) {
+	const (
+		chainID       = "mychain"
+		height  int64 = 10
+	)
+	valSet, privVals := types.GenerateValidatorSet(4)
+	otherValSet, _ := types.GenerateValidatorSet(4)
+	// the block the node committed at the height of the evidence
+	trustedHeader := *makeLightClientAttackEvidence(t, chainID, height, valSet, privVals).ConflictingBlock.Header
+
+	testCases := []struct {
+		name      string
+		chainID   string
+		malleate  func(ev *types.LightClientAttackEvidence, trusted *types.Header)
+		expectErr string
+	}{
+		{"valid evidence", chainID, func(*types.LightClientAttackEvidence, *types.Header) {}, ""},
+		{"different chain", "otherchain", func(*types.LightClientAttackEvidence, *types.Header) {},
+			"belongs to another chain"},
+		{"same hash as the trusted header", chainID, func(ev *types.LightClientAttackEvidence, trusted *types.Header) {
+			*trusted = *ev.ConflictingBlock.Header
+		}, "not a real attack"},
+		{"signed by another quorum", chainID, func(ev *types.LightClientAttackEvidence, _ *types.Header) {
+			ev.ConflictingBlock.Commit.QuorumHash = crypto.RandQuorumHash()
+		}, "signed by quorum"},
+		{"another validator set", chainID, func(ev *types.LightClientAttackEvidence, _ *types.Header) {
+			ev.ConflictingBlock.ValidatorSet = otherValSet
+		}, "doesn't match the validator set"},
+		{"invalid commit", chainID, func(ev *types.LightClientAttackEvidence, _ *types.Header) {
+			commit := ev.ConflictingBlock.Commit
+			commit.ThresholdBlockSignature = commit.ThresholdStateSignature
+		}, "invalid commit"},
+		{"different total voting power", chainID, func(ev *types.LightClientAttackEvidence, _ *types.Header) {
+			ev.TotalVotingPower++
+		}, "total voting power"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ev := makeLightClientAttackEvidence(t, chainID, height, valSet, privVals)
+			trusted := trustedHeader
+			tc.malleate(ev, &trusted)
+
+			err := evidence.VerifyLightClientAttack(ev, tc.chainID, &trusted, valSet)
+			if tc.expectErr == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectErr)
+			}
+		})
+	}
+}
+
+// makeLightClientAttackEvidence returns evidence of a block with a random app
+// hash, signed by the quorum of the validator set.
+func makeLightClientAttackEvidence(
+	t *testing.T,
+	chainID string,
+	height int64,
+	valSet *types.ValidatorSet,
+	privVals []types.PrivValidator,
+) *types.LightClientAttackEvidence {
+	header := &types.Header{
+		ChainID:            chainID,
+		Height:             height,
+		Time:               defaultEvidenceTime,
+		ValidatorsHash:     valSet.Hash(),
+		NextValidatorsHash: valSet.Hash(),
+		AppHash:            crypto.CRandBytes(tmhash.Size),
+		ProposerProTxHash:  valSet.Validators[0].ProTxHash,
+	}
+	blockID := makeBlockID(header.Hash(), 1, []byte("partshash"))
+	voteSet := types.NewVoteSet(chainID, height, 0, tmproto.PrecommitType, valSet)
+	commit, err := types.MakeCommit(blockID, makeStateID(header.AppHash), height, 0, voteSet, privVals)
+	require.NoError(t, err)
+
+	return types.NewLightClientAttackEvidence(&types.LightBlock{
+		SignedHeader: &types.SignedHeader{Header: header, Commit: commit},
+		ValidatorSet: valSet,
+	}, defaultEvidenceTime)
+}
+
 func makeVote(
 	t *testing.T,
 	val types.PrivValidator,
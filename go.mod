@@ -9,15 +9,18 @@ require (
 	github.com/btcsuite/btcutil v1.0.2
 	github.com/dashevo/dashd-go v0.0.0-20210630125816-b417ad8eb165
 	github.com/dashpay/bls-signatures/go-bindings v0.0.0-20201127091120-745324b80143
+	github.com/fergusstrange/embedded-postgres v1.10.0
 	github.com/fortytw2/leaktest v1.3.0
 	github.com/go-kit/kit v0.10.0
 	github.com/go-logfmt/logfmt v0.5.0
 	github.com/go-pkgz/jrpc v0.2.0
+	github.com/go-zeromq/zmq4 v0.13.0
 	github.com/gogo/protobuf v1.3.2
 	github.com/golang/protobuf v1.4.3
 	github.com/google/orderedcode v0.0.1
 	github.com/gorilla/websocket v1.4.2
 	github.com/gtank/merlin v0.1.1
+	github.com/lib/pq v1.10.2
 	github.com/libp2p/go-buffer-pool v0.0.2
 	github.com/minio/highwayhash v1.0.1
 	github.com/pkg/errors v0.9.1
@@ -33,6 +36,7 @@ require (
 	golang.org/x/crypto v0.0.0-20210812204632-0ba0e8f03122
 	golang.org/x/net v0.0.0-20210405180319-a5a99cb37ef4
 	golang.org/x/sys v0.0.0-20210809222454-d867a43fc93e // indirect
+	golang.org/x/term v0.0.0-20201126162022-7de9c90e9dd1
 	google.golang.org/genproto v0.0.0-20201119123407-9b1e624d6bc4 // indirect
 	google.golang.org/grpc v1.37.0
 )
@@ -6,6 +6,15 @@ import (
 	"github.com/tendermint/tendermint/libs/pubsub/query"
 )
 
+// events are matched against the fuzzed queries.
+var events = map[string][]string{
+	"tm.event":        {"Tx"},
+	"tx.height":       {"5"},
+	"transfer.amount": {"10stake", "2.5stake"},
+	"account.owner":   {"Ivan", "Igor"},
+	"app.date":        {"2021-01-02"},
+}
+
 func Fuzz(data []byte) int {
 	sdata := string(data)
 	q0, err := query.New(sdata)
@@ -26,5 +35,37 @@ func Fuzz(data []byte) int {
 		panic("query changed")
 	}
 
+	expr, err := q0.Expression()
+	if err != nil {
+		// an operand of the query can't be parsed
+		return 0
+	}
+
+	// the conditions of a query are the ones of its expression
+	conditions, ok := expr.Conditions()
+	conditions0, err := q0.Conditions()
+	if ok != (err == nil) || len(conditions) != len(conditions0) {
+		fmt.Printf("q0: %q\n", sdata)
+		panic("query conditions differ from the expression ones")
+	}
+
+	// NOT negates the query
+	match, err := q0.Matches(events)
+	if err != nil {
+		return 1
+	}
+	qNot, err := query.New("NOT (" + sdata + ")")
+	if err != nil {
+		panic(err)
+	}
+	notMatch, err := qNot.Matches(events)
+	if err != nil {
+		panic(err)
+	}
+	if match == notMatch {
+		fmt.Printf("q0: %q\n", sdata)
+		panic("NOT doesn't negate the query")
+	}
+
 	return 1
 }
@@ -82,6 +82,26 @@ func TestParser(t *testing.T) {
 		{"account.balance=100 AND slashing.amount EXISTS", true},
 		{"slashing EXISTS", true},
 
+		{"account.balance=100 OR slashing.amount EXISTS", true},
+		{"account.balance=100 OR", false},
+		{"OR account.balance=100", false},
+		{"account.balance=100 OR account.balance=200 AND slashing.amount EXISTS", true},
+		{"(account.balance=100 OR account.balance=200) AND slashing.amount EXISTS", true},
+		{"( account.balance=100 OR account.balance=200 )", true},
+		{"((account.balance=100))", true},
+		{"(account.balance=100", false},
+		{"account.balance=100)", false},
+		{"()", false},
+		{"NOT account.balance=100", true},
+		{"NOT NOT account.balance=100", true},
+		{"NOT (account.balance=100 OR slashing.amount EXISTS)", true},
+		{"account.balance=100 AND NOT slashing.amount EXISTS", true},
+		{"NOT", false},
+		{"account.balance=100 NOT slashing.amount EXISTS", false},
+		// keywords not followed by a space are part of the tag
+		{"NOTaccount.balance=100", true},
+		{"NOT EXISTS", true},
+
 		{"hash='136E18F7E4C348B780CF873A0BF43922E5BAFA63'", true},
 		{"hash=136E18F7E4C348B780CF873A0BF43922E5BAFA63", false},
 	}
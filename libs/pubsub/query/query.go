@@ -1,6 +1,10 @@
 // Package query provides a parser for a custom query format:
 //
 //		abci.invoice.number=22 AND abci.invoice.owner=Ivan
+//		(abci.invoice.number=22 OR abci.invoice.number=23) AND NOT abci.invoice.paid EXISTS
+//
+// Conditions are joined with AND and OR, and negated with NOT. AND takes
+// precedence over OR, and parentheses group conditions.
 //
 // See query.peg for the grammar, which is a https://en.wikipedia.org/wiki/Parsing_expression_grammar.
 // More: https://github.com/PhilippeSigaud/Pegged/wiki/PEG-Basics
@@ -89,55 +93,133 @@ const (
 	TimeLayout = time.RFC3339
 )
 
-// Conditions returns a list of conditions. It returns an error if there is any
-// error with the provided grammar in the Query.
+// Conditions returns the list of conditions of a query made of conditions
+// joined by AND. It returns an error if an operand of the query can't be
+// parsed, or if the query has OR or NOT operators, in which case its
+// expression must be used instead.
 func (q *Query) Conditions() ([]Condition, error) {
-	var (
-		eventAttr string
-		op        Operator
-	)
+	expr, err := q.Expression()
+	if err != nil {
+		return nil, err
+	}
+
+	conditions, ok := expr.Conditions()
+	if !ok {
+		return nil, fmt.Errorf("query %q has OR or NOT operators and can't be turned into a list of conditions", q.str)
+	}
 
-	conditions := make([]Condition, 0)
-	buffer, begin, end := q.parser.Buffer, 0, 0
+	return conditions, nil
+}
 
-	// tokens must be in the following order: tag ("tx.gas") -> operator ("=") -> operand ("7")
-	for token := range q.parser.Tokens() {
-		switch token.pegRule {
-		case rulePegText:
-			begin, end = int(token.begin), int(token.end)
+// Expression returns the syntax tree of the query. It returns an error if an
+// operand of the query can't be parsed.
+func (q *Query) Expression() (*Expression, error) {
+	// the root node is the rule e, of which the first child is the expression
+	root := q.parser.AST()
+	for node := root.up; node != nil; node = node.next {
+		if node.pegRule == ruleexpr {
+			return q.expression(node)
+		}
+	}
+
+	return nil, fmt.Errorf("no expression found in query %q", q.str)
+}
+
+// expression turns the given node of the syntax tree built by the parser, and
+// its children, into an Expression.
+func (q *Query) expression(node *node32) (*Expression, error) {
+	switch node.pegRule {
+	case ruleexpr, ruleterm:
+		// operands joined by OR (expr) or AND (term)
+		kind := ExprOr
+		if node.pegRule == ruleterm {
+			kind = ExprAnd
+		}
+
+		var operands []*Expression
+		for child := node.up; child != nil; child = child.next {
+			if child.pegRule == ruleor || child.pegRule == ruleand {
+				continue
+			}
+
+			operand, err := q.expression(child)
+			if err != nil {
+				return nil, err
+			}
+			operands = append(operands, operand)
+		}
+
+		if len(operands) == 1 {
+			return operands[0], nil
+		}
+		return &Expression{Kind: kind, Operands: operands}, nil
+
+	case rulefactor:
+		// either NOT followed by a factor, a parenthesized expr or a condition
+		child := node.up
+		if child.pegRule == rulenot {
+			operand, err := q.expression(child.next)
+			if err != nil {
+				return nil, err
+			}
+			return &Expression{Kind: ExprNot, Operands: []*Expression{operand}}, nil
+		}
+
+		return q.expression(child)
+
+	case rulecondition:
+		c, err := q.condition(node)
+		if err != nil {
+			return nil, err
+		}
+		return &Expression{Kind: ExprCondition, Condition: c}, nil
+
+	default:
+		return nil, fmt.Errorf("unexpected rule %s in query %q", rul3s[node.pegRule], q.str)
+	}
+}
 
+// condition turns the given condition node of the syntax tree built by the
+// parser into a Condition.
+//
+// The children of the node must be in the following order: tag ("tx.gas") ->
+// operator ("=") -> operand ("7").
+func (q *Query) condition(node *node32) (Condition, error) {
+	var c Condition
+
+	for child := node.up; child != nil; child = child.next {
+		switch child.pegRule {
 		case ruletag:
-			eventAttr = buffer[begin:end]
+			c.CompositeKey = q.text(child)
 
 		case rulele:
-			op = OpLessEqual
+			c.Op = OpLessEqual
 
 		case rulege:
-			op = OpGreaterEqual
+			c.Op = OpGreaterEqual
 
 		case rulel:
-			op = OpLess
+			c.Op = OpLess
 
 		case ruleg:
-			op = OpGreater
+			c.Op = OpGreater
 
 		case ruleequal:
-			op = OpEqual
+			c.Op = OpEqual
 
 		case rulecontains:
-			op = OpContains
+			c.Op = OpContains
 
 		case ruleexists:
-			op = OpExists
-			conditions = append(conditions, Condition{eventAttr, op, nil})
+			c.Op = OpExists
 
 		case rulevalue:
 			// strip single quotes from value (i.e. "'NewBlock'" -> "NewBlock")
-			valueWithoutSingleQuotes := buffer[begin+1 : end-1]
-			conditions = append(conditions, Condition{eventAttr, op, valueWithoutSingleQuotes})
+			value := q.text(child)
+			c.Operand = value[1 : len(value)-1]
 
 		case rulenumber:
-			number := buffer[begin:end]
+			number := q.text(child)
 			if strings.ContainsAny(number, ".") { // if it looks like a floating-point number
 				value, err := strconv.ParseFloat(number, 64)
 				if err != nil {
@@ -145,10 +227,9 @@ func (q *Query) Conditions() ([]Condition, error) {
 						"got %v while trying to parse %s as float64 (should never happen if the grammar is correct)",
 						err, number,
 					)
-					return nil, err
+					return c, err
 				}
-
-				conditions = append(conditions, Condition{eventAttr, op, value})
+				c.Operand = value
 			} else {
 				value, err := strconv.ParseInt(number, 10, 64)
 				if err != nil {
@@ -156,39 +237,45 @@ func (q *Query) Conditions() ([]Condition, error) {
 						"got %v while trying to parse %s as int64 (should never happen if the grammar is correct)",
 						err, number,
 					)
-					return nil, err
+					return c, err
 				}
-
-				conditions = append(conditions, Condition{eventAttr, op, value})
+				c.Operand = value
 			}
 
 		case ruletime:
-			value, err := time.Parse(TimeLayout, buffer[begin:end])
+			// the text of the time rule includes the TIME keyword, unlike the
+			// text of its child
+			text := q.text(child.up)
+			value, err := time.Parse(TimeLayout, text)
 			if err != nil {
 				err = fmt.Errorf(
 					"got %v while trying to parse %s as time.Time / RFC3339 (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
+					err, text,
 				)
-				return nil, err
+				return c, err
 			}
-
-			conditions = append(conditions, Condition{eventAttr, op, value})
+			c.Operand = value
 
 		case ruledate:
-			value, err := time.Parse("2006-01-02", buffer[begin:end])
+			text := q.text(child.up)
+			value, err := time.Parse(DateLayout, text)
 			if err != nil {
 				err = fmt.Errorf(
 					"got %v while trying to parse %s as time.Time / '2006-01-02' (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
+					err, text,
 				)
-				return nil, err
+				return c, err
 			}
-
-			conditions = append(conditions, Condition{eventAttr, op, value})
+			c.Operand = value
 		}
 	}
 
-	return conditions, nil
+	return c, nil
+}
+
+// text returns the part of the query matched by the given node.
+func (q *Query) text(node *node32) string {
+	return string(q.parser.buffer[node.begin:node.end])
 }
 
 // Matches returns true if the query matches against any event in the given set
@@ -203,160 +290,128 @@ func (q *Query) Matches(events map[string][]string) (bool, error) {
 		return false, nil
 	}
 
-	var (
-		eventAttr string
-		op        Operator
-	)
-
-	buffer, begin, end := q.parser.Buffer, 0, 0
-
-	// tokens must be in the following order:
-
-	// tag ("tx.gas") -> operator ("=") -> operand ("7")
-	for token := range q.parser.Tokens() {
-		switch token.pegRule {
-		case rulePegText:
-			begin, end = int(token.begin), int(token.end)
-
-		case ruletag:
-			eventAttr = buffer[begin:end]
-
-		case rulele:
-			op = OpLessEqual
+	expr, err := q.Expression()
+	if err != nil {
+		return false, err
+	}
 
-		case rulege:
-			op = OpGreaterEqual
+	return expr.Matches(events)
+}
 
-		case rulel:
-			op = OpLess
+// ExprKind is the kind of an Expression.
+type ExprKind uint8
 
-		case ruleg:
-			op = OpGreater
+const (
+	// ExprCondition is a single condition.
+	ExprCondition ExprKind = iota
+	// ExprAnd holds if all of its operands hold.
+	ExprAnd
+	// ExprOr holds if any of its operands holds.
+	ExprOr
+	// ExprNot holds if its single operand does not hold.
+	ExprNot
+)
 
-		case ruleequal:
-			op = OpEqual
+// Expression is a node of the syntax tree of a query: either a condition, or a
+// logical operator applied to operand expressions.
+//
+// For example, "a.b = 1 OR NOT (c.d EXISTS AND e.f > 2)" is an ExprOr of the
+// condition "a.b = 1" and an ExprNot, the operand of which is an ExprAnd of the
+// conditions "c.d EXISTS" and "e.f > 2".
+type Expression struct {
+	Kind ExprKind
+	// Condition is set for the ExprCondition kind.
+	Condition Condition
+	// Operands are set for the ExprAnd, ExprOr and ExprNot kinds.
+	Operands []*Expression
+}
 
-		case rulecontains:
-			op = OpContains
-		case ruleexists:
-			op = OpExists
-			if strings.Contains(eventAttr, ".") {
-				// Searching for a full "type.attribute" event.
-				_, ok := events[eventAttr]
-				if !ok {
-					return false, nil
-				}
-			} else {
-				foundEvent := false
-
-			loop:
-				for compositeKey := range events {
-					if strings.Index(compositeKey, eventAttr) == 0 {
-						foundEvent = true
-						break loop
-					}
-				}
-				if !foundEvent {
-					return false, nil
-				}
+// Conditions returns the conditions of the expression if it is a single
+// condition or a conjunction of conditions, i.e. it has neither OR nor NOT
+// operators; ok is false otherwise.
+func (e *Expression) Conditions() (conditions []Condition, ok bool) {
+	switch e.Kind {
+	case ExprCondition:
+		return []Condition{e.Condition}, true
+
+	case ExprAnd:
+		for _, operand := range e.Operands {
+			operandConditions, ok := operand.Conditions()
+			if !ok {
+				return nil, false
 			}
+			conditions = append(conditions, operandConditions...)
+		}
+		return conditions, true
 
-		case rulevalue:
-			// strip single quotes from value (i.e. "'NewBlock'" -> "NewBlock")
-			valueWithoutSingleQuotes := buffer[begin+1 : end-1]
+	default:
+		return nil, false
+	}
+}
 
-			// see if the triplet (event attribute, operator, operand) matches any event
-			// "tx.gas", "=", "7", { "tx.gas": 7, "tx.ID": "4AE393495334" }
-			match, err := match(eventAttr, op, reflect.ValueOf(valueWithoutSingleQuotes), events)
-			if err != nil {
+// Matches returns true if the expression matches against the given set of
+// events, with the semantics of Query.Matches. The operands of AND and OR are
+// evaluated from left to right, until the result is known.
+func (e *Expression) Matches(events map[string][]string) (bool, error) {
+	switch e.Kind {
+	case ExprCondition:
+		return matchCondition(e.Condition, events)
+
+	case ExprAnd:
+		for _, operand := range e.Operands {
+			match, err := operand.Matches(events)
+			if err != nil || !match {
 				return false, err
 			}
+		}
+		return true, nil
 
-			if !match {
-				return false, nil
-			}
-
-		case rulenumber:
-			number := buffer[begin:end]
-			if strings.ContainsAny(number, ".") { // if it looks like a floating-point number
-				value, err := strconv.ParseFloat(number, 64)
-				if err != nil {
-					err = fmt.Errorf(
-						"got %v while trying to parse %s as float64 (should never happen if the grammar is correct)",
-						err, number,
-					)
-					return false, err
-				}
-
-				match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-				if err != nil {
-					return false, err
-				}
-
-				if !match {
-					return false, nil
-				}
-			} else {
-				value, err := strconv.ParseInt(number, 10, 64)
-				if err != nil {
-					err = fmt.Errorf(
-						"got %v while trying to parse %s as int64 (should never happen if the grammar is correct)",
-						err, number,
-					)
-					return false, err
-				}
-
-				match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-				if err != nil {
-					return false, err
-				}
-
-				if !match {
-					return false, nil
-				}
-			}
-
-		case ruletime:
-			value, err := time.Parse(TimeLayout, buffer[begin:end])
-			if err != nil {
-				err = fmt.Errorf(
-					"got %v while trying to parse %s as time.Time / RFC3339 (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
-				)
-				return false, err
+	case ExprOr:
+		for _, operand := range e.Operands {
+			match, err := operand.Matches(events)
+			if err != nil || match {
+				return match, err
 			}
+		}
+		return false, nil
 
-			match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-			if err != nil {
-				return false, err
-			}
+	case ExprNot:
+		match, err := e.Operands[0].Matches(events)
+		if err != nil {
+			return false, err
+		}
+		return !match, nil
 
-			if !match {
-				return false, nil
-			}
+	default:
+		return false, fmt.Errorf("unknown kind of expression %v", e.Kind)
+	}
+}
 
-		case ruledate:
-			value, err := time.Parse("2006-01-02", buffer[begin:end])
-			if err != nil {
-				err = fmt.Errorf(
-					"got %v while trying to parse %s as time.Time / '2006-01-02' (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
-				)
-				return false, err
-			}
+// matchCondition returns true if the given condition matches any event in the
+// given set of events.
+//
+// An EXISTS condition on a composite key ("slash.reason") matches if the key is
+// present, while one on a prefix without dot ("slash") matches if any key
+// starts with it.
+func matchCondition(c Condition, events map[string][]string) (bool, error) {
+	if c.Op != OpExists {
+		// see if the triplet (event attribute, operator, operand) matches any event
+		// "tx.gas", "=", "7", { "tx.gas": 7, "tx.ID": "4AE393495334" }
+		return match(c.CompositeKey, c.Op, reflect.ValueOf(c.Operand), events)
+	}
 
-			match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-			if err != nil {
-				return false, err
-			}
+	if strings.Contains(c.CompositeKey, ".") {
+		// Searching for a full "type.attribute" event.
+		_, ok := events[c.CompositeKey]
+		return ok, nil
+	}
 
-			if !match {
-				return false, nil
-			}
+	for compositeKey := range events {
+		if strings.Index(compositeKey, c.CompositeKey) == 0 {
+			return true, nil
 		}
 	}
-
-	return true, nil
+	return false, nil
 }
 
 // match returns true if the given triplet (attribute, operator, operand) matches
@@ -1,11 +1,15 @@
 // nolint
 package query
 
+// Code generated by peg -inline -switch query.peg DO NOT EDIT.
+
 import (
 	"fmt"
-	"math"
+	"io"
+	"os"
 	"sort"
 	"strconv"
+	"strings"
 )
 
 const endSymbol rune = 1114112
@@ -16,6 +20,9 @@ type pegRule uint8
 const (
 	ruleUnknown pegRule = iota
 	rulee
+	ruleexpr
+	ruleterm
+	rulefactor
 	rulecondition
 	ruletag
 	rulevalue
@@ -27,6 +34,8 @@ const (
 	rulemonth
 	ruleday
 	ruleand
+	ruleor
+	rulenot
 	ruleequal
 	rulecontains
 	ruleexists
@@ -35,15 +44,14 @@ const (
 	rulel
 	ruleg
 	rulePegText
-
-	rulePre
-	ruleIn
-	ruleSuf
 )
 
 var rul3s = [...]string{
 	"Unknown",
 	"e",
+	"expr",
+	"term",
+	"factor",
 	"condition",
 	"tag",
 	"value",
@@ -55,6 +63,8 @@ var rul3s = [...]string{
 	"month",
 	"day",
 	"and",
+	"or",
+	"not",
 	"equal",
 	"contains",
 	"exists",
@@ -63,10 +73,15 @@ var rul3s = [...]string{
 	"l",
 	"g",
 	"PegText",
+}
+
+type token32 struct {
+	pegRule
+	begin, end uint32
+}
 
-	"Pre_",
-	"_In_",
-	"_Suf",
+func (t *token32) String() string {
+	return fmt.Sprintf("\x1B[34m%v\x1B[m %v %v", rul3s[t.pegRule], t.begin, t.end)
 }
 
 type node32 struct {
@@ -74,57 +89,43 @@ type node32 struct {
 	up, next *node32
 }
 
-func (node *node32) print(depth int, buffer string) {
-	for node != nil {
-		for c := 0; c < depth; c++ {
-			fmt.Printf(" ")
-		}
-		fmt.Printf("\x1B[34m%v\x1B[m %v\n", rul3s[node.pegRule], strconv.Quote(string(([]rune(buffer)[node.begin:node.end]))))
-		if node.up != nil {
-			node.up.print(depth+1, buffer)
+func (node *node32) print(w io.Writer, pretty bool, buffer string) {
+	var print func(node *node32, depth int)
+	print = func(node *node32, depth int) {
+		for node != nil {
+			for c := 0; c < depth; c++ {
+				fmt.Fprintf(w, " ")
+			}
+			rule := rul3s[node.pegRule]
+			quote := strconv.Quote(string(([]rune(buffer)[node.begin:node.end])))
+			if !pretty {
+				fmt.Fprintf(w, "%v %v\n", rule, quote)
+			} else {
+				fmt.Fprintf(w, "\x1B[36m%v\x1B[m %v\n", rule, quote)
+			}
+			if node.up != nil {
+				print(node.up, depth+1)
+			}
+			node = node.next
 		}
-		node = node.next
 	}
+	print(node, 0)
 }
 
-func (node *node32) Print(buffer string) {
-	node.print(0, buffer)
-}
-
-type element struct {
-	node *node32
-	down *element
+func (node *node32) Print(w io.Writer, buffer string) {
+	node.print(w, false, buffer)
 }
 
-/* ${@} bit structure for abstract syntax tree */
-type token32 struct {
-	pegRule
-	begin, end, next uint32
-}
-
-func (t *token32) isZero() bool {
-	return t.pegRule == ruleUnknown && t.begin == 0 && t.end == 0 && t.next == 0
-}
-
-func (t *token32) isParentOf(u token32) bool {
-	return t.begin <= u.begin && t.end >= u.end && t.next > u.next
-}
-
-func (t *token32) getToken32() token32 {
-	return token32{pegRule: t.pegRule, begin: uint32(t.begin), end: uint32(t.end), next: uint32(t.next)}
-}
-
-func (t *token32) String() string {
-	return fmt.Sprintf("\x1B[34m%v\x1B[m %v %v %v", rul3s[t.pegRule], t.begin, t.end, t.next)
+func (node *node32) PrettyPrint(w io.Writer, buffer string) {
+	node.print(w, true, buffer)
 }
 
 type tokens32 struct {
-	tree    []token32
-	ordered [][]token32
+	tree []token32
 }
 
-func (t *tokens32) trim(length int) {
-	t.tree = t.tree[0:length]
+func (t *tokens32) Trim(length uint32) {
+	t.tree = t.tree[:length]
 }
 
 func (t *tokens32) Print() {
@@ -133,51 +134,14 @@ func (t *tokens32) Print() {
 	}
 }
 
-func (t *tokens32) Order() [][]token32 {
-	if t.ordered != nil {
-		return t.ordered
-	}
-
-	depths := make([]int32, 1, math.MaxInt16)
-	for i, token := range t.tree {
-		if token.pegRule == ruleUnknown {
-			t.tree = t.tree[:i]
-			break
-		}
-		depth := int(token.next)
-		if length := len(depths); depth >= length {
-			depths = depths[:depth+1]
-		}
-		depths[depth]++
-	}
-	depths = append(depths, 0)
-
-	ordered, pool := make([][]token32, len(depths)), make([]token32, len(t.tree)+len(depths))
-	for i, depth := range depths {
-		depth++
-		ordered[i], pool, depths[i] = pool[:depth], pool[depth:], 0
-	}
-
-	for i, token := range t.tree {
-		depth := token.next
-		token.next = uint32(i)
-		ordered[depth][depths[depth]] = token
-		depths[depth]++
-	}
-	t.ordered = ordered
-	return ordered
-}
-
-type state32 struct {
-	token32
-	depths []int32
-	leaf   bool
-}
-
 func (t *tokens32) AST() *node32 {
+	type element struct {
+		node *node32
+		down *element
+	}
 	tokens := t.Tokens()
-	stack := &element{node: &node32{token32: <-tokens}}
-	for token := range tokens {
+	var stack *element
+	for _, token := range tokens {
 		if token.begin == token.end {
 			continue
 		}
@@ -189,180 +153,55 @@ func (t *tokens32) AST() *node32 {
 		}
 		stack = &element{node: node, down: stack}
 	}
-	return stack.node
-}
-
-func (t *tokens32) PreOrder() (<-chan state32, [][]token32) {
-	s, ordered := make(chan state32, 6), t.Order()
-	go func() {
-		var states [8]state32
-		for i := range states {
-			states[i].depths = make([]int32, len(ordered))
-		}
-		depths, state, depth := make([]int32, len(ordered)), 0, 1
-		write := func(t token32, leaf bool) {
-			S := states[state]
-			state, S.pegRule, S.begin, S.end, S.next, S.leaf = (state+1)%8, t.pegRule, t.begin, t.end, uint32(depth), leaf
-			copy(S.depths, depths)
-			s <- S
-		}
-
-		states[state].token32 = ordered[0][0]
-		depths[0]++
-		state++
-		a, b := ordered[depth-1][depths[depth-1]-1], ordered[depth][depths[depth]]
-	depthFirstSearch:
-		for {
-			for {
-				if i := depths[depth]; i > 0 {
-					if c, j := ordered[depth][i-1], depths[depth-1]; a.isParentOf(c) &&
-						(j < 2 || !ordered[depth-1][j-2].isParentOf(c)) {
-						if c.end != b.begin {
-							write(token32{pegRule: ruleIn, begin: c.end, end: b.begin}, true)
-						}
-						break
-					}
-				}
-
-				if a.begin < b.begin {
-					write(token32{pegRule: rulePre, begin: a.begin, end: b.begin}, true)
-				}
-				break
-			}
-
-			next := depth + 1
-			if c := ordered[next][depths[next]]; c.pegRule != ruleUnknown && b.isParentOf(c) {
-				write(b, false)
-				depths[depth]++
-				depth, a, b = next, b, c
-				continue
-			}
-
-			write(b, true)
-			depths[depth]++
-			c, parent := ordered[depth][depths[depth]], true
-			for {
-				if c.pegRule != ruleUnknown && a.isParentOf(c) {
-					b = c
-					continue depthFirstSearch
-				} else if parent && b.end != a.end {
-					write(token32{pegRule: ruleSuf, begin: b.end, end: a.end}, true)
-				}
-
-				depth--
-				if depth > 0 {
-					a, b, c = ordered[depth-1][depths[depth-1]-1], a, ordered[depth][depths[depth]]
-					parent = a.isParentOf(b)
-					continue
-				}
-
-				break depthFirstSearch
-			}
-		}
-
-		close(s)
-	}()
-	return s, ordered
-}
-
-func (t *tokens32) PrintSyntax() {
-	tokens, ordered := t.PreOrder()
-	max := -1
-	for token := range tokens {
-		if !token.leaf {
-			fmt.Printf("%v", token.begin)
-			for i, leaf, depths := 0, int(token.next), token.depths; i < leaf; i++ {
-				fmt.Printf(" \x1B[36m%v\x1B[m", rul3s[ordered[i][depths[i]-1].pegRule])
-			}
-			fmt.Printf(" \x1B[36m%v\x1B[m\n", rul3s[token.pegRule])
-		} else if token.begin == token.end {
-			fmt.Printf("%v", token.begin)
-			for i, leaf, depths := 0, int(token.next), token.depths; i < leaf; i++ {
-				fmt.Printf(" \x1B[31m%v\x1B[m", rul3s[ordered[i][depths[i]-1].pegRule])
-			}
-			fmt.Printf(" \x1B[31m%v\x1B[m\n", rul3s[token.pegRule])
-		} else {
-			for c, end := token.begin, token.end; c < end; c++ {
-				if i := int(c); max+1 < i {
-					for j := max; j < i; j++ {
-						fmt.Printf("skip %v %v\n", j, token.String())
-					}
-					max = i
-				} else if i := int(c); i <= max {
-					for j := i; j <= max; j++ {
-						fmt.Printf("dupe %v %v\n", j, token.String())
-					}
-				} else {
-					max = int(c)
-				}
-				fmt.Printf("%v", c)
-				for i, leaf, depths := 0, int(token.next), token.depths; i < leaf; i++ {
-					fmt.Printf(" \x1B[34m%v\x1B[m", rul3s[ordered[i][depths[i]-1].pegRule])
-				}
-				fmt.Printf(" \x1B[34m%v\x1B[m\n", rul3s[token.pegRule])
-			}
-			fmt.Printf("\n")
-		}
+	if stack != nil {
+		return stack.node
 	}
+	return nil
 }
 
 func (t *tokens32) PrintSyntaxTree(buffer string) {
-	tokens, _ := t.PreOrder()
-	for token := range tokens {
-		for c := 0; c < int(token.next); c++ {
-			fmt.Printf(" ")
-		}
-		fmt.Printf("\x1B[34m%v\x1B[m %v\n", rul3s[token.pegRule], strconv.Quote(string(([]rune(buffer)[token.begin:token.end]))))
-	}
+	t.AST().Print(os.Stdout, buffer)
 }
 
-func (t *tokens32) Add(rule pegRule, begin, end, depth uint32, index int) {
-	t.tree[index] = token32{pegRule: rule, begin: uint32(begin), end: uint32(end), next: uint32(depth)}
+func (t *tokens32) WriteSyntaxTree(w io.Writer, buffer string) {
+	t.AST().Print(w, buffer)
 }
 
-func (t *tokens32) Tokens() <-chan token32 {
-	s := make(chan token32, 16)
-	go func() {
-		for _, v := range t.tree {
-			s <- v.getToken32()
-		}
-		close(s)
-	}()
-	return s
+func (t *tokens32) PrettyPrintSyntaxTree(buffer string) {
+	t.AST().PrettyPrint(os.Stdout, buffer)
 }
 
-func (t *tokens32) Error() []token32 {
-	ordered := t.Order()
-	length := len(ordered)
-	tokens, length := make([]token32, length), length-1
-	for i := range tokens {
-		o := ordered[length-i]
-		if len(o) > 1 {
-			tokens[i] = o[len(o)-2].getToken32()
-		}
+func (t *tokens32) Add(rule pegRule, begin, end, index uint32) {
+	tree, i := t.tree, int(index)
+	if i >= len(tree) {
+		t.tree = append(tree, token32{pegRule: rule, begin: begin, end: end})
+		return
 	}
-	return tokens
+	tree[i] = token32{pegRule: rule, begin: begin, end: end}
 }
 
-func (t *tokens32) Expand(index int) {
-	tree := t.tree
-	if index >= len(tree) {
-		expanded := make([]token32, 2*len(tree))
-		copy(expanded, tree)
-		t.tree = expanded
-	}
+func (t *tokens32) Tokens() []token32 {
+	return t.tree
 }
 
 type QueryParser struct {
 	Buffer string
 	buffer []rune
-	rules  [21]func() bool
-	Parse  func(rule ...int) error
-	Reset  func()
+	rules  [26]func() bool
+	parse  func(rule ...int) error
+	reset  func()
 	Pretty bool
 	tokens32
 }
 
+func (p *QueryParser) Parse(rule ...int) error {
+	return p.parse(rule...)
+}
+
+func (p *QueryParser) Reset() {
+	p.reset()
+}
+
 type textPosition struct {
 	line, symbol int
 }
@@ -400,7 +239,7 @@ type parseError struct {
 }
 
 func (e *parseError) Error() string {
-	tokens, error := []token32{e.max}, "\n"
+	tokens, err := []token32{e.max}, "\n"
 	positions, p := make([]int, 2*len(tokens)), 0
 	for _, token := range tokens {
 		positions[p], p = int(token.begin), p+1
@@ -413,35 +252,74 @@ func (e *parseError) Error() string {
 	}
 	for _, token := range tokens {
 		begin, end := int(token.begin), int(token.end)
-		error += fmt.Sprintf(format,
+		err += fmt.Sprintf(format,
 			rul3s[token.pegRule],
 			translations[begin].line, translations[begin].symbol,
 			translations[end].line, translations[end].symbol,
 			strconv.Quote(string(e.p.buffer[begin:end])))
 	}
 
-	return error
+	return err
 }
 
 func (p *QueryParser) PrintSyntaxTree() {
-	p.tokens32.PrintSyntaxTree(p.Buffer)
+	if p.Pretty {
+		p.tokens32.PrettyPrintSyntaxTree(p.Buffer)
+	} else {
+		p.tokens32.PrintSyntaxTree(p.Buffer)
+	}
+}
+
+func (p *QueryParser) WriteSyntaxTree(w io.Writer) {
+	p.tokens32.WriteSyntaxTree(w, p.Buffer)
+}
+
+func (p *QueryParser) SprintSyntaxTree() string {
+	var bldr strings.Builder
+	p.WriteSyntaxTree(&bldr)
+	return bldr.String()
 }
 
-func (p *QueryParser) Highlighter() {
-	p.PrintSyntax()
+func Pretty(pretty bool) func(*QueryParser) error {
+	return func(p *QueryParser) error {
+		p.Pretty = pretty
+		return nil
+	}
 }
 
-func (p *QueryParser) Init() {
-	p.buffer = []rune(p.Buffer)
-	if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != endSymbol {
-		p.buffer = append(p.buffer, endSymbol)
+func Size(size int) func(*QueryParser) error {
+	return func(p *QueryParser) error {
+		p.tokens32 = tokens32{tree: make([]token32, 0, size)}
+		return nil
+	}
+}
+func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
+	var (
+		max                  token32
+		position, tokenIndex uint32
+		buffer               []rune
+	)
+	for _, option := range options {
+		err := option(p)
+		if err != nil {
+			return err
+		}
 	}
+	p.reset = func() {
+		max = token32{}
+		position, tokenIndex = 0, 0
 
-	tree := tokens32{tree: make([]token32, math.MaxInt16)}
-	var max token32
-	position, depth, tokenIndex, buffer, _rules := uint32(0), uint32(0), 0, p.buffer, p.rules
+		p.buffer = []rune(p.Buffer)
+		if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != endSymbol {
+			p.buffer = append(p.buffer, endSymbol)
+		}
+		buffer = p.buffer
+	}
+	p.reset()
 
-	p.Parse = func(rule ...int) error {
+	_rules := p.rules
+	tree := p.tokens32
+	p.parse = func(rule ...int) error {
 		r := 1
 		if len(rule) > 0 {
 			r = rule[0]
@@ -449,22 +327,17 @@ func (p *QueryParser) Init() {
 		matches := p.rules[r]()
 		p.tokens32 = tree
 		if matches {
-			p.trim(tokenIndex)
+			p.Trim(tokenIndex)
 			return nil
 		}
 		return &parseError{p, max}
 	}
 
-	p.Reset = func() {
-		position, tokenIndex, depth = 0, 0, 0
-	}
-
 	add := func(rule pegRule, begin uint32) {
-		tree.Expand(tokenIndex)
-		tree.Add(rule, begin, position, depth, tokenIndex)
+		tree.Add(rule, begin, position, tokenIndex)
 		tokenIndex++
 		if begin != position && position > max.end {
-			max = token32{rule, begin, position, depth}
+			max = token32{rule, begin, position}
 		}
 	}
 
@@ -494,1378 +367,1506 @@ func (p *QueryParser) Init() {
 
 	_rules = [...]func() bool{
 		nil,
-		/* 0 e <- <('"' condition (' '+ and ' '+ condition)* '"' !.)> */
+		/* 0 e <- <('"' expr '"' !.)> */
 		func() bool {
-			position0, tokenIndex0, depth0 := position, tokenIndex, depth
+			position0, tokenIndex0 := position, tokenIndex
 			{
 				position1 := position
-				depth++
 				if buffer[position] != rune('"') {
 					goto l0
 				}
 				position++
-				if !_rules[rulecondition]() {
+				if !_rules[ruleexpr]() {
+					goto l0
+				}
+				if buffer[position] != rune('"') {
 					goto l0
 				}
-			l2:
+				position++
+				{
+					position2, tokenIndex2 := position, tokenIndex
+					if !matchDot() {
+						goto l2
+					}
+					goto l0
+				l2:
+					position, tokenIndex = position2, tokenIndex2
+				}
+				add(rulee, position1)
+			}
+			return true
+		l0:
+			position, tokenIndex = position0, tokenIndex0
+			return false
+		},
+		/* 1 expr <- <(term (' '+ or ' '+ term)*)> */
+		func() bool {
+			position3, tokenIndex3 := position, tokenIndex
+			{
+				position4 := position
+				if !_rules[ruleterm]() {
+					goto l3
+				}
+			l5:
 				{
-					position3, tokenIndex3, depth3 := position, tokenIndex, depth
+					position6, tokenIndex6 := position, tokenIndex
 					if buffer[position] != rune(' ') {
-						goto l3
+						goto l6
 					}
 					position++
-				l4:
+				l7:
 					{
-						position5, tokenIndex5, depth5 := position, tokenIndex, depth
+						position8, tokenIndex8 := position, tokenIndex
 						if buffer[position] != rune(' ') {
-							goto l5
+							goto l8
 						}
 						position++
-						goto l4
-					l5:
-						position, tokenIndex, depth = position5, tokenIndex5, depth5
+						goto l7
+					l8:
+						position, tokenIndex = position8, tokenIndex8
 					}
 					{
-						position6 := position
-						depth++
+						position9 := position
+						{
+							position10, tokenIndex10 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l11
+							}
+							position++
+							goto l10
+						l11:
+							position, tokenIndex = position10, tokenIndex10
+							if buffer[position] != rune('O') {
+								goto l6
+							}
+							position++
+						}
+					l10:
 						{
-							position7, tokenIndex7, depth7 := position, tokenIndex, depth
+							position12, tokenIndex12 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l13
+							}
+							position++
+							goto l12
+						l13:
+							position, tokenIndex = position12, tokenIndex12
+							if buffer[position] != rune('R') {
+								goto l6
+							}
+							position++
+						}
+					l12:
+						add(ruleor, position9)
+					}
+					if buffer[position] != rune(' ') {
+						goto l6
+					}
+					position++
+				l14:
+					{
+						position15, tokenIndex15 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l15
+						}
+						position++
+						goto l14
+					l15:
+						position, tokenIndex = position15, tokenIndex15
+					}
+					if !_rules[ruleterm]() {
+						goto l6
+					}
+					goto l5
+				l6:
+					position, tokenIndex = position6, tokenIndex6
+				}
+				add(ruleexpr, position4)
+			}
+			return true
+		l3:
+			position, tokenIndex = position3, tokenIndex3
+			return false
+		},
+		/* 2 term <- <(factor (' '+ and ' '+ factor)*)> */
+		func() bool {
+			position16, tokenIndex16 := position, tokenIndex
+			{
+				position17 := position
+				if !_rules[rulefactor]() {
+					goto l16
+				}
+			l18:
+				{
+					position19, tokenIndex19 := position, tokenIndex
+					if buffer[position] != rune(' ') {
+						goto l19
+					}
+					position++
+				l20:
+					{
+						position21, tokenIndex21 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l21
+						}
+						position++
+						goto l20
+					l21:
+						position, tokenIndex = position21, tokenIndex21
+					}
+					{
+						position22 := position
+						{
+							position23, tokenIndex23 := position, tokenIndex
 							if buffer[position] != rune('a') {
-								goto l8
+								goto l24
 							}
 							position++
-							goto l7
-						l8:
-							position, tokenIndex, depth = position7, tokenIndex7, depth7
+							goto l23
+						l24:
+							position, tokenIndex = position23, tokenIndex23
 							if buffer[position] != rune('A') {
-								goto l3
+								goto l19
 							}
 							position++
 						}
-					l7:
+					l23:
 						{
-							position9, tokenIndex9, depth9 := position, tokenIndex, depth
+							position25, tokenIndex25 := position, tokenIndex
 							if buffer[position] != rune('n') {
-								goto l10
+								goto l26
 							}
 							position++
-							goto l9
-						l10:
-							position, tokenIndex, depth = position9, tokenIndex9, depth9
+							goto l25
+						l26:
+							position, tokenIndex = position25, tokenIndex25
 							if buffer[position] != rune('N') {
-								goto l3
+								goto l19
 							}
 							position++
 						}
-					l9:
+					l25:
 						{
-							position11, tokenIndex11, depth11 := position, tokenIndex, depth
+							position27, tokenIndex27 := position, tokenIndex
 							if buffer[position] != rune('d') {
-								goto l12
+								goto l28
 							}
 							position++
-							goto l11
-						l12:
-							position, tokenIndex, depth = position11, tokenIndex11, depth11
+							goto l27
+						l28:
+							position, tokenIndex = position27, tokenIndex27
 							if buffer[position] != rune('D') {
-								goto l3
+								goto l19
 							}
 							position++
 						}
-					l11:
-						depth--
-						add(ruleand, position6)
+					l27:
+						add(ruleand, position22)
 					}
 					if buffer[position] != rune(' ') {
-						goto l3
+						goto l19
 					}
 					position++
-				l13:
+				l29:
 					{
-						position14, tokenIndex14, depth14 := position, tokenIndex, depth
+						position30, tokenIndex30 := position, tokenIndex
 						if buffer[position] != rune(' ') {
-							goto l14
+							goto l30
 						}
 						position++
-						goto l13
-					l14:
-						position, tokenIndex, depth = position14, tokenIndex14, depth14
+						goto l29
+					l30:
+						position, tokenIndex = position30, tokenIndex30
 					}
-					if !_rules[rulecondition]() {
-						goto l3
+					if !_rules[rulefactor]() {
+						goto l19
 					}
-					goto l2
-				l3:
-					position, tokenIndex, depth = position3, tokenIndex3, depth3
+					goto l18
+				l19:
+					position, tokenIndex = position19, tokenIndex19
 				}
-				if buffer[position] != rune('"') {
-					goto l0
-				}
-				position++
-				{
-					position15, tokenIndex15, depth15 := position, tokenIndex, depth
-					if !matchDot() {
-						goto l15
-					}
-					goto l0
-				l15:
-					position, tokenIndex, depth = position15, tokenIndex15, depth15
-				}
-				depth--
-				add(rulee, position1)
+				add(ruleterm, position17)
 			}
 			return true
-		l0:
-			position, tokenIndex, depth = position0, tokenIndex0, depth0
+		l16:
+			position, tokenIndex = position16, tokenIndex16
 			return false
 		},
-		/* 1 condition <- <(tag ' '* ((le ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / (ge ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / ((&('E' | 'e') exists) | (&('=') (equal ' '* ((&('\'') value) | (&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('>') (g ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('<') (l ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('C' | 'c') (contains ' '* value)))))> */
+		/* 3 factor <- <((not ' '+ factor) / ('(' ' '* expr ' '* ')') / condition)> */
 		func() bool {
-			position16, tokenIndex16, depth16 := position, tokenIndex, depth
+			position31, tokenIndex31 := position, tokenIndex
 			{
-				position17 := position
-				depth++
+				position32 := position
 				{
-					position18 := position
-					depth++
+					position33, tokenIndex33 := position, tokenIndex
 					{
-						position19 := position
-						depth++
+						position35 := position
 						{
-							position22, tokenIndex22, depth22 := position, tokenIndex, depth
-							{
-								switch buffer[position] {
-								case '<':
-									if buffer[position] != rune('<') {
-										goto l22
-									}
-									position++
-									break
-								case '>':
-									if buffer[position] != rune('>') {
-										goto l22
-									}
-									position++
-									break
-								case '=':
-									if buffer[position] != rune('=') {
-										goto l22
-									}
-									position++
-									break
-								case '\'':
-									if buffer[position] != rune('\'') {
-										goto l22
-									}
-									position++
-									break
-								case '"':
-									if buffer[position] != rune('"') {
-										goto l22
-									}
-									position++
-									break
-								case ')':
-									if buffer[position] != rune(')') {
-										goto l22
-									}
-									position++
-									break
-								case '(':
-									if buffer[position] != rune('(') {
-										goto l22
-									}
-									position++
-									break
-								case '\\':
-									if buffer[position] != rune('\\') {
-										goto l22
-									}
-									position++
-									break
-								case '\r':
-									if buffer[position] != rune('\r') {
-										goto l22
-									}
-									position++
-									break
-								case '\n':
-									if buffer[position] != rune('\n') {
-										goto l22
-									}
-									position++
-									break
-								case '\t':
-									if buffer[position] != rune('\t') {
-										goto l22
-									}
-									position++
-									break
-								default:
-									if buffer[position] != rune(' ') {
-										goto l22
-									}
-									position++
-									break
-								}
+							position36, tokenIndex36 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l37
 							}
-
-							goto l16
-						l22:
-							position, tokenIndex, depth = position22, tokenIndex22, depth22
+							position++
+							goto l36
+						l37:
+							position, tokenIndex = position36, tokenIndex36
+							if buffer[position] != rune('N') {
+								goto l34
+							}
+							position++
 						}
-						if !matchDot() {
-							goto l16
+					l36:
+						{
+							position38, tokenIndex38 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l39
+							}
+							position++
+							goto l38
+						l39:
+							position, tokenIndex = position38, tokenIndex38
+							if buffer[position] != rune('O') {
+								goto l34
+							}
+							position++
 						}
-					l20:
+					l38:
 						{
-							position21, tokenIndex21, depth21 := position, tokenIndex, depth
-							{
-								position24, tokenIndex24, depth24 := position, tokenIndex, depth
-								{
-									switch buffer[position] {
-									case '<':
-										if buffer[position] != rune('<') {
-											goto l24
-										}
-										position++
-										break
-									case '>':
-										if buffer[position] != rune('>') {
-											goto l24
-										}
-										position++
-										break
-									case '=':
-										if buffer[position] != rune('=') {
-											goto l24
-										}
-										position++
-										break
-									case '\'':
-										if buffer[position] != rune('\'') {
-											goto l24
-										}
-										position++
-										break
-									case '"':
-										if buffer[position] != rune('"') {
-											goto l24
-										}
-										position++
-										break
-									case ')':
-										if buffer[position] != rune(')') {
-											goto l24
-										}
-										position++
-										break
-									case '(':
-										if buffer[position] != rune('(') {
-											goto l24
-										}
-										position++
-										break
-									case '\\':
-										if buffer[position] != rune('\\') {
-											goto l24
-										}
-										position++
-										break
-									case '\r':
-										if buffer[position] != rune('\r') {
-											goto l24
-										}
-										position++
-										break
-									case '\n':
-										if buffer[position] != rune('\n') {
-											goto l24
-										}
-										position++
-										break
-									case '\t':
-										if buffer[position] != rune('\t') {
-											goto l24
-										}
-										position++
-										break
-									default:
-										if buffer[position] != rune(' ') {
-											goto l24
-										}
-										position++
-										break
-									}
-								}
-
-								goto l21
-							l24:
-								position, tokenIndex, depth = position24, tokenIndex24, depth24
+							position40, tokenIndex40 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l41
 							}
-							if !matchDot() {
-								goto l21
+							position++
+							goto l40
+						l41:
+							position, tokenIndex = position40, tokenIndex40
+							if buffer[position] != rune('T') {
+								goto l34
 							}
-							goto l20
-						l21:
-							position, tokenIndex, depth = position21, tokenIndex21, depth21
+							position++
 						}
-						depth--
-						add(rulePegText, position19)
+					l40:
+						add(rulenot, position35)
 					}
-					depth--
-					add(ruletag, position18)
-				}
-			l26:
-				{
-					position27, tokenIndex27, depth27 := position, tokenIndex, depth
 					if buffer[position] != rune(' ') {
-						goto l27
+						goto l34
 					}
 					position++
-					goto l26
-				l27:
-					position, tokenIndex, depth = position27, tokenIndex27, depth27
-				}
-				{
-					position28, tokenIndex28, depth28 := position, tokenIndex, depth
-					{
-						position30 := position
-						depth++
-						if buffer[position] != rune('<') {
-							goto l29
-						}
-						position++
-						if buffer[position] != rune('=') {
-							goto l29
-						}
-						position++
-						depth--
-						add(rulele, position30)
-					}
-				l31:
+				l42:
 					{
-						position32, tokenIndex32, depth32 := position, tokenIndex, depth
+						position43, tokenIndex43 := position, tokenIndex
 						if buffer[position] != rune(' ') {
-							goto l32
+							goto l43
 						}
 						position++
-						goto l31
-					l32:
-						position, tokenIndex, depth = position32, tokenIndex32, depth32
+						goto l42
+					l43:
+						position, tokenIndex = position43, tokenIndex43
 					}
-					{
-						switch buffer[position] {
-						case 'D', 'd':
-							if !_rules[ruledate]() {
-								goto l29
-							}
-							break
-						case 'T', 't':
-							if !_rules[ruletime]() {
-								goto l29
-							}
-							break
-						default:
-							if !_rules[rulenumber]() {
-								goto l29
-							}
-							break
-						}
+					if !_rules[rulefactor]() {
+						goto l34
 					}
-
-					goto l28
-				l29:
-					position, tokenIndex, depth = position28, tokenIndex28, depth28
+					goto l33
+				l34:
+					position, tokenIndex = position33, tokenIndex33
+					if buffer[position] != rune('(') {
+						goto l44
+					}
+					position++
+				l45:
 					{
-						position35 := position
-						depth++
-						if buffer[position] != rune('>') {
-							goto l34
-						}
-						position++
-						if buffer[position] != rune('=') {
-							goto l34
+						position46, tokenIndex46 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l46
 						}
 						position++
-						depth--
-						add(rulege, position35)
+						goto l45
+					l46:
+						position, tokenIndex = position46, tokenIndex46
 					}
-				l36:
+					if !_rules[ruleexpr]() {
+						goto l44
+					}
+				l47:
 					{
-						position37, tokenIndex37, depth37 := position, tokenIndex, depth
+						position48, tokenIndex48 := position, tokenIndex
 						if buffer[position] != rune(' ') {
-							goto l37
+							goto l48
 						}
 						position++
-						goto l36
-					l37:
-						position, tokenIndex, depth = position37, tokenIndex37, depth37
+						goto l47
+					l48:
+						position, tokenIndex = position48, tokenIndex48
 					}
-					{
-						switch buffer[position] {
-						case 'D', 'd':
-							if !_rules[ruledate]() {
-								goto l34
-							}
-							break
-						case 'T', 't':
-							if !_rules[ruletime]() {
-								goto l34
-							}
-							break
-						default:
-							if !_rules[rulenumber]() {
-								goto l34
-							}
-							break
-						}
+					if buffer[position] != rune(')') {
+						goto l44
 					}
-
-					goto l28
-				l34:
-					position, tokenIndex, depth = position28, tokenIndex28, depth28
+					position++
+					goto l33
+				l44:
+					position, tokenIndex = position33, tokenIndex33
 					{
-						switch buffer[position] {
-						case 'E', 'e':
+						position49 := position
+						{
+							position50 := position
 							{
-								position40 := position
-								depth++
+								position51 := position
 								{
-									position41, tokenIndex41, depth41 := position, tokenIndex, depth
-									if buffer[position] != rune('e') {
-										goto l42
-									}
-									position++
-									goto l41
-								l42:
-									position, tokenIndex, depth = position41, tokenIndex41, depth41
-									if buffer[position] != rune('E') {
-										goto l16
-									}
-									position++
-								}
-							l41:
-								{
-									position43, tokenIndex43, depth43 := position, tokenIndex, depth
-									if buffer[position] != rune('x') {
-										goto l44
-									}
-									position++
-									goto l43
-								l44:
-									position, tokenIndex, depth = position43, tokenIndex43, depth43
-									if buffer[position] != rune('X') {
-										goto l16
-									}
-									position++
-								}
-							l43:
-								{
-									position45, tokenIndex45, depth45 := position, tokenIndex, depth
-									if buffer[position] != rune('i') {
-										goto l46
-									}
-									position++
-									goto l45
-								l46:
-									position, tokenIndex, depth = position45, tokenIndex45, depth45
-									if buffer[position] != rune('I') {
-										goto l16
-									}
-									position++
-								}
-							l45:
-								{
-									position47, tokenIndex47, depth47 := position, tokenIndex, depth
-									if buffer[position] != rune('s') {
-										goto l48
-									}
-									position++
-									goto l47
-								l48:
-									position, tokenIndex, depth = position47, tokenIndex47, depth47
-									if buffer[position] != rune('S') {
-										goto l16
+									position54, tokenIndex54 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '<':
+											if buffer[position] != rune('<') {
+												goto l54
+											}
+											position++
+										case '>':
+											if buffer[position] != rune('>') {
+												goto l54
+											}
+											position++
+										case '=':
+											if buffer[position] != rune('=') {
+												goto l54
+											}
+											position++
+										case '\'':
+											if buffer[position] != rune('\'') {
+												goto l54
+											}
+											position++
+										case '"':
+											if buffer[position] != rune('"') {
+												goto l54
+											}
+											position++
+										case ')':
+											if buffer[position] != rune(')') {
+												goto l54
+											}
+											position++
+										case '(':
+											if buffer[position] != rune('(') {
+												goto l54
+											}
+											position++
+										case '\\':
+											if buffer[position] != rune('\\') {
+												goto l54
+											}
+											position++
+										case '\r':
+											if buffer[position] != rune('\r') {
+												goto l54
+											}
+											position++
+										case '\n':
+											if buffer[position] != rune('\n') {
+												goto l54
+											}
+											position++
+										case '\t':
+											if buffer[position] != rune('\t') {
+												goto l54
+											}
+											position++
+										default:
+											if buffer[position] != rune(' ') {
+												goto l54
+											}
+											position++
+										}
 									}
-									position++
+
+									goto l31
+								l54:
+									position, tokenIndex = position54, tokenIndex54
 								}
-							l47:
-								{
-									position49, tokenIndex49, depth49 := position, tokenIndex, depth
-									if buffer[position] != rune('t') {
-										goto l50
-									}
-									position++
-									goto l49
-								l50:
-									position, tokenIndex, depth = position49, tokenIndex49, depth49
-									if buffer[position] != rune('T') {
-										goto l16
-									}
-									position++
+								if !matchDot() {
+									goto l31
 								}
-							l49:
+							l52:
 								{
-									position51, tokenIndex51, depth51 := position, tokenIndex, depth
-									if buffer[position] != rune('s') {
-										goto l52
+									position53, tokenIndex53 := position, tokenIndex
+									{
+										position56, tokenIndex56 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '<':
+												if buffer[position] != rune('<') {
+													goto l56
+												}
+												position++
+											case '>':
+												if buffer[position] != rune('>') {
+													goto l56
+												}
+												position++
+											case '=':
+												if buffer[position] != rune('=') {
+													goto l56
+												}
+												position++
+											case '\'':
+												if buffer[position] != rune('\'') {
+													goto l56
+												}
+												position++
+											case '"':
+												if buffer[position] != rune('"') {
+													goto l56
+												}
+												position++
+											case ')':
+												if buffer[position] != rune(')') {
+													goto l56
+												}
+												position++
+											case '(':
+												if buffer[position] != rune('(') {
+													goto l56
+												}
+												position++
+											case '\\':
+												if buffer[position] != rune('\\') {
+													goto l56
+												}
+												position++
+											case '\r':
+												if buffer[position] != rune('\r') {
+													goto l56
+												}
+												position++
+											case '\n':
+												if buffer[position] != rune('\n') {
+													goto l56
+												}
+												position++
+											case '\t':
+												if buffer[position] != rune('\t') {
+													goto l56
+												}
+												position++
+											default:
+												if buffer[position] != rune(' ') {
+													goto l56
+												}
+												position++
+											}
+										}
+
+										goto l53
+									l56:
+										position, tokenIndex = position56, tokenIndex56
 									}
-									position++
-									goto l51
-								l52:
-									position, tokenIndex, depth = position51, tokenIndex51, depth51
-									if buffer[position] != rune('S') {
-										goto l16
+									if !matchDot() {
+										goto l53
 									}
-									position++
+									goto l52
+								l53:
+									position, tokenIndex = position53, tokenIndex53
 								}
-							l51:
-								depth--
-								add(ruleexists, position40)
+								add(rulePegText, position51)
 							}
-							break
-						case '=':
+							add(ruletag, position50)
+						}
+					l58:
+						{
+							position59, tokenIndex59 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l59
+							}
+							position++
+							goto l58
+						l59:
+							position, tokenIndex = position59, tokenIndex59
+						}
+						{
+							position60, tokenIndex60 := position, tokenIndex
 							{
-								position53 := position
-								depth++
+								position62 := position
+								if buffer[position] != rune('<') {
+									goto l61
+								}
+								position++
 								if buffer[position] != rune('=') {
-									goto l16
+									goto l61
 								}
 								position++
-								depth--
-								add(ruleequal, position53)
+								add(rulele, position62)
 							}
-						l54:
+						l63:
 							{
-								position55, tokenIndex55, depth55 := position, tokenIndex, depth
+								position64, tokenIndex64 := position, tokenIndex
 								if buffer[position] != rune(' ') {
-									goto l55
+									goto l64
 								}
 								position++
-								goto l54
-							l55:
-								position, tokenIndex, depth = position55, tokenIndex55, depth55
+								goto l63
+							l64:
+								position, tokenIndex = position64, tokenIndex64
 							}
 							{
 								switch buffer[position] {
-								case '\'':
-									if !_rules[rulevalue]() {
-										goto l16
-									}
-									break
 								case 'D', 'd':
 									if !_rules[ruledate]() {
-										goto l16
+										goto l61
 									}
-									break
 								case 'T', 't':
 									if !_rules[ruletime]() {
-										goto l16
+										goto l61
 									}
-									break
 								default:
 									if !_rules[rulenumber]() {
-										goto l16
+										goto l61
 									}
-									break
 								}
 							}
 
-							break
-						case '>':
+							goto l60
+						l61:
+							position, tokenIndex = position60, tokenIndex60
 							{
-								position57 := position
-								depth++
+								position67 := position
 								if buffer[position] != rune('>') {
-									goto l16
-								}
-								position++
-								depth--
-								add(ruleg, position57)
-							}
-						l58:
-							{
-								position59, tokenIndex59, depth59 := position, tokenIndex, depth
-								if buffer[position] != rune(' ') {
-									goto l59
+									goto l66
 								}
 								position++
-								goto l58
-							l59:
-								position, tokenIndex, depth = position59, tokenIndex59, depth59
-							}
-							{
-								switch buffer[position] {
-								case 'D', 'd':
-									if !_rules[ruledate]() {
-										goto l16
-									}
-									break
-								case 'T', 't':
-									if !_rules[ruletime]() {
-										goto l16
-									}
-									break
-								default:
-									if !_rules[rulenumber]() {
-										goto l16
-									}
-									break
-								}
-							}
-
-							break
-						case '<':
-							{
-								position61 := position
-								depth++
-								if buffer[position] != rune('<') {
-									goto l16
+								if buffer[position] != rune('=') {
+									goto l66
 								}
 								position++
-								depth--
-								add(rulel, position61)
+								add(rulege, position67)
 							}
-						l62:
+						l68:
 							{
-								position63, tokenIndex63, depth63 := position, tokenIndex, depth
+								position69, tokenIndex69 := position, tokenIndex
 								if buffer[position] != rune(' ') {
-									goto l63
+									goto l69
 								}
 								position++
-								goto l62
-							l63:
-								position, tokenIndex, depth = position63, tokenIndex63, depth63
+								goto l68
+							l69:
+								position, tokenIndex = position69, tokenIndex69
 							}
 							{
 								switch buffer[position] {
 								case 'D', 'd':
 									if !_rules[ruledate]() {
-										goto l16
+										goto l66
 									}
-									break
 								case 'T', 't':
 									if !_rules[ruletime]() {
-										goto l16
+										goto l66
 									}
-									break
 								default:
 									if !_rules[rulenumber]() {
-										goto l16
+										goto l66
 									}
-									break
 								}
 							}
 
-							break
-						default:
+							goto l60
+						l66:
+							position, tokenIndex = position60, tokenIndex60
 							{
-								position65 := position
-								depth++
-								{
-									position66, tokenIndex66, depth66 := position, tokenIndex, depth
-									if buffer[position] != rune('c') {
-										goto l67
-									}
-									position++
-									goto l66
-								l67:
-									position, tokenIndex, depth = position66, tokenIndex66, depth66
-									if buffer[position] != rune('C') {
-										goto l16
-									}
-									position++
-								}
-							l66:
-								{
-									position68, tokenIndex68, depth68 := position, tokenIndex, depth
-									if buffer[position] != rune('o') {
-										goto l69
-									}
-									position++
-									goto l68
-								l69:
-									position, tokenIndex, depth = position68, tokenIndex68, depth68
-									if buffer[position] != rune('O') {
-										goto l16
+								switch buffer[position] {
+								case 'E', 'e':
+									{
+										position72 := position
+										{
+											position73, tokenIndex73 := position, tokenIndex
+											if buffer[position] != rune('e') {
+												goto l74
+											}
+											position++
+											goto l73
+										l74:
+											position, tokenIndex = position73, tokenIndex73
+											if buffer[position] != rune('E') {
+												goto l31
+											}
+											position++
+										}
+									l73:
+										{
+											position75, tokenIndex75 := position, tokenIndex
+											if buffer[position] != rune('x') {
+												goto l76
+											}
+											position++
+											goto l75
+										l76:
+											position, tokenIndex = position75, tokenIndex75
+											if buffer[position] != rune('X') {
+												goto l31
+											}
+											position++
+										}
+									l75:
+										{
+											position77, tokenIndex77 := position, tokenIndex
+											if buffer[position] != rune('i') {
+												goto l78
+											}
+											position++
+											goto l77
+										l78:
+											position, tokenIndex = position77, tokenIndex77
+											if buffer[position] != rune('I') {
+												goto l31
+											}
+											position++
+										}
+									l77:
+										{
+											position79, tokenIndex79 := position, tokenIndex
+											if buffer[position] != rune('s') {
+												goto l80
+											}
+											position++
+											goto l79
+										l80:
+											position, tokenIndex = position79, tokenIndex79
+											if buffer[position] != rune('S') {
+												goto l31
+											}
+											position++
+										}
+									l79:
+										{
+											position81, tokenIndex81 := position, tokenIndex
+											if buffer[position] != rune('t') {
+												goto l82
+											}
+											position++
+											goto l81
+										l82:
+											position, tokenIndex = position81, tokenIndex81
+											if buffer[position] != rune('T') {
+												goto l31
+											}
+											position++
+										}
+									l81:
+										{
+											position83, tokenIndex83 := position, tokenIndex
+											if buffer[position] != rune('s') {
+												goto l84
+											}
+											position++
+											goto l83
+										l84:
+											position, tokenIndex = position83, tokenIndex83
+											if buffer[position] != rune('S') {
+												goto l31
+											}
+											position++
+										}
+									l83:
+										add(ruleexists, position72)
 									}
-									position++
-								}
-							l68:
-								{
-									position70, tokenIndex70, depth70 := position, tokenIndex, depth
-									if buffer[position] != rune('n') {
-										goto l71
+								case '=':
+									{
+										position85 := position
+										if buffer[position] != rune('=') {
+											goto l31
+										}
+										position++
+										add(ruleequal, position85)
 									}
-									position++
-									goto l70
-								l71:
-									position, tokenIndex, depth = position70, tokenIndex70, depth70
-									if buffer[position] != rune('N') {
-										goto l16
+								l86:
+									{
+										position87, tokenIndex87 := position, tokenIndex
+										if buffer[position] != rune(' ') {
+											goto l87
+										}
+										position++
+										goto l86
+									l87:
+										position, tokenIndex = position87, tokenIndex87
 									}
-									position++
-								}
-							l70:
-								{
-									position72, tokenIndex72, depth72 := position, tokenIndex, depth
-									if buffer[position] != rune('t') {
-										goto l73
+									{
+										switch buffer[position] {
+										case '\'':
+											if !_rules[rulevalue]() {
+												goto l31
+											}
+										case 'D', 'd':
+											if !_rules[ruledate]() {
+												goto l31
+											}
+										case 'T', 't':
+											if !_rules[ruletime]() {
+												goto l31
+											}
+										default:
+											if !_rules[rulenumber]() {
+												goto l31
+											}
+										}
 									}
-									position++
-									goto l72
-								l73:
-									position, tokenIndex, depth = position72, tokenIndex72, depth72
-									if buffer[position] != rune('T') {
-										goto l16
+
+								case '>':
+									{
+										position89 := position
+										if buffer[position] != rune('>') {
+											goto l31
+										}
+										position++
+										add(ruleg, position89)
 									}
-									position++
-								}
-							l72:
-								{
-									position74, tokenIndex74, depth74 := position, tokenIndex, depth
-									if buffer[position] != rune('a') {
-										goto l75
+								l90:
+									{
+										position91, tokenIndex91 := position, tokenIndex
+										if buffer[position] != rune(' ') {
+											goto l91
+										}
+										position++
+										goto l90
+									l91:
+										position, tokenIndex = position91, tokenIndex91
 									}
-									position++
-									goto l74
-								l75:
-									position, tokenIndex, depth = position74, tokenIndex74, depth74
-									if buffer[position] != rune('A') {
-										goto l16
+									{
+										switch buffer[position] {
+										case 'D', 'd':
+											if !_rules[ruledate]() {
+												goto l31
+											}
+										case 'T', 't':
+											if !_rules[ruletime]() {
+												goto l31
+											}
+										default:
+											if !_rules[rulenumber]() {
+												goto l31
+											}
+										}
 									}
-									position++
-								}
-							l74:
-								{
-									position76, tokenIndex76, depth76 := position, tokenIndex, depth
-									if buffer[position] != rune('i') {
-										goto l77
+
+								case '<':
+									{
+										position93 := position
+										if buffer[position] != rune('<') {
+											goto l31
+										}
+										position++
+										add(rulel, position93)
 									}
-									position++
-									goto l76
-								l77:
-									position, tokenIndex, depth = position76, tokenIndex76, depth76
-									if buffer[position] != rune('I') {
-										goto l16
+								l94:
+									{
+										position95, tokenIndex95 := position, tokenIndex
+										if buffer[position] != rune(' ') {
+											goto l95
+										}
+										position++
+										goto l94
+									l95:
+										position, tokenIndex = position95, tokenIndex95
 									}
-									position++
-								}
-							l76:
-								{
-									position78, tokenIndex78, depth78 := position, tokenIndex, depth
-									if buffer[position] != rune('n') {
-										goto l79
+									{
+										switch buffer[position] {
+										case 'D', 'd':
+											if !_rules[ruledate]() {
+												goto l31
+											}
+										case 'T', 't':
+											if !_rules[ruletime]() {
+												goto l31
+											}
+										default:
+											if !_rules[rulenumber]() {
+												goto l31
+											}
+										}
 									}
-									position++
-									goto l78
-								l79:
-									position, tokenIndex, depth = position78, tokenIndex78, depth78
-									if buffer[position] != rune('N') {
-										goto l16
+
+								default:
+									{
+										position97 := position
+										{
+											position98, tokenIndex98 := position, tokenIndex
+											if buffer[position] != rune('c') {
+												goto l99
+											}
+											position++
+											goto l98
+										l99:
+											position, tokenIndex = position98, tokenIndex98
+											if buffer[position] != rune('C') {
+												goto l31
+											}
+											position++
+										}
+									l98:
+										{
+											position100, tokenIndex100 := position, tokenIndex
+											if buffer[position] != rune('o') {
+												goto l101
+											}
+											position++
+											goto l100
+										l101:
+											position, tokenIndex = position100, tokenIndex100
+											if buffer[position] != rune('O') {
+												goto l31
+											}
+											position++
+										}
+									l100:
+										{
+											position102, tokenIndex102 := position, tokenIndex
+											if buffer[position] != rune('n') {
+												goto l103
+											}
+											position++
+											goto l102
+										l103:
+											position, tokenIndex = position102, tokenIndex102
+											if buffer[position] != rune('N') {
+												goto l31
+											}
+											position++
+										}
+									l102:
+										{
+											position104, tokenIndex104 := position, tokenIndex
+											if buffer[position] != rune('t') {
+												goto l105
+											}
+											position++
+											goto l104
+										l105:
+											position, tokenIndex = position104, tokenIndex104
+											if buffer[position] != rune('T') {
+												goto l31
+											}
+											position++
+										}
+									l104:
+										{
+											position106, tokenIndex106 := position, tokenIndex
+											if buffer[position] != rune('a') {
+												goto l107
+											}
+											position++
+											goto l106
+										l107:
+											position, tokenIndex = position106, tokenIndex106
+											if buffer[position] != rune('A') {
+												goto l31
+											}
+											position++
+										}
+									l106:
+										{
+											position108, tokenIndex108 := position, tokenIndex
+											if buffer[position] != rune('i') {
+												goto l109
+											}
+											position++
+											goto l108
+										l109:
+											position, tokenIndex = position108, tokenIndex108
+											if buffer[position] != rune('I') {
+												goto l31
+											}
+											position++
+										}
+									l108:
+										{
+											position110, tokenIndex110 := position, tokenIndex
+											if buffer[position] != rune('n') {
+												goto l111
+											}
+											position++
+											goto l110
+										l111:
+											position, tokenIndex = position110, tokenIndex110
+											if buffer[position] != rune('N') {
+												goto l31
+											}
+											position++
+										}
+									l110:
+										{
+											position112, tokenIndex112 := position, tokenIndex
+											if buffer[position] != rune('s') {
+												goto l113
+											}
+											position++
+											goto l112
+										l113:
+											position, tokenIndex = position112, tokenIndex112
+											if buffer[position] != rune('S') {
+												goto l31
+											}
+											position++
+										}
+									l112:
+										add(rulecontains, position97)
 									}
-									position++
-								}
-							l78:
-								{
-									position80, tokenIndex80, depth80 := position, tokenIndex, depth
-									if buffer[position] != rune('s') {
-										goto l81
+								l114:
+									{
+										position115, tokenIndex115 := position, tokenIndex
+										if buffer[position] != rune(' ') {
+											goto l115
+										}
+										position++
+										goto l114
+									l115:
+										position, tokenIndex = position115, tokenIndex115
 									}
-									position++
-									goto l80
-								l81:
-									position, tokenIndex, depth = position80, tokenIndex80, depth80
-									if buffer[position] != rune('S') {
-										goto l16
+									if !_rules[rulevalue]() {
+										goto l31
 									}
-									position++
 								}
-							l80:
-								depth--
-								add(rulecontains, position65)
 							}
-						l82:
-							{
-								position83, tokenIndex83, depth83 := position, tokenIndex, depth
-								if buffer[position] != rune(' ') {
-									goto l83
-								}
-								position++
-								goto l82
-							l83:
-								position, tokenIndex, depth = position83, tokenIndex83, depth83
-							}
-							if !_rules[rulevalue]() {
-								goto l16
-							}
-							break
+
 						}
+					l60:
+						add(rulecondition, position49)
 					}
-
 				}
-			l28:
-				depth--
-				add(rulecondition, position17)
+			l33:
+				add(rulefactor, position32)
 			}
 			return true
-		l16:
-			position, tokenIndex, depth = position16, tokenIndex16, depth16
+		l31:
+			position, tokenIndex = position31, tokenIndex31
 			return false
 		},
-		/* 2 tag <- <<(!((&('<') '<') | (&('>') '>') | (&('=') '=') | (&('\'') '\'') | (&('"') '"') | (&(')') ')') | (&('(') '(') | (&('\\') '\\') | (&('\r') '\r') | (&('\n') '\n') | (&('\t') '\t') | (&(' ') ' ')) .)+>> */
+		/* 4 condition <- <(tag ' '* ((le ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / (ge ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / ((&('E' | 'e') exists) | (&('=') (equal ' '* ((&('\'') value) | (&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('>') (g ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('<') (l ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('C' | 'c') (contains ' '* value)))))> */
+		nil,
+		/* 5 tag <- <<(!((&('<') '<') | (&('>') '>') | (&('=') '=') | (&('\'') '\'') | (&('"') '"') | (&(')') ')') | (&('(') '(') | (&('\\') '\\') | (&('\r') '\r') | (&('\n') '\n') | (&('\t') '\t') | (&(' ') ' ')) .)+>> */
 		nil,
-		/* 3 value <- <<('\'' (!('"' / '\'') .)* '\'')>> */
+		/* 6 value <- <<('\'' (!('"' / '\'') .)* '\'')>> */
 		func() bool {
-			position85, tokenIndex85, depth85 := position, tokenIndex, depth
+			position118, tokenIndex118 := position, tokenIndex
 			{
-				position86 := position
-				depth++
+				position119 := position
 				{
-					position87 := position
-					depth++
+					position120 := position
 					if buffer[position] != rune('\'') {
-						goto l85
+						goto l118
 					}
 					position++
-				l88:
+				l121:
 					{
-						position89, tokenIndex89, depth89 := position, tokenIndex, depth
+						position122, tokenIndex122 := position, tokenIndex
 						{
-							position90, tokenIndex90, depth90 := position, tokenIndex, depth
+							position123, tokenIndex123 := position, tokenIndex
 							{
-								position91, tokenIndex91, depth91 := position, tokenIndex, depth
+								position124, tokenIndex124 := position, tokenIndex
 								if buffer[position] != rune('"') {
-									goto l92
+									goto l125
 								}
 								position++
-								goto l91
-							l92:
-								position, tokenIndex, depth = position91, tokenIndex91, depth91
+								goto l124
+							l125:
+								position, tokenIndex = position124, tokenIndex124
 								if buffer[position] != rune('\'') {
-									goto l90
+									goto l123
 								}
 								position++
 							}
-						l91:
-							goto l89
-						l90:
-							position, tokenIndex, depth = position90, tokenIndex90, depth90
+						l124:
+							goto l122
+						l123:
+							position, tokenIndex = position123, tokenIndex123
 						}
 						if !matchDot() {
-							goto l89
+							goto l122
 						}
-						goto l88
-					l89:
-						position, tokenIndex, depth = position89, tokenIndex89, depth89
+						goto l121
+					l122:
+						position, tokenIndex = position122, tokenIndex122
 					}
 					if buffer[position] != rune('\'') {
-						goto l85
+						goto l118
 					}
 					position++
-					depth--
-					add(rulePegText, position87)
+					add(rulePegText, position120)
 				}
-				depth--
-				add(rulevalue, position86)
+				add(rulevalue, position119)
 			}
 			return true
-		l85:
-			position, tokenIndex, depth = position85, tokenIndex85, depth85
+		l118:
+			position, tokenIndex = position118, tokenIndex118
 			return false
 		},
-		/* 4 number <- <<('0' / ([1-9] digit* ('.' digit*)?))>> */
+		/* 7 number <- <<('0' / ([1-9] digit* ('.' digit*)?))>> */
 		func() bool {
-			position93, tokenIndex93, depth93 := position, tokenIndex, depth
+			position126, tokenIndex126 := position, tokenIndex
 			{
-				position94 := position
-				depth++
+				position127 := position
 				{
-					position95 := position
-					depth++
+					position128 := position
 					{
-						position96, tokenIndex96, depth96 := position, tokenIndex, depth
+						position129, tokenIndex129 := position, tokenIndex
 						if buffer[position] != rune('0') {
-							goto l97
+							goto l130
 						}
 						position++
-						goto l96
-					l97:
-						position, tokenIndex, depth = position96, tokenIndex96, depth96
+						goto l129
+					l130:
+						position, tokenIndex = position129, tokenIndex129
 						if c := buffer[position]; c < rune('1') || c > rune('9') {
-							goto l93
+							goto l126
 						}
 						position++
-					l98:
+					l131:
 						{
-							position99, tokenIndex99, depth99 := position, tokenIndex, depth
+							position132, tokenIndex132 := position, tokenIndex
 							if !_rules[ruledigit]() {
-								goto l99
+								goto l132
 							}
-							goto l98
-						l99:
-							position, tokenIndex, depth = position99, tokenIndex99, depth99
+							goto l131
+						l132:
+							position, tokenIndex = position132, tokenIndex132
 						}
 						{
-							position100, tokenIndex100, depth100 := position, tokenIndex, depth
+							position133, tokenIndex133 := position, tokenIndex
 							if buffer[position] != rune('.') {
-								goto l100
+								goto l133
 							}
 							position++
-						l102:
+						l135:
 							{
-								position103, tokenIndex103, depth103 := position, tokenIndex, depth
+								position136, tokenIndex136 := position, tokenIndex
 								if !_rules[ruledigit]() {
-									goto l103
+									goto l136
 								}
-								goto l102
-							l103:
-								position, tokenIndex, depth = position103, tokenIndex103, depth103
+								goto l135
+							l136:
+								position, tokenIndex = position136, tokenIndex136
 							}
-							goto l101
-						l100:
-							position, tokenIndex, depth = position100, tokenIndex100, depth100
+							goto l134
+						l133:
+							position, tokenIndex = position133, tokenIndex133
 						}
-					l101:
+					l134:
 					}
-				l96:
-					depth--
-					add(rulePegText, position95)
+				l129:
+					add(rulePegText, position128)
 				}
-				depth--
-				add(rulenumber, position94)
+				add(rulenumber, position127)
 			}
 			return true
-		l93:
-			position, tokenIndex, depth = position93, tokenIndex93, depth93
+		l126:
+			position, tokenIndex = position126, tokenIndex126
 			return false
 		},
-		/* 5 digit <- <[0-9]> */
+		/* 8 digit <- <[0-9]> */
 		func() bool {
-			position104, tokenIndex104, depth104 := position, tokenIndex, depth
+			position137, tokenIndex137 := position, tokenIndex
 			{
-				position105 := position
-				depth++
+				position138 := position
 				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l104
+					goto l137
 				}
 				position++
-				depth--
-				add(ruledigit, position105)
+				add(ruledigit, position138)
 			}
 			return true
-		l104:
-			position, tokenIndex, depth = position104, tokenIndex104, depth104
+		l137:
+			position, tokenIndex = position137, tokenIndex137
 			return false
 		},
-		/* 6 time <- <(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ' ' <(year '-' month '-' day 'T' digit digit ':' digit digit ':' digit digit ((('-' / '+') digit digit ':' digit digit) / 'Z'))>)> */
+		/* 9 time <- <(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ' ' <(year '-' month '-' day 'T' digit digit ':' digit digit ':' digit digit ((('-' / '+') digit digit ':' digit digit) / 'Z'))>)> */
 		func() bool {
-			position106, tokenIndex106, depth106 := position, tokenIndex, depth
+			position139, tokenIndex139 := position, tokenIndex
 			{
-				position107 := position
-				depth++
+				position140 := position
 				{
-					position108, tokenIndex108, depth108 := position, tokenIndex, depth
+					position141, tokenIndex141 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l109
+						goto l142
 					}
 					position++
-					goto l108
-				l109:
-					position, tokenIndex, depth = position108, tokenIndex108, depth108
+					goto l141
+				l142:
+					position, tokenIndex = position141, tokenIndex141
 					if buffer[position] != rune('T') {
-						goto l106
+						goto l139
 					}
 					position++
 				}
-			l108:
+			l141:
 				{
-					position110, tokenIndex110, depth110 := position, tokenIndex, depth
+					position143, tokenIndex143 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l111
+						goto l144
 					}
 					position++
-					goto l110
-				l111:
-					position, tokenIndex, depth = position110, tokenIndex110, depth110
+					goto l143
+				l144:
+					position, tokenIndex = position143, tokenIndex143
 					if buffer[position] != rune('I') {
-						goto l106
+						goto l139
 					}
 					position++
 				}
-			l110:
+			l143:
 				{
-					position112, tokenIndex112, depth112 := position, tokenIndex, depth
+					position145, tokenIndex145 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l113
+						goto l146
 					}
 					position++
-					goto l112
-				l113:
-					position, tokenIndex, depth = position112, tokenIndex112, depth112
+					goto l145
+				l146:
+					position, tokenIndex = position145, tokenIndex145
 					if buffer[position] != rune('M') {
-						goto l106
+						goto l139
 					}
 					position++
 				}
-			l112:
+			l145:
 				{
-					position114, tokenIndex114, depth114 := position, tokenIndex, depth
+					position147, tokenIndex147 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l115
+						goto l148
 					}
 					position++
-					goto l114
-				l115:
-					position, tokenIndex, depth = position114, tokenIndex114, depth114
+					goto l147
+				l148:
+					position, tokenIndex = position147, tokenIndex147
 					if buffer[position] != rune('E') {
-						goto l106
+						goto l139
 					}
 					position++
 				}
-			l114:
+			l147:
 				if buffer[position] != rune(' ') {
-					goto l106
+					goto l139
 				}
 				position++
 				{
-					position116 := position
-					depth++
+					position149 := position
 					if !_rules[ruleyear]() {
-						goto l106
+						goto l139
 					}
 					if buffer[position] != rune('-') {
-						goto l106
+						goto l139
 					}
 					position++
 					if !_rules[rulemonth]() {
-						goto l106
+						goto l139
 					}
 					if buffer[position] != rune('-') {
-						goto l106
+						goto l139
 					}
 					position++
 					if !_rules[ruleday]() {
-						goto l106
+						goto l139
 					}
 					if buffer[position] != rune('T') {
-						goto l106
+						goto l139
 					}
 					position++
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l139
 					}
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l139
 					}
 					if buffer[position] != rune(':') {
-						goto l106
+						goto l139
 					}
 					position++
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l139
 					}
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l139
 					}
 					if buffer[position] != rune(':') {
-						goto l106
+						goto l139
 					}
 					position++
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l139
 					}
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l139
 					}
 					{
-						position117, tokenIndex117, depth117 := position, tokenIndex, depth
+						position150, tokenIndex150 := position, tokenIndex
 						{
-							position119, tokenIndex119, depth119 := position, tokenIndex, depth
+							position152, tokenIndex152 := position, tokenIndex
 							if buffer[position] != rune('-') {
-								goto l120
+								goto l153
 							}
 							position++
-							goto l119
-						l120:
-							position, tokenIndex, depth = position119, tokenIndex119, depth119
+							goto l152
+						l153:
+							position, tokenIndex = position152, tokenIndex152
 							if buffer[position] != rune('+') {
-								goto l118
+								goto l151
 							}
 							position++
 						}
-					l119:
+					l152:
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l151
 						}
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l151
 						}
 						if buffer[position] != rune(':') {
-							goto l118
+							goto l151
 						}
 						position++
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l151
 						}
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l151
 						}
-						goto l117
-					l118:
-						position, tokenIndex, depth = position117, tokenIndex117, depth117
+						goto l150
+					l151:
+						position, tokenIndex = position150, tokenIndex150
 						if buffer[position] != rune('Z') {
-							goto l106
+							goto l139
 						}
 						position++
 					}
-				l117:
-					depth--
-					add(rulePegText, position116)
+				l150:
+					add(rulePegText, position149)
 				}
-				depth--
-				add(ruletime, position107)
+				add(ruletime, position140)
 			}
 			return true
-		l106:
-			position, tokenIndex, depth = position106, tokenIndex106, depth106
+		l139:
+			position, tokenIndex = position139, tokenIndex139
 			return false
 		},
-		/* 7 date <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') ' ' <(year '-' month '-' day)>)> */
+		/* 10 date <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') ' ' <(year '-' month '-' day)>)> */
 		func() bool {
-			position121, tokenIndex121, depth121 := position, tokenIndex, depth
+			position154, tokenIndex154 := position, tokenIndex
 			{
-				position122 := position
-				depth++
+				position155 := position
 				{
-					position123, tokenIndex123, depth123 := position, tokenIndex, depth
+					position156, tokenIndex156 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l124
+						goto l157
 					}
 					position++
-					goto l123
-				l124:
-					position, tokenIndex, depth = position123, tokenIndex123, depth123
+					goto l156
+				l157:
+					position, tokenIndex = position156, tokenIndex156
 					if buffer[position] != rune('D') {
-						goto l121
+						goto l154
 					}
 					position++
 				}
-			l123:
+			l156:
 				{
-					position125, tokenIndex125, depth125 := position, tokenIndex, depth
+					position158, tokenIndex158 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l126
+						goto l159
 					}
 					position++
-					goto l125
-				l126:
-					position, tokenIndex, depth = position125, tokenIndex125, depth125
+					goto l158
+				l159:
+					position, tokenIndex = position158, tokenIndex158
 					if buffer[position] != rune('A') {
-						goto l121
+						goto l154
 					}
 					position++
 				}
-			l125:
+			l158:
 				{
-					position127, tokenIndex127, depth127 := position, tokenIndex, depth
+					position160, tokenIndex160 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l128
+						goto l161
 					}
 					position++
-					goto l127
-				l128:
-					position, tokenIndex, depth = position127, tokenIndex127, depth127
+					goto l160
+				l161:
+					position, tokenIndex = position160, tokenIndex160
 					if buffer[position] != rune('T') {
-						goto l121
+						goto l154
 					}
 					position++
 				}
-			l127:
+			l160:
 				{
-					position129, tokenIndex129, depth129 := position, tokenIndex, depth
+					position162, tokenIndex162 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l130
+						goto l163
 					}
 					position++
-					goto l129
-				l130:
-					position, tokenIndex, depth = position129, tokenIndex129, depth129
+					goto l162
+				l163:
+					position, tokenIndex = position162, tokenIndex162
 					if buffer[position] != rune('E') {
-						goto l121
+						goto l154
 					}
 					position++
 				}
-			l129:
+			l162:
 				if buffer[position] != rune(' ') {
-					goto l121
+					goto l154
 				}
 				position++
 				{
-					position131 := position
-					depth++
+					position164 := position
 					if !_rules[ruleyear]() {
-						goto l121
+						goto l154
 					}
 					if buffer[position] != rune('-') {
-						goto l121
+						goto l154
 					}
 					position++
 					if !_rules[rulemonth]() {
-						goto l121
+						goto l154
 					}
 					if buffer[position] != rune('-') {
-						goto l121
+						goto l154
 					}
 					position++
 					if !_rules[ruleday]() {
-						goto l121
+						goto l154
 					}
-					depth--
-					add(rulePegText, position131)
+					add(rulePegText, position164)
 				}
-				depth--
-				add(ruledate, position122)
+				add(ruledate, position155)
 			}
 			return true
-		l121:
-			position, tokenIndex, depth = position121, tokenIndex121, depth121
+		l154:
+			position, tokenIndex = position154, tokenIndex154
 			return false
 		},
-		/* 8 year <- <(('1' / '2') digit digit digit)> */
+		/* 11 year <- <(('1' / '2') digit digit digit)> */
 		func() bool {
-			position132, tokenIndex132, depth132 := position, tokenIndex, depth
+			position165, tokenIndex165 := position, tokenIndex
 			{
-				position133 := position
-				depth++
+				position166 := position
 				{
-					position134, tokenIndex134, depth134 := position, tokenIndex, depth
+					position167, tokenIndex167 := position, tokenIndex
 					if buffer[position] != rune('1') {
-						goto l135
+						goto l168
 					}
 					position++
-					goto l134
-				l135:
-					position, tokenIndex, depth = position134, tokenIndex134, depth134
+					goto l167
+				l168:
+					position, tokenIndex = position167, tokenIndex167
 					if buffer[position] != rune('2') {
-						goto l132
+						goto l165
 					}
 					position++
 				}
-			l134:
+			l167:
 				if !_rules[ruledigit]() {
-					goto l132
+					goto l165
 				}
 				if !_rules[ruledigit]() {
-					goto l132
+					goto l165
 				}
 				if !_rules[ruledigit]() {
-					goto l132
+					goto l165
 				}
-				depth--
-				add(ruleyear, position133)
+				add(ruleyear, position166)
 			}
 			return true
-		l132:
-			position, tokenIndex, depth = position132, tokenIndex132, depth132
+		l165:
+			position, tokenIndex = position165, tokenIndex165
 			return false
 		},
-		/* 9 month <- <(('0' / '1') digit)> */
+		/* 12 month <- <(('0' / '1') digit)> */
 		func() bool {
-			position136, tokenIndex136, depth136 := position, tokenIndex, depth
+			position169, tokenIndex169 := position, tokenIndex
 			{
-				position137 := position
-				depth++
+				position170 := position
 				{
-					position138, tokenIndex138, depth138 := position, tokenIndex, depth
+					position171, tokenIndex171 := position, tokenIndex
 					if buffer[position] != rune('0') {
-						goto l139
+						goto l172
 					}
 					position++
-					goto l138
-				l139:
-					position, tokenIndex, depth = position138, tokenIndex138, depth138
+					goto l171
+				l172:
+					position, tokenIndex = position171, tokenIndex171
 					if buffer[position] != rune('1') {
-						goto l136
+						goto l169
 					}
 					position++
 				}
-			l138:
+			l171:
 				if !_rules[ruledigit]() {
-					goto l136
+					goto l169
 				}
-				depth--
-				add(rulemonth, position137)
+				add(rulemonth, position170)
 			}
 			return true
-		l136:
-			position, tokenIndex, depth = position136, tokenIndex136, depth136
+		l169:
+			position, tokenIndex = position169, tokenIndex169
 			return false
 		},
-		/* 10 day <- <(((&('3') '3') | (&('2') '2') | (&('1') '1') | (&('0') '0')) digit)> */
+		/* 13 day <- <(((&('3') '3') | (&('2') '2') | (&('1') '1') | (&('0') '0')) digit)> */
 		func() bool {
-			position140, tokenIndex140, depth140 := position, tokenIndex, depth
+			position173, tokenIndex173 := position, tokenIndex
 			{
-				position141 := position
-				depth++
+				position174 := position
 				{
 					switch buffer[position] {
 					case '3':
 						if buffer[position] != rune('3') {
-							goto l140
+							goto l173
 						}
 						position++
-						break
 					case '2':
 						if buffer[position] != rune('2') {
-							goto l140
+							goto l173
 						}
 						position++
-						break
 					case '1':
 						if buffer[position] != rune('1') {
-							goto l140
+							goto l173
 						}
 						position++
-						break
 					default:
 						if buffer[position] != rune('0') {
-							goto l140
+							goto l173
 						}
 						position++
-						break
 					}
 				}
 
 				if !_rules[ruledigit]() {
-					goto l140
+					goto l173
 				}
-				depth--
-				add(ruleday, position141)
+				add(ruleday, position174)
 			}
 			return true
-		l140:
-			position, tokenIndex, depth = position140, tokenIndex140, depth140
+		l173:
+			position, tokenIndex = position173, tokenIndex173
 			return false
 		},
-		/* 11 and <- <(('a' / 'A') ('n' / 'N') ('d' / 'D'))> */
+		/* 14 and <- <(('a' / 'A') ('n' / 'N') ('d' / 'D'))> */
+		nil,
+		/* 15 or <- <(('o' / 'O') ('r' / 'R'))> */
+		nil,
+		/* 16 not <- <(('n' / 'N') ('o' / 'O') ('t' / 'T'))> */
 		nil,
-		/* 12 equal <- <'='> */
+		/* 17 equal <- <'='> */
 		nil,
-		/* 13 contains <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('a' / 'A') ('i' / 'I') ('n' / 'N') ('s' / 'S'))> */
+		/* 18 contains <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('a' / 'A') ('i' / 'I') ('n' / 'N') ('s' / 'S'))> */
 		nil,
-		/* 14 exists <- <(('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S'))> */
+		/* 19 exists <- <(('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S'))> */
 		nil,
-		/* 15 le <- <('<' '=')> */
+		/* 20 le <- <('<' '=')> */
 		nil,
-		/* 16 ge <- <('>' '=')> */
+		/* 21 ge <- <('>' '=')> */
 		nil,
-		/* 17 l <- <'<'> */
+		/* 22 l <- <'<'> */
 		nil,
-		/* 18 g <- <'>'> */
+		/* 23 g <- <'>'> */
 		nil,
 		nil,
 	}
 	p.rules = _rules
+	return nil
 }
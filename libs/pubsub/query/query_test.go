@@ -150,6 +150,44 @@ func TestMatches(t *testing.T) {
 			false,
 			false,
 		},
+		{"abci.owner.name CONTAINS 'Iv'", map[string][]string{"abci.owner.name": {"Igor", "Ivan"}}, false, true, false},
+		{"tx.gas < 7 OR tx.gas > 9", map[string][]string{"tx.gas": {"10"}}, false, true, false},
+		{"tx.gas < 7 OR tx.gas > 9", map[string][]string{"tx.gas": {"8"}}, false, false, false},
+		{"NOT tx.gas = 8", map[string][]string{"tx.gas": {"8"}}, false, false, false},
+		{"NOT tx.gas = 8", map[string][]string{"tx.gas": {"9"}}, false, true, false},
+		{"NOT slash EXISTS", map[string][]string{"tx.gas": {"9"}}, false, true, false},
+		{"NOT NOT slash EXISTS", map[string][]string{"tx.gas": {"9"}}, false, false, false},
+		{
+			"abci.owner.name = 'Pavel' OR abci.owner.name = 'Ivan' AND tx.gas > 9",
+			map[string][]string{"abci.owner.name": {"Igor", "Ivan"}, "tx.gas": {"8"}},
+			false,
+			false,
+			false,
+		},
+		{
+			"abci.owner.name = 'Igor' OR abci.owner.name = 'Ivan' AND tx.gas > 9",
+			map[string][]string{"abci.owner.name": {"Igor", "Ivan"}, "tx.gas": {"8"}},
+			false,
+			true,
+			false,
+		},
+		{
+			"(abci.owner.name = 'Igor' OR abci.owner.name = 'Ivan') AND tx.gas > 9",
+			map[string][]string{"abci.owner.name": {"Igor", "Ivan"}, "tx.gas": {"8"}},
+			false,
+			false,
+			false,
+		},
+		{
+			"NOT (tx.gas > 9 OR slash EXISTS) AND abci.owner.name CONTAINS 'Ig'",
+			map[string][]string{"abci.owner.name": {"Igor", "Ivan"}, "tx.gas": {"8"}},
+			false,
+			true,
+			false,
+		},
+		{"NOT tx.gas > 9", map[string][]string{"tx.gas": {"many"}}, false, false, true},
+		// the first operand of OR matching, the second one isn't evaluated
+		{"tx.gas > 7 OR tx.gas > 9", map[string][]string{"tx.gas": {"8"}}, false, true, false},
 	}
 
 	for _, tc := range testCases {
@@ -219,4 +257,69 @@ func TestConditions(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, tc.conditions, c)
 	}
+
+	// a query with OR or NOT has no list of conditions
+	for _, s := range []string{"tx.gas > 7 OR tx.gas < 9", "NOT tx.gas > 7", "tx.gas > 7 AND NOT tx.gas > 9"} {
+		_, err := query.MustParse(s).Conditions()
+		assert.Error(t, err, s)
+	}
+}
+
+func TestExpression(t *testing.T) {
+	condition := func(key string, op query.Operator, operand interface{}) *query.Expression {
+		return &query.Expression{
+			Kind:      query.ExprCondition,
+			Condition: query.Condition{CompositeKey: key, Op: op, Operand: operand},
+		}
+	}
+
+	testCases := []struct {
+		s    string
+		expr *query.Expression
+	}{
+		{
+			s:    "tx.gas > 7",
+			expr: condition("tx.gas", query.OpGreater, int64(7)),
+		},
+		{
+			s:    "((tx.gas > 7))",
+			expr: condition("tx.gas", query.OpGreater, int64(7)),
+		},
+		{
+			s: "a.b = 'c' OR d.e EXISTS AND f.g CONTAINS 'h'",
+			expr: &query.Expression{Kind: query.ExprOr, Operands: []*query.Expression{
+				condition("a.b", query.OpEqual, "c"),
+				{Kind: query.ExprAnd, Operands: []*query.Expression{
+					condition("d.e", query.OpExists, nil),
+					condition("f.g", query.OpContains, "h"),
+				}},
+			}},
+		},
+		{
+			s: "(a.b = 'c' OR d.e EXISTS) AND NOT f.g <= 1.5",
+			expr: &query.Expression{Kind: query.ExprAnd, Operands: []*query.Expression{
+				{Kind: query.ExprOr, Operands: []*query.Expression{
+					condition("a.b", query.OpEqual, "c"),
+					condition("d.e", query.OpExists, nil),
+				}},
+				{Kind: query.ExprNot, Operands: []*query.Expression{
+					condition("f.g", query.OpLessEqual, 1.5),
+				}},
+			}},
+		},
+		{
+			s: "NOT NOT a.b = 'ünïcödé'",
+			expr: &query.Expression{Kind: query.ExprNot, Operands: []*query.Expression{
+				{Kind: query.ExprNot, Operands: []*query.Expression{
+					condition("a.b", query.OpEqual, "ünïcödé"),
+				}},
+			}},
+		},
+	}
+
+	for _, tc := range testCases {
+		expr, err := query.MustParse(tc.s).Expression()
+		require.NoError(t, err, tc.s)
+		assert.Equal(t, tc.expr, expr, tc.s)
+	}
 }
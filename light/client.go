@@ -310,7 +310,7 @@ func (c *Client) initializeAtHeight(ctx context.Context, height int64) error {
 	}
 
 	// 5) Cross-verify with witnesses to ensure everybody has the same state.
-	if err := c.compareFirstHeaderWithWitnesses(ctx, l.SignedHeader); err != nil {
+	if err := c.compareFirstHeaderWithWitnesses(ctx, l); err != nil {
 		return err
 	}
 
@@ -502,7 +502,7 @@ func (c *Client) verifyLightBlock(ctx context.Context, newLightBlock *types.Ligh
 		return err
 	}
 
-	err = c.compareFirstHeaderWithWitnesses(ctx, newLightBlock.SignedHeader)
+	err = c.compareFirstHeaderWithWitnesses(ctx, newLightBlock)
 
 	if err != nil {
 		c.logger.Error("Witness error", "err", err)
@@ -830,9 +830,10 @@ func (c *Client) findNewPrimary(ctx context.Context, height int64, remove bool)
 	return nil, lastError
 }
 
-// compareFirstHeaderWithWitnesses compares h with all witnesses. If any
-// witness reports a different header than h, the function returns an error.
-func (c *Client) compareFirstHeaderWithWitnesses(ctx context.Context, h *types.SignedHeader) error {
+// compareFirstHeaderWithWitnesses compares the header of l with all witnesses. If any
+// witness reports a different header, the function returns an error. If the quorum
+// signed both headers, evidence is reported to the primary and the witness.
+func (c *Client) compareFirstHeaderWithWitnesses(ctx context.Context, l *types.LightBlock) error {
 	compareCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -845,7 +846,7 @@ func (c *Client) compareFirstHeaderWithWitnesses(ctx context.Context, h *types.S
 
 	errc := make(chan error, len(c.witnesses))
 	for i, witness := range c.witnesses {
-		go c.compareNewHeaderWithWitness(compareCtx, errc, h, witness, i)
+		go c.compareNewHeaderWithWitness(compareCtx, errc, l.SignedHeader, witness, i)
 	}
 
 	witnessesToRemove := make([]int, 0, len(c.witnesses))
@@ -861,6 +862,13 @@ func (c *Client) compareFirstHeaderWithWitnesses(ctx context.Context, h *types.S
 			c.logger.Error(fmt.Sprintf("Witness #%d has a different header. Please check primary is correct and"+
 				" remove witness. Otherwise, use the different primary", e.WitnessIndex), "witness",
 				c.witnesses[e.WitnessIndex])
+			err = c.handleConflictingHeaders(ctx, l, e)
+			if badWitness, ok := err.(errBadWitness); ok {
+				c.logger.Info("Witness sent us invalid conflicting header -> removing it",
+					"witness", c.witnesses[badWitness.WitnessIndex], "err", badWitness)
+				witnessesToRemove = append(witnessesToRemove, badWitness.WitnessIndex)
+				continue
+			}
 			return err
 		case errBadWitness:
 			// If witness sent us an invalid header, then remove it. If it didn't
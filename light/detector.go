@@ -103,13 +103,55 @@ func (c *Client) compareNewHeaderWithWitness(ctx context.Context, errc chan erro
 	errc <- nil
 }
 
+// handleConflictingHeaders is called when a witness returned a block that conflicts with the
+// verified block from the primary. If the block of the witness is valid and signed by the same
+// quorum at the same height, the quorum signed two conflicting blocks. Evidence against each
+// block is then sent to the provider of the other block and ErrLightClientAttack is returned.
+// errBadWitness is returned if the block of the witness is invalid.
+func (c *Client) handleConflictingHeaders(
+	ctx context.Context,
+	primaryBlock *types.LightBlock,
+	e errConflictingHeaders,
+) error {
+	witnessBlock := e.Block
+	witness := c.witnesses[e.WitnessIndex]
+
+	// the witness is behind and its latest block conflicts in time, there are no
+	// two blocks of the same height to build evidence from
+	if witnessBlock.Height != primaryBlock.Height {
+		return e
+	}
+
+	if err := witnessBlock.ValidateBasic(c.chainID); err != nil {
+		return errBadWitness{Reason: err, WitnessIndex: e.WitnessIndex}
+	}
+	if err := c.verifyBlockWithDashCore(ctx, witnessBlock); err != nil {
+		return errBadWitness{Reason: err, WitnessIndex: e.WitnessIndex}
+	}
+
+	// both blocks are valid, but only the same quorum signing both is attributable
+	if !bytes.Equal(witnessBlock.Commit.QuorumHash, primaryBlock.Commit.QuorumHash) {
+		return e
+	}
+
+	c.logger.Error("Attack detected: quorum signed conflicting blocks, sending evidence",
+		"height", primaryBlock.Height, "quorumHash", primaryBlock.Commit.QuorumHash,
+		"primaryBlock", primaryBlock.Hash(), "witnessBlock", witnessBlock.Hash(), "witness", witness)
+
+	// the time of the evidence is the time of the block the receiver committed
+	c.sendEvidence(ctx, types.NewLightClientAttackEvidence(primaryBlock, witnessBlock.Time), witness)
+	c.sendEvidence(ctx, types.NewLightClientAttackEvidence(witnessBlock, primaryBlock.Time), c.primary)
+
+	return ErrLightClientAttack
+}
+
 // sendEvidence sends evidence to a provider on a best effort basis.
-// func (c *Client) sendEvidence(ctx context.Context, ev *types.LightClientAttackEvidence, receiver provider.Provider) {
-//	err := receiver.ReportEvidence(ctx, ev)
-//	if err != nil {
-//		c.logger.Error("Failed to report evidence to provider", "ev", ev, "provider", receiver)
-//	}
-// }
+func (c *Client) sendEvidence(ctx context.Context, ev *types.LightClientAttackEvidence, receiver provider.Provider) {
+	err := receiver.ReportEvidence(ctx, ev)
+	if err != nil {
+		c.logger.Error("Failed to report evidence to provider", "ev", ev, "provider", receiver, "err", err)
+	}
+}
 
 // getTargetBlockOrLatest gets the latest height, if it is greater than the target height then it queries
 // the target height else it returns the latest. returns true if it successfully managed to acquire the target
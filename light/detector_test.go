@@ -0,0 +1,137 @@
+package light_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/tendermint/tm-db"
+
+	dashcore "github.com/tendermint/tendermint/dashcore/rpc"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/light"
+	"github.com/tendermint/tendermint/light/provider"
+	mockp "github.com/tendermint/tendermint/light/provider/mock"
+	dbs "github.com/tendermint/tendermint/light/store/db"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestClientDetectsConflictingBlocks(t *testing.T) {
+	const conflictHeight int64 = 3
+
+	quorumVals, quorumPrivVals := types.GenerateMockValidatorSet(4)
+	quorumKeys := exposeMockPVKeys(quorumPrivVals, quorumVals.QuorumHash)
+	// the same masternodes are also members of another quorum, known to the
+	// Dash Core of the light client
+	otherVals, otherPrivVals := types.GenerateMockValidatorSetUpdatingPrivateValidatorsAtHeight(
+		quorumVals.GetProTxHashes(), types.MapMockPVByProTxHashes(quorumPrivVals), conflictHeight)
+	otherKeys := exposeMockPVKeys(otherPrivVals, otherVals.QuorumHash)
+
+	b1 := quorumKeys.GenSignedHeader(chainID, 1, bTime, nil, quorumVals, quorumVals,
+		hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(quorumKeys))
+	b2 := quorumKeys.GenSignedHeaderLastBlockID(chainID, 2, bTime.Add(30*time.Minute), nil, quorumVals, quorumVals,
+		hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(quorumKeys), types.BlockID{Hash: b1.Hash()})
+	primaryB3 := quorumKeys.GenSignedHeaderLastBlockID(chainID, 3, bTime.Add(1*time.Hour), nil,
+		quorumVals, quorumVals, hash("app_hash"), hash("cons_hash"), hash("results_hash"),
+		0, len(quorumKeys), types.BlockID{Hash: b2.Hash()})
+	primaryBlock := &types.LightBlock{SignedHeader: primaryB3, ValidatorSet: quorumVals}
+
+	testCases := []struct {
+		name string
+		// the block of the witness at the conflict height
+		witnessBlock   func() *types.LightBlock
+		attack         bool
+		witnessRemoved bool
+	}{
+		{
+			name: "same quorum signed both blocks",
+			witnessBlock: func() *types.LightBlock {
+				return &types.LightBlock{
+					SignedHeader: quorumKeys.GenSignedHeaderLastBlockID(chainID, 3, bTime.Add(1*time.Hour), nil,
+						quorumVals, quorumVals, hash("other_app_hash"), hash("cons_hash"), hash("results_hash"),
+						0, len(quorumKeys), types.BlockID{Hash: b2.Hash()}),
+					ValidatorSet: quorumVals,
+				}
+			},
+			attack: true,
+		},
+		{
+			name: "invalid commit of the witness block",
+			witnessBlock: func() *types.LightBlock {
+				sh := quorumKeys.GenSignedHeaderLastBlockID(chainID, 3, bTime.Add(1*time.Hour), nil,
+					quorumVals, quorumVals, hash("other_app_hash"), hash("cons_hash"), hash("results_hash"),
+					0, len(quorumKeys), types.BlockID{Hash: b2.Hash()})
+				sh.Commit.ThresholdBlockSignature = sh.Commit.ThresholdStateSignature
+				return &types.LightBlock{SignedHeader: sh, ValidatorSet: quorumVals}
+			},
+			witnessRemoved: true,
+		},
+		{
+			name: "another quorum signed the witness block",
+			witnessBlock: func() *types.LightBlock {
+				return &types.LightBlock{
+					SignedHeader: otherKeys.GenSignedHeaderLastBlockID(chainID, 3, bTime.Add(1*time.Hour), nil,
+						otherVals, otherVals, hash("other_app_hash"), hash("cons_hash"), hash("results_hash"),
+						0, len(otherKeys), types.BlockID{Hash: b2.Hash()}),
+					ValidatorSet: otherVals,
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			witnessBlock := tc.witnessBlock()
+			primary := mockp.New(
+				chainID,
+				map[int64]*types.SignedHeader{1: b1, 2: b2, 3: primaryB3},
+				map[int64]*types.ValidatorSet{1: quorumVals, 2: quorumVals, 3: quorumVals},
+				quorumPrivVals[0],
+			)
+			witness := mockp.New(
+				chainID,
+				map[int64]*types.SignedHeader{1: b1, 2: b2, 3: witnessBlock.SignedHeader},
+				map[int64]*types.ValidatorSet{1: quorumVals, 2: quorumVals, 3: witnessBlock.ValidatorSet},
+				quorumPrivVals[0],
+			)
+
+			c, err := light.NewClientAtHeight(
+				ctx,
+				1,
+				chainID,
+				primary,
+				[]provider.Provider{witness},
+				dbs.New(dbm.NewMemDB(), chainID),
+				dashcore.NewMockClient(chainID, llmqType, quorumPrivVals[0], true),
+				light.Logger(log.TestingLogger()),
+			)
+			require.NoError(t, err)
+
+			_, err = c.VerifyLightBlockAtHeight(ctx, conflictHeight, bTime.Add(2*time.Hour))
+			switch {
+			case tc.attack:
+				assert.Equal(t, light.ErrLightClientAttack, err)
+			case tc.witnessRemoved:
+				assert.NoError(t, err)
+			default:
+				assert.Error(t, err)
+				assert.NotEqual(t, light.ErrLightClientAttack, err)
+			}
+
+			if tc.witnessRemoved {
+				assert.Empty(t, c.Witnesses())
+			} else {
+				assert.Len(t, c.Witnesses(), 1)
+			}
+
+			// the evidence against each block is sent to the provider of the other one
+			primaryEv := types.NewLightClientAttackEvidence(primaryBlock, witnessBlock.Time)
+			witnessEv := types.NewLightClientAttackEvidence(witnessBlock, primaryBlock.Time)
+			assert.Equal(t, tc.attack, witness.HasEvidence(primaryEv))
+			assert.Equal(t, tc.attack, primary.HasEvidence(witnessEv))
+		})
+	}
+}
@@ -20,7 +20,7 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		// info API
 		"health":        rpcserver.NewRPCFunc(makeHealthFunc(c), ""),
 		"status":        rpcserver.NewRPCFunc(makeStatusFunc(c), ""),
-		"net_info":      rpcserver.NewRPCFunc(makeNetInfoFunc(c), ""),
+		"net_info":      rpcserver.NewRPCFunc(makeNetInfoFunc(c), "detailed"),
 		"blockchain":    rpcserver.NewRPCFunc(makeBlockchainInfoFunc(c), "minHeight,maxHeight"),
 		"genesis":       rpcserver.NewRPCFunc(makeGenesisFunc(c), ""),
 		"block":         rpcserver.NewRPCFunc(makeBlockFunc(c), "height"),
@@ -69,10 +69,13 @@ func makeStatusFunc(c *lrpc.Client) rpcStatusFunc {
 	}
 }
 
-type rpcNetInfoFunc func(ctx *rpctypes.Context, minHeight, maxHeight int64) (*ctypes.ResultNetInfo, error)
+type rpcNetInfoFunc func(ctx *rpctypes.Context, detailed bool) (*ctypes.ResultNetInfo, error)
 
 func makeNetInfoFunc(c *lrpc.Client) rpcNetInfoFunc {
-	return func(ctx *rpctypes.Context, minHeight, maxHeight int64) (*ctypes.ResultNetInfo, error) {
+	return func(ctx *rpctypes.Context, detailed bool) (*ctypes.ResultNetInfo, error) {
+		if detailed {
+			return c.NetInfoDetailed(ctx.Context())
+		}
 		return c.NetInfo(ctx.Context())
 	}
 }
@@ -212,6 +212,18 @@ func (c *Client) NumUnconfirmedTxs(ctx context.Context) (*ctypes.ResultUnconfirm
 	return c.next.NumUnconfirmedTxs(ctx)
 }
 
+func (c *Client) UnconfirmedTx(ctx context.Context, hash []byte) (*ctypes.ResultUnconfirmedTx, error) {
+	return c.next.UnconfirmedTx(ctx, hash)
+}
+
+func (c *Client) UnconfirmedTxsPage(
+	ctx context.Context,
+	cursor int64,
+	limit *int,
+) (*ctypes.ResultUnconfirmedTxsPage, error) {
+	return c.next.UnconfirmedTxsPage(ctx, cursor, limit)
+}
+
 func (c *Client) CheckTx(ctx context.Context, tx types.Tx) (*ctypes.ResultCheckTx, error) {
 	return c.next.CheckTx(ctx, tx)
 }
@@ -220,6 +232,10 @@ func (c *Client) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
 	return c.next.NetInfo(ctx)
 }
 
+func (c *Client) NetInfoDetailed(ctx context.Context) (*ctypes.ResultNetInfo, error) {
+	return c.next.NetInfoDetailed(ctx)
+}
+
 func (c *Client) DumpConsensusState(ctx context.Context) (*ctypes.ResultDumpConsensusState, error) {
 	return c.next.DumpConsensusState(ctx)
 }
@@ -0,0 +1,708 @@
+package mempool
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	cfg "github.com/tendermint/tendermint/config"
+	auto "github.com/tendermint/tendermint/libs/autofile"
+	"github.com/tendermint/tendermint/libs/clist"
+	"github.com/tendermint/tendermint/libs/log"
+	tmsync "github.com/tendermint/tendermint/libs/sync"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/proxy"
+	"github.com/tendermint/tendermint/types"
+)
+
+// txPool is implemented by the mempools to index the txs of the list of the
+// baseMempool they embed, and to decide which txs make it into the mempool.
+type txPool interface {
+	// txElement returns the element of the tx with the given key, if it is in
+	// the mempool.
+	txElement(key [TxKeySize]byte) (*clist.CElement, bool)
+	// addCheckedTx adds a tx the app found valid to the mempool, or returns an
+	// error if there is no room for it.
+	addCheckedTx(memTx *mempoolTx) error
+	// removeTx removes the tx of the given element from the mempool, and from
+	// the cache if removeFromCache is true.
+	removeTx(tx types.Tx, elem *clist.CElement, removeFromCache bool)
+	// recheckedTx is called with a tx of the mempool the app found valid again
+	// on recheck, along with the response of the app.
+	recheckedTx(memTx *mempoolTx, res *abci.ResponseCheckTx)
+	// resetTxs drops the indexes of the txs when the mempool is flushed.
+	resetTxs()
+}
+
+// baseMempool holds the state and the logic shared by CListMempool and
+// PriorityMempool: the list of txs in arrival order, the cache, the WAL, the
+// recheck of the txs after a block is committed and their TTL. How the txs are
+// indexed, admitted and reaped is left to the txPool embedding it.
+type baseMempool struct {
+	// Atomic integers
+	height   int64 // the last block Update()'d to
+	txsBytes int64 // total size of mempool, in bytes
+	lastSeq  int64 // sequence number of the last tx added to the mempool
+
+	// notify listeners (ie. consensus) when txs are available
+	notifiedTxsAvailable bool
+	txsAvailable         chan struct{} // fires once for each height, when the mempool is not empty
+
+	config *cfg.MempoolConfig
+
+	// Exclusive mutex for Update method to prevent concurrent execution of
+	// CheckTx or ReapMaxBytesMaxGas(ReapMaxTxs) methods.
+	updateMtx tmsync.RWMutex
+	preCheck  PreCheckFunc
+	postCheck PostCheckFunc
+
+	wal          *auto.AutoFile // a log of mempool txs
+	txs          *clist.CList   // concurrent linked-list of good txs, in arrival order
+	proxyAppConn proxy.AppConnMempool
+
+	// Track whether we're rechecking txs.
+	// These are not protected by a mutex and are expected to be mutated in
+	// serial (ie. by abci responses which are called in serial).
+	recheckCursor *clist.CElement // next expected response
+	recheckEnd    *clist.CElement // re-checking stops here
+
+	// Keep a cache of already-seen txs.
+	// This reduces the pressure on the proxyApp.
+	cache txCache
+
+	pool txPool
+
+	logger log.Logger
+
+	metrics  *Metrics
+	eventBus types.MempoolEventPublisher
+}
+
+// init sets up the base of the given pool, and registers the callback of the
+// responses of the app. It must be called before the pool is used.
+func (mem *baseMempool) init(
+	config *cfg.MempoolConfig,
+	proxyAppConn proxy.AppConnMempool,
+	height int64,
+	pool txPool,
+) {
+	mem.config = config
+	mem.proxyAppConn = proxyAppConn
+	mem.txs = clist.New()
+	mem.height = height
+	mem.pool = pool
+	mem.logger = log.NewNopLogger()
+	mem.metrics = NopMetrics()
+	mem.eventBus = types.NopEventBus{}
+	if config.CacheSize > 0 {
+		mem.cache = newMapTxCache(config.CacheSize)
+	} else {
+		mem.cache = nopTxCache{}
+	}
+	proxyAppConn.SetResponseCallback(mem.globalCb)
+}
+
+// NOTE: not thread safe - should only be called once, on startup
+func (mem *baseMempool) EnableTxsAvailable() {
+	mem.txsAvailable = make(chan struct{}, 1)
+}
+
+// SetLogger sets the Logger.
+func (mem *baseMempool) SetLogger(l log.Logger) {
+	mem.logger = l
+}
+
+// SetEventBus sets the event bus the mempool publishes the events of its txs to.
+func (mem *baseMempool) SetEventBus(eventBus types.MempoolEventPublisher) {
+	mem.eventBus = eventBus
+}
+
+func (mem *baseMempool) InitWAL() error {
+	af, err := openWAL(mem.config)
+	if err != nil {
+		return err
+	}
+	mem.wal = af
+	return nil
+}
+
+// ReplayWAL checks the txs of the WAL again, with the Recheck type, and adds the
+// valid ones to the mempool, skipping the committed txs recorded in the WAL and
+// the ones of the blocks of the store after its last checkpoint. The WAL is
+// then compacted.
+func (mem *baseMempool) ReplayWAL(blockStore BlockStore) error {
+	if mem.wal == nil {
+		return errors.New("mempool WAL is not initialized")
+	}
+
+	mem.updateMtx.Lock()
+	defer mem.updateMtx.Unlock()
+
+	height, txs, err := readWAL(mem.wal.Path)
+	if err != nil {
+		return fmt.Errorf("can't read mempool WAL: %w", err)
+	}
+	var committed map[[TxKeySize]byte]struct{}
+	if len(txs) > 0 {
+		committed = committedTxKeys(blockStore, height)
+	}
+
+	replayed := 0
+	for _, tx := range txs {
+		if _, ok := committed[TxKey(tx)]; ok {
+			continue
+		}
+		if len(tx) > mem.config.MaxTxBytes {
+			continue
+		}
+		if mem.preCheck != nil && mem.preCheck(tx) != nil {
+			continue
+		}
+		if !mem.cache.Push(tx) {
+			continue
+		}
+		reqRes := mem.proxyAppConn.CheckTxAsync(abci.RequestCheckTx{Tx: tx, Type: abci.CheckTxType_Recheck})
+		reqRes.SetCallback(mem.reqResCb(tx, UnknownPeerID, "", nil))
+		replayed++
+	}
+	if err := mem.proxyAppConn.FlushSync(); err != nil {
+		return err
+	}
+	mem.logger.Info("Replayed mempool WAL", "txs", len(txs), "replayed", replayed, "size", mem.Size())
+
+	return mem.compactWAL()
+}
+
+// compactWAL rewrites the WAL with only the txs in the mempool, at the height
+// of the last block the mempool was updated to.
+//
+// Lock() must be held by the caller during execution.
+func (mem *baseMempool) compactWAL() error {
+	txs := make([]types.Tx, 0, mem.Size())
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		txs = append(txs, e.Value.(*mempoolTx).tx)
+	}
+	wal, err := compactWAL(mem.wal, mem.height, txs)
+	mem.wal = wal
+	if err != nil {
+		return fmt.Errorf("can't compact mempool WAL: %w", err)
+	}
+	return nil
+}
+
+func (mem *baseMempool) CloseWAL() {
+	if err := mem.wal.Close(); err != nil {
+		mem.logger.Error("Error closing WAL", "err", err)
+	}
+	mem.wal = nil
+}
+
+// Safe for concurrent use by multiple goroutines.
+func (mem *baseMempool) Lock() {
+	mem.updateMtx.Lock()
+}
+
+// Safe for concurrent use by multiple goroutines.
+func (mem *baseMempool) Unlock() {
+	mem.updateMtx.Unlock()
+}
+
+// Safe for concurrent use by multiple goroutines.
+func (mem *baseMempool) Size() int {
+	return mem.txs.Len()
+}
+
+// Safe for concurrent use by multiple goroutines.
+func (mem *baseMempool) TxsBytes() int64 {
+	return atomic.LoadInt64(&mem.txsBytes)
+}
+
+// Lock() must be help by the caller during execution.
+func (mem *baseMempool) FlushAppConn() error {
+	return mem.proxyAppConn.FlushSync()
+}
+
+// XXX: Unsafe! Calling Flush may leave mempool in inconsistent state.
+func (mem *baseMempool) Flush() {
+	mem.updateMtx.RLock()
+	defer mem.updateMtx.RUnlock()
+
+	_ = atomic.SwapInt64(&mem.txsBytes, 0)
+	mem.cache.Reset()
+
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		mem.txs.Remove(e)
+		e.DetachPrev()
+	}
+
+	mem.pool.resetTxs()
+}
+
+// TxsFront returns the first transaction in arrival order for peer goroutines
+// to call .NextWait() on.
+// FIXME: leaking implementation details!
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *baseMempool) TxsFront() *clist.CElement {
+	return mem.txs.Front()
+}
+
+// TxByKey returns the tx with the given key, if it is in the mempool.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *baseMempool) TxByKey(key [TxKeySize]byte) (types.Tx, bool) {
+	if e, ok := mem.pool.txElement(key); ok {
+		return e.Value.(*mempoolTx).tx, true
+	}
+	return nil, false
+}
+
+// HasTx returns true if the tx with the given key is in the mempool, or in the
+// cache of the txs it saw recently.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *baseMempool) HasTx(key [TxKeySize]byte) bool {
+	if _, ok := mem.pool.txElement(key); ok {
+		return true
+	}
+	return mem.cache.Has(key)
+}
+
+// GetUnconfirmedTx returns the tx with the given key, if it is in the mempool.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *baseMempool) GetUnconfirmedTx(key [TxKeySize]byte) (*UnconfirmedTx, bool) {
+	if e, ok := mem.pool.txElement(key); ok {
+		return e.Value.(*mempoolTx).unconfirmedTx(), true
+	}
+	return nil, false
+}
+
+// UnconfirmedTxsAfter returns up to max txs in the order they were added to
+// the mempool, following the tx with the given sequence number, or from the
+// first tx if seq is 0. With a PriorityMempool, this isn't the order the txs
+// are reaped in.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *baseMempool) UnconfirmedTxsAfter(seq int64, max int) []*UnconfirmedTx {
+	return unconfirmedTxsAfter(mem.txs.Front(), seq, max)
+}
+
+// TxsWaitChan returns a channel to wait on transactions. It will be closed
+// once the mempool is not empty (ie. the internal `mem.txs` has at least one
+// element)
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *baseMempool) TxsWaitChan() <-chan struct{} {
+	return mem.txs.WaitChan()
+}
+
+// RemoveTxByKey removes a transaction from the mempool by its TxKey index.
+func (mem *baseMempool) RemoveTxByKey(txKey [TxKeySize]byte, removeFromCache bool) {
+	if e, ok := mem.pool.txElement(txKey); ok {
+		mem.pool.removeTx(e.Value.(*mempoolTx).tx, e, removeFromCache)
+	}
+}
+
+// checkTx adds the tx to the cache, and sends it to the app to be checked. The checks specific to the mempool are done by the caller.
+//
+// updateMtx must be held for reading by the caller.
+func (mem *baseMempool) checkTx(tx types.Tx, cb func(*abci.Response), txInfo TxInfo) error {
+	txSize := len(tx)
+
+	if txSize > mem.config.MaxTxBytes {
+		return ErrTxTooLarge{mem.config.MaxTxBytes, txSize}
+	}
+
+	if mem.preCheck != nil {
+		if err := mem.preCheck(tx); err != nil {
+			return ErrPreCheck{err}
+		}
+	}
+
+	// NOTE: calling proxy must be done before adding tx to the cache.
+	// otherwise, if it fails, next time CheckTx is called with tx,
+	// ErrTxInCache will be returned without tx being checked at all even once.
+	// NOTE: proxyAppConn may error if tx buffer is full
+	if err := mem.proxyAppConn.Error(); err != nil {
+		return err
+	}
+
+	if !mem.cache.Push(tx) {
+		// Record a new sender for a tx we've already seen.
+		// Note it's possible a tx is still in the cache but no longer in the mempool
+		// (eg. after committing a block, txs are removed from mempool but not cache),
+		// so we only record the sender for txs still in the mempool.
+		if e, ok := mem.pool.txElement(TxKey(tx)); ok {
+			memTx := e.Value.(*mempoolTx)
+			memTx.senders.LoadOrStore(txInfo.SenderID, txInfo.SenderP2PID)
+			// TODO: consider punishing peer for dups,
+			// its non-trivial since invalid txs can become valid,
+			// but they can spam the same tx with little cost to them atm.
+		}
+
+		return ErrTxInCache
+	}
+
+	reqRes := mem.proxyAppConn.CheckTxAsync(abci.RequestCheckTx{Tx: tx})
+	reqRes.SetCallback(mem.reqResCb(tx, txInfo.SenderID, txInfo.SenderP2PID, cb))
+
+	return nil
+}
+
+// Global callback that will be called after every ABCI response.
+// Having a single global callback avoids needing to set a callback for each request.
+// However, processing the checkTx response requires the peerID (so we can track which txs we heard from who),
+// and peerID is not included in the ABCI request, so we have to set request-specific callbacks that
+// include this information. If we're not in the midst of a recheck, this function will just return,
+// so the request specific callback can do the work.
+//
+// When rechecking, we don't need the peerID, so the recheck callback happens
+// here.
+func (mem *baseMempool) globalCb(req *abci.Request, res *abci.Response) {
+	if mem.recheckCursor == nil {
+		return
+	}
+
+	mem.metrics.RecheckTimes.Add(1)
+	mem.resCbRecheck(req, res)
+
+	// update metrics
+	mem.metrics.Size.Set(float64(mem.Size()))
+}
+
+// Request specific callback that should be set on individual reqRes objects
+// to incorporate local information when processing the response.
+// This allows us to track the peer that sent us this tx, so we can avoid sending it back to them.
+// NOTE: alternatively, we could include this information in the ABCI request itself.
+//
+// External callers of CheckTx, like the RPC, can also pass an externalCb through here that is called
+// when all other response processing is complete.
+//
+// Used in CheckTx to record PeerID who sent us the tx.
+func (mem *baseMempool) reqResCb(
+	tx []byte,
+	peerID uint16,
+	peerP2PID p2p.ID,
+	externalCb func(*abci.Response),
+) func(res *abci.Response) {
+	return func(res *abci.Response) {
+		if mem.recheckCursor != nil {
+			// this should never happen
+			panic("recheck cursor is not nil in reqResCb")
+		}
+
+		mem.resCbFirstTime(tx, peerID, peerP2PID, res)
+
+		// update metrics
+		mem.metrics.Size.Set(float64(mem.Size()))
+
+		// passed in by the caller of CheckTx, eg. the RPC
+		if externalCb != nil {
+			externalCb(res)
+		}
+	}
+}
+
+// pushTx appends the tx to the list of txs, with the next sequence number, and
+// returns its element.
+func (mem *baseMempool) pushTx(memTx *mempoolTx) *clist.CElement {
+	memTx.seq = atomic.AddInt64(&mem.lastSeq, 1)
+	e := mem.txs.PushBack(memTx)
+	atomic.AddInt64(&mem.txsBytes, int64(len(memTx.tx)))
+	mem.metrics.TxSizeBytes.Observe(float64(len(memTx.tx)))
+	return e
+}
+
+// removeElement removes the element of the tx from the list of txs, and the tx
+// from the cache if removeFromCache is true.
+func (mem *baseMempool) removeElement(tx types.Tx, elem *clist.CElement, removeFromCache bool) {
+	mem.txs.Remove(elem)
+	elem.DetachPrev()
+	atomic.AddInt64(&mem.txsBytes, int64(-len(tx)))
+
+	if removeFromCache {
+		mem.cache.Remove(tx)
+	}
+}
+
+// callback, which is called after the app checked the tx for the first time.
+//
+// The case where the app checks the tx for the second and subsequent times is
+// handled by the resCbRecheck callback.
+func (mem *baseMempool) resCbFirstTime(
+	tx []byte,
+	peerID uint16,
+	peerP2PID p2p.ID,
+	res *abci.Response,
+) {
+	switch r := res.Value.(type) {
+	case *abci.Response_CheckTx:
+		var postCheckErr error
+		if mem.postCheck != nil {
+			postCheckErr = mem.postCheck(tx, r.CheckTx)
+		}
+		if (r.CheckTx.Code == abci.CodeTypeOK) && postCheckErr == nil {
+			memTx := &mempoolTx{
+				height:    mem.height,
+				gasWanted: r.CheckTx.GasWanted,
+				priority:  r.CheckTx.Priority,
+				sender:    r.CheckTx.Sender,
+				timestamp: time.Now(),
+				tx:        tx,
+			}
+			memTx.senders.Store(peerID, peerP2PID)
+			if err := mem.pool.addCheckedTx(memTx); err != nil {
+				// remove from cache (mempool might have a space later)
+				mem.cache.Remove(tx)
+				mem.logger.Error(err.Error())
+				mem.publishTxEvent(mem.eventBus.PublishEventMempoolTxRejected,
+					tx, memTx.sender, types.MempoolTxMempoolFull)
+				return
+			}
+			// Log the tx only once it is admitted, as the txs of the WAL are
+			// replayed with the Recheck type, on which the app may skip checks.
+			if mem.wal != nil {
+				if _, err := mem.wal.Write(encodeWALTx(tx)); err != nil {
+					// TODO: Notify administrators when WAL fails
+					mem.logger.Error("Error writing tx to WAL", "tx", txID(tx), "err", err)
+				}
+			}
+			mem.publishTxEvent(mem.eventBus.PublishEventMempoolTxAdded, tx, memTx.sender, "")
+			mem.logger.Debug("added good transaction",
+				"tx", txID(tx),
+				"res", r,
+				"height", memTx.height,
+				"total", mem.Size(),
+			)
+			mem.notifyTxsAvailable()
+		} else {
+			// ignore bad transaction
+			mem.logger.Debug("rejected bad transaction",
+				"tx", txID(tx), "peerID", peerP2PID, "res", r, "err", postCheckErr)
+			mem.metrics.FailedTxs.Add(1)
+			if !mem.config.KeepInvalidTxsInCache {
+				// remove from cache (it might be good later)
+				mem.cache.Remove(tx)
+			}
+		}
+	default:
+		// ignore other messages
+	}
+}
+
+// callback, which is called after the app rechecked the tx.
+//
+// The case where the app checks the tx for the first time is handled by the
+// resCbFirstTime callback.
+func (mem *baseMempool) resCbRecheck(req *abci.Request, res *abci.Response) {
+	switch r := res.Value.(type) {
+	case *abci.Response_CheckTx:
+		tx := req.GetCheckTx().Tx
+		memTx := mem.recheckCursor.Value.(*mempoolTx)
+		if !bytes.Equal(tx, memTx.tx) {
+			panic(fmt.Sprintf(
+				"Unexpected tx response from proxy during recheck\nExpected %X, got %X",
+				memTx.tx,
+				tx))
+		}
+		var postCheckErr error
+		if mem.postCheck != nil {
+			postCheckErr = mem.postCheck(tx, r.CheckTx)
+		}
+		if (r.CheckTx.Code == abci.CodeTypeOK) && postCheckErr == nil {
+			mem.pool.recheckedTx(memTx, r.CheckTx)
+		} else {
+			// Tx became invalidated due to newly committed block.
+			mem.logger.Debug("tx is no longer valid", "tx", txID(tx), "res", r, "err", postCheckErr)
+			// NOTE: we remove tx from the cache because it might be good later
+			mem.pool.removeTx(tx, mem.recheckCursor, !mem.config.KeepInvalidTxsInCache)
+			mem.publishTxEvent(mem.eventBus.PublishEventMempoolTxRemoved,
+				tx, memTx.sender, types.MempoolTxRecheckFailed)
+		}
+		if mem.recheckCursor == mem.recheckEnd {
+			mem.recheckCursor = nil
+		} else {
+			mem.recheckCursor = mem.recheckCursor.Next()
+		}
+		if mem.recheckCursor == nil {
+			// Done!
+			mem.logger.Debug("done rechecking txs")
+
+			// incase the recheck removed all txs
+			if mem.Size() > 0 {
+				mem.notifyTxsAvailable()
+			}
+		}
+	default:
+		// ignore other messages
+	}
+}
+
+// Safe for concurrent use by multiple goroutines.
+func (mem *baseMempool) TxsAvailable() <-chan struct{} {
+	return mem.txsAvailable
+}
+
+func (mem *baseMempool) notifyTxsAvailable() {
+	if mem.Size() == 0 {
+		panic("notified txs available but mempool is empty!")
+	}
+	if mem.txsAvailable != nil && !mem.notifiedTxsAvailable {
+		// channel cap is 1, so this will send once
+		mem.notifiedTxsAvailable = true
+		select {
+		case mem.txsAvailable <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Lock() must be help by the caller during execution.
+func (mem *baseMempool) Update(
+	height int64,
+	txs types.Txs,
+	deliverTxResponses []*abci.ResponseDeliverTx,
+	preCheck PreCheckFunc,
+	postCheck PostCheckFunc,
+) error {
+	// Set height
+	mem.height = height
+	mem.notifiedTxsAvailable = false
+
+	if preCheck != nil {
+		mem.preCheck = preCheck
+	}
+	if postCheck != nil {
+		mem.postCheck = postCheck
+	}
+
+	for i, tx := range txs {
+		if deliverTxResponses[i].Code == abci.CodeTypeOK {
+			// Add valid committed tx to the cache (if missing).
+			_ = mem.cache.Push(tx)
+		} else if !mem.config.KeepInvalidTxsInCache {
+			// Allow invalid transactions to be resubmitted.
+			mem.cache.Remove(tx)
+		}
+
+		// Remove committed tx from the mempool.
+		//
+		// Note an evil proposer can drop valid txs!
+		// Mempool before:
+		//   100 -> 101 -> 102
+		// Block, proposed by an evil proposer:
+		//   101 -> 102
+		// Mempool after:
+		//   100
+		// https://github.com/tendermint/tendermint/issues/3322.
+		if e, ok := mem.pool.txElement(TxKey(tx)); ok {
+			memTx := e.Value.(*mempoolTx)
+			mem.pool.removeTx(tx, e, false)
+			mem.publishTxEvent(mem.eventBus.PublishEventMempoolTxRemoved,
+				tx, memTx.sender, types.MempoolTxCommitted)
+		}
+	}
+
+	// Evict the txs which have been in the mempool for too long, before they
+	// are rechecked.
+	mem.purgeExpiredTxs(height)
+
+	// Record the committed txs in the WAL, so that they aren't replayed nor
+	// looked up in the block store on restart.
+	if mem.wal != nil {
+		if _, err := mem.wal.Write(encodeWALCheckpoint(height, txs)); err != nil {
+			mem.logger.Error("Error writing WAL checkpoint", "err", err)
+		}
+	}
+
+	// Rewrite the WAL without the committed and evicted txs once they take more
+	// room in it than the txs left in the mempool.
+	if mem.wal != nil && walNeedsCompaction(mem.wal, mem.Size(), mem.TxsBytes()) {
+		if err := mem.compactWAL(); err != nil {
+			mem.logger.Error("Error compacting WAL", "err", err)
+		}
+	}
+
+	// Either recheck non-committed txs to see if they became invalid
+	// or just notify there're some txs left.
+	if mem.Size() > 0 {
+		if mem.config.Recheck {
+			mem.logger.Debug("recheck txs", "numtxs", mem.Size(), "height", height)
+			mem.recheckTxs()
+			// At this point, mem.txs are being rechecked.
+			// mem.recheckCursor re-scans mem.txs and possibly removes some txs.
+			// Before mem.Reap(), we should wait for mem.recheckCursor to be nil.
+		} else {
+			mem.notifyTxsAvailable()
+		}
+	}
+
+	// Update metrics
+	mem.metrics.Size.Set(float64(mem.Size()))
+
+	return nil
+}
+
+// purgeExpiredTxs evicts the txs which have been in the mempool for longer than
+// one of the TTLs of the config, and removes them from the cache so that they
+// can be resubmitted.
+func (mem *baseMempool) purgeExpiredTxs(blockHeight int64) {
+	if mem.config.TTLNumBlocks == 0 && mem.config.TTLDuration == 0 {
+		return
+	}
+
+	now := time.Now()
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		memTx := e.Value.(*mempoolTx)
+		if !memTx.isExpired(mem.config, blockHeight, now) {
+			continue
+		}
+		mem.logger.Debug("evicted expired transaction", "tx", txID(memTx.tx), "height", memTx.Height())
+		mem.pool.removeTx(memTx.tx, e, true)
+		mem.metrics.ExpiredTxs.Add(1)
+		mem.publishTxEvent(mem.eventBus.PublishEventMempoolTxEvicted, memTx.tx, memTx.sender, types.MempoolTxExpired)
+	}
+}
+
+// publishTxEvent publishes an event of the given tx, with its sender, if any,
+// and the given reason, with the given function of the event bus.
+func (mem *baseMempool) publishTxEvent(
+	publish func(types.EventDataMempoolTx) error,
+	tx types.Tx,
+	sender string,
+	reason string,
+) {
+	err := publish(types.EventDataMempoolTx{
+		Hash:   tx.Hash(),
+		Sender: sender,
+		Reason: reason,
+	})
+	if err != nil {
+		mem.logger.Error("failed publishing mempool tx event", "tx", txID(tx), "reason", reason, "err", err)
+	}
+}
+
+func (mem *baseMempool) recheckTxs() {
+	if mem.Size() == 0 {
+		panic("recheckTxs is called, but the mempool is empty")
+	}
+
+	mem.recheckCursor = mem.txs.Front()
+	mem.recheckEnd = mem.txs.Back()
+
+	// Push txs to proxyAppConn
+	// NOTE: globalCb may be called concurrently.
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		memTx := e.Value.(*mempoolTx)
+		mem.proxyAppConn.CheckTxAsync(abci.RequestCheckTx{
+			Tx:   memTx.tx,
+			Type: abci.CheckTxType_Recheck,
+		})
+	}
+
+	mem.proxyAppConn.FlushAsync()
+}
@@ -1,6 +1,7 @@
 package mempool
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/binary"
@@ -23,8 +24,10 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/libs/log"
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 	"github.com/tendermint/tendermint/libs/service"
+	"github.com/tendermint/tendermint/p2p"
 	"github.com/tendermint/tendermint/proxy"
 	"github.com/tendermint/tendermint/types"
 )
@@ -273,6 +276,166 @@ func TestMempool_KeepInvalidTxsInCache(t *testing.T) {
 	}
 }
 
+func TestMempoolTTL(t *testing.T) {
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	config := cfg.ResetTestRoot("mempool_test")
+	config.Mempool.TTLNumBlocks = 2
+	config.Mempool.TTLDuration = time.Hour
+	mempool, cleanup := newMempoolWithAppAndConfig(cc, config)
+	defer cleanup()
+
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	mempool.SetEventBus(eventBus)
+	sub, err := eventBus.Subscribe(context.Background(), "test", types.EventQueryMempoolTxEvicted, 10)
+	require.NoError(t, err)
+
+	// txs added at height 0 expire after height 2
+	txs := checkTxs(t, mempool, 2, UnknownPeerID)
+	require.NoError(t, mempool.Update(2, nil, nil, nil, nil))
+	assert.Equal(t, 2, mempool.Size())
+	checkTxs(t, mempool, 1, UnknownPeerID)
+	require.NoError(t, mempool.Update(3, nil, nil, nil, nil))
+	assert.Equal(t, 1, mempool.Size())
+
+	for _, tx := range txs {
+		select {
+		case msg := <-sub.Out():
+			data := msg.Data().(types.EventDataMempoolTx)
+			assert.EqualValues(t, tx.Hash(), data.Hash)
+			assert.Equal(t, types.MempoolTxExpired, data.Reason)
+		case <-time.After(time.Second):
+			t.Fatal("expected an event for each expired tx")
+		}
+		// expired txs are removed from the cache
+		assert.NoError(t, mempool.CheckTx(tx, nil, TxInfo{}))
+	}
+	assert.Equal(t, 3, mempool.Size())
+
+	// txs expire after the TTL duration
+	config.Mempool.TTLNumBlocks = 0
+	config.Mempool.TTLDuration = time.Millisecond
+	time.Sleep(2 * time.Millisecond)
+	require.NoError(t, mempool.Update(4, nil, nil, nil, nil))
+	assert.Zero(t, mempool.Size())
+}
+
+// recheckFailApp is an application accepting txs from alice, which become
+// invalid once they are rechecked.
+type recheckFailApp struct {
+	abci.BaseApplication
+}
+
+func (recheckFailApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	if req.Type == abci.CheckTxType_Recheck {
+		return abci.ResponseCheckTx{Code: 1}
+	}
+	return abci.ResponseCheckTx{Code: abci.CodeTypeOK, GasWanted: 1, Sender: "alice"}
+}
+
+func TestMempoolEvents(t *testing.T) {
+	cc := proxy.NewLocalClientCreator(recheckFailApp{})
+	config := cfg.ResetTestRoot("mempool_test")
+	config.Mempool.Size = 2
+	mempool, cleanup := newMempoolWithAppAndConfig(cc, config)
+	defer cleanup()
+
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	mempool.SetEventBus(eventBus)
+	sub, err := eventBus.Subscribe(context.Background(), "test", tmquery.MustParse("tx.hash EXISTS"), 10)
+	require.NoError(t, err)
+
+	txs := checkTxs(t, mempool, 2, UnknownPeerID)
+	// the mempool is full
+	tx := types.Tx("rejected")
+	require.Error(t, mempool.CheckTx(tx, nil, TxInfo{}))
+	// the first tx is committed and the other one fails the recheck
+	require.NoError(t, mempool.Update(1, txs[:1], abciResponses(1, abci.CodeTypeOK), nil, nil))
+	require.NoError(t, mempool.FlushAppConn())
+	assert.Zero(t, mempool.Size())
+
+	expected := []struct {
+		event  string
+		tx     types.Tx
+		sender string
+		reason string
+	}{
+		{types.EventMempoolTxAdded, txs[0], "alice", ""},
+		{types.EventMempoolTxAdded, txs[1], "alice", ""},
+		{types.EventMempoolTxRejected, tx, "", types.MempoolTxMempoolFull},
+		{types.EventMempoolTxRemoved, txs[0], "alice", types.MempoolTxCommitted},
+		{types.EventMempoolTxRemoved, txs[1], "alice", types.MempoolTxRecheckFailed},
+	}
+	for _, e := range expected {
+		select {
+		case msg := <-sub.Out():
+			assert.Equal(t, []string{e.event}, msg.Events()[types.EventTypeKey])
+			assert.Equal(t, types.EventDataMempoolTx{
+				Hash:   e.tx.Hash(),
+				Sender: e.sender,
+				Reason: e.reason,
+			}, msg.Data())
+		case <-time.After(time.Second):
+			t.Fatalf("expected a %s event", e.event)
+		}
+	}
+}
+
+func TestMempoolUnconfirmedTxs(t *testing.T) {
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mempool, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+
+	txs := checkTxs(t, mempool, 5, UnknownPeerID)
+	// the same tx is then received from two peers
+	require.Equal(t, ErrTxInCache, mempool.CheckTx(txs[4], nil, TxInfo{SenderID: 2, SenderP2PID: "peer2"}))
+	require.Equal(t, ErrTxInCache, mempool.CheckTx(txs[4], nil, TxInfo{SenderID: 1, SenderP2PID: "peer1"}))
+
+	tx, ok := mempool.GetUnconfirmedTx(TxKey(txs[4]))
+	require.True(t, ok)
+	assert.Equal(t, &UnconfirmedTx{Tx: txs[4], Seq: 5, GasWanted: 1, Senders: []p2p.ID{"peer1", "peer2"}}, tx)
+	_, ok = mempool.GetUnconfirmedTx(TxKey(types.Tx("missing")))
+	assert.False(t, ok)
+
+	// page through the txs
+	var paged types.Txs
+	var cursor int64
+	for {
+		page := mempool.UnconfirmedTxsAfter(cursor, 2)
+		if len(page) == 0 {
+			break
+		}
+		for _, tx := range page {
+			paged = append(paged, tx.Tx)
+		}
+		cursor = page[len(page)-1].Seq
+	}
+	assert.Equal(t, txs, paged)
+
+	// the paging goes on when the tx of the cursor leaves the mempool
+	cursor = 2
+	err := mempool.Update(1, txs[1:3], abciResponses(2, abci.CodeTypeOK), nil, nil)
+	require.NoError(t, err)
+	page := mempool.UnconfirmedTxsAfter(cursor, 2)
+	require.Len(t, page, 2)
+	assert.Equal(t, txs[3], page[0].Tx)
+	assert.EqualValues(t, 4, page[0].Seq)
+	assert.Equal(t, txs[4], page[1].Tx)
+}
+
 func TestTxsAvailable(t *testing.T) {
 	app := kvstore.NewApplication()
 	cc := proxy.NewLocalClientCreator(app)
@@ -461,7 +624,7 @@ func TestMempoolCloseWAL(t *testing.T) {
 	sum1 := checksumFile(walFilepath, t)
 
 	// 6. Sanity check to ensure that the written TX matches the expectation.
-	require.Equal(t, sum1, checksumIt([]byte("foo\n")), "foo with a newline should be written")
+	require.Equal(t, sum1, checksumIt([]byte("666f6f\n")), "hex-encoded foo with a newline should be written")
 
 	// 7. Invoke CloseWAL() and ensure it discards the
 	// WAL thus any other write won't go through.
@@ -477,6 +640,127 @@ func TestMempoolCloseWAL(t *testing.T) {
 	require.Equal(t, 1, len(m3), "expecting the wal match in")
 }
 
+// blockStore is a BlockStore with a block of the given txs at each height.
+type blockStore []types.Txs
+
+func (bs blockStore) Height() int64 { return int64(len(bs)) }
+
+func (bs blockStore) LoadBlock(height int64) *types.Block {
+	return &types.Block{Data: types.Data{Txs: bs[height-1]}}
+}
+
+func TestMempoolReplayWAL(t *testing.T) {
+	config := cfg.ResetTestRoot("mempool_test")
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mempool, cleanup := newMempoolWithAppAndConfig(cc, config)
+	defer cleanup()
+	require.NoError(t, mempool.InitWAL())
+
+	txs := []types.Tx{types.Tx("a"), types.Tx("b\nc"), types.Tx("d")}
+	for _, tx := range txs {
+		require.NoError(t, mempool.CheckTx(tx, nil, TxInfo{}))
+	}
+	// a tx whose write was interrupted is ignored
+	_, err := mempool.wal.Write([]byte("6565"))
+	require.NoError(t, err)
+	mempool.CloseWAL()
+
+	// the txs are replayed in a new mempool, except the committed ones
+	mempool, cleanup2 := newMempoolWithAppAndConfig(cc, config)
+	defer cleanup2()
+	mempool.height = 1
+	require.NoError(t, mempool.InitWAL())
+	require.NoError(t, mempool.ReplayWAL(blockStore{{txs[0]}}))
+	assert.Equal(t, txs[1:], []types.Tx(mempool.ReapMaxTxs(-1)))
+
+	// the WAL was compacted
+	height, walTxs, err := readWAL(mempool.wal.Path)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, height)
+	assert.Equal(t, txs[1:], walTxs)
+
+	// the committed txs are skipped from the compaction height only
+	require.NoError(t, mempool.CheckTx(txs[0], nil, TxInfo{}))
+	mempool.CloseWAL()
+	mempool, cleanup3 := newMempoolWithAppAndConfig(cc, config)
+	defer cleanup3()
+	require.NoError(t, mempool.InitWAL())
+	require.NoError(t, mempool.ReplayWAL(blockStore{{txs[0]}, {txs[2]}}))
+	assert.Equal(t, []types.Tx{txs[1], txs[0]}, []types.Tx(mempool.ReapMaxTxs(-1)))
+	mempool.CloseWAL()
+}
+
+// recheckOnlyApp is an application rejecting the txs on their first check, and
+// accepting them on recheck, like apps skipping expensive checks on recheck.
+type recheckOnlyApp struct {
+	abci.BaseApplication
+}
+
+func (recheckOnlyApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	if req.Type == abci.CheckTxType_Recheck {
+		return abci.ResponseCheckTx{Code: abci.CodeTypeOK, GasWanted: 1}
+	}
+	return abci.ResponseCheckTx{Code: 1}
+}
+
+func TestMempoolReplayWALSkipsRejectedTxs(t *testing.T) {
+	config := cfg.ResetTestRoot("mempool_test")
+	cc := proxy.NewLocalClientCreator(recheckOnlyApp{})
+	mempool, cleanup := newMempoolWithAppAndConfig(cc, config)
+	defer cleanup()
+	require.NoError(t, mempool.InitWAL())
+
+	require.NoError(t, mempool.CheckTx(types.Tx("a"), nil, TxInfo{}))
+	require.Zero(t, mempool.Size())
+	mempool.CloseWAL()
+
+	// the rejected tx isn't logged, so it isn't replayed with the Recheck type
+	mempool, cleanup2 := newMempoolWithAppAndConfig(cc, config)
+	defer cleanup2()
+	require.NoError(t, mempool.InitWAL())
+	require.NoError(t, mempool.ReplayWAL(blockStore{}))
+	assert.Zero(t, mempool.Size())
+	mempool.CloseWAL()
+}
+
+func TestMempoolWALCheckpoints(t *testing.T) {
+	config := cfg.ResetTestRoot("mempool_test")
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mempool, cleanup := newMempoolWithAppAndConfig(cc, config)
+	defer cleanup()
+	require.NoError(t, mempool.InitWAL())
+
+	txs := []types.Tx{types.Tx("a"), types.Tx("b"), types.Tx("c")}
+	for _, tx := range txs {
+		require.NoError(t, mempool.CheckTx(tx, nil, TxInfo{}))
+	}
+	require.NoError(t, mempool.Update(1, txs[:1], abciResponses(1, abci.CodeTypeOK), nil, nil))
+	require.NoError(t, mempool.Update(2, txs[1:2], abciResponses(1, abci.CodeTypeOK), nil, nil))
+	walPath := mempool.wal.Path
+	mempool.CloseWAL()
+
+	height, walTxs, err := readWAL(walPath)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, height)
+	assert.Equal(t, txs[2:], walTxs)
+
+	// the committed txs are skipped without the blocks of the checkpoints,
+	// which may have been pruned from the store
+	mempool, cleanup2 := newMempoolWithAppAndConfig(cc, config)
+	defer cleanup2()
+	require.NoError(t, mempool.InitWAL())
+	require.NoError(t, mempool.ReplayWAL(blockStore{nil, nil}))
+	assert.Equal(t, txs[2:], []types.Tx(mempool.ReapMaxTxs(-1)))
+	mempool.CloseWAL()
+
+	// a WAL of a previous version, logging raw txs, is rejected
+	require.NoError(t, ioutil.WriteFile(walPath, []byte("key=value\n"), 0600))
+	_, _, err = readWAL(walPath)
+	assert.Error(t, err)
+}
+
 func TestMempool_CheckTxChecksTxSize(t *testing.T) {
 	app := kvstore.NewApplication()
 	cc := proxy.NewLocalClientCreator(app)
@@ -73,9 +73,26 @@ type Mempool interface {
 	// there is an error, it will be of type *PathError.
 	InitWAL() error
 
+	// ReplayWAL checks the txs of the WAL file again and adds the valid ones to
+	// the mempool, skipping the txs already committed in the blocks of the
+	// store. It must be called after InitWAL, once the app is in sync with the
+	// store.
+	ReplayWAL(blockStore BlockStore) error
+
 	// CloseWAL closes and discards the underlying WAL file.
 	// Any further writes will not be relayed to disk.
 	CloseWAL()
+
+	// GetUnconfirmedTx returns the tx with the given key, if it is in the
+	// mempool.
+	GetUnconfirmedTx(key [TxKeySize]byte) (*UnconfirmedTx, bool)
+
+	// UnconfirmedTxsAfter returns up to max txs in the order they were added to
+	// the mempool, following the tx with the given sequence number (see
+	// UnconfirmedTx.Seq), or from the first tx if seq is 0. The tx with the
+	// given sequence number doesn't need to still be in the mempool. If max is
+	// negative, there is no cap on the number of returned txs.
+	UnconfirmedTxsAfter(seq int64, max int) []*UnconfirmedTx
 }
 
 //--------------------------------------------------------------------------------
@@ -100,6 +117,20 @@ type TxInfo struct {
 	SenderP2PID p2p.ID
 }
 
+// UnconfirmedTx is a tx in the mempool, along with the details of its entry.
+type UnconfirmedTx struct {
+	Tx types.Tx
+	// Seq is the sequence number of the tx in the mempool, which increases
+	// with every tx added. It is the cursor to page through the txs with.
+	Seq int64
+	// Height is the height of the last block when the tx was added.
+	Height int64
+	// GasWanted is the gas wanted by the tx, given by the app in CheckTx.
+	GasWanted int64
+	// Senders are the peers the tx was received from, if any.
+	Senders []p2p.ID
+}
+
 //--------------------------------------------------------------------------------
 
 // PreCheckMaxBytes checks that the size of the transaction is smaller or equal to the expected maxBytes.
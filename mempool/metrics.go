@@ -24,6 +24,14 @@ type Metrics struct {
 	FailedTxs metrics.Counter
 	// Number of times transactions are rechecked in the mempool.
 	RecheckTimes metrics.Counter
+	// Number of transactions evicted to make room for transactions with a
+	// higher priority.
+	EvictedTxs metrics.Counter
+	// Number of transactions evicted after the TTL of the mempool.
+	ExpiredTxs metrics.Counter
+	// Number of bytes of transactions announced by peers which were not
+	// requested, since they were already in the mempool.
+	PullGossipBytesSaved metrics.Counter
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -60,15 +68,36 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "recheck_times",
 			Help:      "Number of times transactions are rechecked in the mempool.",
 		}, labels).With(labelsAndValues...),
+		EvictedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "evicted_txs",
+			Help:      "Number of transactions evicted to make room for transactions with a higher priority.",
+		}, labels).With(labelsAndValues...),
+		ExpiredTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "expired_txs",
+			Help:      "Number of transactions evicted after the TTL of the mempool.",
+		}, labels).With(labelsAndValues...),
+		PullGossipBytesSaved: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "pull_gossip_bytes_saved",
+			Help:      "Number of bytes of transactions announced by peers which were not requested, since they were already in the mempool.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
 // NopMetrics returns no-op Metrics.
 func NopMetrics() *Metrics {
 	return &Metrics{
-		Size:         discard.NewGauge(),
-		TxSizeBytes:  discard.NewHistogram(),
-		FailedTxs:    discard.NewCounter(),
-		RecheckTimes: discard.NewCounter(),
+		Size:                 discard.NewGauge(),
+		TxSizeBytes:          discard.NewHistogram(),
+		FailedTxs:            discard.NewCounter(),
+		RecheckTimes:         discard.NewCounter(),
+		EvictedTxs:           discard.NewCounter(),
+		ExpiredTxs:           discard.NewCounter(),
+		PullGossipBytesSaved: discard.NewCounter(),
 	}
 }
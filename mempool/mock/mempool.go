@@ -38,5 +38,13 @@ func (Mempool) TxsBytes() int64               { return 0 }
 func (Mempool) TxsFront() *clist.CElement    { return nil }
 func (Mempool) TxsWaitChan() <-chan struct{} { return nil }
 
-func (Mempool) InitWAL() error { return nil }
-func (Mempool) CloseWAL()      {}
+func (Mempool) InitWAL() error                   { return nil }
+func (Mempool) ReplayWAL(mempl.BlockStore) error { return nil }
+func (Mempool) CloseWAL()                        {}
+
+func (Mempool) GetUnconfirmedTx([mempl.TxKeySize]byte) (*mempl.UnconfirmedTx, bool) {
+	return nil, false
+}
+func (Mempool) UnconfirmedTxsAfter(int64, int) []*mempl.UnconfirmedTx {
+	return nil
+}
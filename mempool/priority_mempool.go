@@ -0,0 +1,441 @@
+package mempool
+
+import (
+	"container/heap"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/clist"
+	tmsync "github.com/tendermint/tendermint/libs/sync"
+	"github.com/tendermint/tendermint/proxy"
+	"github.com/tendermint/tendermint/types"
+)
+
+// PriorityMempool is an in-memory pool for transactions before they are proposed
+// in a consensus round, which reaps them by the priority the application gives
+// them in CheckTx rather than in arrival order.
+//
+// Transactions with the same sender, as given by the application in CheckTx, are
+// reaped in the order they were added, so that the sequence of transactions of a
+// sender is never reaped with a gap. When the mempool is full, the transactions
+// with the lowest priority are evicted to make room for a transaction with a
+// higher priority. Only the last transaction of a sender is evicted, so that the
+// sequences of transactions of senders stay contiguous.
+//
+// Transactions are gossiped to peers in arrival order, like with CListMempool.
+type PriorityMempool struct {
+	baseMempool
+
+	// Indexes of the txs, by key, by sender and by eviction order. They are
+	// updated by abci responses while CheckTx and the reap methods hold
+	// updateMtx for reading, so they are guarded by their own mutex.
+	txsMtx tmsync.RWMutex
+	// txsMap: txKey -> CElement
+	txsMap map[[TxKeySize]byte]*clist.CElement
+	// senderTxs: sender -> txs of the sender, in arrival order
+	senderTxs map[string][]*mempoolTx
+	// evictable: the last txs of the senders and the txs without a sender, the
+	// next one to evict first
+	evictable *txHeap
+}
+
+var _ Mempool = &PriorityMempool{}
+
+// PriorityMempoolOption sets an optional parameter on the mempool.
+type PriorityMempoolOption func(*PriorityMempool)
+
+// NewPriorityMempool returns a new priority mempool with the given configuration
+// and connection to an application.
+func NewPriorityMempool(
+	config *cfg.MempoolConfig,
+	proxyAppConn proxy.AppConnMempool,
+	height int64,
+	options ...PriorityMempoolOption,
+) *PriorityMempool {
+	mempool := &PriorityMempool{}
+	mempool.init(config, proxyAppConn, height, mempool)
+	mempool.resetTxs()
+	for _, option := range options {
+		option(mempool)
+	}
+	return mempool
+}
+
+// WithPriorityPreCheck sets a filter for the mempool to reject a tx if f(tx)
+// returns false. This is ran before CheckTx. Only applies to the first created
+// block. After that, Update overwrites the existing value.
+func WithPriorityPreCheck(f PreCheckFunc) PriorityMempoolOption {
+	return func(mem *PriorityMempool) { mem.preCheck = f }
+}
+
+// WithPriorityPostCheck sets a filter for the mempool to reject a tx if f(tx)
+// returns false. This is ran after CheckTx. Only applies to the first created
+// block. After that, Update overwrites the existing value.
+func WithPriorityPostCheck(f PostCheckFunc) PriorityMempoolOption {
+	return func(mem *PriorityMempool) { mem.postCheck = f }
+}
+
+// WithPriorityMetrics sets the metrics.
+func WithPriorityMetrics(metrics *Metrics) PriorityMempoolOption {
+	return func(mem *PriorityMempool) { mem.metrics = metrics }
+}
+
+// It blocks if we're waiting on Update() or Reap().
+// cb: A callback from the CheckTx command.
+//     It gets called from another goroutine.
+// CONTRACT: Either cb will get called, or err returned.
+//
+// Unlike CListMempool, a full mempool doesn't reject the tx before it is
+// checked, as the tx might have a higher priority than some txs in the mempool.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) CheckTx(tx types.Tx, cb func(*abci.Response), txInfo TxInfo) error {
+	mem.updateMtx.RLock()
+	// use defer to unlock mutex because application (*local client*) might panic
+	defer mem.updateMtx.RUnlock()
+
+	return mem.checkTx(tx, cb, txInfo)
+}
+
+func (mem *PriorityMempool) txElement(key [TxKeySize]byte) (*clist.CElement, bool) {
+	mem.txsMtx.RLock()
+	defer mem.txsMtx.RUnlock()
+
+	e, ok := mem.txsMap[key]
+	return e, ok
+}
+
+// Called from:
+//  - resCbFirstTime (lock not held) if tx is valid
+func (mem *PriorityMempool) addCheckedTx(memTx *mempoolTx) error {
+	mem.txsMtx.Lock()
+	defer mem.txsMtx.Unlock()
+
+	if err := mem.makeRoom(memTx); err != nil {
+		return err
+	}
+	mem.addTx(memTx)
+	return nil
+}
+
+// addTx adds the tx to the mempool and its indexes. The tx takes the place of
+// the previous last tx of its sender in the eviction heap.
+// txsMtx must be held by the caller.
+func (mem *PriorityMempool) addTx(memTx *mempoolTx) {
+	e := mem.pushTx(memTx)
+	mem.txsMap[TxKey(memTx.tx)] = e
+	if memTx.sender != "" {
+		senderTxs := mem.senderTxs[memTx.sender]
+		if len(senderTxs) > 0 {
+			heap.Remove(mem.evictable, senderTxs[len(senderTxs)-1].heapIndex)
+		}
+		mem.senderTxs[memTx.sender] = append(senderTxs, memTx)
+	}
+	heap.Push(mem.evictable, memTx)
+}
+
+// Called from:
+//  - Update (lock held) if tx was committed
+//  - resCbRecheck (lock not held) if tx was invalidated
+//  - purgeExpiredTxs (lock held) if tx expired
+func (mem *PriorityMempool) removeTx(tx types.Tx, elem *clist.CElement, removeFromCache bool) {
+	mem.txsMtx.Lock()
+	defer mem.txsMtx.Unlock()
+
+	mem.deleteTx(tx, elem, removeFromCache)
+}
+
+// deleteTx removes the tx of the given element from the mempool and its
+// indexes, unless it was removed already. If the tx was the last tx of its
+// sender in the eviction heap, the tx before it takes its place.
+// txsMtx must be held by the caller.
+func (mem *PriorityMempool) deleteTx(tx types.Tx, elem *clist.CElement, removeFromCache bool) {
+	key := TxKey(tx)
+	if mem.txsMap[key] != elem {
+		return
+	}
+	delete(mem.txsMap, key)
+	mem.removeElement(tx, elem, removeFromCache)
+
+	memTx := elem.Value.(*mempoolTx)
+	evictable := memTx.heapIndex >= 0
+	if evictable {
+		heap.Remove(mem.evictable, memTx.heapIndex)
+	}
+	if memTx.sender == "" {
+		return
+	}
+	senderTxs := mem.senderTxs[memTx.sender]
+	for i, senderTx := range senderTxs {
+		if senderTx == memTx {
+			senderTxs = append(senderTxs[:i], senderTxs[i+1:]...)
+			break
+		}
+	}
+	if len(senderTxs) == 0 {
+		delete(mem.senderTxs, memTx.sender)
+		return
+	}
+	mem.senderTxs[memTx.sender] = senderTxs
+	if evictable {
+		heap.Push(mem.evictable, senderTxs[len(senderTxs)-1])
+	}
+}
+
+// The priority of a tx is updated to the one the app gives on recheck.
+func (mem *PriorityMempool) recheckedTx(memTx *mempoolTx, res *abci.ResponseCheckTx) {
+	mem.txsMtx.Lock()
+	defer mem.txsMtx.Unlock()
+
+	memTx.priority = res.Priority
+	if memTx.heapIndex >= 0 {
+		heap.Fix(mem.evictable, memTx.heapIndex)
+	}
+}
+
+func (mem *PriorityMempool) resetTxs() {
+	mem.txsMtx.Lock()
+	defer mem.txsMtx.Unlock()
+
+	mem.txsMap = make(map[[TxKeySize]byte]*clist.CElement)
+	mem.senderTxs = make(map[string][]*mempoolTx)
+	mem.evictable = &txHeap{less: lowerPriority, indexed: true}
+}
+
+// makeRoom evicts the txs with the lowest priority until the given tx fits in
+// the mempool. Only the last tx of a sender is evicted at a time, and never one
+// of the sender of the given tx. It evicts nothing and returns an error if the tx
+// can't fit without evicting txs with a priority higher or equal to its own.
+// txsMtx must be held by the caller.
+func (mem *PriorityMempool) makeRoom(memTx *mempoolTx) error {
+	var (
+		memSize  = mem.Size()
+		txsBytes = mem.TxsBytes()
+		txSize   = int64(len(memTx.tx))
+	)
+	isFull := func() bool {
+		return memSize >= mem.config.Size || txSize+txsBytes > mem.config.MaxTxsBytes
+	}
+	if !isFull() {
+		return nil
+	}
+
+	// Pop the txs to evict off the heap, replacing each one with the tx before
+	// it of its sender, if any. They are put back if the tx can't fit.
+	var (
+		evicted  []*mempoolTx
+		replaced []*mempoolTx       // the tx pushed in place of each evicted tx, or nil
+		last     = map[string]int{} // sender -> index of its last tx not evicted
+		skipped  *mempoolTx         // the last tx of the sender of the given tx
+	)
+	for isFull() {
+		if mem.evictable.Len() > 0 && memTx.sender != "" && mem.evictable.txs[0].sender == memTx.sender {
+			skipped = heap.Pop(mem.evictable).(*mempoolTx)
+			continue
+		}
+		if mem.evictable.Len() == 0 || mem.evictable.txs[0].priority >= memTx.priority {
+			for i := len(evicted) - 1; i >= 0; i-- {
+				if replaced[i] != nil {
+					heap.Remove(mem.evictable, replaced[i].heapIndex)
+				}
+				heap.Push(mem.evictable, evicted[i])
+			}
+			if skipped != nil {
+				heap.Push(mem.evictable, skipped)
+			}
+			return ErrMempoolIsFull{
+				mem.Size(), mem.config.Size,
+				mem.TxsBytes(), mem.config.MaxTxsBytes,
+			}
+		}
+
+		tx := heap.Pop(mem.evictable).(*mempoolTx)
+		evicted = append(evicted, tx)
+		memSize--
+		txsBytes -= int64(len(tx.tx))
+
+		var prev *mempoolTx
+		if tx.sender != "" {
+			senderTxs := mem.senderTxs[tx.sender]
+			i, ok := last[tx.sender]
+			if !ok {
+				i = len(senderTxs) - 1
+			}
+			last[tx.sender] = i - 1
+			if i > 0 {
+				prev = senderTxs[i-1]
+				heap.Push(mem.evictable, prev)
+			}
+		}
+		replaced = append(replaced, prev)
+	}
+	if skipped != nil {
+		heap.Push(mem.evictable, skipped)
+	}
+
+	for _, tx := range evicted {
+		mem.logger.Debug("evicted transaction",
+			"tx", txID(tx.tx), "priority", tx.priority, "sender", tx.sender,
+			"newTx", txID(memTx.tx), "newPriority", memTx.priority)
+		// remove from cache (mempool might have a space later)
+		mem.deleteTx(tx.tx, mem.txsMap[TxKey(tx.tx)], true)
+		mem.metrics.EvictedTxs.Add(1)
+		mem.publishTxEvent(mem.eventBus.PublishEventMempoolTxEvicted, tx.tx, tx.sender, types.MempoolTxLowPriority)
+	}
+	return nil
+}
+
+// forEachByPriority calls fn with the txs of the mempool, from the highest
+// priority to the lowest, until it returns false. Txs of the same sender are
+// passed in the order they were added, and txs with the same priority in the
+// order they were added.
+// txsMtx must be held by the caller.
+func (mem *PriorityMempool) forEachByPriority(fn func(memTx *mempoolTx) bool) {
+	// the next txs are the first txs of the senders, and the txs without a sender
+	next := &txHeap{less: higherPriority}
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		if tx := e.Value.(*mempoolTx); tx.sender == "" {
+			next.txs = append(next.txs, tx)
+		}
+	}
+	senderNext := make(map[string]int, len(mem.senderTxs))
+	for _, senderTxs := range mem.senderTxs {
+		next.txs = append(next.txs, senderTxs[0])
+	}
+	heap.Init(next)
+
+	for next.Len() > 0 {
+		memTx := heap.Pop(next).(*mempoolTx)
+		if !fn(memTx) {
+			return
+		}
+		if memTx.sender != "" {
+			senderNext[memTx.sender]++
+			if i := senderNext[memTx.sender]; i < len(mem.senderTxs[memTx.sender]) {
+				heap.Push(next, mem.senderTxs[memTx.sender][i])
+			}
+		}
+	}
+}
+
+// ReapMaxBytesMaxGas reaps txs by priority, keeping the txs of each sender in
+// the order they were added.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
+	mem.updateMtx.RLock()
+	defer mem.updateMtx.RUnlock()
+	mem.txsMtx.RLock()
+	defer mem.txsMtx.RUnlock()
+
+	var (
+		totalBytes int64
+		totalGas   int64
+	)
+	txs := make([]types.Tx, 0, mem.txs.Len())
+	mem.forEachByPriority(func(memTx *mempoolTx) bool {
+		// The proto size of a list of txs is the sum of the proto size of each tx.
+		dataSize := totalBytes + types.ComputeProtoSizeForTxs([]types.Tx{memTx.tx})
+
+		// Check total size requirement
+		if maxBytes > -1 && dataSize > maxBytes {
+			return false
+		}
+		// Check total gas requirement.
+		// If maxGas is negative, skip this check.
+		newTotalGas := totalGas + memTx.gasWanted
+		if maxGas > -1 && newTotalGas > maxGas {
+			return false
+		}
+		totalBytes = dataSize
+		totalGas = newTotalGas
+		txs = append(txs, memTx.tx)
+		return true
+	})
+	return txs
+}
+
+// ReapMaxTxs reaps up to max txs by priority, keeping the txs of each sender in
+// the order they were added.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) ReapMaxTxs(max int) types.Txs {
+	mem.updateMtx.RLock()
+	defer mem.updateMtx.RUnlock()
+	mem.txsMtx.RLock()
+	defer mem.txsMtx.RUnlock()
+
+	if max < 0 {
+		max = mem.txs.Len()
+	}
+
+	txs := make([]types.Tx, 0, max)
+	mem.forEachByPriority(func(memTx *mempoolTx) bool {
+		if len(txs) >= max {
+			return false
+		}
+		txs = append(txs, memTx.tx)
+		return true
+	})
+	return txs
+}
+
+//--------------------------------------------------------------------------------
+
+// lowerPriority orders the txs by increasing priority, and the most recent
+// first for the same priority, which is the order they are evicted in.
+func lowerPriority(a, b *mempoolTx) bool {
+	if a.priority != b.priority {
+		return a.priority < b.priority
+	}
+	return a.timestamp.After(b.timestamp)
+}
+
+// higherPriority orders the txs by decreasing priority, and the oldest first
+// for the same priority, which is the order they are reaped in.
+func higherPriority(a, b *mempoolTx) bool {
+	if a.priority != b.priority {
+		return a.priority > b.priority
+	}
+	return a.timestamp.Before(b.timestamp)
+}
+
+// txHeap is a heap of txs ordered by less. If it is indexed, it keeps the
+// heapIndex of its txs up to date, so that they can be fixed or removed in
+// O(log n). A tx can only be in a single indexed heap.
+type txHeap struct {
+	txs     []*mempoolTx
+	less    func(a, b *mempoolTx) bool
+	indexed bool
+}
+
+var _ heap.Interface = (*txHeap)(nil)
+
+func (h *txHeap) Len() int           { return len(h.txs) }
+func (h *txHeap) Less(i, j int) bool { return h.less(h.txs[i], h.txs[j]) }
+
+func (h *txHeap) Swap(i, j int) {
+	h.txs[i], h.txs[j] = h.txs[j], h.txs[i]
+	if h.indexed {
+		h.txs[i].heapIndex = i
+		h.txs[j].heapIndex = j
+	}
+}
+
+func (h *txHeap) Push(x interface{}) {
+	tx := x.(*mempoolTx)
+	if h.indexed {
+		tx.heapIndex = len(h.txs)
+	}
+	h.txs = append(h.txs, tx)
+}
+
+func (h *txHeap) Pop() interface{} {
+	last := len(h.txs) - 1
+	tx := h.txs[last]
+	h.txs = h.txs[:last]
+	if h.indexed {
+		tx.heapIndex = -1
+	}
+	return tx
+}
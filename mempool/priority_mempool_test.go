@@ -0,0 +1,134 @@
+package mempool
+
+import (
+	"bytes"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/proxy"
+	"github.com/tendermint/tendermint/types"
+)
+
+// priorityApp is an application giving txs of the form "sender/priority/data"
+// their sender and priority in CheckTx.
+type priorityApp struct {
+	abci.BaseApplication
+}
+
+func (app *priorityApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	parts := bytes.SplitN(req.Tx, []byte("/"), 3)
+	if len(parts) != 3 {
+		return abci.ResponseCheckTx{Code: 1}
+	}
+	priority, err := strconv.ParseInt(string(parts[1]), 10, 64)
+	if err != nil {
+		return abci.ResponseCheckTx{Code: 1}
+	}
+	return abci.ResponseCheckTx{
+		Code:      abci.CodeTypeOK,
+		GasWanted: 1,
+		Sender:    string(parts[0]),
+		Priority:  priority,
+	}
+}
+
+func newPriorityMempool(t *testing.T, size int) (*PriorityMempool, cleanupFunc) {
+	config := cfg.ResetTestRoot("priority_mempool_test")
+	config.Mempool.Size = size
+
+	appConnMem, err := proxy.NewLocalClientCreator(&priorityApp{}).NewABCIClient()
+	require.NoError(t, err)
+	appConnMem.SetLogger(log.TestingLogger().With("module", "abci-client", "connection", "mempool"))
+	require.NoError(t, appConnMem.Start())
+
+	mempool := NewPriorityMempool(config.Mempool, appConnMem, 0)
+	mempool.SetLogger(log.TestingLogger())
+	return mempool, func() { os.RemoveAll(config.RootDir) }
+}
+
+func checkPriorityTxs(t *testing.T, mempool Mempool, txs ...string) {
+	for _, tx := range txs {
+		require.NoError(t, mempool.CheckTx(types.Tx(tx), nil, TxInfo{}))
+	}
+}
+
+func txStrings(txs types.Txs) []string {
+	strs := make([]string, len(txs))
+	for i, tx := range txs {
+		strs[i] = string(tx)
+	}
+	return strs
+}
+
+func TestPriorityMempoolReap(t *testing.T) {
+	mempool, cleanup := newPriorityMempool(t, 100)
+	defer cleanup()
+
+	checkPriorityTxs(t, mempool, "/1/a", "/5/b", "alice/2/c", "alice/9/d", "bob/3/e", "/3/f")
+	require.Equal(t, 6, mempool.Size())
+
+	// the later txs of alice come after her first one, whatever their priority,
+	// and txs with the same priority come in arrival order
+	expected := []string{"/5/b", "bob/3/e", "/3/f", "alice/2/c", "alice/9/d", "/1/a"}
+	assert.Equal(t, expected, txStrings(mempool.ReapMaxTxs(-1)))
+	assert.Equal(t, expected[:2], txStrings(mempool.ReapMaxTxs(2)))
+	assert.Equal(t, expected, txStrings(mempool.ReapMaxBytesMaxGas(-1, -1)))
+	assert.Equal(t, expected[:3], txStrings(mempool.ReapMaxBytesMaxGas(-1, 3)))
+	size := types.ComputeProtoSizeForTxs(types.Txs{types.Tx(expected[0]), types.Tx(expected[1])})
+	assert.Equal(t, expected[:2], txStrings(mempool.ReapMaxBytesMaxGas(size, -1)))
+
+	// committed txs are removed from the sender sequences
+	err := mempool.Update(1, types.Txs{types.Tx("alice/2/c")}, abciResponses(1, abci.CodeTypeOK), nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice/9/d", "/5/b", "bob/3/e", "/3/f", "/1/a"}, txStrings(mempool.ReapMaxTxs(-1)))
+}
+
+func TestPriorityMempoolEviction(t *testing.T) {
+	mempool, cleanup := newPriorityMempool(t, 3)
+	defer cleanup()
+
+	checkPriorityTxs(t, mempool, "alice/5/a", "alice/1/b", "/4/c")
+
+	// the last tx of alice has the lowest priority
+	checkPriorityTxs(t, mempool, "/3/d")
+	assert.Equal(t, []string{"alice/5/a", "/4/c", "/3/d"}, txStrings(mempool.ReapMaxTxs(-1)))
+
+	// a tx with a lower priority than all the ones which can be evicted is rejected
+	checkPriorityTxs(t, mempool, "/2/e")
+	assert.Equal(t, []string{"alice/5/a", "/4/c", "/3/d"}, txStrings(mempool.ReapMaxTxs(-1)))
+
+	// the txs of the sender of the new tx are not evicted
+	checkPriorityTxs(t, mempool, "alice/9/f")
+	assert.Equal(t, []string{"alice/5/a", "alice/9/f", "/4/c"}, txStrings(mempool.ReapMaxTxs(-1)))
+
+	// evicted and rejected txs are removed from the cache
+	assert.NoError(t, mempool.CheckTx(types.Tx("/3/d"), nil, TxInfo{}))
+	assert.NoError(t, mempool.CheckTx(types.Tx("/2/e"), nil, TxInfo{}))
+}
+
+func TestPriorityMempoolEvictionOfSeveralTxs(t *testing.T) {
+	mempool, cleanup := newPriorityMempool(t, 100)
+	defer cleanup()
+	mempool.config.MaxTxsBytes = 20
+
+	checkPriorityTxs(t, mempool, "bob/2/a", "bob/1/b", "/9/c")
+
+	// evicting the last tx of bob isn't enough, and the one before it has the
+	// same priority as the new tx, so nothing is evicted
+	checkPriorityTxs(t, mempool, "/2/dddddddd")
+	assert.Equal(t, []string{"/9/c", "bob/2/a", "bob/1/b"}, txStrings(mempool.ReapMaxTxs(-1)))
+
+	// both txs of bob are evicted, the last one first
+	checkPriorityTxs(t, mempool, "/3/dddddddd")
+	assert.Equal(t, []string{"/9/c", "/3/dddddddd"}, txStrings(mempool.ReapMaxTxs(-1)))
+	assert.EqualValues(t, 15, mempool.TxsBytes())
+	// the txs without a sender are all candidates for eviction
+	assert.Equal(t, 2, mempool.evictable.Len())
+}
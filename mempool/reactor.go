@@ -6,9 +6,11 @@ import (
 	"math"
 	"time"
 
+	"github.com/tendermint/tendermint/behaviour"
 	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/libs/clist"
 	"github.com/tendermint/tendermint/libs/log"
+	tmmath "github.com/tendermint/tendermint/libs/math"
 	tmsync "github.com/tendermint/tendermint/libs/sync"
 	"github.com/tendermint/tendermint/p2p"
 	protomem "github.com/tendermint/tendermint/proto/tendermint/mempool"
@@ -17,6 +19,9 @@ import (
 
 const (
 	MempoolChannel = byte(0x30)
+	// MempoolAnnounceChannel is the channel of the pull gossip, where the keys of
+	// txs are announced and the txs missed are requested.
+	MempoolAnnounceChannel = byte(0x31)
 
 	peerCatchupSleepIntervalMS = 100 // If peer is behind, sleep this amount
 
@@ -25,18 +30,126 @@ const (
 	UnknownPeerID uint16 = 0
 
 	maxActiveIDs = math.MaxUint16
+
+	// maxTxKeysPerMsg is the maximum number of tx keys of a message of the
+	// announce channel.
+	maxTxKeysPerMsg = 1000
+
+	// txRequestTimeout is the time after which a tx requested from a peer is
+	// requested again from the next peer announcing it.
+	txRequestTimeout = 10 * time.Second
+
+	// maxTxRequests is the number of times a tx is requested from each peer
+	// announcing it, before giving up until it is announced again.
+	maxTxRequests = 2
+
+	// maxTxAnnouncers is the number of peers announcing a tx remembered to
+	// request it from.
+	maxTxAnnouncers = 8
+
+	// maxWantedTxs bounds the number of txs requested from peers at a time.
+	maxWantedTxs = 10 * maxTxKeysPerMsg
+
+	// pullSendQueueSize is the number of replies queued for a peer of the pull
+	// gossip, see pullPeer.
+	pullSendQueueSize = 10
+)
+
+// GossipMempool is a Mempool whose txs the Reactor broadcasts to peers, in the
+// order they were added. It is implemented by CListMempool and PriorityMempool.
+type GossipMempool interface {
+	Mempool
+
+	// SetLogger sets the Logger.
+	SetLogger(l log.Logger)
+
+	// SetEventBus sets the event bus the mempool publishes the events of its txs to.
+	SetEventBus(eventBus types.MempoolEventPublisher)
+
+	// TxsFront returns the first tx, for peer goroutines to call .NextWait() on.
+	TxsFront() *clist.CElement
+
+	// TxsWaitChan returns a channel which is closed once the mempool is not empty.
+	TxsWaitChan() <-chan struct{}
+
+	// TxByKey returns the tx with the given key, if it is in the mempool.
+	TxByKey(key [TxKeySize]byte) (types.Tx, bool)
+
+	// HasTx returns true if the tx with the given key is in the mempool, or in
+	// the cache of the txs it saw recently.
+	HasTx(key [TxKeySize]byte) bool
+}
+
+var (
+	_ GossipMempool = (*CListMempool)(nil)
+	_ GossipMempool = (*PriorityMempool)(nil)
 )
 
 // Reactor handles mempool tx broadcasting amongst peers.
 // It maintains a map from peer ID to counter, to prevent gossiping txs to the
 // peers you received it from.
+//
+// With the pull gossip, enabled by the PullGossip config option, the reactor
+// only announces the keys of txs on the MempoolAnnounceChannel to the peers
+// which have this channel too, and sends the txs they request. The other peers
+// get every tx on the MempoolChannel.
 type Reactor struct {
 	p2p.BaseReactor
 	config  *cfg.MempoolConfig
-	mempool *CListMempool
+	mempool GossipMempool
 	ids     *mempoolIDs
+	metrics *Metrics
+
+	// reports the behaviour of the peers to the Switch
+	reporter behaviour.Reporter
+
+	// peers of the pull gossip, see InitPeer
+	pullPeersMtx tmsync.RWMutex
+	pullPeers    map[p2p.ID]*pullPeer
+
+	// txs requested from peers, by key
+	wantedMtx tmsync.Mutex
+	wanted    map[[TxKeySize]byte]*wantedTx
+}
+
+// pullPeer is a peer of the pull gossip. The replies to its messages are
+// queued and sent by its pullSendRoutine, so that Receive never blocks on
+// sending to the peer.
+type pullPeer struct {
+	peer      p2p.Peer
+	sendQueue chan pullReply
 }
 
+// pullReply is a reply queued for a peer of the pull gossip: the keys of the
+// txs requested from it, or the keys of the txs it requested.
+type pullReply struct {
+	wantTxs [][TxKeySize]byte
+	sendTxs [][TxKeySize]byte
+}
+
+// wantedTx is a tx requested from one of the peers which announced it.
+type wantedTx struct {
+	requested  time.Time
+	requests   int      // number of times the tx was requested
+	from       int      // index of the peer it was last requested from
+	announcers []p2p.ID // peers which announced the tx
+}
+
+// addAnnouncer remembers a peer announcing the tx.
+func (w *wantedTx) addAnnouncer(peerID p2p.ID) {
+	for _, id := range w.announcers {
+		if id == peerID {
+			return
+		}
+	}
+	if len(w.announcers) < maxTxAnnouncers {
+		w.announcers = append(w.announcers, peerID)
+	}
+}
+
+// ReactorOption sets an optional parameter on the Reactor.
+type ReactorOption func(*Reactor)
+
 type mempoolIDs struct {
 	mtx       tmsync.RWMutex
 	peerMap   map[p2p.ID]uint16
@@ -101,22 +214,48 @@ func newMempoolIDs() *mempoolIDs {
 }
 
 // NewReactor returns a new Reactor with the given config and mempool.
-func NewReactor(config *cfg.MempoolConfig, mempool *CListMempool) *Reactor {
+func NewReactor(config *cfg.MempoolConfig, mempool GossipMempool, options ...ReactorOption) *Reactor {
 	memR := &Reactor{
-		config:  config,
-		mempool: mempool,
-		ids:     newMempoolIDs(),
+		config:    config,
+		mempool:   mempool,
+		ids:       newMempoolIDs(),
+		metrics:   NopMetrics(),
+		pullPeers: make(map[p2p.ID]*pullPeer),
+		wanted:    make(map[[TxKeySize]byte]*wantedTx),
 	}
 	memR.BaseReactor = *p2p.NewBaseReactor("Mempool", memR)
+	for _, option := range options {
+		option(memR)
+	}
 	return memR
 }
 
+// WithReactorMetrics sets the metrics.
+func WithReactorMetrics(metrics *Metrics) ReactorOption {
+	return func(memR *Reactor) { memR.metrics = metrics }
+}
+
 // InitPeer implements Reactor by creating a state for the peer.
 func (memR *Reactor) InitPeer(peer p2p.Peer) p2p.Peer {
 	memR.ids.ReserveForPeer(peer)
+	if memR.pullGossip(peer) {
+		memR.pullPeersMtx.Lock()
+		memR.pullPeers[peer.ID()] = &pullPeer{
+			peer:      peer,
+			sendQueue: make(chan pullReply, pullSendQueueSize),
+		}
+		memR.pullPeersMtx.Unlock()
+	}
 	return peer
 }
 
+// SetSwitch implements Reactor by setting the Switch the behaviour of the
+// peers is reported to.
+func (memR *Reactor) SetSwitch(sw *p2p.Switch) {
+	memR.Switch = sw
+	memR.reporter = behaviour.NewSwitchReporter(sw)
+}
+
 // SetLogger sets the Logger on the reactor and the underlying mempool.
 func (memR *Reactor) SetLogger(l log.Logger) {
 	memR.Logger = l
@@ -128,6 +267,9 @@ func (memR *Reactor) OnStart() error {
 	if !memR.config.Broadcast {
 		memR.Logger.Info("Tx broadcasting is disabled")
 	}
+	if memR.config.PullGossip {
+		go memR.wantedRoutine()
+	}
 	return nil
 }
 
@@ -141,13 +283,31 @@ func (memR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 		},
 	}
 
-	return []*p2p.ChannelDescriptor{
+	channels := []*p2p.ChannelDescriptor{
 		{
 			ID:                  MempoolChannel,
 			Priority:            5,
 			RecvMessageCapacity: batchMsg.Size(),
 		},
 	}
+
+	if memR.config.PullGossip {
+		keys := make([][]byte, maxTxKeysPerMsg)
+		for i := range keys {
+			keys[i] = make([]byte, TxKeySize)
+		}
+		keysMsg := protomem.Message{
+			Sum: &protomem.Message_TxKeys{
+				TxKeys: &protomem.TxKeys{Keys: keys},
+			},
+		}
+		channels = append(channels, &p2p.ChannelDescriptor{
+			ID:                  MempoolAnnounceChannel,
+			Priority:            5,
+			RecvMessageCapacity: keysMsg.Size(),
+		})
+	}
+	return channels
 }
 
 // AddPeer implements Reactor.
@@ -156,31 +316,53 @@ func (memR *Reactor) AddPeer(peer p2p.Peer) {
 	if memR.config.Broadcast {
 		go memR.broadcastTxRoutine(peer)
 	}
+	if pp := memR.getPullPeer(peer.ID()); pp != nil {
+		go memR.pullSendRoutine(pp)
+	}
 }
 
 // RemovePeer implements Reactor.
 func (memR *Reactor) RemovePeer(peer p2p.Peer, reason interface{}) {
 	memR.ids.Reclaim(peer)
-	// broadcast routine checks if peer is gone and returns
+	memR.pullPeersMtx.Lock()
+	delete(memR.pullPeers, peer.ID())
+	memR.pullPeersMtx.Unlock()
+	// broadcast and pull send routines check if peer is gone and return
 }
 
 // Receive implements Reactor.
-// It adds any received transactions to the mempool.
+// It adds any received transactions to the mempool, requests the announced
+// transactions it misses and sends the requested ones.
 func (memR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 	msg, err := memR.decodeMsg(msgBytes)
 	if err != nil {
 		memR.Logger.Error("Error decoding message", "src", src, "chId", chID, "err", err)
-		memR.Switch.StopPeerForError(src, err)
+		if err := memR.reporter.Report(behaviour.BadMessageFromPeer(src, err.Error())); err != nil {
+			memR.Logger.Debug("Error reporting peer", "err", err)
+		}
 		return
 	}
 	memR.Logger.P2PDebug("Receive", "src", src, "chId", chID, "msg", msg)
 
+	switch msg := msg.(type) {
+	case *TxsMessage:
+		memR.receiveTxs(src, msg.Txs)
+	case *TxKeysMessage:
+		memR.requestMissingTxs(src, msg.Keys)
+	case *WantTxsMessage:
+		memR.sendWantedTxs(src, msg.Keys)
+	}
+}
+
+// receiveTxs checks the txs received from a peer.
+func (memR *Reactor) receiveTxs(src p2p.Peer, txs []types.Tx) {
 	txInfo := TxInfo{SenderID: memR.ids.GetForPeer(src)}
 	if src != nil {
 		txInfo.SenderP2PID = src.ID()
 	}
-	for _, tx := range msg.Txs {
-		err = memR.mempool.CheckTx(tx, nil, txInfo)
+	for _, tx := range txs {
+		memR.unwant(TxKey(tx))
+		err := memR.mempool.CheckTx(tx, nil, txInfo)
 		if err == ErrTxInCache {
 			memR.Logger.P2PDebug("Tx already exists in cache", "tx", txID(tx))
 		} else if err != nil {
@@ -190,6 +372,201 @@ func (memR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 	// broadcasting happens from go routines per peer
 }
 
+// requestMissingTxs requests the txs announced by a peer which are neither in
+// the mempool nor already requested from another peer. The request is queued
+// for the pullSendRoutine of the peer.
+func (memR *Reactor) requestMissingTxs(src p2p.Peer, keys [][TxKeySize]byte) {
+	var missing [][TxKeySize]byte
+	for _, key := range keys {
+		if tx, ok := memR.mempool.TxByKey(key); ok {
+			memR.metrics.PullGossipBytesSaved.Add(float64(len(tx)))
+			continue
+		}
+		if memR.mempool.HasTx(key) || !memR.want(key, src.ID()) {
+			continue
+		}
+		missing = append(missing, key)
+	}
+	if len(missing) == 0 {
+		return
+	}
+
+	// if the request is dropped, the txs are requested again after
+	// txRequestTimeout
+	if !memR.queueReply(src.ID(), pullReply{wantTxs: missing}) {
+		memR.Logger.Debug("Dropping request of txs", "peer", src, "txs", len(missing))
+	}
+}
+
+// sendWantedTxs queues the txs requested by a peer for its pullSendRoutine.
+func (memR *Reactor) sendWantedTxs(src p2p.Peer, keys [][TxKeySize]byte) {
+	// if the reply is dropped, the peer requests the txs again after
+	// txRequestTimeout
+	if !memR.queueReply(src.ID(), pullReply{sendTxs: keys}) {
+		memR.Logger.Debug("Dropping requested txs", "peer", src, "txs", len(keys))
+	}
+}
+
+// getPullPeer returns the pull gossip state of the peer, or nil if the txs
+// are sent to the peer rather than announced.
+func (memR *Reactor) getPullPeer(peerID p2p.ID) *pullPeer {
+	memR.pullPeersMtx.RLock()
+	defer memR.pullPeersMtx.RUnlock()
+	return memR.pullPeers[peerID]
+}
+
+// queueReply queues a reply for the pullSendRoutine of the peer. It never
+// blocks, and returns false if the reply was dropped.
+func (memR *Reactor) queueReply(peerID p2p.ID, reply pullReply) bool {
+	pp := memR.getPullPeer(peerID)
+	if pp == nil {
+		return false
+	}
+	select {
+	case pp.sendQueue <- reply:
+		return true
+	default:
+		return false
+	}
+}
+
+// pullSendRoutine sends the replies queued for a peer of the pull gossip.
+func (memR *Reactor) pullSendRoutine(pp *pullPeer) {
+	for {
+		var reply pullReply
+		select {
+		case reply = <-pp.sendQueue:
+		case <-pp.peer.Quit():
+			return
+		case <-memR.Quit():
+			return
+		}
+
+		if len(reply.wantTxs) > 0 {
+			keys := make([][]byte, len(reply.wantTxs))
+			for i := range reply.wantTxs {
+				keys[i] = reply.wantTxs[i][:]
+			}
+			msg := protomem.Message{
+				Sum: &protomem.Message_WantTxs{
+					WantTxs: &protomem.WantTxs{Keys: keys},
+				},
+			}
+			bz, err := msg.Marshal()
+			if err != nil {
+				panic(err)
+			}
+			pp.peer.Send(MempoolAnnounceChannel, bz)
+		}
+
+		for _, key := range reply.sendTxs {
+			tx, ok := memR.mempool.TxByKey(key)
+			if !ok {
+				continue
+			}
+			msg := protomem.Message{
+				Sum: &protomem.Message_Txs{
+					Txs: &protomem.Txs{Txs: [][]byte{tx}},
+				},
+			}
+			bz, err := msg.Marshal()
+			if err != nil {
+				panic(err)
+			}
+			if !pp.peer.Send(MempoolChannel, bz) {
+				break
+			}
+		}
+	}
+}
+
+// want marks the tx with the given key, announced by the given peer, as
+// requested from it. It returns false if the tx is already requested, from
+// this or another peer.
+func (memR *Reactor) want(key [TxKeySize]byte, peerID p2p.ID) bool {
+	memR.wantedMtx.Lock()
+	defer memR.wantedMtx.Unlock()
+
+	if w, ok := memR.wanted[key]; ok {
+		w.addAnnouncer(peerID)
+		return false
+	}
+	if len(memR.wanted) >= maxWantedTxs {
+		return false
+	}
+	memR.wanted[key] = &wantedTx{
+		requested:  time.Now(),
+		requests:   1,
+		announcers: []p2p.ID{peerID},
+	}
+	return true
+}
+
+// unwant forgets the request of the tx with the given key.
+func (memR *Reactor) unwant(key [TxKeySize]byte) {
+	memR.wantedMtx.Lock()
+	delete(memR.wanted, key)
+	memR.wantedMtx.Unlock()
+}
+
+// wantedRoutine periodically requests the txs which did not arrive within
+// txRequestTimeout again.
+func (memR *Reactor) wantedRoutine() {
+	ticker := time.NewTicker(txRequestTimeout / 4)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			memR.requestTimedOutTxs(now)
+		case <-memR.Quit():
+			return
+		}
+	}
+}
+
+// requestTimedOutTxs requests the txs requested more than txRequestTimeout
+// ago again, from the next peer which announced them. A tx is given up once
+// it was requested maxTxRequests times from each of these peers.
+func (memR *Reactor) requestTimedOutTxs(now time.Time) {
+	requests := make(map[p2p.ID][][TxKeySize]byte)
+
+	memR.wantedMtx.Lock()
+	for key, w := range memR.wanted {
+		if now.Sub(w.requested) < txRequestTimeout {
+			continue
+		}
+		if memR.mempool.HasTx(key) || w.requests >= maxTxRequests*len(w.announcers) {
+			delete(memR.wanted, key)
+			continue
+		}
+		w.from = (w.from + 1) % len(w.announcers)
+		w.requests++
+		w.requested = now
+		peerID := w.announcers[w.from]
+		requests[peerID] = append(requests[peerID], key)
+	}
+	memR.wantedMtx.Unlock()
+
+	for peerID, keys := range requests {
+		for len(keys) > 0 {
+			n := tmmath.MinInt(len(keys), maxTxKeysPerMsg)
+			memR.queueReply(peerID, pullReply{wantTxs: keys[:n]})
+			keys = keys[n:]
+		}
+	}
+}
+
+// pullGossip returns true if the txs are announced to the peer rather than
+// sent to it.
+func (memR *Reactor) pullGossip(peer p2p.Peer) bool {
+	if !memR.config.PullGossip {
+		return false
+	}
+	ni, ok := peer.NodeInfo().(p2p.DefaultNodeInfo)
+	return ok && ni.HasChannel(MempoolAnnounceChannel)
+}
+
 // PeerState describes the state of a peer.
 type PeerState interface {
 	GetHeight() int64
@@ -198,6 +575,7 @@ type PeerState interface {
 // Send new mempool txs to peer.
 func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 	peerID := memR.ids.GetForPeer(peer)
+	pull := memR.pullGossip(peer)
 	var next *clist.CElement
 
 	for {
@@ -243,7 +621,26 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 		// NOTE: Transaction batching was disabled due to
 		// https://github.com/tendermint/tendermint/issues/5796
 
-		if _, ok := memTx.senders.Load(peerID); !ok {
+		if pull {
+			// announce the keys of the txs following this one in one message
+			keys, last := txKeysFrom(next, peerID, peerState.GetHeight())
+			if len(keys) > 0 {
+				msg := protomem.Message{
+					Sum: &protomem.Message_TxKeys{
+						TxKeys: &protomem.TxKeys{Keys: keys},
+					},
+				}
+				bz, err := msg.Marshal()
+				if err != nil {
+					panic(err)
+				}
+				if !peer.Send(MempoolAnnounceChannel, bz) {
+					time.Sleep(peerCatchupSleepIntervalMS * time.Millisecond)
+					continue
+				}
+			}
+			next = last
+		} else if _, ok := memTx.senders.Load(peerID); !ok {
 			msg := protomem.Message{
 				Sum: &protomem.Message_Txs{
 					Txs: &protomem.Txs{Txs: [][]byte{memTx.tx}},
@@ -272,23 +669,44 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 	}
 }
 
+// txKeysFrom returns the keys of the tx of the given element and of the txs
+// following it which the peer didn't send and is ready for, up to
+// maxTxKeysPerMsg keys, along with the element of the last tx considered.
+func txKeysFrom(e *clist.CElement, peerID uint16, peerHeight int64) ([][]byte, *clist.CElement) {
+	var keys [][]byte
+	last := e
+	for ; e != nil && len(keys) < maxTxKeysPerMsg; e = e.Next() {
+		memTx := e.Value.(*mempoolTx)
+		// Allow for a lag of 1 block.
+		if peerHeight < memTx.Height()-1 {
+			break
+		}
+		last = e
+		if _, ok := memTx.senders.Load(peerID); ok {
+			continue
+		}
+		key := TxKey(memTx.tx)
+		keys = append(keys, key[:])
+	}
+	return keys, last
+}
+
 //-----------------------------------------------------------------------------
 // Messages
 
-func (memR *Reactor) decodeMsg(bz []byte) (TxsMessage, error) {
+func (memR *Reactor) decodeMsg(bz []byte) (interface{}, error) {
 	msg := protomem.Message{}
 	err := msg.Unmarshal(bz)
 	if err != nil {
-		return TxsMessage{}, err
+		return nil, err
 	}
 
-	var message TxsMessage
-
-	if i, ok := msg.Sum.(*protomem.Message_Txs); ok {
+	switch i := msg.Sum.(type) {
+	case *protomem.Message_Txs:
 		txs := i.Txs.GetTxs()
 
 		if len(txs) == 0 {
-			return message, errors.New("empty TxsMessage")
+			return nil, errors.New("empty TxsMessage")
 		}
 
 		decoded := make([]types.Tx, len(txs))
@@ -296,12 +714,42 @@ func (memR *Reactor) decodeMsg(bz []byte) (TxsMessage, error) {
 			decoded[j] = types.Tx(tx)
 		}
 
-		message = TxsMessage{
+		return &TxsMessage{
 			Txs: decoded,
+		}, nil
+
+	case *protomem.Message_TxKeys:
+		keys, err := decodeTxKeys(i.TxKeys.GetKeys())
+		if err != nil {
+			return nil, fmt.Errorf("invalid TxKeysMessage: %w", err)
+		}
+		return &TxKeysMessage{Keys: keys}, nil
+
+	case *protomem.Message_WantTxs:
+		keys, err := decodeTxKeys(i.WantTxs.GetKeys())
+		if err != nil {
+			return nil, fmt.Errorf("invalid WantTxsMessage: %w", err)
+		}
+		return &WantTxsMessage{Keys: keys}, nil
+	}
+	return nil, fmt.Errorf("msg type: %T is not supported", msg.Sum)
+}
+
+func decodeTxKeys(keys [][]byte) ([][TxKeySize]byte, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no keys")
+	}
+	if len(keys) > maxTxKeysPerMsg {
+		return nil, fmt.Errorf("too many keys: %d > %d", len(keys), maxTxKeysPerMsg)
+	}
+	decoded := make([][TxKeySize]byte, len(keys))
+	for i, key := range keys {
+		if len(key) != TxKeySize {
+			return nil, fmt.Errorf("key %X has size %d, expected %d", key, len(key), TxKeySize)
 		}
-		return message, nil
+		copy(decoded[i][:], key)
 	}
-	return message, fmt.Errorf("msg type: %T is not supported", msg)
+	return decoded, nil
 }
 
 //-------------------------------------
@@ -315,3 +763,23 @@ type TxsMessage struct {
 func (m *TxsMessage) String() string {
 	return fmt.Sprintf("[TxsMessage %v]", m.Txs)
 }
+
+// TxKeysMessage is a Message announcing the keys of transactions.
+type TxKeysMessage struct {
+	Keys [][TxKeySize]byte
+}
+
+// String returns a string representation of the TxKeysMessage.
+func (m *TxKeysMessage) String() string {
+	return fmt.Sprintf("[TxKeysMessage %X]", m.Keys)
+}
+
+// WantTxsMessage is a Message requesting the transactions with the given keys.
+type WantTxsMessage struct {
+	Keys [][TxKeySize]byte
+}
+
+// String returns a string representation of the WantTxsMessage.
+func (m *WantTxsMessage) String() string {
+	return fmt.Sprintf("[WantTxsMessage %X]", m.Keys)
+}
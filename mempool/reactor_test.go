@@ -151,6 +151,113 @@ func TestReactorNoBroadcastToSender(t *testing.T) {
 	ensureNoTxs(t, reactors[peerID], 100*time.Millisecond)
 }
 
+// Send a bunch of txs to the first reactor's mempool and wait for them all to
+// be requested by the others, to which they are only announced.
+func TestReactorPullGossip(t *testing.T) {
+	config := cfg.TestConfig()
+	config.Mempool.PullGossip = true
+	const N = 2
+	reactors := makeAndConnectReactors(config, N)
+	defer func() {
+		for _, r := range reactors {
+			if err := r.Stop(); err != nil {
+				assert.NoError(t, err)
+			}
+		}
+	}()
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().List() {
+			peer.Set(types.PeerStateKey, peerState{1})
+			assert.True(t, r.pullGossip(peer))
+		}
+	}
+
+	txs := checkTxs(t, reactors[0].mempool, numTxs, UnknownPeerID)
+	waitForTxsOnReactors(t, txs, reactors)
+}
+
+func TestReactorRequestMissingTxs(t *testing.T) {
+	config := cfg.TestConfig()
+	config.Mempool.PullGossip = true
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mempool, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+	reactor := NewReactor(config.Mempool, mempool)
+
+	// two peers of the pull gossip announcing the same txs
+	peers := []*pullPeer{
+		{peer: mock.NewPeer(nil), sendQueue: make(chan pullReply, pullSendQueueSize)},
+		{peer: mock.NewPeer(nil), sendQueue: make(chan pullReply, pullSendQueueSize)},
+	}
+	for _, pp := range peers {
+		reactor.pullPeers[pp.peer.ID()] = pp
+	}
+	requestedFrom := func(pp *pullPeer) [][TxKeySize]byte {
+		select {
+		case reply := <-pp.sendQueue:
+			return reply.wantTxs
+		default:
+			return nil
+		}
+	}
+
+	txs := checkTxs(t, mempool, 1, UnknownPeerID)
+	missing := TxKey(types.Tx("missing"))
+	keys := [][TxKeySize]byte{TxKey(txs[0]), missing}
+	reactor.requestMissingTxs(peers[0].peer, keys)
+	reactor.requestMissingTxs(peers[1].peer, keys)
+
+	// only the missing tx was requested, and only from the first peer
+	assert.Equal(t, [][TxKeySize]byte{missing}, requestedFrom(peers[0]))
+	assert.Empty(t, requestedFrom(peers[1]))
+
+	// it is requested from the next peer once the request timed out, until it
+	// was requested maxTxRequests times from each peer
+	now := time.Now()
+	for i := 1; i < maxTxRequests*len(peers); i++ {
+		now = now.Add(txRequestTimeout)
+		reactor.requestTimedOutTxs(now)
+		assert.Equal(t, [][TxKeySize]byte{missing}, requestedFrom(peers[i%len(peers)]))
+		assert.Empty(t, requestedFrom(peers[(i+1)%len(peers)]))
+	}
+	reactor.requestTimedOutTxs(now.Add(txRequestTimeout))
+	assert.Empty(t, requestedFrom(peers[0]))
+	assert.Empty(t, requestedFrom(peers[1]))
+	reactor.wantedMtx.Lock()
+	assert.Empty(t, reactor.wanted)
+	reactor.wantedMtx.Unlock()
+
+	// a new announcement requests it again, until it is received
+	reactor.requestMissingTxs(peers[1].peer, keys)
+	assert.Equal(t, [][TxKeySize]byte{missing}, requestedFrom(peers[1]))
+	reactor.receiveTxs(peers[1].peer, []types.Tx{types.Tx("missing")})
+	assert.True(t, mempool.HasTx(missing))
+	reactor.wantedMtx.Lock()
+	assert.Empty(t, reactor.wanted)
+	reactor.wantedMtx.Unlock()
+}
+
+func TestTxKeysFrom(t *testing.T) {
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mempool, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+
+	const peerID = 1
+	txs := checkTxs(t, mempool, 3, UnknownPeerID)
+	txs = append(txs, checkTxs(t, mempool, 1, peerID)...)
+
+	// all the txs are announced in one message, except the one the peer sent
+	keys, last := txKeysFrom(mempool.TxsFront(), peerID, 0)
+	require.Len(t, keys, 3)
+	for i, key := range keys {
+		txKey := TxKey(txs[i])
+		assert.Equal(t, txKey[:], key)
+	}
+	assert.Equal(t, txs[3], last.Value.(*mempoolTx).tx)
+}
+
 func TestReactor_MaxTxBytes(t *testing.T) {
 	config := cfg.TestConfig()
 
@@ -0,0 +1,244 @@
+package mempool
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	cfg "github.com/tendermint/tendermint/config"
+	auto "github.com/tendermint/tendermint/libs/autofile"
+	tmos "github.com/tendermint/tendermint/libs/os"
+	"github.com/tendermint/tendermint/types"
+)
+
+// The WAL of the mempool logs every tx admitted to the mempool, once the app
+// accepted it in CheckTx, so that the mempool can be recovered after a restart
+// by replaying the txs through CheckTx again, with the Recheck type.
+// Each line of the WAL is a hex-encoded tx, except the checkpoints written on
+// every Update: the keys of the txs of the committed block, which are not
+// replayed, followed by the height of the block. A compacted WAL starts with
+// the height of the last block the mempool was updated to, and holds only the
+// txs left in the mempool. Only the blocks committed after the last height of
+// the WAL are then read from the block store when replaying it.
+
+const (
+	walFileName = "wal"
+
+	// walHeightPrefix starts the lines holding the height of the last block
+	// the mempool was updated to.
+	walHeightPrefix = "height:"
+
+	// walCommittedPrefix starts the lines holding the hex-encoded key of a
+	// committed tx.
+	walCommittedPrefix = "committed:"
+
+	// walCompactionMinSize is the size below which the WAL is never compacted.
+	walCompactionMinSize = 1 << 20 // 1MB
+)
+
+var newline = []byte("\n")
+
+// BlockStore is the part of the block store the mempool reads committed txs
+// from when replaying its WAL.
+type BlockStore interface {
+	Height() int64
+	LoadBlock(height int64) *types.Block
+}
+
+// openWAL creates the WAL directory of the mempool and opens the WAL file in it.
+func openWAL(config *cfg.MempoolConfig) (*auto.AutoFile, error) {
+	var (
+		walDir  = config.WalDir()
+		walFile = filepath.Join(walDir, walFileName)
+	)
+
+	const perm = 0700
+	if err := tmos.EnsureDir(walDir, perm); err != nil {
+		return nil, err
+	}
+
+	af, err := auto.OpenAutoFile(walFile)
+	if err != nil {
+		return nil, fmt.Errorf("can't open autofile %s: %w", walFile, err)
+	}
+	return af, nil
+}
+
+// encodeWALTx returns the line of the WAL for the given tx.
+func encodeWALTx(tx types.Tx) []byte {
+	line := make([]byte, hex.EncodedLen(len(tx))+len(newline))
+	hex.Encode(line, tx)
+	copy(line[len(line)-len(newline):], newline)
+	return line
+}
+
+// encodeWALCheckpoint returns the lines of the WAL recording that the mempool
+// was updated to the block at the given height, with the given txs.
+func encodeWALCheckpoint(height int64, blockTxs types.Txs) []byte {
+	var buf bytes.Buffer
+	for _, tx := range blockTxs {
+		key := TxKey(tx)
+		fmt.Fprintf(&buf, "%s%X\n", walCommittedPrefix, key[:])
+	}
+	fmt.Fprintf(&buf, "%s%d\n", walHeightPrefix, height)
+	return buf.Bytes()
+}
+
+// readWAL reads the WAL file at the given path and returns the height of its
+// last checkpoint, or 0 if it has none, and its txs which were not committed
+// before that. The last line is skipped if it is not terminated, after a
+// crash in the middle of a write. An error is returned if a line is not a tx
+// nor a checkpoint, like the raw txs logged by the previous versions.
+func readWAL(path string) (int64, []types.Tx, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil, nil
+		}
+		return 0, nil, err
+	}
+	defer f.Close()
+
+	var (
+		height int64
+		txs    []types.Tx
+		// positions of the txs in txs by key, to drop them once committed
+		positions = make(map[[TxKeySize]byte][]int)
+		r         = bufio.NewReader(f)
+	)
+	for lineNum := 1; ; lineNum++ {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+		line = bytes.TrimSuffix(line, newline)
+
+		switch {
+		case len(line) == 0:
+			continue
+
+		case bytes.HasPrefix(line, []byte(walHeightPrefix)):
+			height, err = strconv.ParseInt(string(line[len(walHeightPrefix):]), 10, 64)
+			if err != nil {
+				return 0, nil, fmt.Errorf("invalid WAL height %q: %w", line, err)
+			}
+
+		case bytes.HasPrefix(line, []byte(walCommittedPrefix)):
+			var key [TxKeySize]byte
+			n, err := hex.Decode(key[:], line[len(walCommittedPrefix):])
+			if err != nil || n != TxKeySize {
+				return 0, nil, fmt.Errorf("invalid WAL committed tx key %q", line)
+			}
+			for _, i := range positions[key] {
+				txs[i] = nil
+			}
+			delete(positions, key)
+
+		default:
+			tx := make(types.Tx, hex.DecodedLen(len(line)))
+			if _, err := hex.Decode(tx, line); err != nil {
+				return 0, nil, fmt.Errorf("line %d of the WAL is not a hex-encoded tx, the WAL may have been "+
+					"written by a previous version logging raw txs (remove it to start with an empty mempool): %w",
+					lineNum, err)
+			}
+			key := TxKey(tx)
+			positions[key] = append(positions[key], len(txs))
+			txs = append(txs, tx)
+		}
+	}
+
+	uncommitted := txs[:0]
+	for _, tx := range txs {
+		if tx != nil {
+			uncommitted = append(uncommitted, tx)
+		}
+	}
+	return height, uncommitted, nil
+}
+
+// compactWAL replaces the WAL file with one holding only the given height and
+// txs, and returns the reopened WAL. The new file is written next to the WAL
+// and renamed over it, so that a crash never leaves a partial WAL behind. The
+// returned WAL is always usable, even along with an error.
+func compactWAL(wal *auto.AutoFile, height int64, txs []types.Tx) (*auto.AutoFile, error) {
+	tmpPath := wal.Path + ".tmp"
+	if err := writeWALFile(tmpPath, height, txs); err != nil {
+		os.Remove(tmpPath)
+		return wal, err
+	}
+
+	if err := wal.Close(); err != nil {
+		return wal, err
+	}
+	renameErr := os.Rename(tmpPath, wal.Path)
+	af, err := auto.OpenAutoFile(wal.Path)
+	if err != nil {
+		// the closed WAL reopens its file on the next write
+		return wal, err
+	}
+	return af, renameErr
+}
+
+// writeWALFile writes a WAL file with the given height and txs, and syncs it.
+func writeWALFile(path string, height int64, txs []types.Tx) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	if _, err := fmt.Fprintf(w, "%s%d\n", walHeightPrefix, height); err != nil {
+		return err
+	}
+	for _, tx := range txs {
+		if _, err := w.Write(encodeWALTx(tx)); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// walNeedsCompaction returns true if the WAL is more than twice as large as it
+// would be with only the txs left in the mempool, which total txsBytes bytes.
+// Compacting then at least halves the WAL, so that the cost of rewriting it is
+// amortized over the txs written to it since the last compaction.
+func walNeedsCompaction(wal *auto.AutoFile, numTxs int, txsBytes int64) bool {
+	size, err := wal.Size()
+	if err != nil {
+		return false
+	}
+	compactedSize := int64(hex.EncodedLen(int(txsBytes))) + int64(numTxs*len(newline))
+	return size > walCompactionMinSize && size > 2*compactedSize
+}
+
+// committedTxKeys returns the keys of the txs committed in the blocks of the
+// store above the given height, which is the height of the last checkpoint of
+// the WAL. Pruned blocks are skipped.
+func committedTxKeys(blockStore BlockStore, height int64) map[[TxKeySize]byte]struct{} {
+	keys := make(map[[TxKeySize]byte]struct{})
+	for h := height + 1; h <= blockStore.Height(); h++ {
+		block := blockStore.LoadBlock(h)
+		if block == nil {
+			continue
+		}
+		for _, tx := range block.Txs {
+			keys[TxKey(tx)] = struct{}{}
+		}
+	}
+	return keys
+}
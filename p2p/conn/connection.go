@@ -700,6 +700,19 @@ type ChannelStatus struct {
 	SendQueueSize     int
 	Priority          int
 	RecentlySent      int64
+	Traffic           *ChannelTraffic `json:",omitempty"`
+}
+
+// ChannelTraffic is the traffic of a channel since the connection was
+// established. The messages and bytes sent are counted once they are written to
+// the connection, not when they are queued.
+type ChannelTraffic struct {
+	MsgsSent      int64
+	MsgsReceived  int64
+	BytesSent     int64
+	BytesReceived int64
+	SendRate      int64 // bytes/s, exponential moving average
+	RecvRate      int64 // bytes/s, exponential moving average
 }
 
 func (c *MConnection) Status() ConnectionStatus {
@@ -715,6 +728,7 @@ func (c *MConnection) Status() ConnectionStatus {
 			SendQueueSize:     int(atomic.LoadInt32(&channel.sendQueueSize)),
 			Priority:          channel.desc.Priority,
 			RecentlySent:      atomic.LoadInt64(&channel.recentlySent),
+			Traffic:           channel.traffic(),
 		}
 	}
 	return status
@@ -755,6 +769,14 @@ type Channel struct {
 	sending       []byte
 	recentlySent  int64 // exponential moving average
 
+	// traffic of the channel, the payloads of the packets being accounted
+	msgsSent      int64 // atomic.
+	msgsReceived  int64 // atomic.
+	bytesSent     int64 // atomic.
+	bytesReceived int64 // atomic.
+	sendMonitor   *flow.Monitor
+	recvMonitor   *flow.Monitor
+
 	maxPacketMsgPayloadSize int
 
 	Logger log.Logger
@@ -770,6 +792,8 @@ func newChannel(conn *MConnection, desc ChannelDescriptor) *Channel {
 		desc:                    desc,
 		sendQueue:               make(chan []byte, desc.SendQueueCapacity),
 		recving:                 make([]byte, 0, desc.RecvBufferCapacity),
+		sendMonitor:             flow.New(0, 0),
+		recvMonitor:             flow.New(0, 0),
 		maxPacketMsgPayloadSize: conn.config.MaxPacketMsgPayloadSize,
 	}
 }
@@ -851,6 +875,13 @@ func (ch *Channel) writePacketMsgTo(w io.Writer) (n int, err error) {
 	packet := ch.nextPacketMsg()
 	n, err = protoio.NewDelimitedWriter(w).WriteMsg(mustWrapPacket(&packet))
 	atomic.AddInt64(&ch.recentlySent, int64(n))
+	if err == nil {
+		atomic.AddInt64(&ch.bytesSent, int64(len(packet.Data)))
+		ch.sendMonitor.Update(len(packet.Data))
+		if packet.EOF {
+			atomic.AddInt64(&ch.msgsSent, 1)
+		}
+	}
 	return
 }
 
@@ -864,7 +895,10 @@ func (ch *Channel) recvPacketMsg(packet tmp2p.PacketMsg) ([]byte, error) {
 		return nil, fmt.Errorf("received message exceeds available capacity: %v < %v", recvCap, recvReceived)
 	}
 	ch.recving = append(ch.recving, packet.Data...)
+	atomic.AddInt64(&ch.bytesReceived, int64(len(packet.Data)))
+	ch.recvMonitor.Update(len(packet.Data))
 	if packet.EOF {
+		atomic.AddInt64(&ch.msgsReceived, 1)
 		msgBytes := ch.recving
 
 		// clear the slice without re-allocating.
@@ -877,6 +911,19 @@ func (ch *Channel) recvPacketMsg(packet tmp2p.PacketMsg) ([]byte, error) {
 	return nil, nil
 }
 
+// traffic returns the traffic of the channel.
+// Goroutine-safe
+func (ch *Channel) traffic() *ChannelTraffic {
+	return &ChannelTraffic{
+		MsgsSent:      atomic.LoadInt64(&ch.msgsSent),
+		MsgsReceived:  atomic.LoadInt64(&ch.msgsReceived),
+		BytesSent:     atomic.LoadInt64(&ch.bytesSent),
+		BytesReceived: atomic.LoadInt64(&ch.bytesReceived),
+		SendRate:      ch.sendMonitor.Status().CurRate,
+		RecvRate:      ch.recvMonitor.Status().CurRate,
+	}
+}
+
 // Call this periodically to update stats for throttling purposes.
 // Not goroutine-safe
 func (ch *Channel) updateStats() {
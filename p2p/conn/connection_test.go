@@ -146,6 +146,15 @@ func TestMConnectionReceive(t *testing.T) {
 	case <-time.After(500 * time.Millisecond):
 		t.Fatalf("Did not receive %s message in 500ms", msg)
 	}
+
+	sent := mconn2.Status().Channels[0].Traffic
+	assert.EqualValues(t, 1, sent.MsgsSent)
+	assert.EqualValues(t, len(msg), sent.BytesSent)
+	assert.Zero(t, sent.MsgsReceived)
+	received := mconn1.Status().Channels[0].Traffic
+	assert.EqualValues(t, 1, received.MsgsReceived)
+	assert.EqualValues(t, len(msg), received.BytesReceived)
+	assert.Zero(t, received.MsgsSent)
 }
 
 func TestMConnectionStatus(t *testing.T) {
@@ -161,6 +170,7 @@ func TestMConnectionStatus(t *testing.T) {
 	status := mconn.Status()
 	assert.NotNil(t, status)
 	assert.Zero(t, status.Channels[0].SendQueueSize)
+	assert.Equal(t, &ChannelTraffic{}, status.Channels[0].Traffic)
 }
 
 func TestMConnectionPongTimeoutResultsInError(t *testing.T) {
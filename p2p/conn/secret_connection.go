@@ -47,6 +47,7 @@ var (
 	labelEphemeralUpperPublicKey = []byte("EPHEMERAL_UPPER_PUBLIC_KEY")
 	labelDHSecret                = []byte("DH_SECRET")
 	labelSecretConnectionMac     = []byte("SECRET_CONNECTION_MAC")
+	labelSessionChallenge        = []byte("SESSION_CHALLENGE")
 
 	secretConnKeyAndChallengeGen = []byte("TENDERMINT_SECRET_CONNECTION_KEY_AND_CHALLENGE_GEN")
 )
@@ -66,8 +67,9 @@ type SecretConnection struct {
 	recvAead cipher.AEAD
 	sendAead cipher.AEAD
 
-	remPubKey crypto.PubKey
-	conn      io.ReadWriteCloser
+	remPubKey        crypto.PubKey
+	conn             io.ReadWriteCloser
+	sessionChallenge []byte
 
 	// net.Conn must be thread safe:
 	// https://golang.org/pkg/net/#Conn.
@@ -139,6 +141,10 @@ func MakeSecretConnection(conn io.ReadWriteCloser, locPrivKey crypto.PrivKey) (*
 
 	copy(challenge[:], challengeSlice[0:challengeSize])
 
+	// A distinct challenge, which other authentications of the peers can sign
+	// to bind them to this session.
+	sessionChallenge := transcript.ExtractBytes(labelSessionChallenge, challengeSize)
+
 	sendAead, err := chacha20poly1305.New(sendSecret[:])
 	if err != nil {
 		return nil, errors.New("invalid send SecretConnection Key")
@@ -149,12 +155,13 @@ func MakeSecretConnection(conn io.ReadWriteCloser, locPrivKey crypto.PrivKey) (*
 	}
 
 	sc := &SecretConnection{
-		conn:       conn,
-		recvBuffer: nil,
-		recvNonce:  new([aeadNonceSize]byte),
-		sendNonce:  new([aeadNonceSize]byte),
-		recvAead:   recvAead,
-		sendAead:   sendAead,
+		conn:             conn,
+		recvBuffer:       nil,
+		recvNonce:        new([aeadNonceSize]byte),
+		sendNonce:        new([aeadNonceSize]byte),
+		recvAead:         recvAead,
+		sendAead:         sendAead,
+		sessionChallenge: sessionChallenge,
 	}
 
 	// SignDigest the challenge bytes for authentication.
@@ -187,6 +194,13 @@ func (sc *SecretConnection) RemotePubKey() crypto.PubKey {
 	return sc.remPubKey
 }
 
+// SessionChallenge returns a challenge unique to the session, which both peers
+// share. Signing it binds an authentication to the session, so that it can't
+// be replayed on another connection.
+func (sc *SecretConnection) SessionChallenge() []byte {
+	return sc.sessionChallenge
+}
+
 // Writes encrypted frames of `totalFrameSize + aeadSizeOverhead`.
 // CONTRACT: data smaller than dataMaxSize is written atomically.
 func (sc *SecretConnection) Write(data []byte) (n int, err error) {
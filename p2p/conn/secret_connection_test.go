@@ -56,6 +56,13 @@ func (pk privKeyWithNilPubKey) TypeValue() crypto.KeyType             { return c
 
 func TestSecretConnectionHandshake(t *testing.T) {
 	fooSecConn, barSecConn := makeSecretConnPair(t)
+
+	// both ends share the session challenge, which is unique to the session
+	assert.Len(t, fooSecConn.SessionChallenge(), 32)
+	assert.Equal(t, fooSecConn.SessionChallenge(), barSecConn.SessionChallenge())
+	otherSecConn, _ := makeSecretConnPair(t)
+	assert.NotEqual(t, fooSecConn.SessionChallenge(), otherSecConn.SessionChallenge())
+
 	if err := fooSecConn.Close(); err != nil {
 		t.Error(err)
 	}
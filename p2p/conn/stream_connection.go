@@ -0,0 +1,801 @@
+package conn
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"runtime/debug"
+	"sync/atomic"
+	"time"
+
+	flow "github.com/tendermint/tendermint/libs/flowrate"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/libs/service"
+	tmsync "github.com/tendermint/tendermint/libs/sync"
+)
+
+const (
+	// frame types of a StreamConnection
+	streamFrameData   = byte(0x01)
+	streamFrameCredit = byte(0x02)
+	streamFramePing   = byte(0x03)
+	streamFramePong   = byte(0x04)
+
+	// streamFlagEOF marks the last data frame of a message
+	streamFlagEOF = byte(0x01)
+
+	// type (1) + channel ID (1) + flags (1) + payload length (4)
+	streamFrameHeaderSize = 7
+	streamCreditSize      = 4
+
+	// streamMaxFrameSize keeps every frame within a single frame of the
+	// SecretConnection.
+	streamMaxFrameSize        = dataMaxSize
+	streamMaxFramePayloadSize = streamMaxFrameSize - streamFrameHeaderSize
+
+	// defaultStreamWindowSize is the number of bytes (frame headers included)
+	// a stream can have in flight before the remote end acknowledges their
+	// delivery. The window of a stream is raised to fit its largest message.
+	defaultStreamWindowSize = 1024 * 1024
+	maxStreamWindowSize     = math.MaxInt32
+
+	// streamFlushTimeout bounds the time FlushStop waits for the streams to
+	// send their queued messages.
+	streamFlushTimeout = 5 * time.Second
+)
+
+// Connection is a connection to a peer carrying messages on multiple
+// channels. It is implemented by MConnection and StreamConnection.
+type Connection interface {
+	service.Service
+
+	FlushStop()
+	Send(chID byte, msgBytes []byte) bool
+	TrySend(chID byte, msgBytes []byte) bool
+	CanSend(chID byte) bool
+	Status() ConnectionStatus
+}
+
+var (
+	_ Connection = (*MConnection)(nil)
+	_ Connection = (*StreamConnection)(nil)
+)
+
+/*
+StreamConnection struct:
+A `StreamConnection` is an alternative to `MConnection` which gives each
+`Channel` an independent stream over the underlying connection.
+
+Messages are split into data frames and the frames of the different streams
+are interleaved on the wire, so a large message on one channel (e.g. a block
+part) does not hold back the messages of the other channels (e.g. votes).
+
+Each stream has its own flow control, counted in bytes: the receiving end
+grants every stream a window, at least `defaultStreamWindowSize` bytes and
+enough for its largest message, which bounds the memory buffered for the
+stream. The sender spends credits for every frame, and the remote end grants
+new credits once the messages have been handed to the `onReceive` callback.
+The messages of each stream are delivered by their own routine, so a slow
+reactor only stalls its own channels.
+
+Unlike `MConnection`, which calls `onReceive` from its single recv routine,
+`onReceive` may be called concurrently for different channels. The messages
+of one channel are still delivered in order, one at a time. Reactors sharing
+state between their channels must synchronize it themselves.
+
+The API is the same as the one of `MConnection`.
+*/
+type StreamConnection struct {
+	service.BaseService
+
+	conn          net.Conn
+	bufConnReader *bufio.Reader
+	sendMonitor   *flow.Monitor
+	recvMonitor   *flow.Monitor
+	streams       []*stream
+	streamsIdx    map[byte]*stream
+	onReceive     receiveCbFunc
+	onError       errorCbFunc
+	errored       uint32
+	config        MConnConfig
+
+	// serializes the frames written to conn
+	writeMtx tmsync.Mutex
+
+	ping chan struct{}
+	pong chan struct{}
+
+	created time.Time // time of creation
+}
+
+// NewStreamConnection wraps net.Conn and creates a stream connection with a
+// config.
+func NewStreamConnection(
+	conn net.Conn,
+	chDescs []*ChannelDescriptor,
+	onReceive receiveCbFunc,
+	onError errorCbFunc,
+	config MConnConfig,
+) *StreamConnection {
+	if config.PongTimeout >= config.PingInterval {
+		panic("pongTimeout must be less than pingInterval (otherwise, next ping will reset pong timer)")
+	}
+
+	sconn := &StreamConnection{
+		conn:          conn,
+		bufConnReader: bufio.NewReaderSize(conn, minReadBufferSize),
+		sendMonitor:   flow.New(0, 0),
+		recvMonitor:   flow.New(0, 0),
+		onReceive:     onReceive,
+		onError:       onError,
+		config:        config,
+		ping:          make(chan struct{}, 1),
+		pong:          make(chan struct{}, 1),
+		created:       time.Now(),
+	}
+
+	var streamsIdx = map[byte]*stream{}
+	var streams = []*stream{}
+
+	for _, desc := range chDescs {
+		s := newStream(sconn, *desc)
+		streamsIdx[s.desc.ID] = s
+		streams = append(streams, s)
+	}
+	sconn.streams = streams
+	sconn.streamsIdx = streamsIdx
+
+	sconn.BaseService = *service.NewBaseService(nil, "StreamConnection", sconn)
+
+	return sconn
+}
+
+func (c *StreamConnection) SetLogger(l log.Logger) {
+	c.BaseService.SetLogger(l)
+	for _, s := range c.streams {
+		s.Logger = l
+	}
+}
+
+// OnStart implements BaseService
+func (c *StreamConnection) OnStart() error {
+	if err := c.BaseService.OnStart(); err != nil {
+		return err
+	}
+	for _, s := range c.streams {
+		go s.sendRoutine()
+		go s.deliverRoutine()
+	}
+	go c.recvRoutine()
+	go c.pingRoutine()
+	return nil
+}
+
+// FlushStop gives the streams a chance to send their queued messages
+// before stopping the connection.
+func (c *StreamConnection) FlushStop() {
+	if !c.IsRunning() {
+		return
+	}
+
+	timeout := time.After(streamFlushTimeout)
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+
+FOR_LOOP:
+	for _, s := range c.streams {
+		for !s.isFlushed() {
+			select {
+			case <-ticker.C:
+			case <-timeout:
+				c.Logger.Debug("Timed out flushing streams", "conn", c)
+				break FOR_LOOP
+			case <-c.Quit():
+				return
+			}
+		}
+	}
+
+	if err := c.Stop(); err != nil {
+		c.Logger.Error("Error stopping connection", "err", err)
+	}
+}
+
+// OnStop implements BaseService
+func (c *StreamConnection) OnStop() {
+	c.BaseService.OnStop()
+	c.conn.Close()
+}
+
+func (c *StreamConnection) String() string {
+	if c.conn != nil {
+		return fmt.Sprintf("StreamConn{%v}", c.conn.RemoteAddr())
+	}
+	return "StreamConn{nil}"
+}
+
+// Catch panics, usually caused by remote disconnects.
+func (c *StreamConnection) _recover() {
+	if r := recover(); r != nil {
+		c.Logger.Error("StreamConnection panicked", "err", r, "stack", string(debug.Stack()))
+		c.stopForError(fmt.Errorf("recovered from panic: %v", r))
+	}
+}
+
+func (c *StreamConnection) stopForError(r interface{}) {
+	if err := c.Stop(); err != nil && !errors.Is(err, service.ErrAlreadyStopped) {
+		c.Logger.Error("Error stopping connection", "err", err)
+	}
+	if atomic.CompareAndSwapUint32(&c.errored, 0, 1) {
+		if c.onError != nil {
+			c.onError(r)
+		}
+	}
+}
+
+// Send queues a message to be sent to channel.
+func (c *StreamConnection) Send(chID byte, msgBytes []byte) bool {
+	if !c.IsRunning() {
+		return false
+	}
+
+	s, ok := c.streamsIdx[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Cannot send bytes, unknown channel %X", chID))
+		return false
+	}
+
+	success := s.sendBytes(msgBytes)
+	if !success {
+		c.Logger.Debug("Send failed", "channel", chID, "conn", c, "msgBytes", fmt.Sprintf("%X", msgBytes))
+	}
+	return success
+}
+
+// TrySend queues a message to be sent to channel.
+// Nonblocking, returns true if successful.
+func (c *StreamConnection) TrySend(chID byte, msgBytes []byte) bool {
+	if !c.IsRunning() {
+		return false
+	}
+
+	s, ok := c.streamsIdx[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Cannot send bytes, unknown channel %X", chID))
+		return false
+	}
+
+	return s.trySendBytes(msgBytes)
+}
+
+// CanSend returns true if you can send more data onto the chID, false
+// otherwise. Use only as a heuristic.
+func (c *StreamConnection) CanSend(chID byte) bool {
+	if !c.IsRunning() {
+		return false
+	}
+
+	s, ok := c.streamsIdx[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Unknown channel %X", chID))
+		return false
+	}
+	return s.canSend()
+}
+
+func (c *StreamConnection) Status() ConnectionStatus {
+	var status ConnectionStatus
+	status.Duration = time.Since(c.created)
+	status.SendMonitor = c.sendMonitor.Status()
+	status.RecvMonitor = c.recvMonitor.Status()
+	status.Channels = make([]ChannelStatus, len(c.streams))
+	for i, s := range c.streams {
+		status.Channels[i] = ChannelStatus{
+			ID:                s.desc.ID,
+			SendQueueCapacity: cap(s.sendQueue),
+			SendQueueSize:     int(atomic.LoadInt32(&s.sendQueueSize)),
+			Priority:          s.desc.Priority,
+			RecentlySent:      s.sendMonitor.Status().CurRate,
+			Traffic:           s.traffic(),
+		}
+	}
+	return status
+}
+
+// writeFrame writes a single frame to the connection. Frames of different
+// streams are never interleaved within a frame.
+func (c *StreamConnection) writeFrame(frameType, chID, flags byte, payload []byte) error {
+	frame := make([]byte, streamFrameHeaderSize+len(payload))
+	frame[0] = frameType
+	frame[1] = chID
+	frame[2] = flags
+	binary.BigEndian.PutUint32(frame[3:streamFrameHeaderSize], uint32(len(payload)))
+	copy(frame[streamFrameHeaderSize:], payload)
+
+	c.writeMtx.Lock()
+	defer c.writeMtx.Unlock()
+
+	// Block until sendMonitor allows sending
+	c.sendMonitor.Limit(len(frame), atomic.LoadInt64(&c.config.SendRate), true)
+
+	n, err := c.conn.Write(frame)
+	c.sendMonitor.Update(n)
+	return err
+}
+
+// recvRoutine reads frames and dispatches them to the streams.
+// Blocks depending on how the connection is throttled.
+func (c *StreamConnection) recvRoutine() {
+	defer c._recover()
+
+	header := make([]byte, streamFrameHeaderSize)
+
+	for {
+		// Block until recvMonitor allows reading
+		c.recvMonitor.Limit(streamMaxFrameSize, atomic.LoadInt64(&c.config.RecvRate), true)
+
+		if _, err := io.ReadFull(c.bufConnReader, header); err != nil {
+			if c.IsRunning() {
+				if err == io.EOF {
+					c.Logger.Info("Connection is closed @ recvRoutine (likely by the other side)", "conn", c)
+				} else {
+					c.Logger.Debug("Connection failed @ recvRoutine (reading frame)", "conn", c, "err", err)
+				}
+				c.stopForError(err)
+			}
+			return
+		}
+
+		frameType, chID, flags := header[0], header[1], header[2]
+		length := binary.BigEndian.Uint32(header[3:])
+		if length > streamMaxFramePayloadSize {
+			c.stopForError(fmt.Errorf("frame payload of %d bytes exceeds the maximum of %d bytes",
+				length, streamMaxFramePayloadSize))
+			return
+		}
+
+		payload := make([]byte, length)
+		if _, err := io.ReadFull(c.bufConnReader, payload); err != nil {
+			if c.IsRunning() {
+				c.Logger.Debug("Connection failed @ recvRoutine (reading payload)", "conn", c, "err", err)
+				c.stopForError(err)
+			}
+			return
+		}
+		c.recvMonitor.Update(streamFrameHeaderSize + len(payload))
+
+		var err error
+		switch frameType {
+		case streamFrameData:
+			s, ok := c.streamsIdx[chID]
+			if !ok {
+				err = fmt.Errorf("unknown channel %X", chID)
+				break
+			}
+			err = s.recvFrame(payload, flags&streamFlagEOF != 0)
+
+		case streamFrameCredit:
+			s, ok := c.streamsIdx[chID]
+			if !ok {
+				err = fmt.Errorf("unknown channel %X", chID)
+				break
+			}
+			if len(payload) != streamCreditSize {
+				err = fmt.Errorf("invalid credit frame of %d bytes", len(payload))
+				break
+			}
+			err = s.addCredits(int(binary.BigEndian.Uint32(payload)))
+
+		case streamFramePing:
+			c.Logger.P2PDebug("Receive Ping")
+			select {
+			case c.ping <- struct{}{}:
+			default:
+				// never block
+			}
+
+		case streamFramePong:
+			c.Logger.P2PDebug("Receive Pong")
+			select {
+			case c.pong <- struct{}{}:
+			default:
+				// never block
+			}
+
+		default:
+			err = fmt.Errorf("unknown frame type %X", frameType)
+		}
+
+		if err != nil {
+			if c.IsRunning() {
+				c.Logger.Debug("Connection failed @ recvRoutine", "conn", c, "err", err)
+				c.stopForError(err)
+			}
+			return
+		}
+	}
+}
+
+// pingRoutine pings the peer periodically, answers its pings and closes the
+// connection if the pong does not arrive in time. recvRoutine never writes,
+// so that both ends can't block each other.
+func (c *StreamConnection) pingRoutine() {
+	defer c._recover()
+
+	pingTicker := time.NewTicker(c.config.PingInterval)
+	defer pingTicker.Stop()
+
+	var pongTimeout <-chan time.Time
+
+	for {
+		select {
+		case <-pingTicker.C:
+			c.Logger.P2PDebug("Send Ping")
+			if err := c.writeFrame(streamFramePing, 0, 0, nil); err != nil {
+				if c.IsRunning() {
+					c.Logger.Debug("Failed to send Ping", "err", err)
+					c.stopForError(err)
+				}
+				return
+			}
+			pongTimeout = time.After(c.config.PongTimeout)
+
+		case <-c.ping:
+			c.Logger.P2PDebug("Send Pong")
+			if err := c.writeFrame(streamFramePong, 0, 0, nil); err != nil {
+				if c.IsRunning() {
+					c.Logger.Debug("Failed to send Pong", "err", err)
+					c.stopForError(err)
+				}
+				return
+			}
+
+		case <-c.pong:
+			pongTimeout = nil
+
+		case <-pongTimeout:
+			c.Logger.Debug("Pong timeout")
+			c.stopForError(errors.New("pong timeout"))
+			return
+
+		case <-c.Quit():
+			return
+		}
+	}
+}
+
+//-----------------------------------------------------------------------------
+
+// stream is the sending and receiving end of a channel of a StreamConnection.
+type stream struct {
+	conn          *StreamConnection
+	desc          ChannelDescriptor
+	sendQueue     chan []byte
+	sendQueueSize int32 // atomic.
+	sending       int32 // atomic. 1 while a message is being written
+
+	// credits is the number of bytes the stream may send before the remote
+	// end grants new ones. creditsAdded wakes up sendRoutine.
+	creditsMtx   tmsync.Mutex
+	credits      int
+	creditsAdded chan struct{}
+
+	// recving is the message being received and recvingSize the size of its
+	// frames, accessed only by recvRoutine.
+	recving     []byte
+	recvingSize int
+
+	// recvWindow is the receive buffer budget of the stream, and recvBuffered
+	// the size of the frames received and not yet delivered.
+	recvWindow   int
+	recvBuffered int64 // atomic.
+	recvMtx      tmsync.Mutex
+	recvQueue    []receivedMsg
+	recvReady    chan struct{}
+
+	msgsSent      int64 // atomic.
+	msgsReceived  int64 // atomic.
+	bytesSent     int64 // atomic.
+	bytesReceived int64 // atomic.
+	sendMonitor   *flow.Monitor
+	recvMonitor   *flow.Monitor
+
+	Logger log.Logger
+}
+
+func newStream(conn *StreamConnection, desc ChannelDescriptor) *stream {
+	desc = desc.FillDefaults()
+	if desc.Priority <= 0 {
+		panic("Channel default priority must be a positive integer")
+	}
+	return &stream{
+		conn:         conn,
+		desc:         desc,
+		sendQueue:    make(chan []byte, desc.SendQueueCapacity),
+		creditsAdded: make(chan struct{}, 1),
+		recvWindow:   streamWindowSize(desc.RecvMessageCapacity),
+		recvReady:    make(chan struct{}, 1),
+		sendMonitor:  flow.New(0, 0),
+		recvMonitor:  flow.New(0, 0),
+		Logger:       log.NewNopLogger(),
+	}
+}
+
+// receivedMsg is a message waiting for delivery, with the size of its frames.
+type receivedMsg struct {
+	msgBytes []byte
+	size     int
+}
+
+// streamWindowSize returns the receive window of a stream whose messages are
+// at most recvMessageCapacity bytes long.
+func streamWindowSize(recvMessageCapacity int) int {
+	frames := recvMessageCapacity/streamMaxFramePayloadSize + 1
+	window := recvMessageCapacity + frames*streamFrameHeaderSize
+	if window < defaultStreamWindowSize {
+		return defaultStreamWindowSize
+	}
+	if window > maxStreamWindowSize {
+		return maxStreamWindowSize
+	}
+	return window
+}
+
+// frameSize returns the size of a data frame, charged to the window of its
+// stream.
+func frameSize(payload []byte) int {
+	return streamFrameHeaderSize + len(payload)
+}
+
+// Queues message to send to this stream.
+// Goroutine-safe
+// Times out (and returns false) after defaultSendTimeout
+func (s *stream) sendBytes(bytes []byte) bool {
+	select {
+	case s.sendQueue <- bytes:
+		atomic.AddInt32(&s.sendQueueSize, 1)
+		return true
+	case <-time.After(defaultSendTimeout):
+		return false
+	}
+}
+
+// Queues message to send to this stream.
+// Nonblocking, returns true if successful.
+// Goroutine-safe
+func (s *stream) trySendBytes(bytes []byte) bool {
+	select {
+	case s.sendQueue <- bytes:
+		atomic.AddInt32(&s.sendQueueSize, 1)
+		return true
+	default:
+		return false
+	}
+}
+
+// Goroutine-safe
+func (s *stream) canSend() bool {
+	return int(atomic.LoadInt32(&s.sendQueueSize)) < s.desc.SendQueueCapacity
+}
+
+// isFlushed returns true if the stream has no queued or partially written
+// messages.
+// Goroutine-safe
+func (s *stream) isFlushed() bool {
+	return atomic.LoadInt32(&s.sendQueueSize) == 0 && atomic.LoadInt32(&s.sending) == 0
+}
+
+// sendRoutine writes the queued messages of the stream, waiting for credits
+// before each of their frames.
+func (s *stream) sendRoutine() {
+	defer s.conn._recover()
+
+	quit := s.conn.Quit()
+	for {
+		var msg []byte
+		select {
+		case msg = <-s.sendQueue:
+		case <-quit:
+			return
+		}
+		atomic.StoreInt32(&s.sending, 1)
+		atomic.AddInt32(&s.sendQueueSize, -1)
+
+		if err := s.writeMsg(msg); err != nil {
+			if s.conn.IsRunning() {
+				s.Logger.Debug("Connection failed @ sendRoutine", "conn", s.conn, "err", err)
+				s.conn.stopForError(err)
+			}
+			return
+		}
+		atomic.StoreInt32(&s.sending, 0)
+	}
+}
+
+// writeMsg splits the message into data frames and writes them, spending the
+// credits of the stream.
+func (s *stream) writeMsg(msg []byte) error {
+	for {
+		payload, flags := msg, streamFlagEOF
+		if len(msg) > streamMaxFramePayloadSize {
+			payload, flags = msg[:streamMaxFramePayloadSize], 0
+		}
+		if err := s.takeCredits(frameSize(payload)); err != nil {
+			return err
+		}
+		if err := s.conn.writeFrame(streamFrameData, s.desc.ID, flags, payload); err != nil {
+			return err
+		}
+		atomic.AddInt64(&s.bytesSent, int64(len(payload)))
+		s.sendMonitor.Update(len(payload))
+
+		if flags&streamFlagEOF != 0 {
+			atomic.AddInt64(&s.msgsSent, 1)
+			return nil
+		}
+		msg = msg[streamMaxFramePayloadSize:]
+	}
+}
+
+// takeCredits waits until the stream has n credits and spends them.
+func (s *stream) takeCredits(n int) error {
+	for {
+		s.creditsMtx.Lock()
+		if s.credits >= n {
+			s.credits -= n
+			s.creditsMtx.Unlock()
+			return nil
+		}
+		s.creditsMtx.Unlock()
+
+		select {
+		case <-s.creditsAdded:
+		case <-s.conn.Quit():
+			return errors.New("connection stopped")
+		}
+	}
+}
+
+// addCredits is called by recvRoutine when the remote end granted credits,
+// initially and after delivering messages.
+func (s *stream) addCredits(n int) error {
+	s.creditsMtx.Lock()
+	defer s.creditsMtx.Unlock()
+
+	if n > maxStreamWindowSize-s.credits {
+		return fmt.Errorf("stream %X received more credits than the maximum window of %d bytes",
+			s.desc.ID, maxStreamWindowSize)
+	}
+	s.credits += n
+
+	select {
+	case s.creditsAdded <- struct{}{}:
+	default:
+		// sendRoutine is already woken up
+	}
+	return nil
+}
+
+// recvFrame is called by recvRoutine for every data frame of the stream.
+// It queues completed messages for delivery.
+func (s *stream) recvFrame(payload []byte, eof bool) error {
+	recvCap, recvReceived := s.desc.RecvMessageCapacity, len(s.recving)+len(payload)
+	if recvCap < recvReceived {
+		return fmt.Errorf("received message exceeds available capacity: %v < %v", recvCap, recvReceived)
+	}
+	size := frameSize(payload)
+	if buffered := atomic.AddInt64(&s.recvBuffered, int64(size)); buffered > int64(s.recvWindow) {
+		return fmt.Errorf("stream %X exceeded its window of %d bytes", s.desc.ID, s.recvWindow)
+	}
+	s.recving = append(s.recving, payload...)
+	s.recvingSize += size
+	atomic.AddInt64(&s.bytesReceived, int64(len(payload)))
+	s.recvMonitor.Update(len(payload))
+
+	if !eof {
+		return nil
+	}
+
+	msg := receivedMsg{msgBytes: s.recving, size: s.recvingSize}
+	s.recving, s.recvingSize = nil, 0
+	atomic.AddInt64(&s.msgsReceived, 1)
+
+	s.recvMtx.Lock()
+	s.recvQueue = append(s.recvQueue, msg)
+	s.recvMtx.Unlock()
+
+	select {
+	case s.recvReady <- struct{}{}:
+	default:
+		// deliverRoutine is already woken up
+	}
+	return nil
+}
+
+// nextReceived waits for the next message to deliver. It returns false if the
+// connection stopped.
+func (s *stream) nextReceived() (receivedMsg, bool) {
+	for {
+		s.recvMtx.Lock()
+		if len(s.recvQueue) > 0 {
+			msg := s.recvQueue[0]
+			s.recvQueue[0] = receivedMsg{}
+			s.recvQueue = s.recvQueue[1:]
+			s.recvMtx.Unlock()
+			return msg, true
+		}
+		s.recvMtx.Unlock()
+
+		select {
+		case <-s.recvReady:
+		case <-s.conn.Quit():
+			return receivedMsg{}, false
+		}
+	}
+}
+
+// hasReceived returns true if messages are waiting for delivery.
+func (s *stream) hasReceived() bool {
+	s.recvMtx.Lock()
+	defer s.recvMtx.Unlock()
+	return len(s.recvQueue) > 0
+}
+
+// grantCredits grants the remote end n more bytes to send on the stream.
+func (s *stream) grantCredits(n int) error {
+	credit := make([]byte, streamCreditSize)
+	binary.BigEndian.PutUint32(credit, uint32(n))
+	return s.conn.writeFrame(streamFrameCredit, s.desc.ID, 0, credit)
+}
+
+// deliverRoutine grants the remote end the window of the stream, then hands
+// the received messages to the onReceive callback and grants new credits for
+// them.
+func (s *stream) deliverRoutine() {
+	defer s.conn._recover()
+
+	pending := s.recvWindow
+	for {
+		// Batch the credits unless the remote end may be waiting for them.
+		if pending > 0 && (pending >= s.recvWindow/4 || !s.hasReceived()) {
+			if err := s.grantCredits(pending); err != nil {
+				if s.conn.IsRunning() {
+					s.Logger.Debug("Connection failed @ deliverRoutine", "conn", s.conn, "err", err)
+					s.conn.stopForError(err)
+				}
+				return
+			}
+			pending = 0
+		}
+
+		msg, ok := s.nextReceived()
+		if !ok {
+			return
+		}
+
+		s.Logger.P2PDebug("Received bytes", "chID", s.desc.ID, "msgBytes", msg.msgBytes)
+		// NOTE: Each stream delivers from its own routine, so unlike with
+		// MConnection, reactor.Receive may run concurrently for the different
+		// channels of the same peer.
+		s.conn.onReceive(s.desc.ID, msg.msgBytes)
+		atomic.AddInt64(&s.recvBuffered, -int64(msg.size))
+		pending += msg.size
+	}
+}
+
+// traffic returns the traffic of the stream so far.
+// Goroutine-safe
+func (s *stream) traffic() *ChannelTraffic {
+	return &ChannelTraffic{
+		MsgsSent:      atomic.LoadInt64(&s.msgsSent),
+		MsgsReceived:  atomic.LoadInt64(&s.msgsReceived),
+		BytesSent:     atomic.LoadInt64(&s.bytesSent),
+		BytesReceived: atomic.LoadInt64(&s.bytesReceived),
+		SendRate:      s.sendMonitor.Status().CurRate,
+		RecvRate:      s.recvMonitor.Status().CurRate,
+	}
+}
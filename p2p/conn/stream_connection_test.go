@@ -0,0 +1,359 @@
+package conn
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func createStreamConnectionWithCallbacks(
+	conn net.Conn,
+	onReceive func(chID byte, msgBytes []byte),
+	onError func(r interface{}),
+) *StreamConnection {
+	cfg := DefaultMConnConfig()
+	cfg.PingInterval = 90 * time.Millisecond
+	cfg.PongTimeout = 45 * time.Millisecond
+	// fill the stream windows without throttling the pings
+	cfg.SendRate = 100 * cfg.SendRate
+	cfg.RecvRate = 100 * cfg.RecvRate
+	chDescs := []*ChannelDescriptor{
+		{ID: 0x01, Priority: 1, SendQueueCapacity: 1, RecvMessageCapacity: 10000},
+		{ID: 0x02, Priority: 1, SendQueueCapacity: 1, RecvMessageCapacity: 1024},
+	}
+	c := NewStreamConnection(conn, chDescs, onReceive, onError, cfg)
+	c.SetLogger(log.TestingLogger())
+	return c
+}
+
+func createTestStreamConnection(conn net.Conn) *StreamConnection {
+	return createStreamConnectionWithCallbacks(conn, func(byte, []byte) {}, func(interface{}) {})
+}
+
+// creditFrame returns a frame granting n credits on the channel.
+func creditFrame(chID byte, n int) []byte {
+	frame := []byte{streamFrameCredit, chID, 0, 0, 0, 0, streamCreditSize, 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(frame[streamFrameHeaderSize:], uint32(n))
+	return frame
+}
+
+func grantCredits(t *testing.T, conn net.Conn, chID byte, n int) {
+	_, err := conn.Write(creditFrame(chID, n))
+	require.NoError(t, err)
+}
+
+// readFrame reads a frame written by a StreamConnection.
+func readFrame(t *testing.T, conn net.Conn) (frameType, flags byte, payload []byte) {
+	header := make([]byte, streamFrameHeaderSize)
+	_, err := io.ReadFull(conn, header)
+	require.NoError(t, err)
+	payload = make([]byte, binary.BigEndian.Uint32(header[3:]))
+	_, err = io.ReadFull(conn, payload)
+	require.NoError(t, err)
+	return header[0], header[2], payload
+}
+
+func TestStreamConnectionSendReceive(t *testing.T) {
+	server, client := NetPipe()
+	defer server.Close()
+	defer client.Close()
+
+	receivedCh := make(chan []byte)
+	errorsCh := make(chan interface{})
+	onReceive := func(chID byte, msgBytes []byte) {
+		receivedCh <- msgBytes
+	}
+	onError := func(r interface{}) {
+		errorsCh <- r
+	}
+	sconn1 := createStreamConnectionWithCallbacks(client, onReceive, onError)
+	require.NoError(t, sconn1.Start())
+	defer sconn1.Stop() // nolint:errcheck // ignore for tests
+
+	sconn2 := createTestStreamConnection(server)
+	require.NoError(t, sconn2.Start())
+	defer sconn2.Stop() // nolint:errcheck // ignore for tests
+
+	// spans multiple data frames
+	msg := bytes.Repeat([]byte("Cyclops"), 1000)
+	assert.True(t, sconn2.Send(0x01, msg))
+
+	select {
+	case receivedBytes := <-receivedCh:
+		assert.Equal(t, msg, receivedBytes)
+	case err := <-errorsCh:
+		t.Fatalf("Expected message, got %+v", err)
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("Did not receive message in 500ms")
+	}
+
+	// the sender accounts the last frame after it was written
+	assert.Eventually(t, func() bool {
+		sent := sconn2.Status().Channels[0].Traffic
+		return sent.MsgsSent == 1 && sent.BytesSent == int64(len(msg))
+	}, time.Second, 10*time.Millisecond)
+	received := sconn1.Status().Channels[0].Traffic
+	assert.EqualValues(t, 1, received.MsgsReceived)
+	assert.EqualValues(t, len(msg), received.BytesReceived)
+
+	assert.False(t, sconn2.CanSend(0x05), "CanSend should return false because channel is unknown")
+	assert.False(t, sconn2.Send(0x05, []byte("Absorbing Man")), "Send should return false because channel is unknown")
+}
+
+func TestStreamConnectionIndependentStreams(t *testing.T) {
+	server, client := NetPipe()
+	defer server.Close()
+	defer client.Close()
+
+	// the reactor of channel 0x01 is stuck
+	unblock := make(chan struct{})
+	defer close(unblock)
+	receivedCh := make(chan []byte)
+	onReceive := func(chID byte, msgBytes []byte) {
+		if chID == 0x01 {
+			<-unblock
+			return
+		}
+		receivedCh <- msgBytes
+	}
+	sconn1 := createStreamConnectionWithCallbacks(client, onReceive, func(interface{}) {})
+	require.NoError(t, sconn1.Start())
+	defer sconn1.Stop() // nolint:errcheck // ignore for tests
+
+	sconn2 := createTestStreamConnection(server)
+	require.NoError(t, sconn2.Start())
+	defer sconn2.Stop() // nolint:errcheck // ignore for tests
+
+	// fill the window of channel 0x01, the last message waiting for credits
+	// after some of its frames
+	hulk := bytes.Repeat([]byte("Hulk"), 2250)
+	window := sconn1.streamsIdx[0x01].recvWindow
+	require.Equal(t, defaultStreamWindowSize, window)
+	msgSize := len(hulk) + (len(hulk)/streamMaxFramePayloadSize+1)*streamFrameHeaderSize
+	for i := 0; i <= window/msgSize; i++ {
+		require.True(t, sconn2.Send(0x01, hulk))
+	}
+	s := sconn2.streamsIdx[0x01]
+	assert.Eventually(t, func() bool {
+		s.creditsMtx.Lock()
+		defer s.creditsMtx.Unlock()
+		return s.credits < streamMaxFrameSize && !s.isFlushed() && len(s.sendQueue) == 0
+	}, time.Second, 10*time.Millisecond)
+	assert.EqualValues(t, window/msgSize, sconn2.Status().Channels[0].Traffic.MsgsSent)
+	// the send queue holds a single message
+	require.True(t, sconn2.TrySend(0x01, hulk))
+	assert.False(t, sconn2.TrySend(0x01, hulk), "stream should be out of credits")
+
+	// channel 0x02 is not affected
+	msg := []byte("Quicksilver")
+	require.True(t, sconn2.Send(0x02, msg))
+	select {
+	case receivedBytes := <-receivedCh:
+		assert.Equal(t, msg, receivedBytes)
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("Did not receive message in 500ms")
+	}
+	assert.EqualValues(t, window/msgSize, sconn2.Status().Channels[0].Traffic.MsgsSent)
+}
+
+func TestStreamConnectionFramesFitSecretConnection(t *testing.T) {
+	server, client := NetPipe()
+	defer server.Close()
+	defer client.Close()
+
+	sconn := createTestStreamConnection(client)
+	require.NoError(t, sconn.Start())
+	defer sconn.Stop() // nolint:errcheck // ignore for tests
+
+	grantCredits(t, server, 0x01, defaultStreamWindowSize)
+	msg := bytes.Repeat([]byte("Cyclops"), 1000)
+	require.True(t, sconn.Send(0x01, msg))
+
+	var received []byte
+	for {
+		frameType, flags, payload := readFrame(t, server)
+		if frameType != streamFrameData {
+			continue
+		}
+		assert.LessOrEqual(t, streamFrameHeaderSize+len(payload), dataMaxSize)
+		received = append(received, payload...)
+		if flags&streamFlagEOF != 0 {
+			break
+		}
+	}
+	assert.Equal(t, msg, received)
+}
+
+func TestStreamConnectionRejectsFramesOverWindow(t *testing.T) {
+	server, client := NetPipe()
+	defer server.Close()
+	defer client.Close()
+
+	errorsCh := make(chan interface{}, 1)
+	onError := func(r interface{}) {
+		errorsCh <- r
+	}
+	// the reactor of channel 0x01 is stuck
+	unblock := make(chan struct{})
+	defer close(unblock)
+	sconn := createStreamConnectionWithCallbacks(client, func(byte, []byte) { <-unblock }, onError)
+	require.NoError(t, sconn.Start())
+	defer sconn.Stop() // nolint:errcheck // ignore for tests
+
+	// the remote end ignores the credits
+	frame := make([]byte, streamMaxFrameSize)
+	frame[0], frame[1], frame[2] = streamFrameData, 0x01, streamFlagEOF
+	binary.BigEndian.PutUint32(frame[3:streamFrameHeaderSize], streamMaxFramePayloadSize)
+	go func() {
+		for i := 0; i <= defaultStreamWindowSize/streamMaxFrameSize; i++ {
+			if _, err := server.Write(frame); err != nil {
+				return
+			}
+		}
+	}()
+	go func() {
+		_, _ = io.Copy(ioutil.Discard, server)
+	}()
+
+	select {
+	case err := <-errorsCh:
+		assert.Contains(t, fmt.Sprint(err), "exceeded its window")
+	case <-time.After(3 * time.Second):
+		t.Fatal("Expected an error for frames exceeding the window")
+	}
+}
+
+func TestStreamConnectionRejectsOversizedMessage(t *testing.T) {
+	server, client := NetPipe()
+	defer server.Close()
+	defer client.Close()
+
+	errorsCh := make(chan interface{}, 1)
+	onError := func(r interface{}) {
+		errorsCh <- r
+	}
+	sconn1 := createStreamConnectionWithCallbacks(client, func(byte, []byte) {}, onError)
+	require.NoError(t, sconn1.Start())
+	defer sconn1.Stop() // nolint:errcheck // ignore for tests
+
+	sconn2 := createTestStreamConnection(server)
+	require.NoError(t, sconn2.Start())
+	defer sconn2.Stop() // nolint:errcheck // ignore for tests
+
+	require.True(t, sconn2.Send(0x02, make([]byte, 1025)))
+	select {
+	case err := <-errorsCh:
+		assert.NotNil(t, err)
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("Expected an error for a message exceeding the channel capacity")
+	}
+	assert.False(t, sconn1.IsRunning())
+}
+
+func TestStreamConnectionSendFlushStop(t *testing.T) {
+	server, client := NetPipe()
+	defer server.Close()
+	defer client.Close()
+
+	sconn := createTestStreamConnection(client)
+	require.NoError(t, sconn.Start())
+
+	msg := []byte("abc")
+	assert.True(t, sconn.Send(0x01, msg))
+
+	var read int32
+	go func() {
+		if _, err := server.Write(creditFrame(0x01, defaultStreamWindowSize)); err != nil {
+			return
+		}
+		frame := make([]byte, streamFrameHeaderSize)
+		for {
+			if _, err := io.ReadFull(server, frame); err != nil {
+				return
+			}
+			payload := make([]byte, binary.BigEndian.Uint32(frame[3:]))
+			if _, err := io.ReadFull(server, payload); err != nil {
+				return
+			}
+			if frame[0] == streamFrameData && bytes.Equal(payload, msg) {
+				atomic.StoreInt32(&read, 1)
+				return
+			}
+		}
+	}()
+
+	// stop the conn - it should flush the streams
+	sconn.FlushStop()
+	assert.False(t, sconn.IsRunning())
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&read) == 1
+	}, 3*time.Second, 10*time.Millisecond)
+}
+
+func TestStreamConnectionPongTimeoutResultsInError(t *testing.T) {
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	errorsCh := make(chan interface{})
+	onError := func(r interface{}) {
+		errorsCh <- r
+	}
+	sconn := createStreamConnectionWithCallbacks(client, func(byte, []byte) {}, onError)
+	require.NoError(t, sconn.Start())
+	defer sconn.Stop() // nolint:errcheck // ignore for tests
+
+	// read ping, skipping the credits, and do not respond
+	for {
+		frameType, _, _ := readFrame(t, server)
+		if frameType == streamFramePing {
+			break
+		}
+		require.Equal(t, streamFrameCredit, frameType)
+	}
+
+	pongTimerExpired := sconn.config.PongTimeout + 200*time.Millisecond
+	select {
+	case err := <-errorsCh:
+		assert.NotNil(t, err)
+	case <-time.After(pongTimerExpired):
+		t.Fatalf("Expected to receive error after %v", pongTimerExpired)
+	}
+}
+
+func TestStreamConnectionPingPongs(t *testing.T) {
+	server, client := NetPipe()
+	defer server.Close()
+	defer client.Close()
+
+	errorsCh := make(chan interface{}, 2)
+	onError := func(r interface{}) {
+		errorsCh <- r
+	}
+	sconn1 := createStreamConnectionWithCallbacks(client, func(byte, []byte) {}, onError)
+	require.NoError(t, sconn1.Start())
+	defer sconn1.Stop() // nolint:errcheck // ignore for tests
+
+	sconn2 := createStreamConnectionWithCallbacks(server, func(byte, []byte) {}, onError)
+	require.NoError(t, sconn2.Start())
+	defer sconn2.Stop() // nolint:errcheck // ignore for tests
+
+	select {
+	case err := <-errorsCh:
+		t.Fatalf("Expected no error, but got %v", err)
+	case <-time.After(3 * sconn1.config.PingInterval):
+		assert.True(t, sconn1.IsRunning())
+		assert.True(t, sconn2.IsRunning())
+	}
+}
@@ -23,6 +23,16 @@ type Metrics struct {
 	PeerSendBytesTotal metrics.Counter
 	// Pending bytes to be sent to a given peer.
 	PeerPendingSendBytes metrics.Gauge
+	// Number of messages received from a given peer.
+	PeerReceiveMessagesTotal metrics.Counter
+	// Number of messages sent to a given peer, once written to the connection.
+	PeerSendMessagesTotal metrics.Counter
+	// Number of messages queued to be sent to a given peer on a channel.
+	PeerChannelSendQueueSize metrics.Gauge
+	// Rate of the bytes sent to a given peer on a channel.
+	PeerChannelSendRate metrics.Gauge
+	// Rate of the bytes received from a given peer on a channel.
+	PeerChannelRecvRate metrics.Gauge
 	// Number of transactions submitted by each peer.
 	NumTxs metrics.Gauge
 }
@@ -60,6 +70,36 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "peer_pending_send_bytes",
 			Help:      "Number of pending bytes to be sent to a given peer.",
 		}, append(labels, "peer_id")).With(labelsAndValues...),
+		PeerReceiveMessagesTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_receive_messages_total",
+			Help:      "Number of messages received from a given peer.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		PeerSendMessagesTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_send_messages_total",
+			Help:      "Number of messages sent to a given peer, once written to the connection.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		PeerChannelSendQueueSize: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_channel_send_queue_size",
+			Help:      "Number of messages queued to be sent to a given peer on a channel.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		PeerChannelSendRate: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_channel_send_rate",
+			Help:      "Rate of the bytes sent to a given peer on a channel, in bytes/s.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		PeerChannelRecvRate: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_channel_recv_rate",
+			Help:      "Rate of the bytes received from a given peer on a channel, in bytes/s.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
 		NumTxs: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -72,10 +112,15 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 // NopMetrics returns no-op Metrics.
 func NopMetrics() *Metrics {
 	return &Metrics{
-		Peers:                 discard.NewGauge(),
-		PeerReceiveBytesTotal: discard.NewCounter(),
-		PeerSendBytesTotal:    discard.NewCounter(),
-		PeerPendingSendBytes:  discard.NewGauge(),
-		NumTxs:                discard.NewGauge(),
+		Peers:                    discard.NewGauge(),
+		PeerReceiveBytesTotal:    discard.NewCounter(),
+		PeerSendBytesTotal:       discard.NewCounter(),
+		PeerPendingSendBytes:     discard.NewGauge(),
+		PeerReceiveMessagesTotal: discard.NewCounter(),
+		PeerSendMessagesTotal:    discard.NewCounter(),
+		PeerChannelSendQueueSize: discard.NewGauge(),
+		PeerChannelSendRate:      discard.NewGauge(),
+		PeerChannelRecvRate:      discard.NewGauge(),
+		NumTxs:                   discard.NewGauge(),
 	}
 }
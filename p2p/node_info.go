@@ -105,8 +105,18 @@ type DefaultNodeInfo struct {
 type DefaultNodeInfoOther struct {
 	TxIndex    string `json:"tx_index"`
 	RPCAddress string `json:"rpc_address"`
+	Transport  string `json:"transport"` // connection of the peers, see TransportMConn
 }
 
+const (
+	// TransportMConn is the transport of the nodes connecting their peers
+	// with an MConnection. Nodes not reporting a transport use it.
+	TransportMConn = "mconn"
+	// TransportStream is the transport of the nodes connecting their peers
+	// with a StreamConnection.
+	TransportStream = "stream"
+)
+
 // ID returns the node's peer ID.
 func (info DefaultNodeInfo) ID() ID {
 	return info.DefaultNodeID
@@ -179,13 +189,20 @@ func (info DefaultNodeInfo) Validate() error {
 	if len(rpcAddr) > 0 && (!tmstrings.IsASCIIText(rpcAddr) || tmstrings.ASCIITrim(rpcAddr) == "") {
 		return fmt.Errorf("info.Other.RPCAddress=%v must be valid ASCII text without tabs", rpcAddr)
 	}
+	transport := other.Transport
+	switch transport {
+	case "", TransportMConn, TransportStream:
+	default:
+		return fmt.Errorf("info.Other.Transport should be either '%s', '%s', or empty string, got '%v'",
+			TransportMConn, TransportStream, transport)
+	}
 
 	return nil
 }
 
 // CompatibleWith checks if two DefaultNodeInfo are compatible with eachother.
-// CONTRACT: two nodes are compatible if the Block version and network match
-// and they have at least one channel in common.
+// CONTRACT: two nodes are compatible if the Block version, network and
+// transport match and they have at least one channel in common.
 func (info DefaultNodeInfo) CompatibleWith(otherInfo NodeInfo) error {
 	other, ok := otherInfo.(DefaultNodeInfo)
 	if !ok {
@@ -202,6 +219,11 @@ func (info DefaultNodeInfo) CompatibleWith(otherInfo NodeInfo) error {
 		return fmt.Errorf("peer is on a different network. Got %v, expected %v", other.Network, info.Network)
 	}
 
+	// nodes must connect their peers the same way
+	if info.transport() != other.transport() {
+		return fmt.Errorf("peer uses a different transport. Got %v, expected %v", other.transport(), info.transport())
+	}
+
 	// if we have no channels, we're just testing
 	if len(info.Channels) == 0 {
 		return nil
@@ -237,6 +259,14 @@ func (info DefaultNodeInfo) HasChannel(chID byte) bool {
 	return bytes.Contains(info.Channels, []byte{chID})
 }
 
+// transport returns the transport of the node, defaulting to TransportMConn.
+func (info DefaultNodeInfo) transport() string {
+	if info.Other.Transport == "" {
+		return TransportMConn
+	}
+	return info.Other.Transport
+}
+
 func (info DefaultNodeInfo) ToProto() *tmp2p.DefaultNodeInfo {
 
 	dni := new(tmp2p.DefaultNodeInfo)
@@ -258,6 +288,7 @@ func (info DefaultNodeInfo) ToProto() *tmp2p.DefaultNodeInfo {
 	dni.Other = tmp2p.DefaultNodeInfoOther{
 		TxIndex:    info.Other.TxIndex,
 		RPCAddress: info.Other.RPCAddress,
+		Transport:  info.Other.Transport,
 	}
 
 	return dni
@@ -282,6 +313,7 @@ func DefaultNodeInfoFromToProto(pb *tmp2p.DefaultNodeInfo) (DefaultNodeInfo, err
 		Other: DefaultNodeInfoOther{
 			TxIndex:    pb.Other.TxIndex,
 			RPCAddress: pb.Other.RPCAddress,
+			Transport:  pb.Other.Transport,
 		},
 	}
 
@@ -292,3 +324,44 @@ func DefaultNodeInfoFromToProto(pb *tmp2p.DefaultNodeInfo) (DefaultNodeInfo, err
 
 	return dni, nil
 }
+
+//-------------------------------------------------------------
+
+// proTxHashAuthP2PProtocol is the first P2P protocol version in which the
+// nodes prove the proTxHash of their node info during the handshake. The
+// proTxHash claims of the peers on an earlier version can't be trusted.
+const proTxHashAuthP2PProtocol = 9
+
+// ProTxHashSigner proves that this node is the masternode with the proTxHash
+// of its node info.
+type ProTxHashSigner interface {
+	// SignProTxHash signs the SHA256 message hash with the operator BLS key of
+	// the masternode.
+	SignProTxHash(msgHash []byte) ([]byte, error)
+}
+
+// ProTxHashVerifier verifies the proofs of the proTxHash claims of the peers.
+type ProTxHashVerifier interface {
+	// VerifyProTxHash returns whether the signature of the SHA256 message hash
+	// was made with the operator BLS key of the masternode with the given
+	// proTxHash. It returns an error if the key can't be found, e.g. because
+	// the masternode is unknown.
+	VerifyProTxHash(proTxHash crypto.ProTxHash, msgHash, signature []byte) (bool, error)
+}
+
+// proTxHashAuthMsgHash returns the hash of the message signed by a node to
+// prove its proTxHash. The challenge binds the proof to a session, and the node
+// ID of the signer keeps the peer from sending the proof back as its own.
+func proTxHashAuthMsgHash(challenge []byte, id ID, proTxHash crypto.ProTxHash) []byte {
+	msg := make([]byte, 0, len(challenge)+len(id)+len(proTxHash))
+	msg = append(msg, challenge...)
+	msg = append(msg, id...)
+	msg = append(msg, proTxHash...)
+	return crypto.Sha256(msg)
+}
+
+// supportsProTxHashAuth returns true if the node proves the proTxHash of its
+// node info during the handshake.
+func (info DefaultNodeInfo) supportsProTxHashAuth() bool {
+	return info.ProtocolVersion.P2P >= proTxHashAuthP2PProtocol
+}
@@ -64,6 +64,10 @@ func TestNodeInfoValidate(t *testing.T) {
 		{"Empty space RPCAddress", func(ni *DefaultNodeInfo) { ni.Other.RPCAddress = emptySpace }, true},
 		{"Empty RPCAddress", func(ni *DefaultNodeInfo) { ni.Other.RPCAddress = "" }, false},
 		{"Good RPCAddress", func(ni *DefaultNodeInfo) { ni.Other.RPCAddress = "0.0.0.0:26657" }, false},
+
+		{"Unknown Transport", func(ni *DefaultNodeInfo) { ni.Other.Transport = "quic" }, true},
+		{"Empty Transport", func(ni *DefaultNodeInfo) { ni.Other.Transport = "" }, false},
+		{"Stream Transport", func(ni *DefaultNodeInfo) { ni.Other.Transport = TransportStream }, false},
 	}
 
 	nodeKey := NodeKey{PrivKey: ed25519.GenPrivKey()}
@@ -106,6 +110,10 @@ func TestNodeInfoCompatible(t *testing.T) {
 	assert.True(t, ni2.HasChannel(newTestChannel))
 	assert.NoError(t, ni1.CompatibleWith(ni2))
 
+	// an empty transport is an mconn transport
+	ni2.Other.Transport = TransportMConn
+	assert.NoError(t, ni1.CompatibleWith(ni2))
+
 	// wrong NodeInfo type is not compatible
 	_, netAddr := CreateRoutableAddr()
 	ni3 := mockNodeInfo{netAddr}
@@ -118,6 +126,7 @@ func TestNodeInfoCompatible(t *testing.T) {
 		{"Wrong block version", func(ni *DefaultNodeInfo) { ni.ProtocolVersion.Block++ }},
 		{"Wrong network", func(ni *DefaultNodeInfo) { ni.Network += "-wrong" }},
 		{"No common channels", func(ni *DefaultNodeInfo) { ni.Channels = []byte{newTestChannel} }},
+		{"Wrong transport", func(ni *DefaultNodeInfo) { ni.Other.Transport = TransportStream }},
 	}
 
 	for _, tc := range testCases {
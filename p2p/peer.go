@@ -102,9 +102,11 @@ func (pc peerConn) RemoteIP() net.IP {
 type peer struct {
 	service.BaseService
 
-	// raw peerConn and the multiplex connection
+	// raw peerConn and the connection carrying the channels
 	peerConn
-	mconn *tmconn.MConnection
+	mconn tmconn.Connection
+	// creates mconn, defaults to an MConnection
+	newConn connFactory
 
 	// peer's node info and the channel it knows about
 	// channels = nodeInfo.Channels
@@ -121,6 +123,23 @@ type peer struct {
 
 type PeerOption func(*peer)
 
+// connFactory creates the connection carrying the channels of a peer.
+type connFactory func(
+	conn net.Conn,
+	chDescs []*tmconn.ChannelDescriptor,
+	onReceive func(chID byte, msgBytes []byte),
+	onError func(r interface{}),
+	config tmconn.MConnConfig,
+) tmconn.Connection
+
+// peerConnection sets the factory of the connection carrying the channels of
+// the peer.
+func peerConnection(newConn connFactory) PeerOption {
+	return func(p *peer) {
+		p.newConn = newConn
+	}
+}
+
 func newPeer(
 	pc peerConn,
 	mConfig tmconn.MConnConfig,
@@ -137,9 +156,14 @@ func newPeer(
 		Data:          cmap.NewCMap(),
 		metricsTicker: time.NewTicker(metricsTickerDuration),
 		metrics:       NopMetrics(),
+		newConn:       newMConnection,
 	}
 
-	p.mconn = createMConnection(
+	p.BaseService = *service.NewBaseService(nil, "Peer", p)
+	for _, option := range options {
+		option(p)
+	}
+	p.mconn = createConnection(
 		pc.conn,
 		p,
 		reactorsByCh,
@@ -147,10 +171,6 @@ func newPeer(
 		onPeerError,
 		mConfig,
 	)
-	p.BaseService = *service.NewBaseService(nil, "Peer", p)
-	for _, option := range options {
-		option(p)
-	}
 
 	return p
 }
@@ -359,6 +379,10 @@ func PeerMetrics(metrics *Metrics) PeerOption {
 }
 
 func (p *peer) metricsReporter() {
+	// the messages sent are counted once they are written to the connection,
+	// the same as in the traffic of the channels; msgsSent holds the count of
+	// each channel which was reported already
+	msgsSent := make(map[byte]int64)
 	for {
 		select {
 		case <-p.metricsTicker.C:
@@ -366,6 +390,19 @@ func (p *peer) metricsReporter() {
 			var sendQueueSize float64
 			for _, chStatus := range status.Channels {
 				sendQueueSize += float64(chStatus.SendQueueSize)
+
+				labels := []string{
+					"peer_id", string(p.ID()),
+					"chID", fmt.Sprintf("%#x", chStatus.ID),
+				}
+				p.metrics.PeerChannelSendQueueSize.With(labels...).Set(float64(chStatus.SendQueueSize))
+				if chStatus.Traffic != nil {
+					p.metrics.PeerChannelSendRate.With(labels...).Set(float64(chStatus.Traffic.SendRate))
+					p.metrics.PeerChannelRecvRate.With(labels...).Set(float64(chStatus.Traffic.RecvRate))
+					p.metrics.PeerSendMessagesTotal.With(labels...).Add(
+						float64(chStatus.Traffic.MsgsSent - msgsSent[chStatus.ID]))
+					msgsSent[chStatus.ID] = chStatus.Traffic.MsgsSent
+				}
 			}
 
 			p.metrics.PeerPendingSendBytes.With("peer_id", string(p.ID())).Set(sendQueueSize)
@@ -378,14 +415,14 @@ func (p *peer) metricsReporter() {
 //------------------------------------------------------------------
 // helper funcs
 
-func createMConnection(
+func createConnection(
 	conn net.Conn,
 	p *peer,
 	reactorsByCh map[byte]Reactor,
 	chDescs []*tmconn.ChannelDescriptor,
 	onPeerError func(Peer, interface{}),
 	config tmconn.MConnConfig,
-) *tmconn.MConnection {
+) tmconn.Connection {
 
 	onReceive := func(chID byte, msgBytes []byte) {
 		reactor := reactorsByCh[chID]
@@ -399,6 +436,7 @@ func createMConnection(
 			"chID", fmt.Sprintf("%#x", chID),
 		}
 		p.metrics.PeerReceiveBytesTotal.With(labels...).Add(float64(len(msgBytes)))
+		p.metrics.PeerReceiveMessagesTotal.With(labels...).Add(1)
 		reactor.Receive(chID, p, msgBytes)
 	}
 
@@ -406,7 +444,7 @@ func createMConnection(
 		onPeerError(p, r)
 	}
 
-	return tmconn.NewMConnectionWithConfig(
+	return p.newConn(
 		conn,
 		chDescs,
 		onReceive,
@@ -414,3 +452,23 @@ func createMConnection(
 		config,
 	)
 }
+
+func newMConnection(
+	conn net.Conn,
+	chDescs []*tmconn.ChannelDescriptor,
+	onReceive func(chID byte, msgBytes []byte),
+	onError func(r interface{}),
+	config tmconn.MConnConfig,
+) tmconn.Connection {
+	return tmconn.NewMConnectionWithConfig(conn, chDescs, onReceive, onError, config)
+}
+
+func newStreamConnection(
+	conn net.Conn,
+	chDescs []*tmconn.ChannelDescriptor,
+	onReceive func(chID byte, msgBytes []byte),
+	onError func(r interface{}),
+	config tmconn.MConnConfig,
+) tmconn.Connection {
+	return tmconn.NewStreamConnection(conn, chDescs, onReceive, onError, config)
+}
@@ -3,6 +3,7 @@ package pex
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -52,6 +53,13 @@ const (
 
 	// if a peer is marked bad, it will be banned for at least this time period
 	defaultBanTime = 24 * time.Hour
+
+	// the trust score of the peers whose trust isn't tracked (yet)
+	defaultTrustScore = 100
+
+	// when all the inbound slots are taken, the inbound peer with the lowest
+	// trust score is evicted if its score is below this
+	minInboundTrustScore = 50
 )
 
 type errMaxAttemptsToDial struct {
@@ -464,6 +472,10 @@ func (r *Reactor) ensurePeers() {
 		"numToDial", numToDial,
 	)
 
+	if in >= r.Switch.MaxNumInboundPeers() {
+		r.evictLowTrustPeer()
+	}
+
 	if numToDial <= 0 {
 		return
 	}
@@ -473,16 +485,17 @@ func (r *Reactor) ensurePeers() {
 	// NOTE: range here is [10, 90]. Too high ?
 	newBias := tmmath.MinInt(out, 8)*10 + 10
 
-	toDial := make(map[p2p.ID]*p2p.NetAddress)
-	// Try maxAttempts times to pick numToDial addresses to dial
+	// Try maxAttempts times to pick candidate addresses to dial
 	maxAttempts := numToDial * 3
+	candidates := make([]*p2p.NetAddress, 0, maxAttempts)
+	picked := make(map[p2p.ID]bool)
 
-	for i := 0; i < maxAttempts && len(toDial) < numToDial; i++ {
+	for i := 0; i < maxAttempts; i++ {
 		try := r.book.PickAddress(newBias)
 		if try == nil {
 			continue
 		}
-		if _, selected := toDial[try.ID]; selected {
+		if picked[try.ID] {
 			continue
 		}
 		if r.Switch.IsDialingOrExistingAddress(try) {
@@ -491,8 +504,22 @@ func (r *Reactor) ensurePeers() {
 		// TODO: consider moving some checks from toDial into here
 		// so we don't even consider dialing peers that we want to wait
 		// before dialling again, or have dialed too many times already
-		r.Logger.Info("Will dial address", "addr", try)
-		toDial[try.ID] = try
+		picked[try.ID] = true
+		candidates = append(candidates, try)
+	}
+
+	// Dial the numToDial most trusted candidates, in the order they were
+	// picked among the equally trusted ones.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return r.peerTrustScore(candidates[i].ID) > r.peerTrustScore(candidates[j].ID)
+	})
+	toDial := make(map[p2p.ID]*p2p.NetAddress)
+	for _, addr := range candidates {
+		if len(toDial) >= numToDial {
+			break
+		}
+		r.Logger.Info("Will dial address", "addr", addr)
+		toDial[addr.ID] = addr
 	}
 
 	// Dial picked addresses
@@ -536,6 +563,39 @@ func (r *Reactor) ensurePeers() {
 	}
 }
 
+// peerTrustScore returns the trust score of the peer, or defaultTrustScore if
+// its trust isn't tracked.
+func (r *Reactor) peerTrustScore(id p2p.ID) int {
+	if score, ok := r.Switch.PeerTrustScore(id); ok {
+		return score
+	}
+	return defaultTrustScore
+}
+
+// evictLowTrustPeer disconnects from the inbound peer with the lowest trust
+// score, if it is below minInboundTrustScore, to make room for a better peer.
+// The persistent and unconditional peers are never evicted.
+func (r *Reactor) evictLowTrustPeer() {
+	var (
+		evict      Peer
+		evictScore = minInboundTrustScore
+	)
+	for _, peer := range r.Switch.Peers().List() {
+		if peer.IsOutbound() || peer.IsPersistent() || r.Switch.IsPeerUnconditional(peer.ID()) {
+			continue
+		}
+		if score := r.peerTrustScore(peer.ID()); score < evictScore {
+			evict, evictScore = peer, score
+		}
+	}
+	if evict == nil {
+		return
+	}
+
+	r.Logger.Info("Evicting low trust inbound peer", "peer", evict, "trustScore", evictScore)
+	r.Switch.StopPeerGracefully(evict)
+}
+
 func (r *Reactor) dialAttemptsInfo(addr *p2p.NetAddress) (attempts int, lastDialed time.Time) {
 	_attempts, ok := r.attemptsToDial.Load(addr.DialString())
 	if !ok {
@@ -12,11 +12,13 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/p2p"
 	"github.com/tendermint/tendermint/p2p/mock"
+	"github.com/tendermint/tendermint/p2p/trust"
 	tmp2p "github.com/tendermint/tendermint/proto/tendermint/p2p"
 )
 
@@ -531,6 +533,58 @@ func TestPEXReactorDialPeer(t *testing.T) {
 	}
 }
 
+func TestPEXReactorEvictsLowTrustInboundPeer(t *testing.T) {
+	trustStore := trust.NewTrustMetricStore(dbm.NewMemDB(), trust.DefaultConfig())
+	trustStore.SetLogger(log.TestingLogger())
+	require.NoError(t, trustStore.Start())
+	t.Cleanup(func() {
+		if err := trustStore.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	r, book := createReactor(&ReactorConfig{})
+	defer teardownReactor(book)
+
+	conf := *cfg
+	conf.MaxNumInboundPeers = 4
+	conf.MaxNumOutboundPeers = 0
+	sw := p2p.MakeSwitch(&conf, 0, "127.0.0.1", "123.123.123", nil, func(i int, sw *p2p.Switch) *p2p.Switch {
+		p2p.SwitchTrustMetricStore(trustStore)(sw)
+		return sw
+	})
+	sw.SetLogger(log.TestingLogger())
+	sw.AddReactor(r.String(), r)
+
+	badPeer := mock.NewPeer(nil)
+	trustStore.GetPeerTrustMetric(string(badPeer.ID())).BadEvents(10)
+	goodPeer := mock.NewPeer(nil)
+	trustStore.GetPeerTrustMetric(string(goodPeer.ID())).GoodEvents(10)
+	persistentPeer := mock.NewPeer(nil)
+	persistentPeer.Persistent = true
+	trustStore.GetPeerTrustMetric(string(persistentPeer.ID())).BadEvents(100)
+	for _, peer := range []*mock.Peer{badPeer, goodPeer, persistentPeer} {
+		p2p.AddPeerToSwitchPeerSet(sw, peer)
+	}
+
+	// the persistent peers are never evicted, and the low trust peers only
+	// once all the inbound slots are taken
+	r.ensurePeers()
+	assert.Equal(t, 3, sw.Peers().Size())
+
+	conf.MaxNumInboundPeers = 3
+	r.ensurePeers()
+	assert.Equal(t, 2, sw.Peers().Size())
+	assert.False(t, sw.Peers().Has(badPeer.ID()))
+	assert.True(t, sw.Peers().Has(goodPeer.ID()))
+	assert.True(t, sw.Peers().Has(persistentPeer.ID()))
+
+	// the peers whose trust is above the threshold aren't evicted
+	conf.MaxNumInboundPeers = 2
+	r.ensurePeers()
+	assert.Equal(t, 2, sw.Peers().Size())
+}
+
 func assertPeersWithTimeout(
 	t *testing.T,
 	switches []*p2p.Switch,
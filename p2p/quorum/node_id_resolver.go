@@ -0,0 +1,48 @@
+package quorum
+
+import (
+	"net"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/conn"
+)
+
+// NodeIDResolver resolves the node ID of the node listening at a host:port
+// address.
+type NodeIDResolver interface {
+	ResolveNodeID(address string) (p2p.ID, error)
+}
+
+// secretConnResolver resolves node IDs with the handshake of a secret
+// connection, which authenticates the public key of the remote node.
+type secretConnResolver struct {
+	timeout time.Duration
+}
+
+// NewSecretConnResolver returns a NodeIDResolver which connects to the node
+// and takes its ID from the public key of the secret connection handshake.
+// The handshake uses an ephemeral key, so the node ID of this node isn't
+// revealed. The connection is closed right after.
+func NewSecretConnResolver(timeout time.Duration) NodeIDResolver {
+	return secretConnResolver{timeout: timeout}
+}
+
+// ResolveNodeID implements NodeIDResolver.
+func (r secretConnResolver) ResolveNodeID(address string) (p2p.ID, error) {
+	c, err := net.DialTimeout("tcp", address, r.timeout)
+	if err != nil {
+		return "", err
+	}
+	defer c.Close()
+
+	if err := c.SetDeadline(time.Now().Add(r.timeout)); err != nil {
+		return "", err
+	}
+	sc, err := conn.MakeSecretConnection(c, ed25519.GenPrivKey())
+	if err != nil {
+		return "", err
+	}
+	return p2p.PubKeyToID(sc.RemotePubKey()), nil
+}
@@ -0,0 +1,160 @@
+package quorum
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
+	"github.com/tendermint/tendermint/libs/log"
+	tmsync "github.com/tendermint/tendermint/libs/sync"
+	"github.com/tendermint/tendermint/p2p"
+)
+
+// defaultOperatorKeysRefreshInterval is the minimum time between two fetches
+// of the masternode list by the ProTxHashVerifier.
+const defaultOperatorKeysRefreshInterval = time.Minute
+
+// proTxHashAuthRequestID tells the proofs of proTxHash apart from the other
+// messages signed with the operator keys of the masternodes.
+var proTxHashAuthRequestID = crypto.Sha256([]byte("dpp2p_protxhash_auth"))
+
+// LoadOperatorKey loads the operator BLS private key of a masternode from a
+// file holding it hex encoded, like the masternodeblsprivkey of Dash Core.
+func LoadOperatorKey(filePath string) (crypto.PrivKey, error) {
+	keyHex, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	key, err := hex.DecodeString(strings.TrimSpace(string(keyHex)))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding operator key: %w", err)
+	}
+	if len(key) != bls12381.PrivateKeySize {
+		return nil, fmt.Errorf("invalid operator key size %d, expected %d", len(key), bls12381.PrivateKeySize)
+	}
+	return bls12381.PrivKey(key), nil
+}
+
+// ProTxHashSigner proves the proTxHash of this node to its peers, with the
+// operator key of the masternode. The key is held locally, so that proving the
+// proTxHash never involves Dash Core.
+type ProTxHashSigner struct {
+	operatorKey crypto.PrivKey
+}
+
+var _ p2p.ProTxHashSigner = (*ProTxHashSigner)(nil)
+
+// NewProTxHashSigner returns a new ProTxHashSigner signing with the given
+// operator key.
+func NewProTxHashSigner(operatorKey crypto.PrivKey) *ProTxHashSigner {
+	return &ProTxHashSigner{operatorKey: operatorKey}
+}
+
+// SignProTxHash implements p2p.ProTxHashSigner.
+func (s *ProTxHashSigner) SignProTxHash(msgHash []byte) ([]byte, error) {
+	return s.operatorKey.SignDigest(proTxHashAuthSignID(msgHash))
+}
+
+// ProTxHashVerifier verifies the proofs of proTxHash of the peers against the
+// operator keys of the masternode list of Dash Core.
+//
+// The keys are cached, and the masternode list is fetched again at most once
+// per refresh interval, when the cached keys are older than that. This bounds
+// the calls to Dash Core whatever the number of peers connecting.
+type ProTxHashVerifier struct {
+	masternodes     MasternodeLister
+	refreshInterval time.Duration
+	logger          log.Logger
+
+	mtx          tmsync.Mutex
+	operatorKeys map[string]crypto.PubKey // by proTxHash
+	lastRefresh  time.Time
+}
+
+var _ p2p.ProTxHashVerifier = (*ProTxHashVerifier)(nil)
+
+// ProTxHashVerifierOption sets an optional parameter on the ProTxHashVerifier.
+type ProTxHashVerifierOption func(*ProTxHashVerifier)
+
+// WithRefreshInterval sets the minimum time between two fetches of the
+// masternode list.
+func WithRefreshInterval(interval time.Duration) ProTxHashVerifierOption {
+	return func(v *ProTxHashVerifier) { v.refreshInterval = interval }
+}
+
+// WithLogger sets the logger of the ProTxHashVerifier.
+func WithLogger(logger log.Logger) ProTxHashVerifierOption {
+	return func(v *ProTxHashVerifier) { v.logger = logger }
+}
+
+// NewProTxHashVerifier returns a new ProTxHashVerifier getting the operator
+// keys from the given masternode list.
+func NewProTxHashVerifier(masternodes MasternodeLister, options ...ProTxHashVerifierOption) *ProTxHashVerifier {
+	v := &ProTxHashVerifier{
+		masternodes:     masternodes,
+		refreshInterval: defaultOperatorKeysRefreshInterval,
+		logger:          log.NewNopLogger(),
+		operatorKeys:    make(map[string]crypto.PubKey),
+	}
+	for _, option := range options {
+		option(v)
+	}
+	return v
+}
+
+// VerifyProTxHash implements p2p.ProTxHashVerifier.
+func (v *ProTxHashVerifier) VerifyProTxHash(proTxHash crypto.ProTxHash, msgHash, signature []byte) (bool, error) {
+	pubKey, err := v.operatorKey(proTxHash)
+	if err != nil {
+		return false, err
+	}
+	return pubKey.VerifySignatureDigest(proTxHashAuthSignID(msgHash), signature), nil
+}
+
+// operatorKey returns the operator key of the masternode with the given
+// proTxHash, refreshing the cached keys if they are older than the refresh
+// interval.
+func (v *ProTxHashVerifier) operatorKey(proTxHash crypto.ProTxHash) (crypto.PubKey, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	if time.Since(v.lastRefresh) >= v.refreshInterval {
+		v.refresh()
+	}
+	pubKey, ok := v.operatorKeys[proTxHash.String()]
+	if !ok {
+		return nil, fmt.Errorf("unknown masternode %v", proTxHash)
+	}
+	return pubKey, nil
+}
+
+// refresh fetches the operator keys from the masternode list. The cached keys
+// are kept if it fails.
+func (v *ProTxHashVerifier) refresh() {
+	v.lastRefresh = time.Now()
+	masternodes, err := v.masternodes.MasternodeListJSON("")
+	if err != nil {
+		v.logger.Error("failed to get the masternode list", "err", err)
+		return
+	}
+
+	operatorKeys := make(map[string]crypto.PubKey, len(masternodes))
+	for _, mn := range masternodes {
+		pubKey, err := hex.DecodeString(mn.Pubkeyoperator)
+		if err != nil || len(pubKey) != bls12381.PubKeySize {
+			continue
+		}
+		operatorKeys[strings.ToUpper(mn.ProTxHash)] = bls12381.PubKey(pubKey)
+	}
+	v.operatorKeys = operatorKeys
+}
+
+// proTxHashAuthSignID returns the digest signed by the masternodes to prove
+// their proTxHash.
+func proTxHashAuthSignID(msgHash []byte) []byte {
+	return crypto.Sha256(append(append([]byte{}, proTxHashAuthRequestID...), msgHash...))
+}
@@ -0,0 +1,125 @@
+package quorum
+
+import (
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dashevo/dashd-go/btcjson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+// testOperators is a masternode list holding the operator keys of its
+// masternodes, which counts its fetches.
+type testOperators struct {
+	keys    map[string]crypto.PrivKey // by proTxHash
+	err     error
+	fetches int
+}
+
+func (ops *testOperators) MasternodeListJSON(filter string) (map[string]btcjson.MasternodelistResultJSON, error) {
+	ops.fetches++
+	if ops.err != nil {
+		return nil, ops.err
+	}
+	list := make(map[string]btcjson.MasternodelistResultJSON)
+	for proTxHash, key := range ops.keys {
+		list[proTxHash] = btcjson.MasternodelistResultJSON{
+			// Dash Core returns the proTxHashes in lower case
+			ProTxHash:      strings.ToLower(proTxHash),
+			Pubkeyoperator: hex.EncodeToString(key.PubKey().Bytes()),
+		}
+	}
+	return list, nil
+}
+
+func TestProTxHashAuth(t *testing.T) {
+	proTxHash, otherProTxHash := crypto.RandProTxHash(), crypto.RandProTxHash()
+	operatorKey := bls12381.GenPrivKey()
+	operators := &testOperators{keys: map[string]crypto.PrivKey{
+		proTxHash.String():      operatorKey,
+		otherProTxHash.String(): bls12381.GenPrivKey(),
+	}}
+
+	signer := NewProTxHashSigner(operatorKey)
+	verifier := NewProTxHashVerifier(operators)
+
+	msgHash := crypto.Sha256([]byte("challenge"))
+	signature, err := signer.SignProTxHash(msgHash)
+	require.NoError(t, err)
+
+	valid, err := verifier.VerifyProTxHash(proTxHash, msgHash, signature)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	// the signature is bound to the message and to the masternode
+	valid, err = verifier.VerifyProTxHash(proTxHash, crypto.Sha256([]byte("other")), signature)
+	require.NoError(t, err)
+	assert.False(t, valid)
+	valid, err = verifier.VerifyProTxHash(otherProTxHash, msgHash, signature)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	// the key of a masternode which isn't in the list is unknown
+	_, err = verifier.VerifyProTxHash(crypto.RandProTxHash(), msgHash, signature)
+	assert.Error(t, err)
+}
+
+func TestProTxHashVerifierRefresh(t *testing.T) {
+	proTxHash := crypto.RandProTxHash()
+	operatorKey := bls12381.GenPrivKey()
+	operators := &testOperators{keys: map[string]crypto.PrivKey{}}
+	verifier := NewProTxHashVerifier(operators, WithRefreshInterval(50*time.Millisecond))
+
+	signature, err := NewProTxHashSigner(operatorKey).SignProTxHash(crypto.Sha256([]byte("challenge")))
+	require.NoError(t, err)
+	verify := func() error {
+		_, err := verifier.VerifyProTxHash(proTxHash, crypto.Sha256([]byte("challenge")), signature)
+		return err
+	}
+
+	// the unknown masternodes don't make the verifier fetch the list again
+	// before the refresh interval
+	for i := 0; i < 10; i++ {
+		assert.Error(t, verify())
+	}
+	assert.Equal(t, 1, operators.fetches)
+
+	operators.keys[proTxHash.String()] = operatorKey
+	time.Sleep(50 * time.Millisecond)
+	assert.NoError(t, verify())
+	assert.Equal(t, 2, operators.fetches)
+
+	// the cached keys are kept if the list can't be fetched
+	operators.err = errors.New("dash core is unavailable")
+	time.Sleep(50 * time.Millisecond)
+	assert.NoError(t, verify())
+	assert.Equal(t, 3, operators.fetches)
+}
+
+func TestLoadOperatorKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "operator_key")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	operatorKey := bls12381.PrivKey(tmrand.Bytes(bls12381.PrivateKeySize))
+	file := filepath.Join(dir, "operator_key")
+	require.NoError(t, ioutil.WriteFile(file, []byte(hex.EncodeToString(operatorKey.Bytes())+"\n"), 0600))
+	key, err := LoadOperatorKey(file)
+	require.NoError(t, err)
+	assert.Equal(t, operatorKey, key)
+
+	require.NoError(t, ioutil.WriteFile(file, []byte("abcd"), 0600))
+	_, err = LoadOperatorKey(file)
+	assert.Error(t, err)
+}
@@ -0,0 +1,514 @@
+package quorum
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dashevo/dashd-go/btcjson"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/service"
+	tmsync "github.com/tendermint/tendermint/libs/sync"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	subscriber = "ValidatorConnManager"
+
+	// subscriptionCapacity is the number of new block headers buffered before
+	// they are read, which is done right away.
+	subscriptionCapacity = 10
+
+	defaultRetryInterval  = 30 * time.Second
+	defaultResolveTimeout = 5 * time.Second
+
+	// maxConcurrentResolves is the number of members resolved at the same time.
+	maxConcurrentResolves = 16
+
+	// maxDialFailures is the number of consecutive failures to dial a member
+	// after which its address is resolved again, as it may have changed.
+	maxDialFailures = 3
+)
+
+// Switch is the part of the p2p switch the ValidatorConnManager connects the
+// quorum members with.
+type Switch interface {
+	Peers() p2p.IPeerSet
+	IsPeerUnconditional(id p2p.ID) bool
+	AddUnconditionalPeerIDs(ids []string) error
+	RemoveUnconditionalPeerIDs(ids []string)
+	IsPeerPersistent(addr *p2p.NetAddress) bool
+	IsDialingOrExistingAddress(addr *p2p.NetAddress) bool
+	DialPeerWithAddress(addr *p2p.NetAddress) error
+	StopPeerGracefully(peer p2p.Peer)
+}
+
+// MasternodeLister provides the masternode list of Dash Core, which holds the
+// addresses of the validators.
+type MasternodeLister interface {
+	MasternodeListJSON(filter string) (map[string]btcjson.MasternodelistResultJSON, error)
+}
+
+// ValidatorSetFunc returns the active validator set.
+type ValidatorSetFunc func() (*types.ValidatorSet, error)
+
+// member is another member of the active quorum.
+type member struct {
+	proTxHash crypto.ProTxHash
+	// address is nil until it is resolved
+	address *p2p.NetAddress
+	// unconditional is true if the manager made the member an unconditional
+	// peer, i.e. it wasn't one already
+	unconditional bool
+	// lastResolve is the time of the last attempt to resolve the address
+	lastResolve time.Time
+	// dialFailures is the number of consecutive failures to dial the address
+	dialFailures int
+}
+
+// MemberStatus is the connectivity of this node to another member of the
+// active quorum.
+type MemberStatus struct {
+	ProTxHash crypto.ProTxHash
+	// Address is nil until it is resolved
+	Address   *p2p.NetAddress
+	Connected bool
+}
+
+// Status is the connectivity of this node to the other members of the active
+// quorum. Members is empty if this node isn't a member itself.
+type Status struct {
+	QuorumHash crypto.QuorumHash
+	IsMember   bool
+	Members    []MemberStatus
+}
+
+// ValidatorConnManager keeps this node directly connected to the other members
+// of the active validator quorum, when it is one of them.
+//
+// Whenever a block is committed, it checks whether the validator set or the
+// quorum hash changed. The members are resolved from the masternode list of
+// Dash Core, or found among the connected peers by the proTxHash of their node
+// info, and they are dialed as unconditional peers. The members which leave the
+// quorum are disconnected, unless they are persistent peers. Members which
+// can't be resolved or connected are retried every retry interval.
+type ValidatorConnManager struct {
+	service.BaseService
+
+	proTxHash     crypto.ProTxHash
+	sw            Switch
+	eventBus      *types.EventBus
+	validators    ValidatorSetFunc
+	masternodes   MasternodeLister
+	resolver      NodeIDResolver
+	p2pPort       uint16
+	retryInterval time.Duration
+
+	updateSignal chan struct{}
+
+	// the members are only modified by the update routine, with mtx locked
+	mtx        tmsync.Mutex
+	quorumHash crypto.QuorumHash
+	isMember   bool
+	members    map[string]*member // by proTxHash
+}
+
+// ValidatorConnManagerOption sets an optional parameter on the
+// ValidatorConnManager.
+type ValidatorConnManagerOption func(*ValidatorConnManager)
+
+// WithNodeIDResolver sets the resolver of the node IDs of the members. By
+// default, they are resolved with a secret connection handshake.
+func WithNodeIDResolver(resolver NodeIDResolver) ValidatorConnManagerOption {
+	return func(m *ValidatorConnManager) { m.resolver = resolver }
+}
+
+// WithRetryInterval sets the interval between two attempts to resolve or
+// connect to the members.
+func WithRetryInterval(interval time.Duration) ValidatorConnManagerOption {
+	return func(m *ValidatorConnManager) { m.retryInterval = interval }
+}
+
+// NewValidatorConnManager returns a new ValidatorConnManager for the node with
+// the given proTxHash. p2pPort is the P2P port of the members, which the
+// masternode list doesn't hold; the members connected already are dialed at the
+// port of their listen address instead.
+func NewValidatorConnManager(
+	proTxHash crypto.ProTxHash,
+	sw Switch,
+	eventBus *types.EventBus,
+	validators ValidatorSetFunc,
+	masternodes MasternodeLister,
+	p2pPort uint16,
+	options ...ValidatorConnManagerOption,
+) *ValidatorConnManager {
+	m := &ValidatorConnManager{
+		proTxHash:     proTxHash,
+		sw:            sw,
+		eventBus:      eventBus,
+		validators:    validators,
+		masternodes:   masternodes,
+		resolver:      NewSecretConnResolver(defaultResolveTimeout),
+		p2pPort:       p2pPort,
+		retryInterval: defaultRetryInterval,
+		updateSignal:  make(chan struct{}, 1),
+		members:       make(map[string]*member),
+	}
+	m.BaseService = *service.NewBaseService(nil, "ValidatorConnManager", m)
+	for _, option := range options {
+		option(m)
+	}
+	return m
+}
+
+// OnStart implements service.Service by subscribing to new block headers and
+// starting the update routine.
+func (m *ValidatorConnManager) OnStart() error {
+	sub, err := m.eventBus.Subscribe(
+		context.Background(),
+		subscriber,
+		types.EventQueryNewBlockHeader,
+		subscriptionCapacity,
+	)
+	if err != nil {
+		return err
+	}
+
+	go m.subscriptionRoutine(sub)
+	go m.updateRoutine()
+	return nil
+}
+
+// OnStop implements service.Service by unsubscribing from new block headers.
+func (m *ValidatorConnManager) OnStop() {
+	if m.eventBus.IsRunning() {
+		_ = m.eventBus.UnsubscribeAll(context.Background(), subscriber)
+	}
+}
+
+// Status returns the connectivity of this node to the other members of the
+// active quorum, sorted by proTxHash.
+func (m *ValidatorConnManager) Status() Status {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	status := Status{
+		QuorumHash: m.quorumHash,
+		IsMember:   m.isMember,
+		Members:    make([]MemberStatus, 0, len(m.members)),
+	}
+	for _, mb := range m.members {
+		status.Members = append(status.Members, MemberStatus{
+			ProTxHash: mb.proTxHash,
+			Address:   mb.address,
+			Connected: mb.address != nil && m.sw.Peers().Has(mb.address.ID),
+		})
+	}
+	sort.Slice(status.Members, func(i, j int) bool {
+		return bytes.Compare(status.Members[i].ProTxHash, status.Members[j].ProTxHash) < 0
+	})
+	return status
+}
+
+// subscriptionRoutine signals the update routine on every new block header.
+func (m *ValidatorConnManager) subscriptionRoutine(sub types.Subscription) {
+	for {
+		select {
+		case <-sub.Out():
+			select {
+			case m.updateSignal <- struct{}{}:
+			default:
+			}
+		case <-sub.Cancelled():
+			if m.IsRunning() {
+				m.Logger.Error("subscription to new block headers was cancelled", "err", sub.Err())
+			}
+			return
+		case <-m.Quit():
+			return
+		}
+	}
+}
+
+// updateRoutine updates the connections to the members on every new block
+// header, and every retry interval.
+func (m *ValidatorConnManager) updateRoutine() {
+	ticker := time.NewTicker(m.retryInterval)
+	defer ticker.Stop()
+
+	m.update()
+	for {
+		select {
+		case <-m.updateSignal:
+		case <-ticker.C:
+		case <-m.Quit():
+			return
+		}
+		m.update()
+	}
+}
+
+// update takes the members of the active quorum, disconnects from the ones
+// which left it, and connects to the others, resolving their addresses first.
+func (m *ValidatorConnManager) update() {
+	valSet, err := m.validators()
+	if err != nil {
+		m.Logger.Error("failed to load the validator set", "err", err)
+		return
+	}
+	if valSet == nil {
+		return
+	}
+
+	m.mtx.Lock()
+	m.setQuorum(valSet)
+	unresolved := m.unresolvedMembers()
+	m.mtx.Unlock()
+
+	// resolving takes network round trips, so the status stays available
+	addresses := m.resolve(unresolved)
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	for key, addr := range addresses {
+		if mb, ok := m.members[key]; ok {
+			mb.address = addr
+			mb.dialFailures = 0
+		}
+	}
+	m.connectMembers()
+}
+
+// setQuorum replaces the members with the ones of the validator set, and
+// disconnects from the members which left the quorum.
+func (m *ValidatorConnManager) setQuorum(valSet *types.ValidatorSet) {
+	isMember := valSet.HasProTxHash(m.proTxHash)
+	members := make(map[string]*member)
+	if isMember {
+		for _, val := range valSet.Validators {
+			if bytes.Equal(val.ProTxHash, m.proTxHash) {
+				continue
+			}
+			key := val.ProTxHash.String()
+			if mb, ok := m.members[key]; ok {
+				members[key] = mb
+			} else {
+				members[key] = &member{proTxHash: val.ProTxHash}
+			}
+		}
+	}
+
+	for key, mb := range m.members {
+		if _, ok := members[key]; !ok {
+			m.disconnect(mb)
+		}
+	}
+
+	if !bytes.Equal(m.quorumHash, valSet.QuorumHash) || m.isMember != isMember {
+		m.Logger.Info("Active quorum changed",
+			"quorumHash", valSet.QuorumHash, "isMember", isMember, "members", len(members))
+	}
+	m.quorumHash = valSet.QuorumHash
+	m.isMember = isMember
+	m.members = members
+}
+
+// unresolvedMembers returns the members without address which weren't
+// resolved since the retry interval. The members connected already get the
+// address of their peer.
+func (m *ValidatorConnManager) unresolvedMembers() []*member {
+	var unresolved []*member
+	for _, mb := range m.members {
+		if mb.address != nil {
+			continue
+		}
+		if addr := m.peerAddress(mb.proTxHash); addr != nil {
+			mb.address = addr
+			continue
+		}
+		if time.Since(mb.lastResolve) < m.retryInterval {
+			continue
+		}
+		mb.lastResolve = time.Now()
+		unresolved = append(unresolved, &member{proTxHash: mb.proTxHash})
+	}
+	return unresolved
+}
+
+// peerAddress returns the address of the connected peer with the given
+// proTxHash in its node info, if any. The port is the one the peer was dialed
+// at, or the one of the listen address in its node info, as the members may
+// not all listen at the same port.
+func (m *ValidatorConnManager) peerAddress(proTxHash crypto.ProTxHash) *p2p.NetAddress {
+	for _, peer := range m.sw.Peers().List() {
+		peerProTxHash := peer.NodeInfo().GetProTxHash()
+		if peerProTxHash == nil || !bytes.Equal(*peerProTxHash, proTxHash) {
+			continue
+		}
+		port := m.p2pPort
+		if peer.IsOutbound() {
+			port = peer.SocketAddr().Port
+		} else if listenAddr, err := peer.NodeInfo().NetAddress(); err == nil && listenAddr.Port != 0 {
+			port = listenAddr.Port
+		}
+		addr := p2p.NewNetAddressIPPort(peer.RemoteIP(), port)
+		addr.ID = peer.ID()
+		return addr
+	}
+	return nil
+}
+
+// resolve returns the addresses of the given members, by proTxHash, from the
+// masternode list and their node IDs. The node IDs are resolved in parallel, as
+// each resolution can take up to the resolve timeout. The members which can't be
+// resolved are left out.
+func (m *ValidatorConnManager) resolve(members []*member) map[string]*p2p.NetAddress {
+	if len(members) == 0 {
+		return nil
+	}
+
+	masternodes, err := m.masternodes.MasternodeListJSON("")
+	if err != nil {
+		m.Logger.Error("failed to get the masternode list", "err", err)
+		return nil
+	}
+	hosts := make(map[string]string, len(masternodes))
+	for _, mn := range masternodes {
+		host, _, err := net.SplitHostPort(mn.Address)
+		if err != nil {
+			continue
+		}
+		hosts[strings.ToUpper(mn.ProTxHash)] = host
+	}
+
+	var (
+		mtx       sync.Mutex
+		wg        sync.WaitGroup
+		sem       = make(chan struct{}, maxConcurrentResolves)
+		addresses = make(map[string]*p2p.NetAddress, len(members))
+	)
+	for _, mb := range members {
+		key := mb.proTxHash.String()
+		host, ok := hosts[key]
+		if !ok {
+			m.Logger.Debug("validator is not in the masternode list", "proTxHash", key)
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(key, hostPort string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if addr := m.resolveAddress(key, hostPort); addr != nil {
+				mtx.Lock()
+				addresses[key] = addr
+				mtx.Unlock()
+			}
+		}(key, net.JoinHostPort(host, strconv.Itoa(int(m.p2pPort))))
+	}
+	wg.Wait()
+	return addresses
+}
+
+// resolveAddress returns the address of the member with the given proTxHash,
+// listening at hostPort, or nil if its node ID can't be resolved.
+func (m *ValidatorConnManager) resolveAddress(key, hostPort string) *p2p.NetAddress {
+	id, err := m.resolver.ResolveNodeID(hostPort)
+	if err != nil {
+		m.Logger.Debug("failed to resolve the node ID of validator",
+			"proTxHash", key, "address", hostPort, "err", err)
+		return nil
+	}
+	addr, err := p2p.NewNetAddressString(p2p.IDAddressString(id, hostPort))
+	if err != nil {
+		m.Logger.Error("validator has an invalid address", "proTxHash", key, "err", err)
+		return nil
+	}
+	return addr
+}
+
+// connectMembers makes the resolved members unconditional peers, and dials the
+// ones which aren't connected. The dials are done in the background, and their
+// failures are counted by dialed.
+func (m *ValidatorConnManager) connectMembers() {
+	for _, mb := range m.members {
+		if mb.address == nil {
+			continue
+		}
+
+		if !mb.unconditional && !m.sw.IsPeerUnconditional(mb.address.ID) {
+			if err := m.sw.AddUnconditionalPeerIDs([]string{string(mb.address.ID)}); err != nil {
+				m.Logger.Error("failed to make validator an unconditional peer",
+					"proTxHash", mb.proTxHash, "err", err)
+				continue
+			}
+			mb.unconditional = true
+		}
+
+		if !m.sw.IsDialingOrExistingAddress(mb.address) {
+			go func(mb *member, addr *p2p.NetAddress) {
+				err := m.sw.DialPeerWithAddress(addr)
+				if err != nil {
+					m.Logger.Debug("failed to dial validator", "address", addr, "err", err)
+				}
+				m.dialed(mb, addr, err)
+			}(mb, mb.address)
+		}
+	}
+}
+
+// dialed counts the consecutive failures to dial the address of a member. After
+// maxDialFailures, the address is dropped, to be resolved again from the
+// masternode list once the retry interval has passed since the last resolution,
+// as the member may have moved or changed its node key.
+func (m *ValidatorConnManager) dialed(mb *member, addr *p2p.NetAddress, err error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	// the address was dropped or resolved again in the meantime
+	if mb.address != addr {
+		return
+	}
+	if err == nil {
+		mb.dialFailures = 0
+		return
+	}
+	mb.dialFailures++
+	if mb.dialFailures < maxDialFailures {
+		return
+	}
+
+	m.Logger.Info("Resolving the address of validator again after failing to dial it",
+		"proTxHash", mb.proTxHash, "address", addr, "failures", mb.dialFailures)
+	if mb.unconditional {
+		m.sw.RemoveUnconditionalPeerIDs([]string{string(addr.ID)})
+		mb.unconditional = false
+	}
+	mb.address = nil
+	mb.dialFailures = 0
+}
+
+// disconnect disconnects from a member which left the quorum, if the manager
+// made it an unconditional peer and it isn't a persistent peer.
+func (m *ValidatorConnManager) disconnect(mb *member) {
+	if mb.address == nil || !mb.unconditional {
+		return
+	}
+
+	m.sw.RemoveUnconditionalPeerIDs([]string{string(mb.address.ID)})
+	if peer := m.sw.Peers().Get(mb.address.ID); peer != nil && !m.sw.IsPeerPersistent(mb.address) {
+		m.Logger.Info("Disconnecting from validator which left the quorum",
+			"proTxHash", mb.proTxHash, "peer", peer.ID())
+		m.sw.StopPeerGracefully(peer)
+	}
+}
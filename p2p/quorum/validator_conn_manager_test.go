@@ -0,0 +1,514 @@
+package quorum
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dashevo/dashd-go/btcjson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/conn"
+	"github.com/tendermint/tendermint/p2p/mock"
+	"github.com/tendermint/tendermint/types"
+)
+
+// testSwitch is a Switch which connects to the peers it dials right away.
+type testSwitch struct {
+	mtx           sync.Mutex
+	peers         *p2p.PeerSet
+	unconditional map[p2p.ID]bool
+	persistent    map[p2p.ID]bool
+	dialed        chan *p2p.NetAddress
+	dialErr       error
+	proTxHashes   map[p2p.ID]crypto.ProTxHash
+}
+
+func newTestSwitch() *testSwitch {
+	return &testSwitch{
+		peers:         p2p.NewPeerSet(),
+		unconditional: make(map[p2p.ID]bool),
+		persistent:    make(map[p2p.ID]bool),
+		dialed:        make(chan *p2p.NetAddress, 10),
+		proTxHashes:   make(map[p2p.ID]crypto.ProTxHash),
+	}
+}
+
+func (sw *testSwitch) Peers() p2p.IPeerSet { return sw.peers }
+
+func (sw *testSwitch) IsPeerUnconditional(id p2p.ID) bool {
+	sw.mtx.Lock()
+	defer sw.mtx.Unlock()
+	return sw.unconditional[id]
+}
+
+func (sw *testSwitch) AddUnconditionalPeerIDs(ids []string) error {
+	sw.mtx.Lock()
+	defer sw.mtx.Unlock()
+	for _, id := range ids {
+		sw.unconditional[p2p.ID(id)] = true
+	}
+	return nil
+}
+
+func (sw *testSwitch) RemoveUnconditionalPeerIDs(ids []string) {
+	sw.mtx.Lock()
+	defer sw.mtx.Unlock()
+	for _, id := range ids {
+		delete(sw.unconditional, p2p.ID(id))
+	}
+}
+
+func (sw *testSwitch) IsPeerPersistent(addr *p2p.NetAddress) bool {
+	sw.mtx.Lock()
+	defer sw.mtx.Unlock()
+	return sw.persistent[addr.ID]
+}
+
+func (sw *testSwitch) IsDialingOrExistingAddress(addr *p2p.NetAddress) bool {
+	return sw.peers.Has(addr.ID)
+}
+
+func (sw *testSwitch) DialPeerWithAddress(addr *p2p.NetAddress) error {
+	sw.mtx.Lock()
+	err := sw.dialErr
+	sw.mtx.Unlock()
+	if err == nil {
+		sw.addPeer(addr, sw.proTxHashes[addr.ID], true)
+	}
+	sw.dialed <- addr
+	return err
+}
+
+func (sw *testSwitch) setDialErr(err error) {
+	sw.mtx.Lock()
+	defer sw.mtx.Unlock()
+	sw.dialErr = err
+}
+
+func (sw *testSwitch) StopPeerGracefully(peer p2p.Peer) {
+	sw.peers.Remove(peer)
+}
+
+func (sw *testSwitch) addPeer(addr *p2p.NetAddress, proTxHash crypto.ProTxHash, outbound bool) {
+	peer := &testPeer{Peer: mock.NewPeer(addr.IP), id: addr.ID, addr: addr, proTxHash: proTxHash}
+	peer.Outbound = outbound
+	if err := sw.peers.Add(peer); err != nil {
+		panic(err)
+	}
+}
+
+// testPeer is a peer with a given address and proTxHash.
+type testPeer struct {
+	*mock.Peer
+	id         p2p.ID
+	addr       *p2p.NetAddress
+	listenAddr string
+	proTxHash  crypto.ProTxHash
+}
+
+func (p *testPeer) ID() p2p.ID                  { return p.id }
+func (p *testPeer) SocketAddr() *p2p.NetAddress { return p.addr }
+func (p *testPeer) NodeInfo() p2p.NodeInfo {
+	info := p2p.DefaultNodeInfo{DefaultNodeID: p.id, ListenAddr: p.listenAddr}
+	if p.proTxHash != nil {
+		info.ProTxHash = &p.proTxHash
+	}
+	return info
+}
+
+// testMasternodes is a masternode list with a node at 127.0.0.<i+1> for each
+// proTxHash.
+type testMasternodes struct {
+	proTxHashes []crypto.ProTxHash
+	err         error
+}
+
+func (mns testMasternodes) MasternodeListJSON(filter string) (map[string]btcjson.MasternodelistResultJSON, error) {
+	if mns.err != nil {
+		return nil, mns.err
+	}
+	list := make(map[string]btcjson.MasternodelistResultJSON)
+	for i, proTxHash := range mns.proTxHashes {
+		list[fmt.Sprintf("COutPoint(%d)", i)] = btcjson.MasternodelistResultJSON{
+			// Dash Core returns the proTxHashes in lower case
+			ProTxHash: strings.ToLower(proTxHash.String()),
+			Address:   fmt.Sprintf("127.0.0.%d:9999", i+1),
+			Status:    "ENABLED",
+		}
+	}
+	return list, nil
+}
+
+// testResolver resolves the addresses to the node IDs of its map.
+type testResolver struct {
+	mtx   sync.Mutex
+	ids   map[string]p2p.ID
+	calls int
+}
+
+func (r *testResolver) ResolveNodeID(address string) (p2p.ID, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.calls++
+	id, ok := r.ids[address]
+	if !ok {
+		return "", errors.New("connection refused")
+	}
+	return id, nil
+}
+
+// testQuorum is a validator set of n validators, along with their node IDs and
+// addresses.
+type testQuorum struct {
+	proTxHashes []crypto.ProTxHash
+	ids         []p2p.ID
+	addresses   []string
+}
+
+func newTestQuorum(n int) testQuorum {
+	q := testQuorum{}
+	for i := 0; i < n; i++ {
+		q.proTxHashes = append(q.proTxHashes, crypto.RandProTxHash())
+		q.ids = append(q.ids, p2p.PubKeyToID(ed25519.GenPrivKey().PubKey()))
+		q.addresses = append(q.addresses, fmt.Sprintf("127.0.0.%d:26656", i+1))
+	}
+	return q
+}
+
+func (q testQuorum) validatorSet(members ...int) *types.ValidatorSet {
+	valSet := &types.ValidatorSet{QuorumHash: crypto.RandQuorumHash()}
+	for _, i := range members {
+		valSet.Validators = append(valSet.Validators, &types.Validator{ProTxHash: q.proTxHashes[i], VotingPower: 100})
+	}
+	return valSet
+}
+
+func (q testQuorum) resolver() *testResolver {
+	r := &testResolver{ids: make(map[string]p2p.ID)}
+	for i, addr := range q.addresses {
+		r.ids[addr] = q.ids[i]
+	}
+	return r
+}
+
+func (q testQuorum) netAddress(t *testing.T, i int) *p2p.NetAddress {
+	addr, err := p2p.NewNetAddressString(p2p.IDAddressString(q.ids[i], q.addresses[i]))
+	require.NoError(t, err)
+	return addr
+}
+
+func newTestManager(
+	q testQuorum,
+	sw *testSwitch,
+	valSet **types.ValidatorSet,
+	resolver NodeIDResolver,
+) *ValidatorConnManager {
+	for i, id := range q.ids {
+		sw.proTxHashes[id] = q.proTxHashes[i]
+	}
+	m := NewValidatorConnManager(
+		q.proTxHashes[0],
+		sw,
+		types.NewEventBus(),
+		func() (*types.ValidatorSet, error) { return *valSet, nil },
+		testMasternodes{proTxHashes: q.proTxHashes},
+		26656,
+		WithNodeIDResolver(resolver),
+		WithRetryInterval(time.Hour),
+	)
+	m.SetLogger(log.TestingLogger())
+	return m
+}
+
+func waitDialed(t *testing.T, sw *testSwitch, n int) []*p2p.NetAddress {
+	var dialed []*p2p.NetAddress
+	for i := 0; i < n; i++ {
+		select {
+		case addr := <-sw.dialed:
+			dialed = append(dialed, addr)
+		case <-time.After(time.Second):
+			t.Fatalf("dialed %d validators, expected %d", len(dialed), n)
+		}
+	}
+	return dialed
+}
+
+func TestValidatorConnManagerConnectsMembers(t *testing.T) {
+	q := newTestQuorum(4)
+	sw := newTestSwitch()
+	valSet := q.validatorSet(0, 1, 2)
+	m := newTestManager(q, sw, &valSet, q.resolver())
+
+	m.update()
+	dialed := waitDialed(t, sw, 2)
+	assert.ElementsMatch(t, []*p2p.NetAddress{q.netAddress(t, 1), q.netAddress(t, 2)}, dialed)
+	assert.True(t, sw.IsPeerUnconditional(q.ids[1]))
+	assert.True(t, sw.IsPeerUnconditional(q.ids[2]))
+	assert.False(t, sw.IsPeerUnconditional(q.ids[3]))
+
+	status := m.Status()
+	assert.Equal(t, valSet.QuorumHash, status.QuorumHash)
+	assert.True(t, status.IsMember)
+	require.Len(t, status.Members, 2)
+	for _, mb := range status.Members {
+		assert.True(t, mb.Connected)
+		assert.NotNil(t, mb.Address)
+	}
+
+	// the members connected already aren't dialed again
+	m.update()
+	select {
+	case addr := <-sw.dialed:
+		t.Fatalf("dialed %v again", addr)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestValidatorConnManagerDisconnectsRotatedMembers(t *testing.T) {
+	q := newTestQuorum(4)
+	sw := newTestSwitch()
+	valSet := q.validatorSet(0, 1, 2)
+	m := newTestManager(q, sw, &valSet, q.resolver())
+
+	// validator 2 is a persistent peer
+	sw.persistent[q.ids[2]] = true
+
+	m.update()
+	waitDialed(t, sw, 2)
+
+	// validators 1 and 2 rotate out of the quorum
+	valSet = q.validatorSet(0, 3)
+	m.update()
+	dialed := waitDialed(t, sw, 1)
+	assert.Equal(t, q.netAddress(t, 3), dialed[0])
+
+	assert.False(t, sw.Peers().Has(q.ids[1]))
+	assert.False(t, sw.IsPeerUnconditional(q.ids[1]))
+	assert.True(t, sw.Peers().Has(q.ids[2]), "persistent peer was disconnected")
+	assert.True(t, sw.Peers().Has(q.ids[3]))
+
+	// this node rotates out of the quorum
+	valSet = q.validatorSet(1, 2, 3)
+	m.update()
+	assert.False(t, sw.Peers().Has(q.ids[3]))
+	status := m.Status()
+	assert.False(t, status.IsMember)
+	assert.Empty(t, status.Members)
+}
+
+func TestValidatorConnManagerKeepsUnconditionalPeers(t *testing.T) {
+	q := newTestQuorum(2)
+	sw := newTestSwitch()
+	valSet := q.validatorSet(0, 1)
+	m := newTestManager(q, sw, &valSet, q.resolver())
+
+	// validator 1 is an unconditional peer of the config
+	require.NoError(t, sw.AddUnconditionalPeerIDs([]string{string(q.ids[1])}))
+
+	m.update()
+	waitDialed(t, sw, 1)
+
+	valSet = q.validatorSet(0)
+	m.update()
+	assert.True(t, sw.IsPeerUnconditional(q.ids[1]))
+	assert.True(t, sw.Peers().Has(q.ids[1]))
+}
+
+func TestValidatorConnManagerUsesConnectedPeers(t *testing.T) {
+	q := newTestQuorum(2)
+	sw := newTestSwitch()
+	valSet := q.validatorSet(0, 1)
+	resolver := q.resolver()
+	m := newTestManager(q, sw, &valSet, resolver)
+
+	// validator 1 connected to this node
+	sw.addPeer(q.netAddress(t, 1), q.proTxHashes[1], false)
+
+	m.update()
+	assert.Zero(t, resolver.calls)
+	assert.True(t, sw.IsPeerUnconditional(q.ids[1]))
+
+	status := m.Status()
+	require.Len(t, status.Members, 1)
+	assert.True(t, status.Members[0].Connected)
+	assert.Equal(t, q.netAddress(t, 1), status.Members[0].Address)
+}
+
+func TestValidatorConnManagerUsesListenPortOfInboundPeers(t *testing.T) {
+	q := newTestQuorum(2)
+	sw := newTestSwitch()
+	valSet := q.validatorSet(0, 1)
+	m := newTestManager(q, sw, &valSet, q.resolver())
+
+	// validator 1 listens at another port than the one of the config, and
+	// connected to this node from an ephemeral port
+	socketAddr := p2p.NewNetAddressIPPort(net.ParseIP("127.0.0.2"), 50123)
+	socketAddr.ID = q.ids[1]
+	peer := &testPeer{
+		Peer:       mock.NewPeer(socketAddr.IP),
+		id:         q.ids[1],
+		addr:       socketAddr,
+		listenAddr: "tcp://0.0.0.0:26700",
+		proTxHash:  q.proTxHashes[1],
+	}
+	require.NoError(t, sw.peers.Add(peer))
+
+	m.update()
+	status := m.Status()
+	require.Len(t, status.Members, 1)
+	require.NotNil(t, status.Members[0].Address)
+	assert.Equal(t, "127.0.0.2", status.Members[0].Address.IP.String())
+	assert.EqualValues(t, 26700, status.Members[0].Address.Port)
+}
+
+func TestValidatorConnManagerRetriesResolving(t *testing.T) {
+	q := newTestQuorum(2)
+	sw := newTestSwitch()
+	valSet := q.validatorSet(0, 1)
+	resolver := &testResolver{ids: make(map[string]p2p.ID)}
+	m := newTestManager(q, sw, &valSet, resolver)
+
+	m.update()
+	assert.Equal(t, 1, resolver.calls)
+	status := m.Status()
+	require.Len(t, status.Members, 1)
+	assert.Nil(t, status.Members[0].Address)
+	assert.False(t, status.Members[0].Connected)
+
+	// the resolution isn't retried before the retry interval
+	m.update()
+	assert.Equal(t, 1, resolver.calls)
+
+	resolver.ids = q.resolver().ids
+	m.retryInterval = 0
+	m.update()
+	assert.Equal(t, 2, resolver.calls)
+	waitDialed(t, sw, 1)
+}
+
+func TestValidatorConnManagerResolvesAgainAfterDialFailures(t *testing.T) {
+	q := newTestQuorum(2)
+	sw := newTestSwitch()
+	valSet := q.validatorSet(0, 1)
+	resolver := q.resolver()
+	m := newTestManager(q, sw, &valSet, resolver)
+	m.retryInterval = 0
+	sw.setDialErr(errors.New("connection refused"))
+
+	key := q.proTxHashes[1].String()
+	dialFailures := func() int {
+		m.mtx.Lock()
+		defer m.mtx.Unlock()
+		return m.members[key].dialFailures
+	}
+
+	for i := 1; i < maxDialFailures; i++ {
+		m.update()
+		waitDialed(t, sw, 1)
+		require.Eventually(t, func() bool { return dialFailures() == i }, time.Second, 10*time.Millisecond)
+	}
+	assert.Equal(t, 1, resolver.calls)
+	assert.True(t, sw.IsPeerUnconditional(q.ids[1]))
+
+	// validator 1 changed its node key
+	newID := p2p.PubKeyToID(ed25519.GenPrivKey().PubKey())
+	resolver.mtx.Lock()
+	resolver.ids[q.addresses[1]] = newID
+	resolver.mtx.Unlock()
+
+	m.update()
+	waitDialed(t, sw, 1)
+	require.Eventually(t, func() bool { return m.Status().Members[0].Address == nil }, time.Second, 10*time.Millisecond)
+	assert.False(t, sw.IsPeerUnconditional(q.ids[1]))
+
+	sw.setDialErr(nil)
+	m.update()
+	assert.Equal(t, 2, resolver.calls)
+	dialed := waitDialed(t, sw, 1)
+	assert.Equal(t, newID, dialed[0].ID)
+	assert.True(t, sw.IsPeerUnconditional(newID))
+}
+
+// blockingResolver resolves the node IDs only once n resolutions are in
+// progress at the same time.
+type blockingResolver struct {
+	*testResolver
+	n       int
+	mtx     sync.Mutex
+	pending int
+	all     chan struct{}
+}
+
+func (r *blockingResolver) ResolveNodeID(address string) (p2p.ID, error) {
+	r.mtx.Lock()
+	r.pending++
+	if r.pending == r.n {
+		close(r.all)
+	}
+	r.mtx.Unlock()
+
+	select {
+	case <-r.all:
+		return r.testResolver.ResolveNodeID(address)
+	case <-time.After(time.Second):
+		return "", errors.New("timeout")
+	}
+}
+
+func TestValidatorConnManagerResolvesInParallel(t *testing.T) {
+	q := newTestQuorum(4)
+	sw := newTestSwitch()
+	valSet := q.validatorSet(0, 1, 2, 3)
+	resolver := &blockingResolver{testResolver: q.resolver(), n: 3, all: make(chan struct{})}
+	m := newTestManager(q, sw, &valSet, resolver)
+
+	m.update()
+	dialed := waitDialed(t, sw, 3)
+	assert.ElementsMatch(t, []*p2p.NetAddress{q.netAddress(t, 1), q.netAddress(t, 2), q.netAddress(t, 3)}, dialed)
+}
+
+func TestValidatorConnManagerMasternodeListError(t *testing.T) {
+	q := newTestQuorum(2)
+	sw := newTestSwitch()
+	valSet := q.validatorSet(0, 1)
+	m := newTestManager(q, sw, &valSet, q.resolver())
+	m.masternodes = testMasternodes{err: errors.New("dashd is down")}
+
+	m.update()
+	status := m.Status()
+	require.Len(t, status.Members, 1)
+	assert.Nil(t, status.Members[0].Address)
+}
+
+func TestSecretConnResolver(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	nodeKey := ed25519.GenPrivKey()
+	go func() {
+		c, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		_, _ = conn.MakeSecretConnection(c, nodeKey)
+	}()
+
+	id, err := NewSecretConnResolver(time.Second).ResolveNodeID(ln.Addr().String())
+	require.NoError(t, err)
+	assert.Equal(t, p2p.PubKeyToID(nodeKey.PubKey()), id)
+
+	_, err = NewSecretConnResolver(time.Second).ResolveNodeID(ln.Addr().String())
+	assert.Error(t, err)
+}
@@ -0,0 +1,123 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/p2p/conn"
+)
+
+// newStreamTransport returns a tcp connected stream transport using the
+// default MConnConfig. It's a convenience function used for testing.
+func newStreamTransport(
+	nodeInfo NodeInfo,
+	nodeKey NodeKey,
+) *MultiplexTransport {
+	mt := NewMultiplexTransport(nodeInfo, nodeKey, conn.DefaultMConnConfig())
+	MultiplexTransportStreams()(mt)
+	return mt
+}
+
+func TestTransportStream(t *testing.T) {
+	runTransportTests(t, newStreamTransport)
+}
+
+func TestTransportStreamNodeInfo(t *testing.T) {
+	pv := ed25519.GenPrivKey()
+	st := newStreamTransport(
+		testNodeInfo(PubKeyToID(pv.PubKey()), "stream", nil),
+		NodeKey{PrivKey: pv},
+	)
+	assert.Equal(t, TransportStream, st.nodeInfo.(DefaultNodeInfo).Other.Transport)
+}
+
+func TestTransportStreamRejectMultiplex(t *testing.T) {
+	mt := testSetupTransport(t, newStreamTransport)
+
+	errc := make(chan error)
+	go func() {
+		pv := ed25519.GenPrivKey()
+		dialer := newMultiplexTransport(
+			testNodeInfo(PubKeyToID(pv.PubKey()), "dialer", nil),
+			NodeKey{PrivKey: pv},
+		)
+		addr := NewNetAddress(mt.nodeKey.ID(), mt.listener.Addr())
+
+		_, err := dialer.Dial(*addr, peerConfig{})
+		errc <- err
+	}()
+
+	_, err := mt.Accept(peerConfig{})
+	rejected, ok := err.(ErrRejected)
+	require.True(t, ok, "expected ErrRejected, got %v", err)
+	assert.True(t, rejected.IsIncompatible())
+	assert.Error(t, <-errc)
+}
+
+func TestTransportStreamPeers(t *testing.T) {
+	chDescs := []*conn.ChannelDescriptor{
+		{ID: byte(0x00), Priority: 10},
+		{ID: byte(0x01), Priority: 10},
+	}
+	reactor := NewTestReactor(chDescs, true)
+	cfg := peerConfig{
+		chDescs:      chDescs,
+		onPeerError:  func(p Peer, r interface{}) { t.Errorf("peer %v errored: %v", p, r) },
+		reactorsByCh: map[byte]Reactor{0x00: reactor, 0x01: reactor},
+		metrics:      NopMetrics(),
+	}
+
+	mt := testSetupTransport(t, newStreamTransport)
+	defer mt.Close() // nolint:errcheck // ignore for tests
+	for _, chDesc := range chDescs {
+		mt.AddChannel(chDesc.ID)
+	}
+
+	pc := make(chan Peer, 1)
+	go func() {
+		pv := ed25519.GenPrivKey()
+		dialer := newStreamTransport(
+			testNodeInfo(PubKeyToID(pv.PubKey()), "dialer", nil),
+			NodeKey{PrivKey: pv},
+		)
+		for _, chDesc := range chDescs {
+			dialer.AddChannel(chDesc.ID)
+		}
+		addr := NewNetAddress(mt.nodeKey.ID(), mt.listener.Addr())
+
+		p, err := dialer.Dial(*addr, cfg)
+		if err != nil {
+			t.Error(err)
+			close(pc)
+			return
+		}
+		pc <- p
+	}()
+
+	inbound, err := mt.Accept(cfg)
+	require.NoError(t, err)
+	outbound, ok := <-pc
+	require.True(t, ok)
+
+	for _, p := range []Peer{inbound, outbound} {
+		p.SetLogger(log.TestingLogger())
+		require.NoError(t, p.Start())
+		defer p.Stop() // nolint:errcheck // ignore for tests
+	}
+
+	// a message spanning many frames and a small one on another channel
+	large := make([]byte, 64*1024)
+	assert.True(t, outbound.Send(0x00, large))
+	assert.True(t, outbound.Send(0x01, []byte("vote")))
+
+	assert.Eventually(t, func() bool {
+		msgs := reactor.getMsgs(0x00)
+		return len(msgs) == 1 && len(reactor.getMsgs(0x01)) == 1 && len(msgs[0].Bytes) == len(large)
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []byte("vote"), reactor.getMsgs(0x01)[0].Bytes)
+}
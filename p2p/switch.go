@@ -11,6 +11,7 @@ import (
 	"github.com/tendermint/tendermint/libs/rand"
 	"github.com/tendermint/tendermint/libs/service"
 	"github.com/tendermint/tendermint/p2p/conn"
+	"github.com/tendermint/tendermint/p2p/trust"
 )
 
 const (
@@ -80,7 +81,7 @@ type Switch struct {
 	addrBook     AddrBook
 	// peers addresses with whom we'll maintain constant connection
 	persistentPeersAddrs []*NetAddress
-	unconditionalPeerIDs map[ID]struct{}
+	unconditionalPeerIDs *cmap.CMap
 
 	transport Transport
 
@@ -90,6 +91,9 @@ type Switch struct {
 	rng *rand.Rand // seed for randomizing dial times and orders
 
 	metrics *Metrics
+
+	// trust metrics of the peers, fed by the reactors' reports
+	trustStore *trust.MetricStore
 }
 
 // NetAddress returns the address the switch is listening on.
@@ -119,7 +123,7 @@ func NewSwitch(
 		transport:            transport,
 		filterTimeout:        defaultFilterTimeout,
 		persistentPeersAddrs: make([]*NetAddress, 0),
-		unconditionalPeerIDs: make(map[ID]struct{}),
+		unconditionalPeerIDs: cmap.NewCMap(),
 	}
 
 	// Ensure we have a completely undeterministic PRNG.
@@ -149,6 +153,13 @@ func WithMetrics(metrics *Metrics) SwitchOption {
 	return func(sw *Switch) { sw.metrics = metrics }
 }
 
+// SwitchTrustMetricStore sets the store recording the trust metrics of the
+// peers. The good and bad behaviour of the peers, see MarkPeerAsGood and
+// StopPeerForError, is recorded into it.
+func SwitchTrustMetricStore(store *trust.MetricStore) SwitchOption {
+	return func(sw *Switch) { sw.trustStore = store }
+}
+
 //---------------------------------------------------------------------
 // Switch setup
 
@@ -304,8 +315,7 @@ func (sw *Switch) NumPeers() (outbound, inbound, dialing int) {
 }
 
 func (sw *Switch) IsPeerUnconditional(id ID) bool {
-	_, ok := sw.unconditionalPeerIDs[id]
-	return ok
+	return sw.unconditionalPeerIDs.Has(string(id))
 }
 
 // MaxNumOutboundPeers returns a maximum number of outbound peers.
@@ -313,6 +323,11 @@ func (sw *Switch) MaxNumOutboundPeers() int {
 	return sw.config.MaxNumOutboundPeers
 }
 
+// MaxNumInboundPeers returns a maximum number of inbound peers.
+func (sw *Switch) MaxNumInboundPeers() int {
+	return sw.config.MaxNumInboundPeers
+}
+
 // Peers returns the set of peers that are connected to the switch.
 func (sw *Switch) Peers() IPeerSet {
 	return sw.peers
@@ -370,6 +385,10 @@ func (sw *Switch) stopAndRemovePeer(peer Peer, reason interface{}) {
 	if sw.peers.Remove(peer) {
 		sw.metrics.Peers.Add(float64(-1))
 	}
+
+	if sw.trustStore != nil {
+		sw.trustStore.PeerDisconnected(string(peer.ID()))
+	}
 }
 
 // reconnectToPeer tries to reconnect to the addr, first repeatedly
@@ -440,6 +459,26 @@ func (sw *Switch) MarkPeerAsGood(peer Peer) {
 	if sw.addrBook != nil {
 		sw.addrBook.MarkGood(peer.ID())
 	}
+	if sw.trustStore != nil {
+		sw.trustStore.GetPeerTrustMetric(string(peer.ID())).GoodEvents(1)
+	}
+}
+
+// MarkPeerAsBad records a misbehaviour of the given peer reported by a
+// reactor, e.g. an invalid message. Transport errors aren't misbehaviours.
+func (sw *Switch) MarkPeerAsBad(peer Peer) {
+	if sw.trustStore != nil {
+		sw.trustStore.GetPeerTrustMetric(string(peer.ID())).BadEvents(1)
+	}
+}
+
+// PeerTrustScore returns the trust score of the peer, between 0 and 100, and
+// false if the trust of the peer isn't tracked.
+func (sw *Switch) PeerTrustScore(id ID) (int, bool) {
+	if sw.trustStore == nil {
+		return 0, false
+	}
+	return sw.trustStore.PeerTrustScore(string(id))
 }
 
 //---------------------------------------------------------------------
@@ -586,11 +625,20 @@ func (sw *Switch) AddUnconditionalPeerIDs(ids []string) error {
 		if err != nil {
 			return fmt.Errorf("wrong ID #%d: %w", i, err)
 		}
-		sw.unconditionalPeerIDs[ID(id)] = struct{}{}
+		sw.unconditionalPeerIDs.Set(id, struct{}{})
 	}
 	return nil
 }
 
+// RemoveUnconditionalPeerIDs makes the peers with the given IDs subject to the
+// limits on the number of peers again. It doesn't disconnect them.
+func (sw *Switch) RemoveUnconditionalPeerIDs(ids []string) {
+	sw.Logger.Info("Removing unconditional peer ids", "ids", ids)
+	for _, id := range ids {
+		sw.unconditionalPeerIDs.Delete(id)
+	}
+}
+
 func (sw *Switch) AddPrivatePeerIDs(ids []string) error {
 	validIDs := make([]string, 0, len(ids))
 	for i, id := range ids {
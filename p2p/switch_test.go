@@ -19,12 +19,14 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/libs/log"
 	tmsync "github.com/tendermint/tendermint/libs/sync"
 	"github.com/tendermint/tendermint/p2p/conn"
+	"github.com/tendermint/tendermint/p2p/trust"
 )
 
 var (
@@ -451,6 +453,54 @@ func TestSwitchStopPeerForError(t *testing.T) {
 	assert.EqualValues(t, 0, peersMetricValue())
 }
 
+func TestSwitchTrustMetricStore(t *testing.T) {
+	trustStore := trust.NewTrustMetricStore(dbm.NewMemDB(), trust.DefaultConfig())
+	trustStore.SetLogger(log.TestingLogger())
+	require.NoError(t, trustStore.Start())
+	t.Cleanup(func() {
+		if err := trustStore.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	sw1, sw2 := MakeSwitchPair(t, func(i int, sw *Switch) *Switch {
+		if i == 0 {
+			SwitchTrustMetricStore(trustStore)(sw)
+		}
+		return initSwitchFunc(i, sw)
+	})
+	t.Cleanup(func() {
+		if err := sw2.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	require.Len(t, sw1.Peers().List(), 1)
+	p := sw1.Peers().List()[0]
+
+	// the trust of a peer is tracked once it behaves well or badly
+	_, ok := sw1.PeerTrustScore(p.ID())
+	assert.False(t, ok)
+	sw1.MarkPeerAsGood(p)
+	score, ok := sw1.PeerTrustScore(p.ID())
+	require.True(t, ok)
+	assert.Equal(t, 100, score)
+
+	// the transport errors don't lower the trust, the misbehaviours reported
+	// by the reactors do
+	sw1.StopPeerForError(p, fmt.Errorf("some err"))
+	score, ok = sw1.PeerTrustScore(p.ID())
+	require.True(t, ok)
+	assert.Equal(t, 100, score)
+	sw1.MarkPeerAsBad(p)
+	score, ok = sw1.PeerTrustScore(p.ID())
+	require.True(t, ok)
+	assert.Less(t, score, 100)
+
+	// the switch doesn't track the trust of its peers without a store
+	_, ok = sw2.PeerTrustScore(sw1.NodeInfo().ID())
+	assert.False(t, ok)
+}
+
 func TestSwitchReconnectsToOutboundPersistentPeer(t *testing.T) {
 	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", nil, initSwitchFunc)
 	err := sw.Start()
@@ -676,6 +726,21 @@ func TestSwitchAcceptRoutine(t *testing.T) {
 	}
 }
 
+func TestSwitchRemoveUnconditionalPeerIDs(t *testing.T) {
+	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", nil, initSwitchFunc)
+	ids := []string{
+		string(PubKeyToID(ed25519.GenPrivKey().PubKey())),
+		string(PubKeyToID(ed25519.GenPrivKey().PubKey())),
+	}
+	require.NoError(t, sw.AddUnconditionalPeerIDs(ids))
+	assert.True(t, sw.IsPeerUnconditional(ID(ids[0])))
+	assert.True(t, sw.IsPeerUnconditional(ID(ids[1])))
+
+	sw.RemoveUnconditionalPeerIDs(ids[:1])
+	assert.False(t, sw.IsPeerUnconditional(ID(ids[0])))
+	assert.True(t, sw.IsPeerUnconditional(ID(ids[1])))
+}
+
 type errorTransport struct {
 	acceptErr error
 }
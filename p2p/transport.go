@@ -18,6 +18,8 @@ const (
 	defaultDialTimeout      = time.Second
 	defaultFilterTimeout    = 5 * time.Second
 	defaultHandshakeTimeout = 3 * time.Second
+
+	maxProTxHashAuthSize = 1024 // bytes
 )
 
 // IPResolver is a behaviour subset of net.Resolver.
@@ -130,6 +132,34 @@ func MultiplexTransportMaxIncomingConnections(n int) MultiplexTransportOption {
 	return func(mt *MultiplexTransport) { mt.maxIncomingConnections = n }
 }
 
+// MultiplexTransportProTxHashAuth sets the signer proving the proTxHash of our
+// node info to the peers, and the verifier of the proTxHash claims of the
+// peers. Without a verifier, the peers claiming a proTxHash are downgraded to
+// regular peers.
+func MultiplexTransportProTxHashAuth(signer ProTxHashSigner, verifier ProTxHashVerifier) MultiplexTransportOption {
+	return func(mt *MultiplexTransport) {
+		mt.proTxHashSigner = signer
+		mt.proTxHashVerifier = verifier
+	}
+}
+
+// MultiplexTransportStreams carries the channels of the peers on independent
+// streams with their own flow control (see conn.StreamConnection), so a large
+// message on one channel doesn't delay the messages of the others. Reactors
+// may then receive the messages of different channels of a peer concurrently.
+//
+// The transport is announced in the node info, so the nodes only connect to
+// peers using the same transport.
+func MultiplexTransportStreams() MultiplexTransportOption {
+	return func(mt *MultiplexTransport) {
+		if ni, ok := mt.nodeInfo.(DefaultNodeInfo); ok {
+			ni.Other.Transport = TransportStream
+			mt.nodeInfo = ni
+		}
+		mt.newConn = newStreamConnection
+	}
+}
+
 // MultiplexTransport accepts and dials tcp connections and upgrades them to
 // multiplexed peers.
 type MultiplexTransport struct {
@@ -151,10 +181,16 @@ type MultiplexTransport struct {
 	nodeKey          NodeKey
 	resolver         IPResolver
 
+	proTxHashSigner   ProTxHashSigner
+	proTxHashVerifier ProTxHashVerifier
+
 	// TODO(xla): This config is still needed as we parameterise peerConn and
 	// peer currently. All relevant configuration should be refactored into options
 	// with sane defaults.
 	mConfig conn.MConnConfig
+
+	// creates the connections of the peers, see MultiplexTransportStreams
+	newConn connFactory
 }
 
 // Test multiplexTransport for interface completeness.
@@ -174,6 +210,7 @@ func NewMultiplexTransport(
 		filterTimeout:    defaultFilterTimeout,
 		handshakeTimeout: defaultHandshakeTimeout,
 		mConfig:          mConfig,
+		newConn:          newMConnection,
 		nodeInfo:         nodeInfo,
 		nodeKey:          nodeKey,
 		conns:            NewConnSet(),
@@ -485,7 +522,76 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	return secretConn, nodeInfo, nil
+	authNodeInfo, err := mt.authenticateProTxHash(secretConn, nodeInfo)
+	if err != nil {
+		return nil, nil, ErrRejected{
+			conn:          c,
+			err:           fmt.Errorf("proTxHash authentication failed: %w", err),
+			id:            nodeInfo.ID(),
+			isAuthFailure: true,
+		}
+	}
+
+	return secretConn, authNodeInfo, nil
+}
+
+// authenticateProTxHash exchanges the proofs of the proTxHashes of the node
+// infos, and verifies the one of the peer. It returns the node info of the peer
+// without proTxHash if the claim can't be verified, and an error if the proof
+// is invalid.
+func (mt *MultiplexTransport) authenticateProTxHash(
+	sc *conn.SecretConnection,
+	nodeInfo NodeInfo,
+) (NodeInfo, error) {
+	ourNodeInfo, ok := mt.nodeInfo.(DefaultNodeInfo)
+	if !ok {
+		return nodeInfo, nil
+	}
+	peerNodeInfo, ok := nodeInfo.(DefaultNodeInfo)
+	if !ok {
+		return nodeInfo, nil
+	}
+	if !ourNodeInfo.supportsProTxHashAuth() || !peerNodeInfo.supportsProTxHashAuth() {
+		peerNodeInfo.ProTxHash = nil
+		return peerNodeInfo, nil
+	}
+
+	// the proofs are only exchanged between nodes which both claim a
+	// proTxHash, so that other nodes can't make a masternode sign
+	if ourNodeInfo.ProTxHash == nil || peerNodeInfo.ProTxHash == nil {
+		peerNodeInfo.ProTxHash = nil
+		return peerNodeInfo, nil
+	}
+
+	// a node which can't prove its proTxHash sends an empty proof, and is
+	// downgraded by the peer
+	ourAuth := &tmp2p.ProTxHashAuth{}
+	if mt.proTxHashSigner != nil {
+		msgHash := proTxHashAuthMsgHash(sc.SessionChallenge(), ourNodeInfo.ID(), *ourNodeInfo.ProTxHash)
+		if signature, err := mt.proTxHashSigner.SignProTxHash(msgHash); err == nil {
+			ourAuth.Signature = signature
+		}
+	}
+
+	peerAuth, err := exchangeProTxHashAuth(sc, mt.handshakeTimeout, ourAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	if mt.proTxHashVerifier == nil || len(peerAuth.Signature) == 0 {
+		peerNodeInfo.ProTxHash = nil
+		return peerNodeInfo, nil
+	}
+	msgHash := proTxHashAuthMsgHash(sc.SessionChallenge(), peerNodeInfo.ID(), *peerNodeInfo.ProTxHash)
+	valid, err := mt.proTxHashVerifier.VerifyProTxHash(*peerNodeInfo.ProTxHash, msgHash, peerAuth.Signature)
+	if err != nil {
+		peerNodeInfo.ProTxHash = nil
+		return peerNodeInfo, nil
+	}
+	if !valid {
+		return nil, fmt.Errorf("invalid signature of proTxHash %v", peerNodeInfo.ProTxHash)
+	}
+	return peerNodeInfo, nil
 }
 
 func (mt *MultiplexTransport) wrapPeer(
@@ -522,6 +628,7 @@ func (mt *MultiplexTransport) wrapPeer(
 		cfg.chDescs,
 		cfg.onPeerError,
 		PeerMetrics(cfg.metrics),
+		peerConnection(mt.newConn),
 	)
 
 	return p
@@ -569,6 +676,41 @@ func handshake(
 	return peerNodeInfo, c.SetDeadline(time.Time{})
 }
 
+// exchangeProTxHashAuth sends our proof of proTxHash and receives the one of
+// the peer.
+func exchangeProTxHashAuth(
+	c net.Conn,
+	timeout time.Duration,
+	ourAuth *tmp2p.ProTxHashAuth,
+) (*tmp2p.ProTxHashAuth, error) {
+	if err := c.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, err
+	}
+
+	var (
+		errc     = make(chan error, 2)
+		peerAuth = new(tmp2p.ProTxHashAuth)
+	)
+
+	go func(errc chan<- error, c net.Conn) {
+		_, err := protoio.NewDelimitedWriter(c).WriteMsg(ourAuth)
+		errc <- err
+	}(errc, c)
+	go func(errc chan<- error, c net.Conn) {
+		_, err := protoio.NewDelimitedReader(c, maxProTxHashAuthSize).ReadMsg(peerAuth)
+		errc <- err
+	}(errc, c)
+
+	for i := 0; i < cap(errc); i++ {
+		err := <-errc
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return peerAuth, c.SetDeadline(time.Time{})
+}
+
 func upgradeSecretConn(
 	c net.Conn,
 	timeout time.Duration,
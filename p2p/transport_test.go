@@ -1,6 +1,8 @@
 package p2p
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"math/rand"
 	"net"
@@ -10,6 +12,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/libs/protoio"
 	"github.com/tendermint/tendermint/p2p/conn"
@@ -34,8 +40,43 @@ func newMultiplexTransport(
 	)
 }
 
-func TestTransportMultiplexConnFilter(t *testing.T) {
-	mt := newMultiplexTransport(
+// testTransportFactory creates the transport under test, see transportTests.
+type testTransportFactory func(nodeInfo NodeInfo, nodeKey NodeKey) *MultiplexTransport
+
+// transportTests are run against every transport implementation.
+var transportTests = []struct {
+	name string
+	test func(t *testing.T, newTransport testTransportFactory)
+}{
+	{"ConnFilter", testTransportConnFilter},
+	{"ConnFilterTimeout", testTransportConnFilterTimeout},
+	{"MaxIncomingConnections", testTransportMaxIncomingConnections},
+	{"AcceptMultiple", testTransportAcceptMultiple},
+	{"AcceptNonBlocking", testTransportAcceptNonBlocking},
+	{"ValidateNodeInfo", testTransportValidateNodeInfo},
+	{"RejectMissmatchID", testTransportRejectMissmatchID},
+	{"DialRejectWrongID", testTransportDialRejectWrongID},
+	{"RejectIncompatible", testTransportRejectIncompatible},
+	{"RejectSelf", testTransportRejectSelf},
+	{"ProTxHashAuth", testTransportProTxHashAuth},
+	{"AddChannel", testTransportAddChannel},
+}
+
+func runTransportTests(t *testing.T, newTransport testTransportFactory) {
+	for _, tc := range transportTests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.test(t, newTransport)
+		})
+	}
+}
+
+func TestTransportMultiplex(t *testing.T) {
+	runTransportTests(t, newMultiplexTransport)
+}
+
+func testTransportConnFilter(t *testing.T, newTransport testTransportFactory) {
+	mt := newTransport(
 		emptyNodeInfo(),
 		NodeKey{
 			PrivKey: ed25519.GenPrivKey(),
@@ -88,8 +129,8 @@ func TestTransportMultiplexConnFilter(t *testing.T) {
 	}
 }
 
-func TestTransportMultiplexConnFilterTimeout(t *testing.T) {
-	mt := newMultiplexTransport(
+func testTransportConnFilterTimeout(t *testing.T, newTransport testTransportFactory) {
+	mt := newTransport(
 		emptyNodeInfo(),
 		NodeKey{
 			PrivKey: ed25519.GenPrivKey(),
@@ -137,10 +178,10 @@ func TestTransportMultiplexConnFilterTimeout(t *testing.T) {
 	}
 }
 
-func TestTransportMultiplexMaxIncomingConnections(t *testing.T) {
+func testTransportMaxIncomingConnections(t *testing.T, newTransport testTransportFactory) {
 	pv := ed25519.GenPrivKey()
 	id := PubKeyToID(pv.PubKey())
-	mt := newMultiplexTransport(
+	mt := newTransport(
 		testNodeInfo(
 			id, "transport", nil,
 		),
@@ -166,7 +207,7 @@ func TestTransportMultiplexMaxIncomingConnections(t *testing.T) {
 	// Connect more peers than max
 	for i := 0; i <= maxIncomingConns; i++ {
 		errc := make(chan error)
-		go testDialer(*laddr, errc)
+		go testDialer(newTransport, *laddr, errc)
 
 		err = <-errc
 		if i < maxIncomingConns {
@@ -186,8 +227,8 @@ func TestTransportMultiplexMaxIncomingConnections(t *testing.T) {
 	}
 }
 
-func TestTransportMultiplexAcceptMultiple(t *testing.T) {
-	mt := testSetupMultiplexTransport(t)
+func testTransportAcceptMultiple(t *testing.T, newTransport testTransportFactory) {
+	mt := testSetupTransport(t, newTransport)
 	laddr := NewNetAddress(mt.nodeKey.ID(), mt.listener.Addr())
 
 	var (
@@ -198,7 +239,7 @@ func TestTransportMultiplexAcceptMultiple(t *testing.T) {
 
 	// Setup dialers.
 	for i := 0; i < nDialers; i++ {
-		go testDialer(*laddr, errc)
+		go testDialer(newTransport, *laddr, errc)
 	}
 
 	// Catch connection errors.
@@ -240,10 +281,10 @@ func TestTransportMultiplexAcceptMultiple(t *testing.T) {
 	}
 }
 
-func testDialer(dialAddr NetAddress, errc chan error) {
+func testDialer(newTransport testTransportFactory, dialAddr NetAddress, errc chan error) {
 	var (
 		pv     = ed25519.GenPrivKey()
-		dialer = newMultiplexTransport(
+		dialer = newTransport(
 			testNodeInfo(PubKeyToID(pv.PubKey()), defaultNodeName, nil),
 			NodeKey{
 				PrivKey: pv,
@@ -261,16 +302,22 @@ func testDialer(dialAddr NetAddress, errc chan error) {
 	errc <- nil
 }
 
-func TestTransportMultiplexAcceptNonBlocking(t *testing.T) {
-	mt := testSetupMultiplexTransport(t)
+func testTransportAcceptNonBlocking(t *testing.T, newTransport testTransportFactory) {
+	mt := testSetupTransport(t, newTransport)
 
 	var (
 		fastNodePV   = ed25519.GenPrivKey()
 		fastNodeInfo = testNodeInfo(PubKeyToID(fastNodePV.PubKey()), "fastnode", nil)
-		errc         = make(chan error)
-		fastc        = make(chan struct{})
-		slowc        = make(chan struct{})
-		slowdonec    = make(chan struct{})
+		fastNode     = newTransport(
+			fastNodeInfo,
+			NodeKey{
+				PrivKey: fastNodePV,
+			},
+		)
+		errc      = make(chan error)
+		fastc     = make(chan struct{})
+		slowc     = make(chan struct{})
+		slowdonec = make(chan struct{})
 	)
 
 	// Simulate slow Peer.
@@ -320,17 +367,9 @@ func TestTransportMultiplexAcceptNonBlocking(t *testing.T) {
 	go func() {
 		<-slowc
 
-		var (
-			dialer = newMultiplexTransport(
-				fastNodeInfo,
-				NodeKey{
-					PrivKey: fastNodePV,
-				},
-			)
-		)
 		addr := NewNetAddress(mt.nodeKey.ID(), mt.listener.Addr())
 
-		_, err := dialer.Dial(*addr, peerConfig{})
+		_, err := fastNode.Dial(*addr, peerConfig{})
 		if err != nil {
 			errc <- err
 			return
@@ -350,20 +389,20 @@ func TestTransportMultiplexAcceptNonBlocking(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if have, want := p.NodeInfo(), fastNodeInfo; !reflect.DeepEqual(have, want) {
+	if have, want := p.NodeInfo(), fastNode.nodeInfo; !reflect.DeepEqual(have, want) {
 		t.Errorf("have %v, want %v", have, want)
 	}
 }
 
-func TestTransportMultiplexValidateNodeInfo(t *testing.T) {
-	mt := testSetupMultiplexTransport(t)
+func testTransportValidateNodeInfo(t *testing.T, newTransport testTransportFactory) {
+	mt := testSetupTransport(t, newTransport)
 
 	errc := make(chan error)
 
 	go func() {
 		var (
 			pv     = ed25519.GenPrivKey()
-			dialer = newMultiplexTransport(
+			dialer = newTransport(
 				testNodeInfo(PubKeyToID(pv.PubKey()), "", nil), // Should not be empty
 				NodeKey{
 					PrivKey: pv,
@@ -396,13 +435,13 @@ func TestTransportMultiplexValidateNodeInfo(t *testing.T) {
 	}
 }
 
-func TestTransportMultiplexRejectMissmatchID(t *testing.T) {
-	mt := testSetupMultiplexTransport(t)
+func testTransportRejectMissmatchID(t *testing.T, newTransport testTransportFactory) {
+	mt := testSetupTransport(t, newTransport)
 
 	errc := make(chan error)
 
 	go func() {
-		dialer := newMultiplexTransport(
+		dialer := newTransport(
 			testNodeInfo(
 				PubKeyToID(ed25519.GenPrivKey().PubKey()), "dialer", nil,
 			),
@@ -435,12 +474,12 @@ func TestTransportMultiplexRejectMissmatchID(t *testing.T) {
 	}
 }
 
-func TestTransportMultiplexDialRejectWrongID(t *testing.T) {
-	mt := testSetupMultiplexTransport(t)
+func testTransportDialRejectWrongID(t *testing.T, newTransport testTransportFactory) {
+	mt := testSetupTransport(t, newTransport)
 
 	var (
 		pv     = ed25519.GenPrivKey()
-		dialer = newMultiplexTransport(
+		dialer = newTransport(
 			testNodeInfo(PubKeyToID(pv.PubKey()), "", nil), // Should not be empty
 			NodeKey{
 				PrivKey: pv,
@@ -464,15 +503,15 @@ func TestTransportMultiplexDialRejectWrongID(t *testing.T) {
 	}
 }
 
-func TestTransportMultiplexRejectIncompatible(t *testing.T) {
-	mt := testSetupMultiplexTransport(t)
+func testTransportRejectIncompatible(t *testing.T, newTransport testTransportFactory) {
+	mt := testSetupTransport(t, newTransport)
 
 	errc := make(chan error)
 
 	go func() {
 		var (
 			pv     = ed25519.GenPrivKey()
-			dialer = newMultiplexTransport(
+			dialer = newTransport(
 				testNodeInfoWithNetwork(PubKeyToID(pv.PubKey()), "dialer", "incompatible-network", nil),
 				NodeKey{
 					PrivKey: pv,
@@ -500,8 +539,8 @@ func TestTransportMultiplexRejectIncompatible(t *testing.T) {
 	}
 }
 
-func TestTransportMultiplexRejectSelf(t *testing.T) {
-	mt := testSetupMultiplexTransport(t)
+func testTransportRejectSelf(t *testing.T, newTransport testTransportFactory) {
+	mt := testSetupTransport(t, newTransport)
 
 	errc := make(chan error)
 
@@ -622,8 +661,114 @@ func TestTransportHandshake(t *testing.T) {
 	}
 }
 
-func TestTransportAddChannel(t *testing.T) {
-	mt := newMultiplexTransport(
+// testProTxHashAuth signs the message hashes with the proTxHash as key, and
+// verifies them for the masternodes it knows.
+type testProTxHashAuth struct {
+	proTxHash   crypto.ProTxHash
+	masternodes bool
+}
+
+func (a testProTxHashAuth) signature(proTxHash crypto.ProTxHash, msgHash []byte) []byte {
+	return crypto.Sha256(append(append([]byte{}, proTxHash...), msgHash...))
+}
+
+func (a testProTxHashAuth) SignProTxHash(msgHash []byte) ([]byte, error) {
+	return a.signature(a.proTxHash, msgHash), nil
+}
+
+func (a testProTxHashAuth) VerifyProTxHash(proTxHash crypto.ProTxHash, msgHash, signature []byte) (bool, error) {
+	if !a.masternodes {
+		return false, errors.New("unknown masternode")
+	}
+	return bytes.Equal(signature, a.signature(proTxHash, msgHash)), nil
+}
+
+func testTransportProTxHashAuth(t *testing.T, newTransport testTransportFactory) {
+	verifier := testProTxHashAuth{masternodes: true}
+	testCases := []struct {
+		name string
+		// options of the dialer, which claims a proTxHash
+		signer      ProTxHashSigner
+		p2pProtocol uint64
+		// options of the listener, which verifies the claim
+		verifier  ProTxHashVerifier
+		anonymous bool
+
+		rejected   bool
+		downgraded bool
+	}{
+		{"valid proof", testProTxHashAuth{}, 0, verifier, false, false, false},
+		{"invalid proof", testProTxHashAuth{proTxHash: crypto.RandProTxHash()}, 0, verifier, false, true, false},
+		{"no proof", nil, 0, verifier, false, false, true},
+		{"unknown masternode", testProTxHashAuth{}, 0, testProTxHashAuth{}, false, false, true},
+		{"no verifier", testProTxHashAuth{}, 0, nil, false, false, true},
+		{"listener without proTxHash", testProTxHashAuth{}, 0, verifier, true, false, true},
+		{"earlier protocol", testProTxHashAuth{}, proTxHashAuthP2PProtocol - 1, verifier, false, false, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			listenerProTxHash := crypto.RandProTxHash()
+			listenerProTxHashP := &listenerProTxHash
+			if tc.anonymous {
+				listenerProTxHashP = nil
+			}
+			mt := testSetupTransportWithProTxHash(t, newTransport, listenerProTxHashP,
+				MultiplexTransportProTxHashAuth(testProTxHashAuth{proTxHash: listenerProTxHash}, tc.verifier))
+
+			var (
+				pv             = ed25519.GenPrivKey()
+				proTxHash      = crypto.RandProTxHash()
+				dialerNodeInfo = testNodeInfo(PubKeyToID(pv.PubKey()), "dialer", &proTxHash).(DefaultNodeInfo)
+			)
+			if tc.p2pProtocol != 0 {
+				dialerNodeInfo.ProtocolVersion.P2P = tc.p2pProtocol
+			}
+			dialer := newTransport(dialerNodeInfo, NodeKey{PrivKey: pv})
+			if signer, ok := tc.signer.(testProTxHashAuth); ok {
+				if signer.proTxHash == nil {
+					signer.proTxHash = proTxHash
+				}
+				MultiplexTransportProTxHashAuth(signer, verifier)(dialer)
+			}
+
+			errc := make(chan error, 1)
+			go func() {
+				addr := NewNetAddress(mt.nodeKey.ID(), mt.listener.Addr())
+				p, err := dialer.Dial(*addr, peerConfig{})
+				if err == nil && tc.signer != nil && tc.p2pProtocol == 0 && !tc.anonymous {
+					// the listener proves its proTxHash to the dialer
+					if peerProTxHash := p.NodeInfo().GetProTxHash(); peerProTxHash == nil ||
+						!bytes.Equal(*peerProTxHash, listenerProTxHash) {
+						err = fmt.Errorf("listener proTxHash %v, expected %v", peerProTxHash, listenerProTxHash)
+					}
+				}
+				errc <- err
+			}()
+
+			p, err := mt.Accept(peerConfig{})
+			if tc.rejected {
+				rejected, ok := err.(ErrRejected)
+				require.True(t, ok, "expected ErrRejected, got %v", err)
+				assert.True(t, rejected.IsAuthFailure())
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, <-errc)
+
+			if tc.downgraded {
+				assert.Nil(t, p.NodeInfo().GetProTxHash())
+			} else {
+				require.NotNil(t, p.NodeInfo().GetProTxHash())
+				assert.Equal(t, proTxHash, *p.NodeInfo().GetProTxHash())
+			}
+		})
+	}
+}
+
+func testTransportAddChannel(t *testing.T, newTransport testTransportFactory) {
+	mt := newTransport(
 		emptyNodeInfo(),
 		NodeKey{
 			PrivKey: ed25519.GenPrivKey(),
@@ -638,19 +783,31 @@ func TestTransportAddChannel(t *testing.T) {
 }
 
 // create listener
-func testSetupMultiplexTransport(t *testing.T) *MultiplexTransport {
+func testSetupTransport(t *testing.T, newTransport testTransportFactory) *MultiplexTransport {
+	return testSetupTransportWithProTxHash(t, newTransport, nil)
+}
+
+func testSetupTransportWithProTxHash(
+	t *testing.T,
+	newTransport testTransportFactory,
+	proTxHash *crypto.ProTxHash,
+	options ...MultiplexTransportOption,
+) *MultiplexTransport {
 	var (
 		pv = ed25519.GenPrivKey()
 		id = PubKeyToID(pv.PubKey())
-		mt = newMultiplexTransport(
+		mt = newTransport(
 			testNodeInfo(
-				id, "transport", nil,
+				id, "transport", proTxHash,
 			),
 			NodeKey{
 				PrivKey: pv,
 			},
 		)
 	)
+	for _, option := range options {
+		option(mt)
+	}
 
 	addr, err := NewNetAddressString(IDAddressString(id, "127.0.0.1:0"))
 	if err != nil {
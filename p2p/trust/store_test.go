@@ -141,6 +141,8 @@ func TestTrustMetricStorePeerScore(t *testing.T) {
 	require.NoError(t, err)
 
 	key := "TestKey"
+	_, ok := store.PeerTrustScore(key)
+	assert.False(t, ok, "the score of an unknown peer should not be tracked")
 	tm := store.GetPeerTrustMetric(key)
 
 	// This peer is innocent so far
@@ -162,6 +164,9 @@ func TestTrustMetricStorePeerScore(t *testing.T) {
 	// We will remember our experiences with this peer
 	tm = store.GetPeerTrustMetric(key)
 	assert.NotEqual(t, 100, tm.TrustScore())
+	score, ok := store.PeerTrustScore(key)
+	assert.True(t, ok)
+	assert.Equal(t, tm.TrustScore(), score)
 	err = store.Stop()
 	require.NoError(t, err)
 }
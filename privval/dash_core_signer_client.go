@@ -236,12 +236,12 @@ func (sc *DashCoreSignerClient) SignVote(
 
 	blockResponse, err := sc.dashCoreRPCClient.QuorumSign(quorumType, blockRequestID, blockMessageHash, quorumHash)
 
+	if err != nil {
+		return remoteSignerError(err)
+	}
 	if blockResponse == nil {
 		return ErrUnexpectedResponse
 	}
-	if err != nil {
-		return &RemoteSignerError{Code: 500, Description: err.Error()}
-	}
 
 	// fmt.Printf("blockResponse %v", blockResponse)
 	//
@@ -272,7 +272,7 @@ func (sc *DashCoreSignerClient) SignVote(
 
 	pubKey, err := sc.GetPubKey(quorumHash)
 	if err != nil {
-		return &RemoteSignerError{Code: 500, Description: err.Error()}
+		return remoteSignerError(err)
 	}
 	verified := pubKey.VerifySignatureDigest(signID, blockDecodedSignature)
 	if verified {
@@ -292,12 +292,12 @@ func (sc *DashCoreSignerClient) SignVote(
 		stateResponse, err := sc.dashCoreRPCClient.QuorumSign(
 			sc.defaultQuorumType, stateRequestID, stateMessageHash, quorumHash)
 
+		if err != nil {
+			return remoteSignerError(err)
+		}
 		if stateResponse == nil {
 			return ErrUnexpectedResponse
 		}
-		if err != nil {
-			return &RemoteSignerError{Code: 500, Description: err.Error()}
-		}
 
 		stateDecodedSignature, err := hex.DecodeString(stateResponse.Signature)
 		if err != nil {
@@ -349,12 +349,12 @@ func (sc *DashCoreSignerClient) SignProposal(
 
 	response, err := sc.dashCoreRPCClient.QuorumSign(quorumType, requestIDHash, messageHash, quorumHash)
 
+	if err != nil {
+		return nil, remoteSignerError(err)
+	}
 	if response == nil {
 		return nil, ErrUnexpectedResponse
 	}
-	if err != nil {
-		return nil, &RemoteSignerError{Code: 500, Description: err.Error()}
-	}
 
 	decodedSignature, err := hex.DecodeString(response.Signature)
 	if err != nil {
@@ -408,3 +408,12 @@ func (sc *DashCoreSignerClient) UpdatePrivateKey(
 func (sc *DashCoreSignerClient) GetPrivateKey(quorumHash crypto.QuorumHash) (crypto.PrivKey, error) {
 	return nil, nil
 }
+
+// remoteSignerError wraps an error returned by Dash Core. Unavailability of
+// Dash Core is returned as is, so that consensus can tell it apart.
+func remoteSignerError(err error) error {
+	if dashcore.IsCoreUnavailable(err) {
+		return err
+	}
+	return &RemoteSignerError{Code: 500, Description: err.Error()}
+}
@@ -60,9 +60,11 @@ type FilePVKey struct {
 	ProTxHash            crypto.ProTxHash  `json:"pro_tx_hash"`
 
 	filePath string
+	// the key file is encrypted with this passphrase if it is set
+	passphrase []byte
 }
 
-// Save persists the FilePVKey to its filePath.
+// Save persists the FilePVKey to its filePath, encrypted if it has a passphrase.
 func (pvKey FilePVKey) Save() {
 	outFile := pvKey.filePath
 	if outFile == "" {
@@ -73,6 +75,12 @@ func (pvKey FilePVKey) Save() {
 	if err != nil {
 		panic(err)
 	}
+	if pvKey.passphrase != nil {
+		jsonBytes, err = encryptKey(jsonBytes, pvKey.passphrase)
+		if err != nil {
+			panic(err)
+		}
+	}
 	err = tempfile.WriteFileAtomic(outFile, jsonBytes, 0600)
 	if err != nil {
 		panic(err)
@@ -80,6 +88,20 @@ func (pvKey FilePVKey) Save() {
 
 }
 
+// Encrypted returns true if the FilePVKey is saved encrypted.
+func (pvKey FilePVKey) Encrypted() bool {
+	return pvKey.passphrase != nil
+}
+
+// SetPassphrase sets the passphrase the FilePVKey is encrypted with when it is saved.
+func (pvKey *FilePVKey) SetPassphrase(passphrase []byte) error {
+	if len(passphrase) == 0 {
+		return ErrEmptyKeyPassphrase
+	}
+	pvKey.passphrase = passphrase
+	return nil
+}
+
 func (pvKey FilePVKey) PrivateKeyForQuorumHash(quorumHash crypto.QuorumHash) (crypto.PrivKey, error) {
 	if keys, ok := pvKey.PrivateKeys[quorumHash.String()]; ok {
 		return keys.PrivKey, nil
@@ -331,23 +353,49 @@ func GenFilePV(keyFilePath, stateFilePath string) *FilePV {
 
 // LoadFilePV loads a FilePV from the filePaths.  The FilePV handles double
 // signing prevention by persisting data to the stateFilePath.  If either file path
-// does not exist, the program will exit.
+// does not exist, the program will exit. An encrypted key file is unlocked with
+// DefaultKeyPassphrase.
 func LoadFilePV(keyFilePath, stateFilePath string) *FilePV {
-	return loadFilePV(keyFilePath, stateFilePath, true)
+	return loadFilePV(keyFilePath, stateFilePath, true, DefaultKeyPassphrase(""))
+}
+
+// LoadFilePVWithPassphrase loads a FilePV from the filePaths like LoadFilePV, unlocking
+// an encrypted key file with the passphrase returned by the given function.
+func LoadFilePVWithPassphrase(keyFilePath, stateFilePath string, passphrase KeyPassphraseFunc) *FilePV {
+	return loadFilePV(keyFilePath, stateFilePath, true, passphrase)
 }
 
 // LoadFilePVEmptyState loads a FilePV from the given keyFilePath, with an empty LastSignState.
 // If the keyFilePath does not exist, the program will exit.
 func LoadFilePVEmptyState(keyFilePath, stateFilePath string) *FilePV {
-	return loadFilePV(keyFilePath, stateFilePath, false)
+	return loadFilePV(keyFilePath, stateFilePath, false, DefaultKeyPassphrase(""))
+}
+
+// LoadFilePVEmptyStateWithPassphrase loads a FilePV from the given keyFilePath like
+// LoadFilePVEmptyState, unlocking an encrypted key file with the passphrase returned by
+// the given function.
+func LoadFilePVEmptyStateWithPassphrase(keyFilePath, stateFilePath string, passphrase KeyPassphraseFunc) *FilePV {
+	return loadFilePV(keyFilePath, stateFilePath, false, passphrase)
 }
 
 // If loadState is true, we load from the stateFilePath. Otherwise, we use an empty LastSignState.
-func loadFilePV(keyFilePath, stateFilePath string, loadState bool) *FilePV {
+// The passphrase function is only called if the key file is encrypted.
+func loadFilePV(keyFilePath, stateFilePath string, loadState bool, getPassphrase KeyPassphraseFunc) *FilePV {
 	keyJSONBytes, err := ioutil.ReadFile(keyFilePath)
 	if err != nil {
 		tmos.Exit(err.Error())
 	}
+	var passphrase []byte
+	if isEncryptedKeyFile(keyJSONBytes) {
+		passphrase, err = getPassphrase()
+		if err != nil {
+			tmos.Exit(fmt.Sprintf("Error unlocking PrivValidator key %v: %v\n", keyFilePath, err))
+		}
+		keyJSONBytes, err = decryptKey(keyJSONBytes, passphrase)
+		if err != nil {
+			tmos.Exit(fmt.Sprintf("Error decrypting PrivValidator key %v: %v\n", keyFilePath, err))
+		}
+	}
 	pvKey := FilePVKey{}
 	err = tmjson.Unmarshal(keyJSONBytes, &pvKey)
 	if err != nil {
@@ -359,6 +407,7 @@ func loadFilePV(keyFilePath, stateFilePath string, loadState bool) *FilePV {
 	}
 
 	pvKey.filePath = keyFilePath
+	pvKey.passphrase = passphrase
 
 	pvState := FilePVLastSignState{}
 
@@ -384,9 +433,16 @@ func loadFilePV(keyFilePath, stateFilePath string, loadState bool) *FilePV {
 // LoadOrGenFilePV loads a FilePV from the given filePaths
 // or else generates a new one and saves it to the filePaths.
 func LoadOrGenFilePV(keyFilePath, stateFilePath string) *FilePV {
+	return LoadOrGenFilePVWithPassphrase(keyFilePath, stateFilePath, DefaultKeyPassphrase(""))
+}
+
+// LoadOrGenFilePVWithPassphrase loads a FilePV from the given filePaths like
+// LoadFilePVWithPassphrase, or else generates a new one and saves it to the filePaths.
+// New key files are saved unencrypted.
+func LoadOrGenFilePVWithPassphrase(keyFilePath, stateFilePath string, passphrase KeyPassphraseFunc) *FilePV {
 	var pv *FilePV
 	if tmos.FileExists(keyFilePath) {
-		pv = LoadFilePV(keyFilePath, stateFilePath)
+		pv = LoadFilePVWithPassphrase(keyFilePath, stateFilePath, passphrase)
 	} else {
 		pv = GenFilePV(keyFilePath, stateFilePath)
 		pv.Save()
@@ -0,0 +1,209 @@
+package privval
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/term"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/xchacha20poly1305"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+)
+
+const (
+	// KeyPassphraseEnv is the environment variable the passphrase of an encrypted
+	// private validator key file is read from.
+	KeyPassphraseEnv = "TM_PRIV_VALIDATOR_KEY_PASSPHRASE"
+
+	keyFileCipher = "xchacha20poly1305"
+	keyFileKDF    = "scrypt"
+
+	// scrypt parameters recommended for interactive logins.
+	scryptN       = 1 << 15
+	scryptR       = 8
+	scryptP       = 1
+	scryptSaltLen = 32
+
+	// Upper bounds of the scrypt parameters of the key files, which are read before
+	// the passphrase is checked: scrypt takes 128*N*R bytes of memory and time
+	// proportional to N*R*P, so a tampered key file mustn't exhaust the node.
+	maxScryptN = 1 << 20
+	maxScryptR = 32
+	maxScryptP = 16
+)
+
+// ErrEmptyKeyPassphrase is returned when a key file is encrypted or unlocked with an
+// empty passphrase.
+var ErrEmptyKeyPassphrase = errors.New("empty private validator key passphrase")
+
+// encryptedKeyFile is the content of an encrypted private validator key file. The
+// ciphertext is the nonce followed by the sealed JSON of the FilePVKey.
+type encryptedKeyFile struct {
+	Cipher     string `json:"cipher"`
+	KDF        string `json:"kdf"`
+	N          int    `json:"n"`
+	R          int    `json:"r"`
+	P          int    `json:"p"`
+	Salt       []byte `json:"salt"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// isEncryptedKeyFile returns true if the given key file content is encrypted.
+func isEncryptedKeyFile(keyJSONBytes []byte) bool {
+	var keyFile encryptedKeyFile
+	if err := tmjson.Unmarshal(keyJSONBytes, &keyFile); err != nil {
+		return false
+	}
+	return keyFile.Cipher != "" && len(keyFile.Ciphertext) > 0
+}
+
+// IsEncryptedKeyFile returns true if the private validator key file at the given path
+// is encrypted.
+func IsEncryptedKeyFile(keyFilePath string) (bool, error) {
+	keyJSONBytes, err := ioutil.ReadFile(keyFilePath)
+	if err != nil {
+		return false, err
+	}
+	return isEncryptedKeyFile(keyJSONBytes), nil
+}
+
+// encryptKey seals the given FilePVKey JSON with a key derived from the passphrase.
+func encryptKey(keyJSONBytes []byte, passphrase []byte) ([]byte, error) {
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyKeyPassphrase
+	}
+	keyFile := encryptedKeyFile{
+		Cipher: keyFileCipher,
+		KDF:    keyFileKDF,
+		N:      scryptN,
+		R:      scryptR,
+		P:      scryptP,
+		Salt:   crypto.CRandBytes(scryptSaltLen),
+	}
+	aead, err := keyFile.aead(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	nonce := crypto.CRandBytes(xchacha20poly1305.NonceSize)
+	keyFile.Ciphertext = aead.Seal(nonce, nonce, keyJSONBytes, nil)
+	return tmjson.MarshalIndent(keyFile, "", "  ")
+}
+
+// decryptKey opens an encrypted key file with the passphrase and returns the FilePVKey
+// JSON.
+func decryptKey(keyFileBytes []byte, passphrase []byte) ([]byte, error) {
+	var keyFile encryptedKeyFile
+	if err := tmjson.Unmarshal(keyFileBytes, &keyFile); err != nil {
+		return nil, err
+	}
+	if keyFile.Cipher != keyFileCipher {
+		return nil, fmt.Errorf("unsupported key file cipher %q", keyFile.Cipher)
+	}
+	if len(keyFile.Ciphertext) < xchacha20poly1305.NonceSize {
+		return nil, errors.New("key file ciphertext is too short")
+	}
+	if keyFile.N > maxScryptN || keyFile.R > maxScryptR || keyFile.P > maxScryptP {
+		return nil, fmt.Errorf("key file scrypt parameters n=%d, r=%d, p=%d exceed the maximum n=%d, r=%d, p=%d",
+			keyFile.N, keyFile.R, keyFile.P, maxScryptN, maxScryptR, maxScryptP)
+	}
+	aead, err := keyFile.aead(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	nonce := keyFile.Ciphertext[:xchacha20poly1305.NonceSize]
+	keyJSONBytes, err := aead.Open(nil, nonce, keyFile.Ciphertext[xchacha20poly1305.NonceSize:], nil)
+	if err != nil {
+		return nil, errors.New("wrong passphrase or corrupted key file")
+	}
+	return keyJSONBytes, nil
+}
+
+// aead returns the cipher of the key file, keyed with the passphrase.
+func (keyFile encryptedKeyFile) aead(passphrase []byte) (cipher.AEAD, error) {
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyKeyPassphrase
+	}
+	if keyFile.KDF != keyFileKDF {
+		return nil, fmt.Errorf("unsupported key file kdf %q", keyFile.KDF)
+	}
+	secret, err := scrypt.Key(passphrase, keyFile.Salt, keyFile.N, keyFile.R, keyFile.P, xchacha20poly1305.KeySize)
+	if err != nil {
+		return nil, err
+	}
+	return xchacha20poly1305.New(secret)
+}
+
+//-------------------------------------------------------------------------------
+
+// KeyPassphraseFunc returns the passphrase unlocking an encrypted private validator key
+// file. It is only called for key files that are encrypted.
+type KeyPassphraseFunc func() ([]byte, error)
+
+// DefaultKeyPassphrase returns a KeyPassphraseFunc reading the passphrase from the
+// KeyPassphraseEnv environment variable, or else from passphraseFile if it is not empty,
+// or else prompting for it when the standard input is a terminal.
+func DefaultKeyPassphrase(passphraseFile string) KeyPassphraseFunc {
+	return func() ([]byte, error) {
+		if passphrase, ok := os.LookupEnv(KeyPassphraseEnv); ok {
+			return []byte(passphrase), nil
+		}
+		if passphraseFile != "" {
+			return ReadKeyPassphraseFile(passphraseFile)
+		}
+		return PromptKeyPassphrase("Enter the passphrase of the private validator key: ")
+	}
+}
+
+// ReadKeyPassphraseFile reads a passphrase from the given file, without the trailing
+// newline.
+func ReadKeyPassphraseFile(passphraseFile string) ([]byte, error) {
+	passphrase, err := ioutil.ReadFile(passphraseFile)
+	if err != nil {
+		return nil, fmt.Errorf("can't read private validator key passphrase: %w", err)
+	}
+	return []byte(strings.TrimRight(string(passphrase), "\r\n")), nil
+}
+
+// PromptKeyPassphrase prints the prompt to the standard error and reads a passphrase from
+// the terminal, without echoing it. It returns an error if the standard input is not a
+// terminal.
+func PromptKeyPassphrase(prompt string) ([]byte, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return nil, fmt.Errorf("can't prompt for the private validator key passphrase: "+
+			"stdin is not a terminal, set %s or a passphrase file instead", KeyPassphraseEnv)
+	}
+	fmt.Fprint(os.Stderr, prompt)
+	passphrase, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return nil, err
+	}
+	return passphrase, nil
+}
+
+// PromptNewKeyPassphrase prompts for a new passphrase twice and returns it if both match.
+func PromptNewKeyPassphrase() ([]byte, error) {
+	passphrase, err := PromptKeyPassphrase("Enter a new passphrase for the private validator key: ")
+	if err != nil {
+		return nil, err
+	}
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyKeyPassphrase
+	}
+	confirmation, err := PromptKeyPassphrase("Repeat the passphrase: ")
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(passphrase, confirmation) {
+		return nil, errors.New("passphrases don't match")
+	}
+	return passphrase, nil
+}
@@ -114,6 +114,109 @@ func TestLoadOrGenValidator(t *testing.T) {
 	assert.Equal(publicKey, publicKey2, "expected privval public keys to be the same")
 }
 
+func TestEncryptedKeyFile(t *testing.T) {
+	tempKeyFile, err := ioutil.TempFile("", "priv_validator_key_")
+	require.Nil(t, err)
+	tempStateFile, err := ioutil.TempFile("", "priv_validator_state_")
+	require.Nil(t, err)
+
+	privVal := GenFilePV(tempKeyFile.Name(), tempStateFile.Name())
+	privVal.Save()
+	encrypted, err := IsEncryptedKeyFile(tempKeyFile.Name())
+	require.NoError(t, err)
+	assert.False(t, encrypted)
+
+	assert.Equal(t, ErrEmptyKeyPassphrase, privVal.Key.SetPassphrase(nil))
+	passphrase := []byte("correct horse battery staple")
+	require.NoError(t, privVal.Key.SetPassphrase(passphrase))
+	privVal.Save()
+
+	keyFileBytes, err := ioutil.ReadFile(tempKeyFile.Name())
+	require.NoError(t, err)
+	encrypted, err = IsEncryptedKeyFile(tempKeyFile.Name())
+	require.NoError(t, err)
+	assert.True(t, encrypted)
+	privKey, err := privVal.GetPrivateKey(mustFirstQuorumHash(t, privVal))
+	require.NoError(t, err)
+	assert.NotContains(t, string(keyFileBytes), base64.StdEncoding.EncodeToString(privKey.Bytes()))
+
+	loaded := LoadFilePVWithPassphrase(tempKeyFile.Name(), tempStateFile.Name(), func() ([]byte, error) {
+		return passphrase, nil
+	})
+	assert.True(t, loaded.Key.Encrypted())
+	assert.Equal(t, privVal.Key.PrivateKeys, loaded.Key.PrivateKeys)
+	assert.Equal(t, privVal.Key.ProTxHash, loaded.Key.ProTxHash)
+
+	// the key stays encrypted when it is saved again
+	loaded.Save()
+	encrypted, err = IsEncryptedKeyFile(tempKeyFile.Name())
+	require.NoError(t, err)
+	assert.True(t, encrypted)
+
+	// the passphrase is read from the environment first
+	os.Setenv(KeyPassphraseEnv, string(passphrase))
+	defer os.Unsetenv(KeyPassphraseEnv)
+	loaded = LoadFilePV(tempKeyFile.Name(), tempStateFile.Name())
+	assert.Equal(t, privVal.Key.PrivateKeys, loaded.Key.PrivateKeys)
+
+	_, err = decryptKey(keyFileBytes, []byte("wrong passphrase"))
+	assert.Error(t, err)
+}
+
+func TestDecryptKeyScryptParameters(t *testing.T) {
+	passphrase := []byte("correct horse battery staple")
+	keyFileBytes, err := encryptKey([]byte(`{"pro_tx_hash":""}`), passphrase)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		modify func(*encryptedKeyFile)
+		valid  bool
+	}{
+		{"default", func(*encryptedKeyFile) {}, true},
+		{"n too large", func(kf *encryptedKeyFile) { kf.N = maxScryptN << 1 }, false},
+		{"r too large", func(kf *encryptedKeyFile) { kf.R = maxScryptR + 1 }, false},
+		{"p too large", func(kf *encryptedKeyFile) { kf.P = maxScryptP + 1 }, false},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var keyFile encryptedKeyFile
+			require.NoError(t, tmjson.Unmarshal(keyFileBytes, &keyFile))
+			tc.modify(&keyFile)
+			modified, err := tmjson.Marshal(keyFile)
+			require.NoError(t, err)
+
+			_, err = decryptKey(modified, passphrase)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "exceed the maximum")
+			}
+		})
+	}
+}
+
+func TestReadKeyPassphraseFile(t *testing.T) {
+	passphraseFile, err := ioutil.TempFile("", "priv_validator_key_passphrase_")
+	require.NoError(t, err)
+	defer os.Remove(passphraseFile.Name())
+	_, err = passphraseFile.WriteString("passphrase\n")
+	require.NoError(t, err)
+	require.NoError(t, passphraseFile.Close())
+
+	passphrase, err := DefaultKeyPassphrase(passphraseFile.Name())()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("passphrase"), passphrase)
+}
+
+func mustFirstQuorumHash(t *testing.T, privVal *FilePV) crypto.QuorumHash {
+	quorumHash, err := privVal.GetFirstQuorumHash()
+	require.NoError(t, err)
+	return quorumHash
+}
+
 func TestUnmarshalValidatorState(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
 
@@ -36,6 +36,26 @@ func mustWrapMsg(pb proto.Message) privvalproto.Message {
 		msg.Sum = &privvalproto.Message_PingRequest{PingRequest: pb}
 	case *privvalproto.PingResponse:
 		msg.Sum = &privvalproto.Message_PingResponse{PingResponse: pb}
+	case *privvalproto.ThresholdPubKeyRequest:
+		msg.Sum = &privvalproto.Message_ThresholdPubKeyRequest{ThresholdPubKeyRequest: pb}
+	case *privvalproto.ThresholdPubKeyResponse:
+		msg.Sum = &privvalproto.Message_ThresholdPubKeyResponse{ThresholdPubKeyResponse: pb}
+	case *privvalproto.UpdatePrivateKeyRequest:
+		msg.Sum = &privvalproto.Message_UpdatePrivateKeyRequest{UpdatePrivateKeyRequest: pb}
+	case *privvalproto.UpdatePrivateKeyResponse:
+		msg.Sum = &privvalproto.Message_UpdatePrivateKeyResponse{UpdatePrivateKeyResponse: pb}
+	case *privvalproto.QuorumHeightRequest:
+		msg.Sum = &privvalproto.Message_QuorumHeightRequest{QuorumHeightRequest: pb}
+	case *privvalproto.QuorumHeightResponse:
+		msg.Sum = &privvalproto.Message_QuorumHeightResponse{QuorumHeightResponse: pb}
+	case *privvalproto.FirstQuorumHashRequest:
+		msg.Sum = &privvalproto.Message_FirstQuorumHashRequest{FirstQuorumHashRequest: pb}
+	case *privvalproto.FirstQuorumHashResponse:
+		msg.Sum = &privvalproto.Message_FirstQuorumHashResponse{FirstQuorumHashResponse: pb}
+	case *privvalproto.QuorumHashesRequest:
+		msg.Sum = &privvalproto.Message_QuorumHashesRequest{QuorumHashesRequest: pb}
+	case *privvalproto.QuorumHashesResponse:
+		msg.Sum = &privvalproto.Message_QuorumHashesResponse{QuorumHashesResponse: pb}
 	default:
 		panic(fmt.Errorf("unknown message type %T", pb))
 	}
@@ -74,6 +74,8 @@ func TestPrivvalVectors(t *testing.T) {
 	proposal := exampleProposal()
 	proposalpb := proposal.ToProto()
 
+	quorumHash := tmhash.Sum([]byte("quorum_hash"))
+
 	// Create a Reuseable remote error
 	remoteError := &privproto.RemoteSignerError{Code: 1, Description: "it's a error"}
 
@@ -93,6 +95,14 @@ func TestPrivvalVectors(t *testing.T) {
 		{"Proposal Request", &privproto.SignProposalRequest{Proposal: proposalpb}, "2a700a6e08011003180220022a4a0a208b01023386c371778ecb6368573e539afc3cc860ec3a2f614e54fe5652f4fc80122608c0843d122072db3d959635dff1bb567bedaa70573392c5159666a3f8caf11e413aac52207a320608f49a8ded053a10697427732061207369676e6174757265"},
 		{"Proposal Response", &privproto.SignedProposalResponse{Proposal: *proposalpb, Error: nil}, "32700a6e08011003180220022a4a0a208b01023386c371778ecb6368573e539afc3cc860ec3a2f614e54fe5652f4fc80122608c0843d122072db3d959635dff1bb567bedaa70573392c5159666a3f8caf11e413aac52207a320608f49a8ded053a10697427732061207369676e6174757265"},
 		{"Proposal Response with error", &privproto.SignedProposalResponse{Proposal: tmproto.Proposal{}, Error: remoteError}, "32250a112a021200320b088092b8c398feffffff0112100801120c697427732061206572726f72"},
+		{"Quorum Height Request", &privproto.QuorumHeightRequest{QuorumHash: quorumHash}, "7a221220ac0b3ebacef7bc4ada994aeb1dcd8686e452ece44e2173fc01847e34d8cf0246"},
+		{"Quorum Height Response", &privproto.QuorumHeightResponse{Height: 100}, "8201020864"},
+		{"First Quorum Hash Request", &privproto.FirstQuorumHashRequest{}, "8a0100"},
+		{"First Quorum Hash Response", &privproto.FirstQuorumHashResponse{QuorumHash: quorumHash}, "9201220a20ac0b3ebacef7bc4ada994aeb1dcd8686e452ece44e2173fc01847e34d8cf0246"},
+		{"Quorum Hashes Request", &privproto.QuorumHashesRequest{}, "9a0100"},
+		{"Quorum Hashes Response", &privproto.QuorumHashesResponse{QuorumHashes: [][]byte{quorumHash}}, "a201220a20ac0b3ebacef7bc4ada994aeb1dcd8686e452ece44e2173fc01847e34d8cf0246"},
+		{"Quorum Hashes Response with error", &privproto.QuorumHashesResponse{Error: remoteError}, "a2011212100801120c697427732061206572726f72"},
+		{"Update Private Key Response", &privproto.UpdatePrivateKeyResponse{}, "7200"},
 	}
 
 	for _, tc := range testCases {
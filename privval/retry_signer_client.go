@@ -1,7 +1,6 @@
 package privval
 
 import (
-	"errors"
 	"fmt"
 	"time"
 
@@ -104,7 +103,41 @@ func (sc *RetrySignerClient) GetProTxHash() (crypto.ProTxHash, error) {
 }
 
 func (sc *RetrySignerClient) GetFirstQuorumHash() (crypto.QuorumHash, error) {
-	return nil, errors.New("getFirstQuorumHash should not be called on a signer client")
+	var (
+		quorumHash crypto.QuorumHash
+		err        error
+	)
+	for i := 0; i < sc.retries || sc.retries == 0; i++ {
+		quorumHash, err = sc.next.GetFirstQuorumHash()
+		if err == nil {
+			return quorumHash, nil
+		}
+		// If remote signer errors, we don't retry.
+		if _, ok := err.(*RemoteSignerError); ok {
+			return nil, err
+		}
+		time.Sleep(sc.timeout)
+	}
+	return nil, fmt.Errorf("exhausted all attempts to get first quorum hash: %w", err)
+}
+
+func (sc *RetrySignerClient) GetQuorumHashes() ([]crypto.QuorumHash, error) {
+	var (
+		quorumHashes []crypto.QuorumHash
+		err          error
+	)
+	for i := 0; i < sc.retries || sc.retries == 0; i++ {
+		quorumHashes, err = sc.next.GetQuorumHashes()
+		if err == nil {
+			return quorumHashes, nil
+		}
+		// If remote signer errors, we don't retry.
+		if _, ok := err.(*RemoteSignerError); ok {
+			return nil, err
+		}
+		time.Sleep(sc.timeout)
+	}
+	return nil, fmt.Errorf("exhausted all attempts to get quorum hashes: %w", err)
 }
 
 func (sc *RetrySignerClient) GetThresholdPublicKey(quorumHash crypto.QuorumHash) (crypto.PubKey, error) {
@@ -125,8 +158,24 @@ func (sc *RetrySignerClient) GetThresholdPublicKey(quorumHash crypto.QuorumHash)
 	}
 	return nil, fmt.Errorf("exhausted all attempts to get pubkey: %w", err)
 }
+
 func (sc *RetrySignerClient) GetHeight(quorumHash crypto.QuorumHash) (int64, error) {
-	return 0, fmt.Errorf("getHeight should not be called on asigner client %s", quorumHash.String())
+	var (
+		height int64
+		err    error
+	)
+	for i := 0; i < sc.retries || sc.retries == 0; i++ {
+		height, err = sc.next.GetHeight(quorumHash)
+		if err == nil {
+			return height, nil
+		}
+		// If remote signer errors, we don't retry.
+		if _, ok := err.(*RemoteSignerError); ok {
+			return 0, err
+		}
+		time.Sleep(sc.timeout)
+	}
+	return 0, fmt.Errorf("exhausted all attempts to get height: %w", err)
 }
 
 func (sc *RetrySignerClient) SignVote(
@@ -169,7 +218,19 @@ func (sc *RetrySignerClient) SignProposal(
 func (sc *RetrySignerClient) UpdatePrivateKey(
 	privateKey crypto.PrivKey, quorumHash crypto.QuorumHash, thresholdPublicKey crypto.PubKey, height int64,
 ) {
-
+	var err error
+	for i := 0; i < sc.retries || sc.retries == 0; i++ {
+		err = sc.next.updatePrivateKey(privateKey, quorumHash, thresholdPublicKey, height)
+		if err == nil {
+			return
+		}
+		// If remote signer errors, we don't retry.
+		if _, ok := err.(*RemoteSignerError); ok {
+			break
+		}
+		time.Sleep(sc.timeout)
+	}
+	sc.next.endpoint.Logger.Error("RetrySignerClient::UpdatePrivateKey", "quorumHash", quorumHash, "err", err)
 }
 
 func (sc *RetrySignerClient) GetPrivateKey(quorumHash crypto.QuorumHash) (crypto.PrivKey, error) {
@@ -1,7 +1,6 @@
 package privval
 
 import (
-	"errors"
 	"fmt"
 	"time"
 
@@ -23,7 +22,10 @@ type SignerClient struct {
 	chainID  string
 }
 
-var _ types.PrivValidator = (*SignerClient)(nil)
+var (
+	_ types.PrivValidator = (*SignerClient)(nil)
+	_ QuorumHashLister    = (*SignerClient)(nil)
+)
 
 // NewSignerClient returns an instance of SignerClient.
 // it will start the endpoint (if not already started)
@@ -131,8 +133,44 @@ func (sc *SignerClient) GetProTxHash() (crypto.ProTxHash, error) {
 	return resp.ProTxHash, nil
 }
 
+// GetFirstQuorumHash retrieves the hash of the first quorum the remote signer holds a key of
 func (sc *SignerClient) GetFirstQuorumHash() (crypto.QuorumHash, error) {
-	return nil, errors.New("getFirstQuorumHash should not be called on a signer client")
+	response, err := sc.endpoint.SendRequest(mustWrapMsg(&privvalproto.FirstQuorumHashRequest{ChainId: sc.chainID}))
+	if err != nil {
+		return nil, fmt.Errorf("send: %w", err)
+	}
+
+	resp := response.GetFirstQuorumHashResponse()
+	if resp == nil {
+		return nil, ErrUnexpectedResponse
+	}
+	if resp.Error != nil {
+		return nil, &RemoteSignerError{Code: int(resp.Error.Code), Description: resp.Error.Description}
+	}
+
+	return resp.QuorumHash, nil
+}
+
+// GetQuorumHashes retrieves the hashes of all the quorums the remote signer holds a key of
+func (sc *SignerClient) GetQuorumHashes() ([]crypto.QuorumHash, error) {
+	response, err := sc.endpoint.SendRequest(mustWrapMsg(&privvalproto.QuorumHashesRequest{ChainId: sc.chainID}))
+	if err != nil {
+		return nil, fmt.Errorf("send: %w", err)
+	}
+
+	resp := response.GetQuorumHashesResponse()
+	if resp == nil {
+		return nil, ErrUnexpectedResponse
+	}
+	if resp.Error != nil {
+		return nil, &RemoteSignerError{Code: int(resp.Error.Code), Description: resp.Error.Description}
+	}
+
+	quorumHashes := make([]crypto.QuorumHash, len(resp.QuorumHashes))
+	for i, quorumHash := range resp.QuorumHashes {
+		quorumHashes[i] = quorumHash
+	}
+	return quorumHashes, nil
 }
 
 func (sc *SignerClient) GetThresholdPublicKey(quorumHash crypto.QuorumHash) (crypto.PubKey, error) {
@@ -147,7 +185,7 @@ func (sc *SignerClient) GetThresholdPublicKey(quorumHash crypto.QuorumHash) (cry
 		return nil, fmt.Errorf("send: %w", err)
 	}
 
-	resp := response.GetPubKeyResponse()
+	resp := response.GetThresholdPubKeyResponse()
 	if resp == nil {
 		return nil, ErrUnexpectedResponse
 	}
@@ -162,8 +200,25 @@ func (sc *SignerClient) GetThresholdPublicKey(quorumHash crypto.QuorumHash) (cry
 
 	return pk, nil
 }
+
+// GetHeight retrieves the height from which the remote signer uses its key of the quorum
 func (sc *SignerClient) GetHeight(quorumHash crypto.QuorumHash) (int64, error) {
-	return 0, fmt.Errorf("getHeight should not be called on asigner client %s", quorumHash.String())
+	response, err := sc.endpoint.SendRequest(
+		mustWrapMsg(&privvalproto.QuorumHeightRequest{ChainId: sc.chainID, QuorumHash: quorumHash}),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("send: %w", err)
+	}
+
+	resp := response.GetQuorumHeightResponse()
+	if resp == nil {
+		return 0, ErrUnexpectedResponse
+	}
+	if resp.Error != nil {
+		return 0, &RemoteSignerError{Code: int(resp.Error.Code), Description: resp.Error.Description}
+	}
+
+	return resp.Height, nil
 }
 
 // SignVote requests a remote signer to sign a vote
@@ -218,10 +273,43 @@ func (sc *SignerClient) SignProposal(
 	return blockSignID, nil
 }
 
+// UpdatePrivateKey stages the private key share of the quorum on the remote signer, to be used
+// from the given height on. Errors are logged, as PrivValidator doesn't allow returning them.
 func (sc *SignerClient) UpdatePrivateKey(
 	privateKey crypto.PrivKey, quorumHash crypto.QuorumHash, thresholdPublicKey crypto.PubKey, height int64,
 ) {
+	if err := sc.updatePrivateKey(privateKey, quorumHash, thresholdPublicKey, height); err != nil {
+		sc.endpoint.Logger.Error("SignerClient::UpdatePrivateKey", "quorumHash", quorumHash, "err", err)
+	}
+}
 
+func (sc *SignerClient) updatePrivateKey(
+	privateKey crypto.PrivKey, quorumHash crypto.QuorumHash, thresholdPublicKey crypto.PubKey, height int64,
+) error {
+	pk, err := cryptoenc.PubKeyToProto(thresholdPublicKey)
+	if err != nil {
+		return err
+	}
+	response, err := sc.endpoint.SendRequest(mustWrapMsg(&privvalproto.UpdatePrivateKeyRequest{
+		ChainId:            sc.chainID,
+		QuorumHash:         quorumHash,
+		PrivateKey:         privateKey.Bytes(),
+		ThresholdPublicKey: pk,
+		Height:             height,
+	}))
+	if err != nil {
+		return fmt.Errorf("send: %w", err)
+	}
+
+	resp := response.GetUpdatePrivateKeyResponse()
+	if resp == nil {
+		return ErrUnexpectedResponse
+	}
+	if resp.Error != nil {
+		return &RemoteSignerError{Code: int(resp.Error.Code), Description: resp.Error.Description}
+	}
+
+	return nil
 }
 
 func (sc *SignerClient) GetPrivateKey(quorumHash crypto.QuorumHash) (crypto.PrivKey, error) {
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 	cryptoproto "github.com/tendermint/tendermint/proto/tendermint/crypto"
@@ -121,6 +122,72 @@ func TestSignerGetPubKey(t *testing.T) {
 	}
 }
 
+func TestSignerGetThresholdPublicKey(t *testing.T) {
+	for _, tc := range getSignerTestCases(t) {
+		tc := tc
+		t.Cleanup(func() {
+			if err := tc.signerServer.Stop(); err != nil {
+				t.Error(err)
+			}
+		})
+		t.Cleanup(func() {
+			if err := tc.signerClient.Close(); err != nil {
+				t.Error(err)
+			}
+		})
+
+		pubKey, err := tc.signerClient.GetThresholdPublicKey(tc.quorumHash)
+		require.NoError(t, err)
+		expectedPubKey, err := tc.mockPV.GetThresholdPublicKey(tc.quorumHash)
+		require.NoError(t, err)
+
+		assert.Equal(t, expectedPubKey, pubKey)
+	}
+}
+
+func TestSignerQuorums(t *testing.T) {
+	for _, tc := range getSignerTestCases(t) {
+		tc := tc
+		t.Cleanup(func() {
+			if err := tc.signerServer.Stop(); err != nil {
+				t.Error(err)
+			}
+		})
+		t.Cleanup(func() {
+			if err := tc.signerClient.Close(); err != nil {
+				t.Error(err)
+			}
+		})
+
+		quorumHash, err := tc.signerClient.GetFirstQuorumHash()
+		require.NoError(t, err)
+		assert.Equal(t, tc.quorumHash, quorumHash)
+
+		_, err = tc.signerClient.GetHeight(crypto.RandQuorumHash())
+		assert.Error(t, err)
+
+		// stage a key of an upcoming quorum
+		nextQuorumHash := crypto.RandQuorumHash()
+		privKey := bls12381.GenPrivKey()
+		thresholdPublicKey := bls12381.GenPrivKey().PubKey()
+		tc.signerClient.UpdatePrivateKey(privKey, nextQuorumHash, thresholdPublicKey, 100)
+
+		pubKey, err := tc.signerClient.GetPubKey(nextQuorumHash)
+		require.NoError(t, err)
+		assert.Equal(t, privKey.PubKey(), pubKey)
+		pubKey, err = tc.signerClient.GetThresholdPublicKey(nextQuorumHash)
+		require.NoError(t, err)
+		assert.Equal(t, thresholdPublicKey, pubKey)
+		height, err := tc.signerClient.GetHeight(nextQuorumHash)
+		require.NoError(t, err)
+		assert.EqualValues(t, 100, height)
+
+		quorumHashes, err := tc.signerClient.GetQuorumHashes()
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []crypto.QuorumHash{tc.quorumHash, nextQuorumHash}, quorumHashes)
+	}
+}
+
 func TestSignerProposal(t *testing.T) {
 	for _, tc := range getSignerTestCases(t) {
 		ts := time.Now()
@@ -1,12 +1,14 @@
 package privval
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/dashevo/dashd-go/btcjson"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	cryptoenc "github.com/tendermint/tendermint/crypto/encoding"
 	cryptoproto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 	privvalproto "github.com/tendermint/tendermint/proto/tendermint/privval"
@@ -14,6 +16,12 @@ import (
 	"github.com/tendermint/tendermint/types"
 )
 
+// QuorumHashLister is implemented by private validators which can list the quorums they
+// hold a private key share of.
+type QuorumHashLister interface {
+	GetQuorumHashes() ([]crypto.QuorumHash, error)
+}
+
 func DefaultValidationRequestHandler(
 	privVal types.PrivValidator,
 	req privvalproto.Message,
@@ -28,11 +36,11 @@ func DefaultValidationRequestHandler(
 	case *privvalproto.Message_PubKeyRequest:
 		res, err = handleKeyRequest(
 			r.PubKeyRequest.QuorumHash, r.PubKeyRequest.GetChainId,
-			computePubKeyResponse, chainID, privVal, "unable to provide pubkey")
+			computePubKeyResponse, chainID, privVal.GetPubKey, "unable to provide pubkey")
 	case *privvalproto.Message_ThresholdPubKeyRequest:
 		res, err = handleKeyRequest(
 			r.ThresholdPubKeyRequest.QuorumHash, r.ThresholdPubKeyRequest.GetChainId,
-			computeThresholdPubKeyResponse, chainID, privVal, "unable to provide threshold pubkey")
+			computeThresholdPubKeyResponse, chainID, privVal.GetThresholdPublicKey, "unable to provide threshold pubkey")
 	case *privvalproto.Message_ProTxHashRequest:
 		if r.ProTxHashRequest.GetChainId() != chainID {
 			res = mustWrapMsg(&privvalproto.ProTxHashResponse{
@@ -94,6 +102,85 @@ func DefaultValidationRequestHandler(
 		} else {
 			res = mustWrapMsg(&privvalproto.SignedProposalResponse{Proposal: *proposal, Error: nil})
 		}
+	case *privvalproto.Message_UpdatePrivateKeyRequest:
+		if r.UpdatePrivateKeyRequest.GetChainId() != chainID {
+			res = mustWrapMsg(&privvalproto.UpdatePrivateKeyResponse{
+				Error: &privvalproto.RemoteSignerError{Code: 0, Description: "unable to update private key"}})
+			return res, fmt.Errorf("want chainID: %s, got chainID: %s", r.UpdatePrivateKeyRequest.GetChainId(), chainID)
+		}
+
+		var thresholdPublicKey crypto.PubKey
+		thresholdPublicKey, err = cryptoenc.PubKeyFromProto(r.UpdatePrivateKeyRequest.ThresholdPublicKey)
+		if err == nil && len(r.UpdatePrivateKeyRequest.PrivateKey) != bls12381.PrivateKeySize {
+			err = fmt.Errorf("private key must be %d bytes long", bls12381.PrivateKeySize)
+		}
+		if err != nil {
+			res = mustWrapMsg(&privvalproto.UpdatePrivateKeyResponse{
+				Error: &privvalproto.RemoteSignerError{Code: 0, Description: err.Error()}})
+		} else {
+			privVal.UpdatePrivateKey(
+				bls12381.PrivKey(r.UpdatePrivateKeyRequest.PrivateKey), r.UpdatePrivateKeyRequest.QuorumHash,
+				thresholdPublicKey, r.UpdatePrivateKeyRequest.Height)
+			res = mustWrapMsg(&privvalproto.UpdatePrivateKeyResponse{})
+		}
+
+	case *privvalproto.Message_QuorumHeightRequest:
+		if r.QuorumHeightRequest.GetChainId() != chainID {
+			res = mustWrapMsg(&privvalproto.QuorumHeightResponse{
+				Error: &privvalproto.RemoteSignerError{Code: 0, Description: "unable to provide quorum height"}})
+			return res, fmt.Errorf("want chainID: %s, got chainID: %s", r.QuorumHeightRequest.GetChainId(), chainID)
+		}
+
+		var height int64
+		height, err = privVal.GetHeight(r.QuorumHeightRequest.QuorumHash)
+		if err != nil {
+			res = mustWrapMsg(&privvalproto.QuorumHeightResponse{
+				Error: &privvalproto.RemoteSignerError{Code: 0, Description: err.Error()}})
+		} else {
+			res = mustWrapMsg(&privvalproto.QuorumHeightResponse{Height: height})
+		}
+
+	case *privvalproto.Message_FirstQuorumHashRequest:
+		if r.FirstQuorumHashRequest.GetChainId() != chainID {
+			res = mustWrapMsg(&privvalproto.FirstQuorumHashResponse{
+				Error: &privvalproto.RemoteSignerError{Code: 0, Description: "unable to provide first quorum hash"}})
+			return res, fmt.Errorf("want chainID: %s, got chainID: %s", r.FirstQuorumHashRequest.GetChainId(), chainID)
+		}
+
+		var quorumHash crypto.QuorumHash
+		quorumHash, err = privVal.GetFirstQuorumHash()
+		if err != nil {
+			res = mustWrapMsg(&privvalproto.FirstQuorumHashResponse{
+				Error: &privvalproto.RemoteSignerError{Code: 0, Description: err.Error()}})
+		} else {
+			res = mustWrapMsg(&privvalproto.FirstQuorumHashResponse{QuorumHash: quorumHash})
+		}
+
+	case *privvalproto.Message_QuorumHashesRequest:
+		if r.QuorumHashesRequest.GetChainId() != chainID {
+			res = mustWrapMsg(&privvalproto.QuorumHashesResponse{
+				Error: &privvalproto.RemoteSignerError{Code: 0, Description: "unable to provide quorum hashes"}})
+			return res, fmt.Errorf("want chainID: %s, got chainID: %s", r.QuorumHashesRequest.GetChainId(), chainID)
+		}
+
+		var quorumHashes []crypto.QuorumHash
+		lister, ok := privVal.(QuorumHashLister)
+		if ok {
+			quorumHashes, err = lister.GetQuorumHashes()
+		} else {
+			err = errors.New("listing quorums is not supported by the private validator")
+		}
+		if err != nil {
+			res = mustWrapMsg(&privvalproto.QuorumHashesResponse{
+				Error: &privvalproto.RemoteSignerError{Code: 0, Description: err.Error()}})
+		} else {
+			pbQuorumHashes := make([][]byte, len(quorumHashes))
+			for i, quorumHash := range quorumHashes {
+				pbQuorumHashes[i] = quorumHash
+			}
+			res = mustWrapMsg(&privvalproto.QuorumHashesResponse{QuorumHashes: pbQuorumHashes})
+		}
+
 	case *privvalproto.Message_PingRequest:
 		err, res = nil, mustWrapMsg(&privvalproto.PingResponse{})
 
@@ -126,10 +213,13 @@ func computeThresholdPubKeyResponse(pubKey cryptoproto.PublicKey, err *privvalpr
 // getChainID is a function type for getting chainID of a request
 type getChainID func() string
 
+// getKey is a function type for getting the key of a quorum requested
+type getKey func(quorumHash crypto.QuorumHash) (crypto.PubKey, error)
+
 // handleKeyRequest handles key message requests
 func handleKeyRequest(
 	quorumHash crypto.QuorumHash, getChainIDFn getChainID, keyResponseFn computeKeyResponse,
-	chainID string, privVal types.PrivValidator, description string,
+	chainID string, getKeyFn getKey, description string,
 ) (res privvalproto.Message, err error) {
 	if getChainIDFn() != chainID {
 		res = mustWrapMsg(keyResponseFn(
@@ -143,7 +233,7 @@ func handleKeyRequest(
 	}
 
 	var pubKey crypto.PubKey
-	pubKey, err = privVal.GetPubKey(quorumHash)
+	pubKey, err = getKeyFn(quorumHash)
 	if err != nil {
 		res = mustWrapMsg(keyResponseFn(
 			cryptoproto.PublicKey{},
@@ -254,6 +254,7 @@ func (m *DefaultNodeInfo) GetProTxHash() []byte {
 type DefaultNodeInfoOther struct {
 	TxIndex    string `protobuf:"bytes,1,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
 	RPCAddress string `protobuf:"bytes,2,opt,name=rpc_address,json=rpcAddress,proto3" json:"rpc_address,omitempty"`
+	Transport  string `protobuf:"bytes,3,opt,name=transport,proto3" json:"transport,omitempty"`
 }
 
 func (m *DefaultNodeInfoOther) Reset()         { *m = DefaultNodeInfoOther{} }
@@ -303,49 +304,105 @@ func (m *DefaultNodeInfoOther) GetRPCAddress() string {
 	return ""
 }
 
+func (m *DefaultNodeInfoOther) GetTransport() string {
+	if m != nil {
+		return m.Transport
+	}
+	return ""
+}
+
+// ProTxHashAuth proves that a node is the masternode with the proTxHash of its
+// node info, with a signature of the handshake challenge by its operator key.
+type ProTxHashAuth struct {
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *ProTxHashAuth) Reset()         { *m = ProTxHashAuth{} }
+func (m *ProTxHashAuth) String() string { return proto.CompactTextString(m) }
+func (*ProTxHashAuth) ProtoMessage()    {}
+func (*ProTxHashAuth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8a29e659aeca578, []int{4}
+}
+func (m *ProTxHashAuth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProTxHashAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProTxHashAuth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProTxHashAuth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProTxHashAuth.Merge(m, src)
+}
+func (m *ProTxHashAuth) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProTxHashAuth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProTxHashAuth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProTxHashAuth proto.InternalMessageInfo
+
+func (m *ProTxHashAuth) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*NetAddress)(nil), "tendermint.p2p.NetAddress")
 	proto.RegisterType((*ProtocolVersion)(nil), "tendermint.p2p.ProtocolVersion")
 	proto.RegisterType((*DefaultNodeInfo)(nil), "tendermint.p2p.DefaultNodeInfo")
 	proto.RegisterType((*DefaultNodeInfoOther)(nil), "tendermint.p2p.DefaultNodeInfoOther")
+	proto.RegisterType((*ProTxHashAuth)(nil), "tendermint.p2p.ProTxHashAuth")
 }
 
 func init() { proto.RegisterFile("tendermint/p2p/types.proto", fileDescriptor_c8a29e659aeca578) }
 
 var fileDescriptor_c8a29e659aeca578 = []byte{
-	// 504 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x3d, 0x8f, 0xda, 0x40,
-	0x10, 0xc5, 0x60, 0xbe, 0x86, 0x70, 0x5c, 0x56, 0x28, 0xf2, 0x51, 0xd8, 0x08, 0xa5, 0xa0, 0x02,
-	0x89, 0x28, 0x45, 0xba, 0x84, 0x50, 0x84, 0xe6, 0xce, 0x5a, 0x9d, 0x52, 0xa4, 0xb1, 0x8c, 0x77,
-	0x0f, 0xaf, 0x80, 0xdd, 0xd5, 0xee, 0x5e, 0x42, 0x7e, 0x43, 0x9a, 0xfc, 0xac, 0x2b, 0xaf, 0x4c,
-	0x85, 0x22, 0xf3, 0x47, 0x22, 0xaf, 0x7d, 0x09, 0x87, 0xd2, 0xcd, 0x9b, 0xd9, 0x79, 0x6f, 0xf6,
-	0x69, 0x06, 0x06, 0x86, 0x72, 0x42, 0xd5, 0x8e, 0x71, 0x33, 0x95, 0x33, 0x39, 0x35, 0xdf, 0x25,
-	0xd5, 0x13, 0xa9, 0x84, 0x11, 0xe8, 0xe2, 0x5f, 0x6d, 0x22, 0x67, 0x72, 0xd0, 0x5f, 0x8b, 0xb5,
-	0xb0, 0xa5, 0x69, 0x1e, 0x15, 0xaf, 0x46, 0x21, 0xc0, 0x35, 0x35, 0x1f, 0x08, 0x51, 0x54, 0x6b,
-	0xf4, 0x0a, 0xaa, 0x8c, 0x78, 0xce, 0xd0, 0x19, 0xb7, 0xe7, 0x8d, 0xec, 0x10, 0x54, 0x97, 0x0b,
-	0x5c, 0x65, 0xc4, 0xe6, 0xa5, 0x57, 0x3d, 0xc9, 0x87, 0xb8, 0xca, 0x24, 0x42, 0xe0, 0x4a, 0xa1,
-	0x8c, 0x57, 0x1b, 0x3a, 0xe3, 0x2e, 0xb6, 0xf1, 0xe8, 0x16, 0x7a, 0x61, 0x4e, 0x9d, 0x88, 0xed,
-	0x67, 0xaa, 0x34, 0x13, 0x1c, 0x5d, 0x41, 0x4d, 0xce, 0xa4, 0xe5, 0x75, 0xe7, 0xcd, 0xec, 0x10,
-	0xd4, 0xc2, 0x59, 0x88, 0xf3, 0x1c, 0xea, 0x43, 0x7d, 0xb5, 0x15, 0xc9, 0xc6, 0x92, 0xbb, 0xb8,
-	0x00, 0xe8, 0x12, 0x6a, 0xb1, 0x94, 0x96, 0xd6, 0xc5, 0x79, 0x38, 0xfa, 0x51, 0x83, 0xde, 0x82,
-	0xde, 0xc5, 0xf7, 0x5b, 0x73, 0x2d, 0x08, 0x5d, 0xf2, 0x3b, 0x81, 0x42, 0xb8, 0x94, 0xa5, 0x52,
-	0xf4, 0xb5, 0x90, 0xb2, 0x1a, 0x9d, 0x59, 0x30, 0x79, 0xfe, 0xf9, 0xc9, 0xd9, 0x44, 0x73, 0xf7,
-	0xe1, 0x10, 0x54, 0x70, 0x4f, 0x9e, 0x0d, 0xfa, 0x0e, 0x7a, 0xa4, 0x10, 0x89, 0xb8, 0x20, 0x34,
-	0x62, 0xa4, 0xfc, 0xf4, 0xcb, 0xec, 0x10, 0x74, 0x4f, 0xf5, 0x17, 0xb8, 0x4b, 0x4e, 0x20, 0x41,
-	0x01, 0x74, 0xb6, 0x4c, 0x1b, 0xca, 0xa3, 0x98, 0x10, 0x65, 0x47, 0x6f, 0x63, 0x28, 0x52, 0xb9,
-	0xbd, 0xc8, 0x83, 0x26, 0xa7, 0xe6, 0x9b, 0x50, 0x1b, 0xcf, 0xb5, 0xc5, 0x27, 0x98, 0x57, 0x9e,
-	0xc6, 0xaf, 0x17, 0x95, 0x12, 0xa2, 0x01, 0xb4, 0x92, 0x34, 0xe6, 0x9c, 0x6e, 0xb5, 0xd7, 0x18,
-	0x3a, 0xe3, 0x17, 0xf8, 0x2f, 0xce, 0xbb, 0x76, 0x82, 0xb3, 0x0d, 0x55, 0x5e, 0xb3, 0xe8, 0x2a,
-	0x21, 0x7a, 0x0f, 0x75, 0x61, 0x52, 0xaa, 0xbc, 0x96, 0x35, 0xe3, 0xf5, 0xb9, 0x19, 0x67, 0x3e,
-	0xde, 0xe4, 0x6f, 0x4b, 0x47, 0x8a, 0x46, 0xe4, 0x43, 0x47, 0x2a, 0x11, 0x99, 0x7d, 0x94, 0xc6,
-	0x3a, 0xf5, 0xda, 0x56, 0xba, 0x2d, 0x95, 0xb8, 0xdd, 0x7f, 0x8a, 0x75, 0x3a, 0x5a, 0x41, 0xff,
-	0x7f, 0x24, 0xe8, 0x0a, 0x5a, 0x66, 0x1f, 0x31, 0x4e, 0xe8, 0xbe, 0xd8, 0x22, 0xdc, 0x34, 0xfb,
-	0x65, 0x0e, 0xd1, 0x14, 0x3a, 0x4a, 0x26, 0xd6, 0x1c, 0xaa, 0x75, 0x69, 0xeb, 0x45, 0x76, 0x08,
-	0x00, 0x87, 0x1f, 0xcb, 0xfd, 0xc3, 0xa0, 0x64, 0x52, 0xc6, 0xf3, 0x9b, 0x87, 0xcc, 0x77, 0x1e,
-	0x33, 0xdf, 0xf9, 0x9d, 0xf9, 0xce, 0xcf, 0xa3, 0x5f, 0x79, 0x3c, 0xfa, 0x95, 0x5f, 0x47, 0xbf,
-	0xf2, 0xe5, 0xed, 0x9a, 0x99, 0xf4, 0x7e, 0x35, 0x49, 0xc4, 0x6e, 0x7a, 0x72, 0x00, 0xa7, 0xb7,
-	0x60, 0xd7, 0xfc, 0xf9, 0x71, 0xac, 0x1a, 0x36, 0xfb, 0xe6, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x3c, 0xbe, 0x56, 0x42, 0x35, 0x03, 0x00, 0x00,
+	// 543 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xb1, 0x6e, 0xdb, 0x30,
+	0x10, 0xb5, 0x64, 0x25, 0xb6, 0xce, 0x71, 0x9c, 0x12, 0x41, 0xa1, 0x04, 0x85, 0x14, 0x18, 0x1d,
+	0x32, 0xd9, 0x80, 0x83, 0x0e, 0xdd, 0x1a, 0x37, 0x43, 0xdd, 0x21, 0x11, 0x88, 0xa0, 0x43, 0x17,
+	0x41, 0x16, 0x19, 0x4b, 0xb0, 0x4d, 0x12, 0x24, 0xdd, 0xba, 0x5b, 0xf7, 0x2e, 0xfd, 0xac, 0x8c,
+	0x19, 0x3b, 0x19, 0x85, 0xfc, 0x23, 0x85, 0x28, 0x39, 0x76, 0x8c, 0x6e, 0xf7, 0xde, 0x91, 0xef,
+	0x1d, 0x1f, 0x78, 0x70, 0xae, 0x29, 0x23, 0x54, 0xce, 0x33, 0xa6, 0xfb, 0x62, 0x20, 0xfa, 0xfa,
+	0x87, 0xa0, 0xaa, 0x27, 0x24, 0xd7, 0x1c, 0x1d, 0x6f, 0x7b, 0x3d, 0x31, 0x10, 0xe7, 0xa7, 0x13,
+	0x3e, 0xe1, 0xa6, 0xd5, 0x2f, 0xaa, 0xf2, 0x54, 0x37, 0x04, 0xb8, 0xa5, 0xfa, 0x9a, 0x10, 0x49,
+	0x95, 0x42, 0xaf, 0xc1, 0xce, 0x88, 0x67, 0x5d, 0x58, 0x97, 0xee, 0xf0, 0x30, 0x5f, 0x05, 0xf6,
+	0xe8, 0x06, 0xdb, 0x19, 0x31, 0xbc, 0xf0, 0xec, 0x1d, 0x3e, 0xc4, 0x76, 0x26, 0x10, 0x02, 0x47,
+	0x70, 0xa9, 0xbd, 0xfa, 0x85, 0x75, 0xd9, 0xc6, 0xa6, 0xee, 0xde, 0x43, 0x27, 0x2c, 0xa4, 0x13,
+	0x3e, 0xfb, 0x42, 0xa5, 0xca, 0x38, 0x43, 0x67, 0x50, 0x17, 0x03, 0x61, 0x74, 0x9d, 0x61, 0x23,
+	0x5f, 0x05, 0xf5, 0x70, 0x10, 0xe2, 0x82, 0x43, 0xa7, 0x70, 0x30, 0x9e, 0xf1, 0x64, 0x6a, 0xc4,
+	0x1d, 0x5c, 0x02, 0x74, 0x02, 0xf5, 0x58, 0x08, 0x23, 0xeb, 0xe0, 0xa2, 0xec, 0xfe, 0xaa, 0x43,
+	0xe7, 0x86, 0x3e, 0xc4, 0x8b, 0x99, 0xbe, 0xe5, 0x84, 0x8e, 0xd8, 0x03, 0x47, 0x21, 0x9c, 0x88,
+	0xca, 0x29, 0xfa, 0x56, 0x5a, 0x19, 0x8f, 0xd6, 0x20, 0xe8, 0xbd, 0x7c, 0x7c, 0x6f, 0x6f, 0xa2,
+	0xa1, 0xf3, 0xb8, 0x0a, 0x6a, 0xb8, 0x23, 0xf6, 0x06, 0x7d, 0x0f, 0x1d, 0x52, 0x9a, 0x44, 0x8c,
+	0x13, 0x1a, 0x65, 0xa4, 0x7a, 0xf4, 0xab, 0x7c, 0x15, 0xb4, 0x77, 0xfd, 0x6f, 0x70, 0x9b, 0xec,
+	0x40, 0x82, 0x02, 0x68, 0xcd, 0x32, 0xa5, 0x29, 0x8b, 0x62, 0x42, 0xa4, 0x19, 0xdd, 0xc5, 0x50,
+	0x52, 0x45, 0xbc, 0xc8, 0x83, 0x06, 0xa3, 0xfa, 0x3b, 0x97, 0x53, 0xcf, 0x31, 0xcd, 0x0d, 0x2c,
+	0x3a, 0x9b, 0xf1, 0x0f, 0xca, 0x4e, 0x05, 0xd1, 0x39, 0x34, 0x93, 0x34, 0x66, 0x8c, 0xce, 0x94,
+	0x77, 0x78, 0x61, 0x5d, 0x1e, 0xe1, 0x67, 0x5c, 0xdc, 0x9a, 0x73, 0x96, 0x4d, 0xa9, 0xf4, 0x1a,
+	0xe5, 0xad, 0x0a, 0xa2, 0x0f, 0x70, 0xc0, 0x75, 0x4a, 0xa5, 0xd7, 0x34, 0x61, 0xbc, 0xdd, 0x0f,
+	0x63, 0x2f, 0xc7, 0xbb, 0xe2, 0x6c, 0x95, 0x48, 0x79, 0x11, 0xf9, 0xd0, 0x12, 0x92, 0x47, 0x7a,
+	0x19, 0xa5, 0xb1, 0x4a, 0x3d, 0xd7, 0x58, 0xbb, 0x42, 0xf2, 0xfb, 0xe5, 0xa7, 0x58, 0xa5, 0xdd,
+	0x9f, 0x16, 0x9c, 0xfe, 0x4f, 0x05, 0x9d, 0x41, 0x53, 0x2f, 0xa3, 0x8c, 0x11, 0xba, 0x2c, 0xbf,
+	0x11, 0x6e, 0xe8, 0xe5, 0xa8, 0x80, 0xa8, 0x0f, 0x2d, 0x29, 0x12, 0x93, 0x0e, 0x55, 0xaa, 0xca,
+	0xf5, 0x38, 0x5f, 0x05, 0x80, 0xc3, 0x8f, 0xd5, 0x07, 0xc4, 0x20, 0x45, 0x52, 0xd5, 0xe8, 0x0d,
+	0xb8, 0x5a, 0xc6, 0x4c, 0x3d, 0xff, 0x30, 0x17, 0x6f, 0x89, 0xee, 0x15, 0xb4, 0xc3, 0xcd, 0x3c,
+	0xd7, 0x0b, 0x9d, 0x16, 0xc7, 0x55, 0x36, 0x61, 0xb1, 0x5e, 0x48, 0x6a, 0xd4, 0x8f, 0xf0, 0x96,
+	0xf8, 0xec, 0x34, 0xad, 0x13, 0x7b, 0x78, 0xf7, 0x98, 0xfb, 0xd6, 0x53, 0xee, 0x5b, 0x7f, 0x73,
+	0xdf, 0xfa, 0xbd, 0xf6, 0x6b, 0x4f, 0x6b, 0xbf, 0xf6, 0x67, 0xed, 0xd7, 0xbe, 0xbe, 0x9b, 0x64,
+	0x3a, 0x5d, 0x8c, 0x7b, 0x09, 0x9f, 0xf7, 0x77, 0x96, 0x6a, 0xa7, 0x2c, 0x57, 0xe7, 0xe5, 0xc2,
+	0x8d, 0x0f, 0x0d, 0x7b, 0xf5, 0x6f, 0x00, 0x6e, 0x38, 0x6e, 0xd3, 0x89, 0x03, 0x00, 0x00,
 }
 
 func (m *NetAddress) Marshal() (dAtA []byte, err error) {
@@ -540,6 +597,13 @@ func (m *DefaultNodeInfoOther) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Transport) > 0 {
+		i -= len(m.Transport)
+		copy(dAtA[i:], m.Transport)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Transport)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.RPCAddress) > 0 {
 		i -= len(m.RPCAddress)
 		copy(dAtA[i:], m.RPCAddress)
@@ -557,6 +621,36 @@ func (m *DefaultNodeInfoOther) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProTxHashAuth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProTxHashAuth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProTxHashAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -661,6 +755,23 @@ func (m *DefaultNodeInfoOther) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.Transport)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ProTxHashAuth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1347,6 +1458,122 @@ func (m *DefaultNodeInfoOther) Unmarshal(dAtA []byte) error {
 			}
 			m.RPCAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transport", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transport = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProTxHashAuth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProTxHashAuth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProTxHashAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
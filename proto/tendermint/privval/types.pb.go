@@ -742,35 +742,28 @@ func (m *PingResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PingResponse proto.InternalMessageInfo
 
-type Message struct {
-	// Types that are valid to be assigned to Sum:
-	//	*Message_PubKeyRequest
-	//	*Message_PubKeyResponse
-	//	*Message_SignVoteRequest
-	//	*Message_SignedVoteResponse
-	//	*Message_SignProposalRequest
-	//	*Message_SignedProposalResponse
-	//	*Message_PingRequest
-	//	*Message_PingResponse
-	//	*Message_ProTxHashRequest
-	//	*Message_ProTxHashResponse
-	//	*Message_ThresholdPubKeyRequest
-	//	*Message_ThresholdPubKeyResponse
-	Sum isMessage_Sum `protobuf_oneof:"sum"`
-}
-
-func (m *Message) Reset()         { *m = Message{} }
-func (m *Message) String() string { return proto.CompactTextString(m) }
-func (*Message) ProtoMessage()    {}
-func (*Message) Descriptor() ([]byte, []int) {
+// UpdatePrivateKeyRequest stages the private key share of the signer for a quorum, to be
+// used from the given height on.
+type UpdatePrivateKeyRequest struct {
+	ChainId            string           `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	QuorumHash         []byte           `protobuf:"bytes,2,opt,name=quorum_hash,json=quorumHash,proto3" json:"quorum_hash,omitempty"`
+	PrivateKey         []byte           `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	ThresholdPublicKey crypto.PublicKey `protobuf:"bytes,4,opt,name=threshold_public_key,json=thresholdPublicKey,proto3" json:"threshold_public_key"`
+	Height             int64            `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *UpdatePrivateKeyRequest) Reset()         { *m = UpdatePrivateKeyRequest{} }
+func (m *UpdatePrivateKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdatePrivateKeyRequest) ProtoMessage()    {}
+func (*UpdatePrivateKeyRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cb4e437a5328cf9c, []int{13}
 }
-func (m *Message) XXX_Unmarshal(b []byte) error {
+func (m *UpdatePrivateKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdatePrivateKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Message.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdatePrivateKeyRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -780,692 +773,831 @@ func (m *Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Message) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Message.Merge(m, src)
+func (m *UpdatePrivateKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePrivateKeyRequest.Merge(m, src)
 }
-func (m *Message) XXX_Size() int {
+func (m *UpdatePrivateKeyRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Message) XXX_DiscardUnknown() {
-	xxx_messageInfo_Message.DiscardUnknown(m)
+func (m *UpdatePrivateKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePrivateKeyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Message proto.InternalMessageInfo
+var xxx_messageInfo_UpdatePrivateKeyRequest proto.InternalMessageInfo
 
-type isMessage_Sum interface {
-	isMessage_Sum()
-	MarshalTo([]byte) (int, error)
-	Size() int
+func (m *UpdatePrivateKeyRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-type Message_PubKeyRequest struct {
-	PubKeyRequest *PubKeyRequest `protobuf:"bytes,1,opt,name=pub_key_request,json=pubKeyRequest,proto3,oneof" json:"pub_key_request,omitempty"`
-}
-type Message_PubKeyResponse struct {
-	PubKeyResponse *PubKeyResponse `protobuf:"bytes,2,opt,name=pub_key_response,json=pubKeyResponse,proto3,oneof" json:"pub_key_response,omitempty"`
+func (m *UpdatePrivateKeyRequest) GetQuorumHash() []byte {
+	if m != nil {
+		return m.QuorumHash
+	}
+	return nil
 }
-type Message_SignVoteRequest struct {
-	SignVoteRequest *SignVoteRequest `protobuf:"bytes,3,opt,name=sign_vote_request,json=signVoteRequest,proto3,oneof" json:"sign_vote_request,omitempty"`
+
+func (m *UpdatePrivateKeyRequest) GetPrivateKey() []byte {
+	if m != nil {
+		return m.PrivateKey
+	}
+	return nil
 }
-type Message_SignedVoteResponse struct {
-	SignedVoteResponse *SignedVoteResponse `protobuf:"bytes,4,opt,name=signed_vote_response,json=signedVoteResponse,proto3,oneof" json:"signed_vote_response,omitempty"`
+
+func (m *UpdatePrivateKeyRequest) GetThresholdPublicKey() crypto.PublicKey {
+	if m != nil {
+		return m.ThresholdPublicKey
+	}
+	return crypto.PublicKey{}
 }
-type Message_SignProposalRequest struct {
-	SignProposalRequest *SignProposalRequest `protobuf:"bytes,5,opt,name=sign_proposal_request,json=signProposalRequest,proto3,oneof" json:"sign_proposal_request,omitempty"`
+
+func (m *UpdatePrivateKeyRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
-type Message_SignedProposalResponse struct {
-	SignedProposalResponse *SignedProposalResponse `protobuf:"bytes,6,opt,name=signed_proposal_response,json=signedProposalResponse,proto3,oneof" json:"signed_proposal_response,omitempty"`
+
+// UpdatePrivateKeyResponse is a response to confirm that the private key was staged.
+type UpdatePrivateKeyResponse struct {
+	Error *RemoteSignerError `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 }
-type Message_PingRequest struct {
-	PingRequest *PingRequest `protobuf:"bytes,7,opt,name=ping_request,json=pingRequest,proto3,oneof" json:"ping_request,omitempty"`
+
+func (m *UpdatePrivateKeyResponse) Reset()         { *m = UpdatePrivateKeyResponse{} }
+func (m *UpdatePrivateKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdatePrivateKeyResponse) ProtoMessage()    {}
+func (*UpdatePrivateKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb4e437a5328cf9c, []int{14}
 }
-type Message_PingResponse struct {
-	PingResponse *PingResponse `protobuf:"bytes,8,opt,name=ping_response,json=pingResponse,proto3,oneof" json:"ping_response,omitempty"`
+func (m *UpdatePrivateKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-type Message_ProTxHashRequest struct {
-	ProTxHashRequest *ProTxHashRequest `protobuf:"bytes,9,opt,name=pro_tx_hash_request,json=proTxHashRequest,proto3,oneof" json:"pro_tx_hash_request,omitempty"`
+func (m *UpdatePrivateKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdatePrivateKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-type Message_ProTxHashResponse struct {
-	ProTxHashResponse *ProTxHashResponse `protobuf:"bytes,10,opt,name=pro_tx_hash_response,json=proTxHashResponse,proto3,oneof" json:"pro_tx_hash_response,omitempty"`
+func (m *UpdatePrivateKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePrivateKeyResponse.Merge(m, src)
 }
-type Message_ThresholdPubKeyRequest struct {
-	ThresholdPubKeyRequest *ThresholdPubKeyRequest `protobuf:"bytes,11,opt,name=threshold_pub_key_request,json=thresholdPubKeyRequest,proto3,oneof" json:"threshold_pub_key_request,omitempty"`
+func (m *UpdatePrivateKeyResponse) XXX_Size() int {
+	return m.Size()
 }
-type Message_ThresholdPubKeyResponse struct {
-	ThresholdPubKeyResponse *ThresholdPubKeyResponse `protobuf:"bytes,12,opt,name=threshold_pub_key_response,json=thresholdPubKeyResponse,proto3,oneof" json:"threshold_pub_key_response,omitempty"`
+func (m *UpdatePrivateKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePrivateKeyResponse.DiscardUnknown(m)
 }
 
-func (*Message_PubKeyRequest) isMessage_Sum()           {}
-func (*Message_PubKeyResponse) isMessage_Sum()          {}
-func (*Message_SignVoteRequest) isMessage_Sum()         {}
-func (*Message_SignedVoteResponse) isMessage_Sum()      {}
-func (*Message_SignProposalRequest) isMessage_Sum()     {}
-func (*Message_SignedProposalResponse) isMessage_Sum()  {}
-func (*Message_PingRequest) isMessage_Sum()             {}
-func (*Message_PingResponse) isMessage_Sum()            {}
-func (*Message_ProTxHashRequest) isMessage_Sum()        {}
-func (*Message_ProTxHashResponse) isMessage_Sum()       {}
-func (*Message_ThresholdPubKeyRequest) isMessage_Sum()  {}
-func (*Message_ThresholdPubKeyResponse) isMessage_Sum() {}
+var xxx_messageInfo_UpdatePrivateKeyResponse proto.InternalMessageInfo
 
-func (m *Message) GetSum() isMessage_Sum {
+func (m *UpdatePrivateKeyResponse) GetError() *RemoteSignerError {
 	if m != nil {
-		return m.Sum
+		return m.Error
 	}
 	return nil
 }
 
-func (m *Message) GetPubKeyRequest() *PubKeyRequest {
-	if x, ok := m.GetSum().(*Message_PubKeyRequest); ok {
-		return x.PubKeyRequest
-	}
-	return nil
+// QuorumHeightRequest requests the height from which the signer uses its key of a quorum.
+type QuorumHeightRequest struct {
+	ChainId    string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	QuorumHash []byte `protobuf:"bytes,2,opt,name=quorum_hash,json=quorumHash,proto3" json:"quorum_hash,omitempty"`
 }
 
-func (m *Message) GetPubKeyResponse() *PubKeyResponse {
-	if x, ok := m.GetSum().(*Message_PubKeyResponse); ok {
-		return x.PubKeyResponse
+func (m *QuorumHeightRequest) Reset()         { *m = QuorumHeightRequest{} }
+func (m *QuorumHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QuorumHeightRequest) ProtoMessage()    {}
+func (*QuorumHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb4e437a5328cf9c, []int{15}
+}
+func (m *QuorumHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuorumHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuorumHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *QuorumHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuorumHeightRequest.Merge(m, src)
+}
+func (m *QuorumHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuorumHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuorumHeightRequest.DiscardUnknown(m)
 }
 
-func (m *Message) GetSignVoteRequest() *SignVoteRequest {
-	if x, ok := m.GetSum().(*Message_SignVoteRequest); ok {
-		return x.SignVoteRequest
+var xxx_messageInfo_QuorumHeightRequest proto.InternalMessageInfo
+
+func (m *QuorumHeightRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return nil
+	return ""
 }
 
-func (m *Message) GetSignedVoteResponse() *SignedVoteResponse {
-	if x, ok := m.GetSum().(*Message_SignedVoteResponse); ok {
-		return x.SignedVoteResponse
+func (m *QuorumHeightRequest) GetQuorumHash() []byte {
+	if m != nil {
+		return m.QuorumHash
 	}
 	return nil
 }
 
-func (m *Message) GetSignProposalRequest() *SignProposalRequest {
-	if x, ok := m.GetSum().(*Message_SignProposalRequest); ok {
-		return x.SignProposalRequest
+// QuorumHeightResponse is a response message containing the height of a quorum.
+type QuorumHeightResponse struct {
+	Height int64              `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Error  *RemoteSignerError `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *QuorumHeightResponse) Reset()         { *m = QuorumHeightResponse{} }
+func (m *QuorumHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QuorumHeightResponse) ProtoMessage()    {}
+func (*QuorumHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb4e437a5328cf9c, []int{16}
+}
+func (m *QuorumHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuorumHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuorumHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *QuorumHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuorumHeightResponse.Merge(m, src)
+}
+func (m *QuorumHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuorumHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuorumHeightResponse.DiscardUnknown(m)
 }
 
-func (m *Message) GetSignedProposalResponse() *SignedProposalResponse {
-	if x, ok := m.GetSum().(*Message_SignedProposalResponse); ok {
-		return x.SignedProposalResponse
+var xxx_messageInfo_QuorumHeightResponse proto.InternalMessageInfo
+
+func (m *QuorumHeightResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return nil
+	return 0
 }
 
-func (m *Message) GetPingRequest() *PingRequest {
-	if x, ok := m.GetSum().(*Message_PingRequest); ok {
-		return x.PingRequest
+func (m *QuorumHeightResponse) GetError() *RemoteSignerError {
+	if m != nil {
+		return m.Error
 	}
 	return nil
 }
 
-func (m *Message) GetPingResponse() *PingResponse {
-	if x, ok := m.GetSum().(*Message_PingResponse); ok {
-		return x.PingResponse
+// FirstQuorumHashRequest requests the hash of the first quorum the signer holds a key of.
+type FirstQuorumHashRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *FirstQuorumHashRequest) Reset()         { *m = FirstQuorumHashRequest{} }
+func (m *FirstQuorumHashRequest) String() string { return proto.CompactTextString(m) }
+func (*FirstQuorumHashRequest) ProtoMessage()    {}
+func (*FirstQuorumHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb4e437a5328cf9c, []int{17}
+}
+func (m *FirstQuorumHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FirstQuorumHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FirstQuorumHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *FirstQuorumHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FirstQuorumHashRequest.Merge(m, src)
+}
+func (m *FirstQuorumHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FirstQuorumHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FirstQuorumHashRequest.DiscardUnknown(m)
 }
 
-func (m *Message) GetProTxHashRequest() *ProTxHashRequest {
-	if x, ok := m.GetSum().(*Message_ProTxHashRequest); ok {
-		return x.ProTxHashRequest
+var xxx_messageInfo_FirstQuorumHashRequest proto.InternalMessageInfo
+
+func (m *FirstQuorumHashRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return nil
+	return ""
 }
 
-func (m *Message) GetProTxHashResponse() *ProTxHashResponse {
-	if x, ok := m.GetSum().(*Message_ProTxHashResponse); ok {
-		return x.ProTxHashResponse
+// FirstQuorumHashResponse is a response message containing a quorum hash.
+type FirstQuorumHashResponse struct {
+	QuorumHash []byte             `protobuf:"bytes,1,opt,name=quorum_hash,json=quorumHash,proto3" json:"quorum_hash,omitempty"`
+	Error      *RemoteSignerError `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *FirstQuorumHashResponse) Reset()         { *m = FirstQuorumHashResponse{} }
+func (m *FirstQuorumHashResponse) String() string { return proto.CompactTextString(m) }
+func (*FirstQuorumHashResponse) ProtoMessage()    {}
+func (*FirstQuorumHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb4e437a5328cf9c, []int{18}
+}
+func (m *FirstQuorumHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FirstQuorumHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FirstQuorumHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *FirstQuorumHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FirstQuorumHashResponse.Merge(m, src)
+}
+func (m *FirstQuorumHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FirstQuorumHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FirstQuorumHashResponse.DiscardUnknown(m)
 }
 
-func (m *Message) GetThresholdPubKeyRequest() *ThresholdPubKeyRequest {
-	if x, ok := m.GetSum().(*Message_ThresholdPubKeyRequest); ok {
-		return x.ThresholdPubKeyRequest
+var xxx_messageInfo_FirstQuorumHashResponse proto.InternalMessageInfo
+
+func (m *FirstQuorumHashResponse) GetQuorumHash() []byte {
+	if m != nil {
+		return m.QuorumHash
 	}
 	return nil
 }
 
-func (m *Message) GetThresholdPubKeyResponse() *ThresholdPubKeyResponse {
-	if x, ok := m.GetSum().(*Message_ThresholdPubKeyResponse); ok {
-		return x.ThresholdPubKeyResponse
+func (m *FirstQuorumHashResponse) GetError() *RemoteSignerError {
+	if m != nil {
+		return m.Error
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*Message) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*Message_PubKeyRequest)(nil),
-		(*Message_PubKeyResponse)(nil),
-		(*Message_SignVoteRequest)(nil),
-		(*Message_SignedVoteResponse)(nil),
-		(*Message_SignProposalRequest)(nil),
-		(*Message_SignedProposalResponse)(nil),
-		(*Message_PingRequest)(nil),
-		(*Message_PingResponse)(nil),
-		(*Message_ProTxHashRequest)(nil),
-		(*Message_ProTxHashResponse)(nil),
-		(*Message_ThresholdPubKeyRequest)(nil),
-		(*Message_ThresholdPubKeyResponse)(nil),
-	}
+// QuorumHashesRequest requests the hashes of all the quorums the signer holds a key of.
+type QuorumHashesRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func init() {
-	proto.RegisterEnum("tendermint.privval.Errors", Errors_name, Errors_value)
-	proto.RegisterType((*RemoteSignerError)(nil), "tendermint.privval.RemoteSignerError")
-	proto.RegisterType((*PubKeyRequest)(nil), "tendermint.privval.PubKeyRequest")
-	proto.RegisterType((*ThresholdPubKeyRequest)(nil), "tendermint.privval.ThresholdPubKeyRequest")
-	proto.RegisterType((*ProTxHashRequest)(nil), "tendermint.privval.ProTxHashRequest")
-	proto.RegisterType((*PubKeyResponse)(nil), "tendermint.privval.PubKeyResponse")
-	proto.RegisterType((*ThresholdPubKeyResponse)(nil), "tendermint.privval.ThresholdPubKeyResponse")
-	proto.RegisterType((*ProTxHashResponse)(nil), "tendermint.privval.ProTxHashResponse")
-	proto.RegisterType((*SignVoteRequest)(nil), "tendermint.privval.SignVoteRequest")
-	proto.RegisterType((*SignedVoteResponse)(nil), "tendermint.privval.SignedVoteResponse")
-	proto.RegisterType((*SignProposalRequest)(nil), "tendermint.privval.SignProposalRequest")
-	proto.RegisterType((*SignedProposalResponse)(nil), "tendermint.privval.SignedProposalResponse")
-	proto.RegisterType((*PingRequest)(nil), "tendermint.privval.PingRequest")
-	proto.RegisterType((*PingResponse)(nil), "tendermint.privval.PingResponse")
-	proto.RegisterType((*Message)(nil), "tendermint.privval.Message")
+func (m *QuorumHashesRequest) Reset()         { *m = QuorumHashesRequest{} }
+func (m *QuorumHashesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuorumHashesRequest) ProtoMessage()    {}
+func (*QuorumHashesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb4e437a5328cf9c, []int{19}
 }
-
-func init() { proto.RegisterFile("tendermint/privval/types.proto", fileDescriptor_cb4e437a5328cf9c) }
-
-var fileDescriptor_cb4e437a5328cf9c = []byte{
-	// 949 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x5d, 0x6f, 0xe3, 0x44,
-	0x17, 0xb6, 0xdb, 0xa4, 0x1f, 0x27, 0x69, 0x9b, 0x4e, 0xfb, 0xb6, 0x69, 0xb4, 0xaf, 0x5b, 0xc2,
-	0xd7, 0xaa, 0x88, 0x04, 0x2d, 0x12, 0x12, 0x5a, 0x6e, 0x68, 0x6b, 0xe1, 0x28, 0x5a, 0x27, 0x4c,
-	0x5c, 0x76, 0xb5, 0x12, 0xb2, 0xf2, 0x31, 0x38, 0x66, 0x1b, 0xcf, 0xac, 0xc7, 0xa9, 0x36, 0xd7,
-	0xdc, 0x71, 0x85, 0xb4, 0x77, 0xfc, 0x02, 0xc4, 0x2f, 0xe0, 0x27, 0xec, 0xe5, 0x5e, 0x72, 0x85,
-	0x50, 0xfb, 0x47, 0x90, 0xc7, 0x13, 0xc7, 0x89, 0x1d, 0x58, 0x54, 0x10, 0x77, 0x9e, 0x73, 0xce,
-	0x3c, 0xe7, 0xf1, 0x79, 0xce, 0x99, 0x19, 0xd0, 0x02, 0xe2, 0x0d, 0x88, 0x3f, 0x72, 0xbd, 0xa0,
-	0xce, 0x7c, 0xf7, 0xfa, 0xba, 0x7b, 0x55, 0x0f, 0x26, 0x8c, 0xf0, 0x1a, 0xf3, 0x69, 0x40, 0x11,
-	0x9a, 0xf9, 0x6b, 0xd2, 0x5f, 0xb9, 0x97, 0xd8, 0xd3, 0xf7, 0x27, 0x2c, 0xa0, 0xf5, 0x67, 0x64,
-	0x22, 0x77, 0xcc, 0x79, 0x05, 0x52, 0x12, 0xaf, 0xb2, 0xef, 0x50, 0x87, 0x8a, 0xcf, 0x7a, 0xf8,
-	0x15, 0x59, 0xab, 0x0d, 0xd8, 0xc5, 0x64, 0x44, 0x03, 0xd2, 0x71, 0x1d, 0x8f, 0xf8, 0xba, 0xef,
-	0x53, 0x1f, 0x21, 0xc8, 0xf5, 0xe9, 0x80, 0x94, 0xd5, 0x13, 0xf5, 0x7e, 0x1e, 0x8b, 0x6f, 0x74,
-	0x02, 0x85, 0x01, 0xe1, 0x7d, 0xdf, 0x65, 0x81, 0x4b, 0xbd, 0xf2, 0xca, 0x89, 0x7a, 0x7f, 0x13,
-	0x27, 0x4d, 0xd5, 0x26, 0x6c, 0xb5, 0xc7, 0xbd, 0x26, 0x99, 0x60, 0xf2, 0x7c, 0x4c, 0x78, 0x80,
-	0x8e, 0x60, 0xa3, 0x3f, 0xec, 0xba, 0x9e, 0xed, 0x0e, 0x04, 0xd4, 0x26, 0x5e, 0x17, 0xeb, 0xc6,
-	0x00, 0x1d, 0x43, 0xe1, 0xf9, 0x98, 0xfa, 0xe3, 0x91, 0x3d, 0xec, 0xf2, 0xa1, 0x40, 0x2b, 0x62,
-	0x88, 0x4c, 0x46, 0x97, 0x0f, 0xab, 0x16, 0x1c, 0x58, 0x43, 0x9f, 0xf0, 0x21, 0xbd, 0x1a, 0xfc,
-	0x73, 0xa8, 0x1f, 0x42, 0xa9, 0xed, 0x53, 0xeb, 0x45, 0xb8, 0xf8, 0x6b, 0xbc, 0xea, 0xf7, 0x2a,
-	0x6c, 0x4f, 0x93, 0x73, 0x46, 0x3d, 0x4e, 0xd0, 0x43, 0x58, 0x67, 0xe3, 0x9e, 0xfd, 0x8c, 0x4c,
-	0x44, 0x70, 0xe1, 0xc1, 0xbd, 0x5a, 0x42, 0xa7, 0x48, 0x93, 0x5a, 0x7b, 0xdc, 0xbb, 0x72, 0xfb,
-	0x4d, 0x32, 0x39, 0xcb, 0xbd, 0xfa, 0xed, 0x58, 0xc1, 0x6b, 0x4c, 0x80, 0xa0, 0x87, 0x90, 0x27,
-	0x61, 0x81, 0x05, 0xb3, 0xc2, 0x83, 0x77, 0x6b, 0x69, 0x89, 0x6b, 0x29, 0x35, 0x70, 0xb4, 0xa7,
-	0xfa, 0x52, 0x85, 0xc3, 0x54, 0x49, 0xfe, 0x73, 0x56, 0x0c, 0x76, 0x13, 0x15, 0x95, 0x74, 0x34,
-	0x28, 0x30, 0x9f, 0xda, 0xc1, 0x8b, 0x48, 0x07, 0x55, 0xe8, 0xb0, 0xc9, 0xa6, 0x71, 0x77, 0xcb,
-	0xf8, 0xa3, 0x0a, 0x3b, 0xa1, 0xf9, 0x2b, 0x1a, 0x90, 0xa9, 0x86, 0xa7, 0x90, 0xbb, 0xa6, 0x01,
-	0x91, 0x3f, 0x7f, 0x90, 0xc4, 0x8b, 0x46, 0x40, 0x04, 0x8b, 0x98, 0x39, 0xbd, 0x57, 0x96, 0xf5,
-	0x4f, 0xb8, 0xab, 0xbc, 0x2a, 0xda, 0x5f, 0xf6, 0x8f, 0x35, 0x61, 0x64, 0xb1, 0xc1, 0x72, 0xa9,
-	0x06, 0xfb, 0x4e, 0x05, 0x24, 0x38, 0x0f, 0x22, 0x7a, 0xb2, 0x20, 0x1f, 0xbd, 0x09, 0x3f, 0x29,
-	0x4b, 0xc4, 0xf2, 0x4e, 0x25, 0xfa, 0x59, 0x85, 0xbd, 0xd0, 0xdc, 0xf6, 0x29, 0xa3, 0xbc, 0x7b,
-	0x35, 0x2d, 0xd3, 0x27, 0xb0, 0xc1, 0xa4, 0x49, 0x52, 0xa9, 0xa4, 0xa9, 0xc4, 0x9b, 0xe2, 0xd8,
-	0x7f, 0xb7, 0x64, 0x2f, 0x55, 0x38, 0x88, 0x4a, 0x36, 0xa3, 0x2b, 0xcb, 0xf6, 0xd9, 0xdf, 0xe1,
-	0x2b, 0xcb, 0x37, 0x63, 0x7d, 0xa7, 0x12, 0x6e, 0x41, 0xa1, 0xed, 0x7a, 0x8e, 0xac, 0x5c, 0x75,
-	0x1b, 0x8a, 0xd1, 0x32, 0x62, 0x56, 0xfd, 0x65, 0x03, 0xd6, 0x1f, 0x11, 0xce, 0xbb, 0x0e, 0x41,
-	0x4d, 0xd8, 0x91, 0xc3, 0x67, 0xfb, 0x51, 0xb8, 0x24, 0xfb, 0x56, 0x56, 0xc6, 0xb9, 0xc3, 0xcc,
-	0x50, 0xf0, 0x16, 0x9b, 0x3b, 0xdd, 0x4c, 0x28, 0xcd, 0xc0, 0xa2, 0x64, 0x92, 0x7f, 0xf5, 0xcf,
-	0xd0, 0xa2, 0x48, 0x43, 0xc1, 0xdb, 0x6c, 0xfe, 0x64, 0xf8, 0x12, 0x76, 0xb9, 0xeb, 0x78, 0x76,
-	0xd8, 0x54, 0x31, 0xbd, 0x55, 0x01, 0xf8, 0x76, 0x16, 0xe0, 0xc2, 0x64, 0x19, 0x0a, 0xde, 0xe1,
-	0x0b, 0xc3, 0xf6, 0x14, 0xf6, 0xb9, 0xd0, 0x6b, 0x0a, 0x2a, 0x69, 0xe6, 0x04, 0xea, 0x7b, 0xcb,
-	0x50, 0xe7, 0x47, 0xc2, 0x50, 0x30, 0xe2, 0xe9, 0x41, 0xf9, 0x1a, 0xfe, 0x27, 0xe8, 0x4e, 0x45,
-	0x8c, 0x29, 0xe7, 0x05, 0xf8, 0xfb, 0xcb, 0xc0, 0x17, 0x3a, 0xdd, 0x50, 0xf0, 0x1e, 0xcf, 0x18,
-	0x80, 0x6f, 0xa0, 0x2c, 0xa9, 0x27, 0x12, 0x48, 0xfa, 0x6b, 0x22, 0xc3, 0xe9, 0x72, 0xfa, 0x8b,
-	0xed, 0x69, 0x28, 0xf8, 0x80, 0x67, 0x37, 0xee, 0x05, 0x14, 0x99, 0xeb, 0x39, 0x31, 0xfb, 0x75,
-	0x81, 0x7d, 0x9c, 0xa9, 0xe0, 0xac, 0xcb, 0x0c, 0x05, 0x17, 0xd8, 0x6c, 0x89, 0xbe, 0x80, 0x2d,
-	0x89, 0x22, 0x29, 0x6e, 0x08, 0x98, 0x93, 0xe5, 0x30, 0x31, 0xb1, 0x22, 0x4b, 0xac, 0xd1, 0x25,
-	0xec, 0x25, 0xce, 0xe3, 0x98, 0xd5, 0xa6, 0x80, 0x7b, 0x27, 0x13, 0x6e, 0xe1, 0x96, 0x34, 0x14,
-	0x5c, 0x62, 0x8b, 0x37, 0xe7, 0x13, 0xd8, 0x9f, 0x87, 0x95, 0x34, 0x61, 0xf9, 0xbc, 0xa5, 0xee,
-	0x0a, 0x43, 0xc1, 0xbb, 0x2c, 0x75, 0x81, 0x38, 0x70, 0x14, 0x4c, 0xaf, 0x3a, 0x7b, 0x71, 0xb8,
-	0x0a, 0xcb, 0x85, 0xca, 0x7e, 0x32, 0x84, 0x42, 0x05, 0xd9, 0x8f, 0x89, 0x6f, 0xa1, 0x92, 0x95,
-	0x48, 0xfe, 0x48, 0x51, 0x64, 0xfa, 0xe0, 0x8d, 0x32, 0xc5, 0xbf, 0x73, 0x18, 0x64, 0xbb, 0xce,
-	0xf2, 0xb0, 0xca, 0xc7, 0xa3, 0xd3, 0x9f, 0x54, 0x58, 0x13, 0x47, 0x0d, 0x47, 0x08, 0xb6, 0x75,
-	0x8c, 0x5b, 0xb8, 0x63, 0x5f, 0x9a, 0x4d, 0xb3, 0xf5, 0xd8, 0x2c, 0x29, 0x48, 0x83, 0x4a, 0x6c,
-	0xd3, 0x9f, 0xb4, 0xf5, 0x73, 0x4b, 0xbf, 0xb0, 0xb1, 0xde, 0x69, 0xb7, 0xcc, 0x8e, 0x5e, 0x52,
-	0x51, 0x19, 0xf6, 0xa5, 0xdf, 0x6c, 0xd9, 0xe7, 0x2d, 0xd3, 0xd4, 0xcf, 0xad, 0x46, 0xcb, 0x2c,
-	0xad, 0xa0, 0xff, 0xc3, 0x91, 0xf4, 0xcc, 0xcc, 0xb6, 0xd5, 0x78, 0xa4, 0xb7, 0x2e, 0xad, 0xd2,
-	0x2a, 0x3a, 0x84, 0x3d, 0xe9, 0xc6, 0xfa, 0xe7, 0x17, 0xb1, 0x23, 0x97, 0x40, 0x7c, 0x8c, 0x1b,
-	0x96, 0x1e, 0x7b, 0xf2, 0x67, 0x9d, 0x57, 0x37, 0x9a, 0xfa, 0xfa, 0x46, 0x53, 0x7f, 0xbf, 0xd1,
-	0xd4, 0x1f, 0x6e, 0x35, 0xe5, 0xf5, 0xad, 0xa6, 0xfc, 0x7a, 0xab, 0x29, 0x4f, 0x3f, 0x75, 0xdc,
-	0x60, 0x38, 0xee, 0xd5, 0xfa, 0x74, 0x54, 0x4f, 0xbe, 0x3a, 0x93, 0x4f, 0xda, 0xf0, 0xa5, 0x99,
-	0x7e, 0xe3, 0xf6, 0xd6, 0x84, 0xe7, 0xe3, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x7c, 0xe0,
-	0x47, 0x00, 0x0b, 0x00, 0x00,
+func (m *QuorumHashesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *RemoteSignerError) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuorumHashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuorumHashesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *RemoteSignerError) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuorumHashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuorumHashesRequest.Merge(m, src)
 }
-
-func (m *RemoteSignerError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Code != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QuorumHashesRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *PubKeyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuorumHashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuorumHashesRequest.DiscardUnknown(m)
 }
 
-func (m *PubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QuorumHashesRequest proto.InternalMessageInfo
 
-func (m *PubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QuorumHash) > 0 {
-		i -= len(m.QuorumHash)
-		copy(dAtA[i:], m.QuorumHash)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.QuorumHash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QuorumHashesRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ThresholdPubKeyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QuorumHashesResponse is a response message containing quorum hashes.
+type QuorumHashesResponse struct {
+	QuorumHashes [][]byte           `protobuf:"bytes,1,rep,name=quorum_hashes,json=quorumHashes,proto3" json:"quorum_hashes,omitempty"`
+	Error        *RemoteSignerError `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *ThresholdPubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuorumHashesResponse) Reset()         { *m = QuorumHashesResponse{} }
+func (m *QuorumHashesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuorumHashesResponse) ProtoMessage()    {}
+func (*QuorumHashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb4e437a5328cf9c, []int{20}
 }
-
-func (m *ThresholdPubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QuorumHash) > 0 {
-		i -= len(m.QuorumHash)
-		copy(dAtA[i:], m.QuorumHash)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.QuorumHash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QuorumHashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ProTxHashRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuorumHashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuorumHashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *ProTxHashRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuorumHashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuorumHashesResponse.Merge(m, src)
+}
+func (m *QuorumHashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuorumHashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuorumHashesResponse.DiscardUnknown(m)
 }
 
-func (m *ProTxHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QuorumHashesResponse proto.InternalMessageInfo
+
+func (m *QuorumHashesResponse) GetQuorumHashes() [][]byte {
+	if m != nil {
+		return m.QuorumHashes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *PubKeyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuorumHashesResponse) GetError() *RemoteSignerError {
+	if m != nil {
+		return m.Error
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Message struct {
+	// Types that are valid to be assigned to Sum:
+	//	*Message_PubKeyRequest
+	//	*Message_PubKeyResponse
+	//	*Message_SignVoteRequest
+	//	*Message_SignedVoteResponse
+	//	*Message_SignProposalRequest
+	//	*Message_SignedProposalResponse
+	//	*Message_PingRequest
+	//	*Message_PingResponse
+	//	*Message_ProTxHashRequest
+	//	*Message_ProTxHashResponse
+	//	*Message_ThresholdPubKeyRequest
+	//	*Message_ThresholdPubKeyResponse
+	//	*Message_UpdatePrivateKeyRequest
+	//	*Message_UpdatePrivateKeyResponse
+	//	*Message_QuorumHeightRequest
+	//	*Message_QuorumHeightResponse
+	//	*Message_FirstQuorumHashRequest
+	//	*Message_FirstQuorumHashResponse
+	//	*Message_QuorumHashesRequest
+	//	*Message_QuorumHashesResponse
+	Sum isMessage_Sum `protobuf_oneof:"sum"`
 }
 
-func (m *PubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Error != nil {
-		{
-			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+func (m *Message) Reset()         { *m = Message{} }
+func (m *Message) String() string { return proto.CompactTextString(m) }
+func (*Message) ProtoMessage()    {}
+func (*Message) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb4e437a5328cf9c, []int{21}
+}
+func (m *Message) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Message.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *ThresholdPubKeyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Message) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Message.Merge(m, src)
+}
+func (m *Message) XXX_Size() int {
+	return m.Size()
+}
+func (m *Message) XXX_DiscardUnknown() {
+	xxx_messageInfo_Message.DiscardUnknown(m)
 }
 
-func (m *ThresholdPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Message proto.InternalMessageInfo
+
+type isMessage_Sum interface {
+	isMessage_Sum()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *ThresholdPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Error != nil {
-		{
-			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+type Message_PubKeyRequest struct {
+	PubKeyRequest *PubKeyRequest `protobuf:"bytes,1,opt,name=pub_key_request,json=pubKeyRequest,proto3,oneof" json:"pub_key_request,omitempty"`
+}
+type Message_PubKeyResponse struct {
+	PubKeyResponse *PubKeyResponse `protobuf:"bytes,2,opt,name=pub_key_response,json=pubKeyResponse,proto3,oneof" json:"pub_key_response,omitempty"`
+}
+type Message_SignVoteRequest struct {
+	SignVoteRequest *SignVoteRequest `protobuf:"bytes,3,opt,name=sign_vote_request,json=signVoteRequest,proto3,oneof" json:"sign_vote_request,omitempty"`
+}
+type Message_SignedVoteResponse struct {
+	SignedVoteResponse *SignedVoteResponse `protobuf:"bytes,4,opt,name=signed_vote_response,json=signedVoteResponse,proto3,oneof" json:"signed_vote_response,omitempty"`
+}
+type Message_SignProposalRequest struct {
+	SignProposalRequest *SignProposalRequest `protobuf:"bytes,5,opt,name=sign_proposal_request,json=signProposalRequest,proto3,oneof" json:"sign_proposal_request,omitempty"`
+}
+type Message_SignedProposalResponse struct {
+	SignedProposalResponse *SignedProposalResponse `protobuf:"bytes,6,opt,name=signed_proposal_response,json=signedProposalResponse,proto3,oneof" json:"signed_proposal_response,omitempty"`
+}
+type Message_PingRequest struct {
+	PingRequest *PingRequest `protobuf:"bytes,7,opt,name=ping_request,json=pingRequest,proto3,oneof" json:"ping_request,omitempty"`
+}
+type Message_PingResponse struct {
+	PingResponse *PingResponse `protobuf:"bytes,8,opt,name=ping_response,json=pingResponse,proto3,oneof" json:"ping_response,omitempty"`
+}
+type Message_ProTxHashRequest struct {
+	ProTxHashRequest *ProTxHashRequest `protobuf:"bytes,9,opt,name=pro_tx_hash_request,json=proTxHashRequest,proto3,oneof" json:"pro_tx_hash_request,omitempty"`
+}
+type Message_ProTxHashResponse struct {
+	ProTxHashResponse *ProTxHashResponse `protobuf:"bytes,10,opt,name=pro_tx_hash_response,json=proTxHashResponse,proto3,oneof" json:"pro_tx_hash_response,omitempty"`
+}
+type Message_ThresholdPubKeyRequest struct {
+	ThresholdPubKeyRequest *ThresholdPubKeyRequest `protobuf:"bytes,11,opt,name=threshold_pub_key_request,json=thresholdPubKeyRequest,proto3,oneof" json:"threshold_pub_key_request,omitempty"`
+}
+type Message_ThresholdPubKeyResponse struct {
+	ThresholdPubKeyResponse *ThresholdPubKeyResponse `protobuf:"bytes,12,opt,name=threshold_pub_key_response,json=thresholdPubKeyResponse,proto3,oneof" json:"threshold_pub_key_response,omitempty"`
+}
+type Message_UpdatePrivateKeyRequest struct {
+	UpdatePrivateKeyRequest *UpdatePrivateKeyRequest `protobuf:"bytes,13,opt,name=update_private_key_request,json=updatePrivateKeyRequest,proto3,oneof" json:"update_private_key_request,omitempty"`
+}
+type Message_UpdatePrivateKeyResponse struct {
+	UpdatePrivateKeyResponse *UpdatePrivateKeyResponse `protobuf:"bytes,14,opt,name=update_private_key_response,json=updatePrivateKeyResponse,proto3,oneof" json:"update_private_key_response,omitempty"`
+}
+type Message_QuorumHeightRequest struct {
+	QuorumHeightRequest *QuorumHeightRequest `protobuf:"bytes,15,opt,name=quorum_height_request,json=quorumHeightRequest,proto3,oneof" json:"quorum_height_request,omitempty"`
+}
+type Message_QuorumHeightResponse struct {
+	QuorumHeightResponse *QuorumHeightResponse `protobuf:"bytes,16,opt,name=quorum_height_response,json=quorumHeightResponse,proto3,oneof" json:"quorum_height_response,omitempty"`
+}
+type Message_FirstQuorumHashRequest struct {
+	FirstQuorumHashRequest *FirstQuorumHashRequest `protobuf:"bytes,17,opt,name=first_quorum_hash_request,json=firstQuorumHashRequest,proto3,oneof" json:"first_quorum_hash_request,omitempty"`
+}
+type Message_FirstQuorumHashResponse struct {
+	FirstQuorumHashResponse *FirstQuorumHashResponse `protobuf:"bytes,18,opt,name=first_quorum_hash_response,json=firstQuorumHashResponse,proto3,oneof" json:"first_quorum_hash_response,omitempty"`
+}
+type Message_QuorumHashesRequest struct {
+	QuorumHashesRequest *QuorumHashesRequest `protobuf:"bytes,19,opt,name=quorum_hashes_request,json=quorumHashesRequest,proto3,oneof" json:"quorum_hashes_request,omitempty"`
+}
+type Message_QuorumHashesResponse struct {
+	QuorumHashesResponse *QuorumHashesResponse `protobuf:"bytes,20,opt,name=quorum_hashes_response,json=quorumHashesResponse,proto3,oneof" json:"quorum_hashes_response,omitempty"`
 }
 
-func (m *ProTxHashResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (*Message_PubKeyRequest) isMessage_Sum()            {}
+func (*Message_PubKeyResponse) isMessage_Sum()           {}
+func (*Message_SignVoteRequest) isMessage_Sum()          {}
+func (*Message_SignedVoteResponse) isMessage_Sum()       {}
+func (*Message_SignProposalRequest) isMessage_Sum()      {}
+func (*Message_SignedProposalResponse) isMessage_Sum()   {}
+func (*Message_PingRequest) isMessage_Sum()              {}
+func (*Message_PingResponse) isMessage_Sum()             {}
+func (*Message_ProTxHashRequest) isMessage_Sum()         {}
+func (*Message_ProTxHashResponse) isMessage_Sum()        {}
+func (*Message_ThresholdPubKeyRequest) isMessage_Sum()   {}
+func (*Message_ThresholdPubKeyResponse) isMessage_Sum()  {}
+func (*Message_UpdatePrivateKeyRequest) isMessage_Sum()  {}
+func (*Message_UpdatePrivateKeyResponse) isMessage_Sum() {}
+func (*Message_QuorumHeightRequest) isMessage_Sum()      {}
+func (*Message_QuorumHeightResponse) isMessage_Sum()     {}
+func (*Message_FirstQuorumHashRequest) isMessage_Sum()   {}
+func (*Message_FirstQuorumHashResponse) isMessage_Sum()  {}
+func (*Message_QuorumHashesRequest) isMessage_Sum()      {}
+func (*Message_QuorumHashesResponse) isMessage_Sum()     {}
+
+func (m *Message) GetSum() isMessage_Sum {
+	if m != nil {
+		return m.Sum
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ProTxHashResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Message) GetPubKeyRequest() *PubKeyRequest {
+	if x, ok := m.GetSum().(*Message_PubKeyRequest); ok {
+		return x.PubKeyRequest
+	}
+	return nil
 }
 
-func (m *ProTxHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Error != nil {
-		{
-			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProTxHash) > 0 {
-		i -= len(m.ProTxHash)
-		copy(dAtA[i:], m.ProTxHash)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProTxHash)))
-		i--
-		dAtA[i] = 0xa
+func (m *Message) GetPubKeyResponse() *PubKeyResponse {
+	if x, ok := m.GetSum().(*Message_PubKeyResponse); ok {
+		return x.PubKeyResponse
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SignVoteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Message) GetSignVoteRequest() *SignVoteRequest {
+	if x, ok := m.GetSum().(*Message_SignVoteRequest); ok {
+		return x.SignVoteRequest
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SignVoteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Message) GetSignedVoteResponse() *SignedVoteResponse {
+	if x, ok := m.GetSum().(*Message_SignedVoteResponse); ok {
+		return x.SignedVoteResponse
+	}
+	return nil
 }
 
-func (m *SignVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QuorumHash) > 0 {
-		i -= len(m.QuorumHash)
-		copy(dAtA[i:], m.QuorumHash)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.QuorumHash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.QuorumType != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.QuorumType))
-		i--
-		dAtA[i] = 0x18
+func (m *Message) GetSignProposalRequest() *SignProposalRequest {
+	if x, ok := m.GetSum().(*Message_SignProposalRequest); ok {
+		return x.SignProposalRequest
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *Message) GetSignedProposalResponse() *SignedProposalResponse {
+	if x, ok := m.GetSum().(*Message_SignedProposalResponse); ok {
+		return x.SignedProposalResponse
 	}
-	if m.Vote != nil {
-		{
-			size, err := m.Vote.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *Message) GetPingRequest() *PingRequest {
+	if x, ok := m.GetSum().(*Message_PingRequest); ok {
+		return x.PingRequest
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SignedVoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Message) GetPingResponse() *PingResponse {
+	if x, ok := m.GetSum().(*Message_PingResponse); ok {
+		return x.PingResponse
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SignedVoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Message) GetProTxHashRequest() *ProTxHashRequest {
+	if x, ok := m.GetSum().(*Message_ProTxHashRequest); ok {
+		return x.ProTxHashRequest
+	}
+	return nil
 }
 
-func (m *SignedVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Error != nil {
-		{
-			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *Message) GetProTxHashResponse() *ProTxHashResponse {
+	if x, ok := m.GetSum().(*Message_ProTxHashResponse); ok {
+		return x.ProTxHashResponse
 	}
-	{
-		size, err := m.Vote.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *Message) GetThresholdPubKeyRequest() *ThresholdPubKeyRequest {
+	if x, ok := m.GetSum().(*Message_ThresholdPubKeyRequest); ok {
+		return x.ThresholdPubKeyRequest
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SignProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Message) GetThresholdPubKeyResponse() *ThresholdPubKeyResponse {
+	if x, ok := m.GetSum().(*Message_ThresholdPubKeyResponse); ok {
+		return x.ThresholdPubKeyResponse
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SignProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Message) GetUpdatePrivateKeyRequest() *UpdatePrivateKeyRequest {
+	if x, ok := m.GetSum().(*Message_UpdatePrivateKeyRequest); ok {
+		return x.UpdatePrivateKeyRequest
+	}
+	return nil
 }
 
-func (m *SignProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QuorumHash) > 0 {
-		i -= len(m.QuorumHash)
-		copy(dAtA[i:], m.QuorumHash)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.QuorumHash)))
-		i--
-		dAtA[i] = 0x22
+func (m *Message) GetUpdatePrivateKeyResponse() *UpdatePrivateKeyResponse {
+	if x, ok := m.GetSum().(*Message_UpdatePrivateKeyResponse); ok {
+		return x.UpdatePrivateKeyResponse
 	}
-	if m.QuorumType != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.QuorumType))
-		i--
-		dAtA[i] = 0x18
+	return nil
+}
+
+func (m *Message) GetQuorumHeightRequest() *QuorumHeightRequest {
+	if x, ok := m.GetSum().(*Message_QuorumHeightRequest); ok {
+		return x.QuorumHeightRequest
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *Message) GetQuorumHeightResponse() *QuorumHeightResponse {
+	if x, ok := m.GetSum().(*Message_QuorumHeightResponse); ok {
+		return x.QuorumHeightResponse
 	}
-	if m.Proposal != nil {
-		{
-			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *Message) GetFirstQuorumHashRequest() *FirstQuorumHashRequest {
+	if x, ok := m.GetSum().(*Message_FirstQuorumHashRequest); ok {
+		return x.FirstQuorumHashRequest
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SignedProposalResponse) Marshal() (dAtA []byte, err error) {
+func (m *Message) GetFirstQuorumHashResponse() *FirstQuorumHashResponse {
+	if x, ok := m.GetSum().(*Message_FirstQuorumHashResponse); ok {
+		return x.FirstQuorumHashResponse
+	}
+	return nil
+}
+
+func (m *Message) GetQuorumHashesRequest() *QuorumHashesRequest {
+	if x, ok := m.GetSum().(*Message_QuorumHashesRequest); ok {
+		return x.QuorumHashesRequest
+	}
+	return nil
+}
+
+func (m *Message) GetQuorumHashesResponse() *QuorumHashesResponse {
+	if x, ok := m.GetSum().(*Message_QuorumHashesResponse); ok {
+		return x.QuorumHashesResponse
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Message) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Message_PubKeyRequest)(nil),
+		(*Message_PubKeyResponse)(nil),
+		(*Message_SignVoteRequest)(nil),
+		(*Message_SignedVoteResponse)(nil),
+		(*Message_SignProposalRequest)(nil),
+		(*Message_SignedProposalResponse)(nil),
+		(*Message_PingRequest)(nil),
+		(*Message_PingResponse)(nil),
+		(*Message_ProTxHashRequest)(nil),
+		(*Message_ProTxHashResponse)(nil),
+		(*Message_ThresholdPubKeyRequest)(nil),
+		(*Message_ThresholdPubKeyResponse)(nil),
+		(*Message_UpdatePrivateKeyRequest)(nil),
+		(*Message_UpdatePrivateKeyResponse)(nil),
+		(*Message_QuorumHeightRequest)(nil),
+		(*Message_QuorumHeightResponse)(nil),
+		(*Message_FirstQuorumHashRequest)(nil),
+		(*Message_FirstQuorumHashResponse)(nil),
+		(*Message_QuorumHashesRequest)(nil),
+		(*Message_QuorumHashesResponse)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterEnum("tendermint.privval.Errors", Errors_name, Errors_value)
+	proto.RegisterType((*RemoteSignerError)(nil), "tendermint.privval.RemoteSignerError")
+	proto.RegisterType((*PubKeyRequest)(nil), "tendermint.privval.PubKeyRequest")
+	proto.RegisterType((*ThresholdPubKeyRequest)(nil), "tendermint.privval.ThresholdPubKeyRequest")
+	proto.RegisterType((*ProTxHashRequest)(nil), "tendermint.privval.ProTxHashRequest")
+	proto.RegisterType((*PubKeyResponse)(nil), "tendermint.privval.PubKeyResponse")
+	proto.RegisterType((*ThresholdPubKeyResponse)(nil), "tendermint.privval.ThresholdPubKeyResponse")
+	proto.RegisterType((*ProTxHashResponse)(nil), "tendermint.privval.ProTxHashResponse")
+	proto.RegisterType((*SignVoteRequest)(nil), "tendermint.privval.SignVoteRequest")
+	proto.RegisterType((*SignedVoteResponse)(nil), "tendermint.privval.SignedVoteResponse")
+	proto.RegisterType((*SignProposalRequest)(nil), "tendermint.privval.SignProposalRequest")
+	proto.RegisterType((*SignedProposalResponse)(nil), "tendermint.privval.SignedProposalResponse")
+	proto.RegisterType((*PingRequest)(nil), "tendermint.privval.PingRequest")
+	proto.RegisterType((*PingResponse)(nil), "tendermint.privval.PingResponse")
+	proto.RegisterType((*UpdatePrivateKeyRequest)(nil), "tendermint.privval.UpdatePrivateKeyRequest")
+	proto.RegisterType((*UpdatePrivateKeyResponse)(nil), "tendermint.privval.UpdatePrivateKeyResponse")
+	proto.RegisterType((*QuorumHeightRequest)(nil), "tendermint.privval.QuorumHeightRequest")
+	proto.RegisterType((*QuorumHeightResponse)(nil), "tendermint.privval.QuorumHeightResponse")
+	proto.RegisterType((*FirstQuorumHashRequest)(nil), "tendermint.privval.FirstQuorumHashRequest")
+	proto.RegisterType((*FirstQuorumHashResponse)(nil), "tendermint.privval.FirstQuorumHashResponse")
+	proto.RegisterType((*QuorumHashesRequest)(nil), "tendermint.privval.QuorumHashesRequest")
+	proto.RegisterType((*QuorumHashesResponse)(nil), "tendermint.privval.QuorumHashesResponse")
+	proto.RegisterType((*Message)(nil), "tendermint.privval.Message")
+}
+
+func init() { proto.RegisterFile("tendermint/privval/types.proto", fileDescriptor_cb4e437a5328cf9c) }
+
+var fileDescriptor_cb4e437a5328cf9c = []byte{
+	// 1263 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4d, 0x6f, 0x23, 0x45,
+	0x13, 0x9e, 0x59, 0xdb, 0xc9, 0xa6, 0xfc, 0x11, 0xa7, 0xed, 0xd7, 0xf1, 0xe6, 0x5d, 0x1c, 0xe3,
+	0xe5, 0x23, 0x0a, 0xe0, 0xac, 0x76, 0x25, 0x24, 0xb4, 0x5c, 0x48, 0x62, 0x70, 0x14, 0xad, 0xed,
+	0x74, 0x1c, 0xb2, 0x5a, 0x09, 0x0d, 0x8e, 0xdd, 0xb1, 0x87, 0xc4, 0x9e, 0xce, 0xf4, 0x38, 0xc4,
+	0x67, 0x6e, 0x9c, 0x90, 0xf6, 0xc6, 0x2f, 0x40, 0xfc, 0x92, 0x3d, 0xee, 0x91, 0x13, 0x42, 0x09,
+	0x27, 0x7e, 0x05, 0x9a, 0x9e, 0xf6, 0xb8, 0xc7, 0x33, 0x43, 0x0c, 0x0e, 0xe2, 0x36, 0x5d, 0xd5,
+	0xfd, 0xd4, 0x53, 0x1f, 0x5d, 0xd5, 0x1a, 0x28, 0x58, 0x64, 0xd0, 0x21, 0x66, 0x5f, 0x1f, 0x58,
+	0x5b, 0xd4, 0xd4, 0x2f, 0x2f, 0x5b, 0xe7, 0x5b, 0xd6, 0x88, 0x12, 0x56, 0xa6, 0xa6, 0x61, 0x19,
+	0x08, 0x4d, 0xf4, 0x65, 0xa1, 0x5f, 0x7b, 0x28, 0x9d, 0x69, 0x9b, 0x23, 0x6a, 0x19, 0x5b, 0x67,
+	0x64, 0x24, 0x4e, 0x78, 0xb4, 0x1c, 0x49, 0xc6, 0x5b, 0xcb, 0x76, 0x8d, 0xae, 0xc1, 0x3f, 0xb7,
+	0xec, 0x2f, 0x47, 0x5a, 0xda, 0x83, 0x15, 0x4c, 0xfa, 0x86, 0x45, 0x0e, 0xf5, 0xee, 0x80, 0x98,
+	0x15, 0xd3, 0x34, 0x4c, 0x84, 0x20, 0xda, 0x36, 0x3a, 0x24, 0xaf, 0x16, 0xd5, 0x8d, 0x18, 0xe6,
+	0xdf, 0xa8, 0x08, 0xf1, 0x0e, 0x61, 0x6d, 0x53, 0xa7, 0x96, 0x6e, 0x0c, 0xf2, 0xf7, 0x8a, 0xea,
+	0xc6, 0x12, 0x96, 0x45, 0xa5, 0x7d, 0x48, 0x36, 0x86, 0x27, 0xfb, 0x64, 0x84, 0xc9, 0xc5, 0x90,
+	0x30, 0x0b, 0x3d, 0x80, 0xfb, 0xed, 0x5e, 0x4b, 0x1f, 0x68, 0x7a, 0x87, 0x43, 0x2d, 0xe1, 0x45,
+	0xbe, 0xde, 0xeb, 0xa0, 0x75, 0x88, 0x5f, 0x0c, 0x0d, 0x73, 0xd8, 0xd7, 0x7a, 0x2d, 0xd6, 0xe3,
+	0x68, 0x09, 0x0c, 0x8e, 0xa8, 0xda, 0x62, 0xbd, 0x52, 0x13, 0x72, 0xcd, 0x9e, 0x49, 0x58, 0xcf,
+	0x38, 0xef, 0xdc, 0x1d, 0xea, 0x47, 0x90, 0x6e, 0x98, 0x46, 0xf3, 0xca, 0x5e, 0xdc, 0x8e, 0x57,
+	0xfa, 0x5e, 0x85, 0xd4, 0xd8, 0x38, 0xa3, 0xc6, 0x80, 0x11, 0xf4, 0x0c, 0x16, 0xe9, 0xf0, 0x44,
+	0x3b, 0x23, 0x23, 0xbe, 0x39, 0xfe, 0xe4, 0x61, 0x59, 0xca, 0x93, 0x93, 0x93, 0x72, 0x63, 0x78,
+	0x72, 0xae, 0xb7, 0xf7, 0xc9, 0x68, 0x3b, 0xfa, 0xfa, 0xd7, 0x75, 0x05, 0x2f, 0x50, 0x0e, 0x82,
+	0x9e, 0x41, 0x8c, 0xd8, 0x01, 0xe6, 0xcc, 0xe2, 0x4f, 0xde, 0x2d, 0xfb, 0x53, 0x5c, 0xf6, 0x65,
+	0x03, 0x3b, 0x67, 0x4a, 0xaf, 0x54, 0x58, 0xf5, 0x85, 0xe4, 0x3f, 0x67, 0x45, 0x61, 0x45, 0x8a,
+	0xa8, 0xa0, 0x53, 0x80, 0x38, 0x35, 0x0d, 0xcd, 0xba, 0x72, 0xf2, 0xa0, 0xf2, 0x3c, 0x2c, 0xd1,
+	0xf1, 0xbe, 0xf9, 0x2c, 0xfe, 0xa8, 0xc2, 0xb2, 0x2d, 0xfe, 0xd2, 0xb0, 0xc8, 0x38, 0x87, 0x9b,
+	0x10, 0xbd, 0x34, 0x2c, 0x22, 0x9c, 0xcf, 0xc9, 0x78, 0xce, 0x15, 0xe0, 0x9b, 0xf9, 0x1e, 0x4f,
+	0xbe, 0xef, 0x85, 0xd5, 0x8f, 0x7d, 0x2a, 0x1f, 0xe1, 0xe5, 0x2f, 0xea, 0xa7, 0x39, 0xa2, 0x64,
+	0xba, 0xc0, 0xa2, 0xbe, 0x02, 0xfb, 0x4e, 0x05, 0xc4, 0x39, 0x77, 0x1c, 0x7a, 0x22, 0x20, 0x8f,
+	0x67, 0xe1, 0x27, 0xd2, 0xe2, 0xb0, 0x9c, 0x2b, 0x44, 0x3f, 0xab, 0x90, 0xb1, 0xc5, 0x0d, 0xd3,
+	0xa0, 0x06, 0x6b, 0x9d, 0x8f, 0xc3, 0xf4, 0x31, 0xdc, 0xa7, 0x42, 0x24, 0xa8, 0xac, 0xf9, 0xa9,
+	0xb8, 0x87, 0xdc, 0xbd, 0xff, 0x6e, 0xc8, 0x5e, 0xa9, 0x90, 0x73, 0x42, 0x36, 0xa1, 0x2b, 0xc2,
+	0xf6, 0xe9, 0xdf, 0xe1, 0x2b, 0xc2, 0x37, 0x61, 0x3d, 0x57, 0x08, 0x93, 0x10, 0x6f, 0xe8, 0x83,
+	0xae, 0x88, 0x5c, 0x29, 0x05, 0x09, 0x67, 0xe9, 0x30, 0x2b, 0xfd, 0xae, 0xc2, 0xea, 0x11, 0xed,
+	0xb4, 0x2c, 0xd2, 0x30, 0xf5, 0xcb, 0x96, 0x45, 0xee, 0xa6, 0x41, 0xd9, 0x1b, 0xa8, 0x03, 0xc8,
+	0x2f, 0x73, 0xc4, 0xd9, 0x40, 0x5d, 0x1b, 0xa8, 0x09, 0x59, 0x6b, 0xdc, 0x04, 0x34, 0xca, 0x6f,
+	0x34, 0xdf, 0x19, 0x9d, 0xf9, 0xda, 0x23, 0x4b, 0x6a, 0x22, 0x8e, 0x06, 0xe5, 0x60, 0xa1, 0x47,
+	0xf4, 0x6e, 0xcf, 0xca, 0xc7, 0x8a, 0xea, 0x46, 0x04, 0x8b, 0x55, 0xe9, 0x18, 0xf2, 0x7e, 0x2f,
+	0xdd, 0x9e, 0x23, 0xc2, 0xab, 0xfe, 0x83, 0xf0, 0x1e, 0x40, 0xe6, 0xc0, 0xf1, 0x9a, 0x1b, 0xba,
+	0x8b, 0xde, 0x7e, 0x06, 0x59, 0x2f, 0xa4, 0xe0, 0x39, 0xf1, 0x4d, 0x95, 0x7d, 0x9b, 0xaf, 0x3c,
+	0x9e, 0x42, 0xee, 0x73, 0xdd, 0x64, 0xd6, 0x81, 0x6b, 0x7f, 0x86, 0x71, 0xf2, 0x2d, 0xac, 0xfa,
+	0x0e, 0x09, 0x92, 0x53, 0xde, 0xa9, 0xbe, 0xc2, 0x98, 0x8b, 0xed, 0x63, 0x37, 0xda, 0x2d, 0xd6,
+	0x23, 0x6c, 0x06, 0xaa, 0x57, 0x90, 0xf5, 0x9e, 0x10, 0x3c, 0x1f, 0x41, 0x52, 0xe2, 0x49, 0x58,
+	0x5e, 0x2d, 0x46, 0x36, 0x12, 0x38, 0x71, 0x21, 0x6d, 0x9e, 0x8f, 0xeb, 0x1f, 0x29, 0x58, 0x7c,
+	0x4e, 0x18, 0x6b, 0x75, 0x09, 0xda, 0x87, 0x65, 0x31, 0xd6, 0x34, 0xd3, 0xe1, 0x2c, 0x8a, 0xed,
+	0xed, 0x20, 0x48, 0xcf, 0x33, 0xa1, 0xaa, 0xe0, 0x24, 0x95, 0x05, 0xa8, 0x06, 0xe9, 0x09, 0x98,
+	0xe3, 0x8e, 0x20, 0x58, 0xfa, 0x2b, 0x34, 0x67, 0x67, 0x55, 0xc1, 0x29, 0xea, 0x91, 0xa0, 0x03,
+	0x58, 0x61, 0x7a, 0x77, 0xa0, 0xd9, 0xed, 0xda, 0xa5, 0x17, 0xe1, 0x80, 0x8f, 0x82, 0x00, 0xa7,
+	0x66, 0x56, 0x55, 0xc1, 0xcb, 0xcc, 0x2b, 0x42, 0x2f, 0x21, 0xcb, 0x78, 0x27, 0x1c, 0x83, 0x0a,
+	0x9a, 0xce, 0xe5, 0x7e, 0x2f, 0x0c, 0xd5, 0x3b, 0x6c, 0xaa, 0x0a, 0x46, 0xcc, 0x27, 0x45, 0x5f,
+	0xc1, 0xff, 0x38, 0xdd, 0x71, 0x7b, 0x74, 0x29, 0xc7, 0x38, 0xf8, 0xfb, 0x61, 0xe0, 0x53, 0x33,
+	0xa4, 0xaa, 0xe0, 0x0c, 0xf3, 0x8b, 0xd1, 0x29, 0xe4, 0x05, 0x75, 0xc9, 0x80, 0xa0, 0xbf, 0xc0,
+	0x2d, 0x6c, 0x86, 0xd3, 0x9f, 0x6e, 0xfc, 0x55, 0x05, 0xe7, 0x58, 0xa0, 0x06, 0xed, 0x42, 0x82,
+	0xea, 0x83, 0xae, 0xcb, 0x7e, 0x91, 0x63, 0xaf, 0x07, 0x66, 0x70, 0xd2, 0xbf, 0xab, 0x0a, 0x8e,
+	0xd3, 0xc9, 0x12, 0x7d, 0x01, 0x49, 0x81, 0x22, 0x28, 0xde, 0xe7, 0x30, 0xc5, 0x70, 0x18, 0x97,
+	0x58, 0x82, 0x4a, 0x6b, 0x74, 0x04, 0x19, 0xe9, 0xa5, 0xe3, 0xb2, 0x5a, 0xe2, 0x70, 0xef, 0x04,
+	0xc2, 0x4d, 0xbd, 0x3f, 0xab, 0x0a, 0x4e, 0xd3, 0x29, 0x19, 0x7a, 0x01, 0x59, 0x2f, 0xac, 0xa0,
+	0x09, 0xe1, 0x17, 0xca, 0xf7, 0x0a, 0xab, 0x2a, 0x78, 0x85, 0x4e, 0x0b, 0x51, 0x17, 0x1e, 0x78,
+	0xe6, 0x87, 0xe7, 0x72, 0xc5, 0xc3, 0x13, 0x15, 0xfc, 0x18, 0xb7, 0x13, 0x65, 0x05, 0x6a, 0xd0,
+	0x37, 0xb0, 0x16, 0x64, 0x48, 0x38, 0x92, 0xe0, 0x96, 0x3e, 0x98, 0xc9, 0x92, 0xeb, 0xce, 0xaa,
+	0x15, 0xac, 0xb2, 0x6d, 0x0d, 0xf9, 0x98, 0xd2, 0xa4, 0xe1, 0xe9, 0x7a, 0x95, 0x0c, 0xb7, 0x15,
+	0x32, 0xc2, 0x6d, 0x5b, 0xc3, 0x60, 0x15, 0xea, 0xc3, 0xff, 0x03, 0x6d, 0x09, 0xc7, 0x52, 0xdc,
+	0xd8, 0x87, 0xb3, 0x19, 0x73, 0x3d, 0xcb, 0x0f, 0x43, 0x74, 0xf6, 0xb5, 0x1d, 0x37, 0x5c, 0x3e,
+	0xb6, 0x5c, 0xaf, 0x96, 0xc3, 0xaf, 0x6d, 0xc0, 0x64, 0xb5, 0xaf, 0xed, 0x85, 0x5f, 0x8c, 0xbe,
+	0x86, 0xdc, 0x34, 0xbc, 0x70, 0x24, 0xcd, 0xf1, 0x37, 0x6e, 0xc7, 0x77, 0x9d, 0xc8, 0x5e, 0x04,
+	0xc8, 0xed, 0x82, 0x3b, 0xb5, 0x87, 0x9e, 0x26, 0xcd, 0x0d, 0xd7, 0x89, 0x95, 0xf0, 0x82, 0x0b,
+	0x1e, 0xaf, 0x76, 0xc1, 0x9d, 0x06, 0x6a, 0xec, 0x22, 0x08, 0x32, 0x24, 0xdc, 0x41, 0xe1, 0x45,
+	0x10, 0x32, 0x93, 0xed, 0x22, 0x38, 0x0d, 0x56, 0xc9, 0x59, 0xe1, 0x23, 0xcf, 0x75, 0x28, 0x73,
+	0x6b, 0x56, 0xe4, 0x09, 0x2c, 0x65, 0x45, 0x16, 0xcb, 0x59, 0x19, 0xc3, 0x0b, 0x37, 0xb2, 0xb7,
+	0x66, 0xc5, 0x33, 0xaf, 0xa5, 0xac, 0x78, 0xe4, 0xdb, 0x31, 0x88, 0xb0, 0x61, 0x7f, 0xf3, 0x27,
+	0x15, 0x16, 0xf8, 0xf4, 0x65, 0x08, 0x41, 0xaa, 0x82, 0x71, 0x1d, 0x1f, 0x6a, 0x47, 0xb5, 0xfd,
+	0x5a, 0xfd, 0xb8, 0x96, 0x56, 0x50, 0x01, 0xd6, 0x5c, 0x59, 0xe5, 0x45, 0xa3, 0xb2, 0xd3, 0xac,
+	0xec, 0x6a, 0xb8, 0x72, 0xd8, 0xa8, 0xd7, 0x0e, 0x2b, 0x69, 0x15, 0xe5, 0x21, 0x2b, 0xf4, 0xb5,
+	0xba, 0xb6, 0x53, 0xaf, 0xd5, 0x2a, 0x3b, 0xcd, 0xbd, 0x7a, 0x2d, 0x7d, 0x0f, 0xbd, 0x05, 0x0f,
+	0x84, 0x66, 0x22, 0xd6, 0x9a, 0x7b, 0xcf, 0x2b, 0xf5, 0xa3, 0x66, 0x3a, 0x82, 0x56, 0x21, 0x23,
+	0xd4, 0xb8, 0xf2, 0xd9, 0xae, 0xab, 0x88, 0x4a, 0x88, 0xc7, 0x78, 0xaf, 0x59, 0x71, 0x35, 0xb1,
+	0xed, 0xc3, 0xd7, 0xd7, 0x05, 0xf5, 0xcd, 0x75, 0x41, 0xfd, 0xed, 0xba, 0xa0, 0xfe, 0x70, 0x53,
+	0x50, 0xde, 0xdc, 0x14, 0x94, 0x5f, 0x6e, 0x0a, 0xca, 0xcb, 0x4f, 0xba, 0xba, 0xd5, 0x1b, 0x9e,
+	0x94, 0xdb, 0x46, 0x7f, 0x4b, 0xfe, 0x03, 0x32, 0xf9, 0x74, 0xfe, 0x7a, 0xf8, 0xff, 0xb7, 0x9c,
+	0x2c, 0x70, 0xcd, 0xd3, 0x3f, 0x07, 0x00, 0x4b, 0x52, 0x1a, 0x6c, 0x8c, 0x11, 0x00, 0x00,
+}
+
+func (m *RemoteSignerError) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1475,42 +1607,32 @@ func (m *SignedProposalResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SignedProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoteSignerError) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignedProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoteSignerError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Error != nil {
-		{
-			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
 		i--
 		dAtA[i] = 0x12
 	}
-	{
-		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+	if m.Code != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *PingRequest) Marshal() (dAtA []byte, err error) {
+func (m *PubKeyRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1520,20 +1642,34 @@ func (m *PingRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PingRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.QuorumHash) > 0 {
+		i -= len(m.QuorumHash)
+		copy(dAtA[i:], m.QuorumHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.QuorumHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PingResponse) Marshal() (dAtA []byte, err error) {
+func (m *ThresholdPubKeyRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1543,20 +1679,34 @@ func (m *PingResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PingResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ThresholdPubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ThresholdPubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.QuorumHash) > 0 {
+		i -= len(m.QuorumHash)
+		copy(dAtA[i:], m.QuorumHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.QuorumHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Message) Marshal() (dAtA []byte, err error) {
+func (m *ProTxHashRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1566,38 +1716,49 @@ func (m *Message) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Message) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProTxHashRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProTxHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Sum != nil {
-		{
-			size := m.Sum.Size()
-			i -= size
-			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Message_PubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_PubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.PubKeyRequest != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
 		{
-			size, err := m.PubKeyRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1605,20 +1766,44 @@ func (m *Message_PubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTypes(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
-func (m *Message_PubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *ThresholdPubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThresholdPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_PubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ThresholdPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.PubKeyResponse != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
 		{
-			size, err := m.PubKeyResponse.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1628,18 +1813,42 @@ func (m *Message_PubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x12
 	}
+	{
+		size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
-func (m *Message_SignVoteRequest) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *ProTxHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProTxHashResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_SignVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProTxHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.SignVoteRequest != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
 		{
-			size, err := m.SignVoteRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1647,20 +1856,60 @@ func (m *Message_SignVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintTypes(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
+	}
+	if len(m.ProTxHash) > 0 {
+		i -= len(m.ProTxHash)
+		copy(dAtA[i:], m.ProTxHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProTxHash)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_SignedVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *SignVoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignVoteRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_SignedVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.SignedVoteResponse != nil {
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuorumHash) > 0 {
+		i -= len(m.QuorumHash)
+		copy(dAtA[i:], m.QuorumHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.QuorumHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.QuorumType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.QuorumType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Vote != nil {
 		{
-			size, err := m.SignedVoteResponse.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Vote.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1668,20 +1917,34 @@ func (m *Message_SignedVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintTypes(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_SignProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *SignedVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedVoteResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_SignProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignedVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.SignProposalRequest != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
 		{
-			size, err := m.SignProposalRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1689,20 +1952,63 @@ func (m *Message_SignProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 			i = encodeVarintTypes(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Vote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
-func (m *Message_SignedProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *SignProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignProposalRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_SignedProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.SignedProposalResponse != nil {
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuorumHash) > 0 {
+		i -= len(m.QuorumHash)
+		copy(dAtA[i:], m.QuorumHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.QuorumHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.QuorumType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.QuorumType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Proposal != nil {
 		{
-			size, err := m.SignedProposalResponse.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1710,20 +2016,34 @@ func (m *Message_SignedProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintTypes(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_PingRequest) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *SignedProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedProposalResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_PingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignedProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.PingRequest != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
 		{
-			size, err := m.PingRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1731,83 +2051,149 @@ func (m *Message_PingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTypes(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
-func (m *Message_PingResponse) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *PingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PingRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_PingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.PingResponse != nil {
-		{
-			size, err := m.PingResponse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
+	_ = i
+	var l int
+	_ = l
 	return len(dAtA) - i, nil
 }
-func (m *Message_ProTxHashRequest) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *PingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PingResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_ProTxHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.ProTxHashRequest != nil {
-		{
-			size, err := m.ProTxHashRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
+	_ = i
+	var l int
+	_ = l
 	return len(dAtA) - i, nil
 }
-func (m *Message_ProTxHashResponse) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *UpdatePrivateKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatePrivateKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_ProTxHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdatePrivateKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.ProTxHashResponse != nil {
-		{
-			size, err := m.ProTxHashResponse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.ThresholdPublicKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.PrivateKey) > 0 {
+		i -= len(m.PrivateKey)
+		copy(dAtA[i:], m.PrivateKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PrivateKey)))
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x1a
+	}
+	if len(m.QuorumHash) > 0 {
+		i -= len(m.QuorumHash)
+		copy(dAtA[i:], m.QuorumHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.QuorumHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_ThresholdPubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *UpdatePrivateKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatePrivateKeyResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_ThresholdPubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdatePrivateKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.ThresholdPubKeyRequest != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
 		{
-			size, err := m.ThresholdPubKeyRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1815,20 +2201,71 @@ func (m *Message_ThresholdPubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintTypes(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_ThresholdPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *QuorumHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuorumHeightRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_ThresholdPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuorumHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.ThresholdPubKeyResponse != nil {
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuorumHash) > 0 {
+		i -= len(m.QuorumHash)
+		copy(dAtA[i:], m.QuorumHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.QuorumHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuorumHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuorumHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuorumHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
 		{
-			size, err := m.ThresholdPubKeyResponse.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1836,391 +2273,2179 @@ func (m *Message_ThresholdPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int
 			i = encodeVarintTypes(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+
+func (m *FirstQuorumHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *RemoteSignerError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovTypes(uint64(m.Code))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+
+func (m *FirstQuorumHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PubKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *FirstQuorumHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.QuorumHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ThresholdPubKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FirstQuorumHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FirstQuorumHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FirstQuorumHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.QuorumHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.QuorumHash) > 0 {
+		i -= len(m.QuorumHash)
+		copy(dAtA[i:], m.QuorumHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.QuorumHash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ProTxHashRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuorumHashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuorumHashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuorumHashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PubKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuorumHashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuorumHashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuorumHashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.PubKey.Size()
-	n += 1 + l + sovTypes(uint64(l))
 	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
-}
-
-func (m *ThresholdPubKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	l = m.PubKey.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.QuorumHashes) > 0 {
+		for iNdEx := len(m.QuorumHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.QuorumHashes[iNdEx])
+			copy(dAtA[i:], m.QuorumHashes[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.QuorumHashes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ProTxHashResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProTxHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovTypes(uint64(l))
+func (m *Message) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SignVoteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Vote != nil {
-		l = m.Vote.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.QuorumType != 0 {
-		n += 1 + sovTypes(uint64(m.QuorumType))
-	}
-	l = len(m.QuorumHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+func (m *Message) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignedVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Vote.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Sum != nil {
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SignProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Proposal != nil {
-		l = m.Proposal.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.QuorumType != 0 {
-		n += 1 + sovTypes(uint64(m.QuorumType))
-	}
-	l = len(m.QuorumHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+func (m *Message_PubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignedProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Proposal.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovTypes(uint64(l))
+func (m *Message_PubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PubKeyRequest != nil {
+		{
+			size, err := m.PubKeyRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *Message_PubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Message_PubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PubKeyResponse != nil {
+		{
+			size, err := m.PubKeyResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *Message_SignVoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Message_SignVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SignVoteRequest != nil {
+		{
+			size, err := m.SignVoteRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *Message_SignedVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sum != nil {
-		n += m.Sum.Size()
+func (m *Message_SignedVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SignedVoteResponse != nil {
+		{
+			size, err := m.SignedVoteResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *Message_SignProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_PubKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PubKeyRequest != nil {
-		l = m.PubKeyRequest.Size()
-		n += 1 + l + sovTypes(uint64(l))
+func (m *Message_SignProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SignProposalRequest != nil {
+		{
+			size, err := m.SignProposalRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Message_PubKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PubKeyResponse != nil {
-		l = m.PubKeyResponse.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
-}
-func (m *Message_SignVoteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SignVoteRequest != nil {
-		l = m.SignVoteRequest.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
-}
-func (m *Message_SignedVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SignedVoteResponse != nil {
-		l = m.SignedVoteResponse.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
-}
-func (m *Message_SignProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SignProposalRequest != nil {
-		l = m.SignProposalRequest.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+func (m *Message_SignedProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Message_SignedProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+
+func (m *Message_SignedProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.SignedProposalResponse != nil {
-		l = m.SignedProposalResponse.Size()
-		n += 1 + l + sovTypes(uint64(l))
+		{
+			size, err := m.SignedProposalResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Message_PingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *Message_PingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_PingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.PingRequest != nil {
-		l = m.PingRequest.Size()
-		n += 1 + l + sovTypes(uint64(l))
+		{
+			size, err := m.PingRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Message_PingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *Message_PingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_PingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.PingResponse != nil {
-		l = m.PingResponse.Size()
-		n += 1 + l + sovTypes(uint64(l))
+		{
+			size, err := m.PingResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Message_ProTxHashRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *Message_ProTxHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_ProTxHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.ProTxHashRequest != nil {
-		l = m.ProTxHashRequest.Size()
-		n += 1 + l + sovTypes(uint64(l))
+		{
+			size, err := m.ProTxHashRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Message_ProTxHashResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *Message_ProTxHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_ProTxHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.ProTxHashResponse != nil {
-		l = m.ProTxHashResponse.Size()
-		n += 1 + l + sovTypes(uint64(l))
+		{
+			size, err := m.ProTxHashResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Message_ThresholdPubKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *Message_ThresholdPubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_ThresholdPubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.ThresholdPubKeyRequest != nil {
-		l = m.ThresholdPubKeyRequest.Size()
-		n += 1 + l + sovTypes(uint64(l))
+		{
+			size, err := m.ThresholdPubKeyRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Message_ThresholdPubKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *Message_ThresholdPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_ThresholdPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.ThresholdPubKeyResponse != nil {
-		l = m.ThresholdPubKeyResponse.Size()
-		n += 1 + l + sovTypes(uint64(l))
+		{
+			size, err := m.ThresholdPubKeyResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_UpdatePrivateKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_UpdatePrivateKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpdatePrivateKeyRequest != nil {
+		{
+			size, err := m.UpdatePrivateKeyRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_UpdatePrivateKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_UpdatePrivateKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpdatePrivateKeyResponse != nil {
+		{
+			size, err := m.UpdatePrivateKeyResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_QuorumHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_QuorumHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.QuorumHeightRequest != nil {
+		{
+			size, err := m.QuorumHeightRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_QuorumHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_QuorumHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.QuorumHeightResponse != nil {
+		{
+			size, err := m.QuorumHeightResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_FirstQuorumHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_FirstQuorumHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.FirstQuorumHashRequest != nil {
+		{
+			size, err := m.FirstQuorumHashRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_FirstQuorumHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_FirstQuorumHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.FirstQuorumHashResponse != nil {
+		{
+			size, err := m.FirstQuorumHashResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_QuorumHashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_QuorumHashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.QuorumHashesRequest != nil {
+		{
+			size, err := m.QuorumHashesRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_QuorumHashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_QuorumHashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.QuorumHashesResponse != nil {
+		{
+			size, err := m.QuorumHashesResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RemoteSignerError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTypes(uint64(m.Code))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *PubKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.QuorumHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ThresholdPubKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.QuorumHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ProTxHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *PubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PubKey.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ThresholdPubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PubKey.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ProTxHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProTxHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *SignVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Vote != nil {
+		l = m.Vote.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.QuorumType != 0 {
+		n += 1 + sovTypes(uint64(m.QuorumType))
+	}
+	l = len(m.QuorumHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *SignedVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Vote.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *SignProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != nil {
+		l = m.Proposal.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.QuorumType != 0 {
+		n += 1 + sovTypes(uint64(m.QuorumType))
+	}
+	l = len(m.QuorumHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *SignedProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *PingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UpdatePrivateKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.QuorumHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.PrivateKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.ThresholdPublicKey.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *UpdatePrivateKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *QuorumHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.QuorumHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *QuorumHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *FirstQuorumHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *FirstQuorumHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QuorumHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *QuorumHashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *QuorumHashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QuorumHashes) > 0 {
+		for _, b := range m.QuorumHashes {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Message) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *Message_PubKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKeyRequest != nil {
+		l = m.PubKeyRequest.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_PubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKeyResponse != nil {
+		l = m.PubKeyResponse.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_SignVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignVoteRequest != nil {
+		l = m.SignVoteRequest.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_SignedVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedVoteResponse != nil {
+		l = m.SignedVoteResponse.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_SignProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignProposalRequest != nil {
+		l = m.SignProposalRequest.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_SignedProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedProposalResponse != nil {
+		l = m.SignedProposalResponse.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_PingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PingRequest != nil {
+		l = m.PingRequest.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_PingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PingResponse != nil {
+		l = m.PingResponse.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_ProTxHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProTxHashRequest != nil {
+		l = m.ProTxHashRequest.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_ProTxHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProTxHashResponse != nil {
+		l = m.ProTxHashResponse.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_ThresholdPubKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ThresholdPubKeyRequest != nil {
+		l = m.ThresholdPubKeyRequest.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_ThresholdPubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ThresholdPubKeyResponse != nil {
+		l = m.ThresholdPubKeyResponse.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_UpdatePrivateKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdatePrivateKeyRequest != nil {
+		l = m.UpdatePrivateKeyRequest.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_UpdatePrivateKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdatePrivateKeyResponse != nil {
+		l = m.UpdatePrivateKeyResponse.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_QuorumHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QuorumHeightRequest != nil {
+		l = m.QuorumHeightRequest.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_QuorumHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QuorumHeightResponse != nil {
+		l = m.QuorumHeightResponse.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_FirstQuorumHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FirstQuorumHashRequest != nil {
+		l = m.FirstQuorumHashRequest.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_FirstQuorumHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FirstQuorumHashResponse != nil {
+		l = m.FirstQuorumHashResponse.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_QuorumHashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QuorumHashesRequest != nil {
+		l = m.QuorumHashesRequest.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_QuorumHashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QuorumHashesResponse != nil {
+		l = m.QuorumHashesResponse.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RemoteSignerError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteSignerError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteSignerError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuorumHash = append(m.QuorumHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.QuorumHash == nil {
+				m.QuorumHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThresholdPubKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThresholdPubKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThresholdPubKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuorumHash = append(m.QuorumHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.QuorumHash == nil {
+				m.QuorumHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProTxHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProTxHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProTxHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &RemoteSignerError{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThresholdPubKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThresholdPubKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThresholdPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &RemoteSignerError{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProTxHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProTxHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProTxHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProTxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProTxHash = append(m.ProTxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProTxHash == nil {
+				m.ProTxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &RemoteSignerError{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignVoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignVoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vote == nil {
+				m.Vote = &types.Vote{}
+			}
+			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumType", wireType)
+			}
+			m.QuorumType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuorumHash = append(m.QuorumHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.QuorumHash == nil {
+				m.QuorumHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *RemoteSignerError) Unmarshal(dAtA []byte) error {
+func (m *SignedVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2243,17 +4468,17 @@ func (m *RemoteSignerError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoteSignerError: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignedVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoteSignerError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignedVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
 			}
-			m.Code = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2263,16 +4488,30 @@ func (m *RemoteSignerError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Code |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2282,23 +4521,27 @@ func (m *RemoteSignerError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.Error == nil {
+				m.Error = &RemoteSignerError{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2321,7 +4564,7 @@ func (m *RemoteSignerError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PubKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *SignProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2344,13 +4587,49 @@ func (m *PubKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PubKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PubKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proposal == nil {
+				m.Proposal = &types.Proposal{}
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -2382,7 +4661,26 @@ func (m *PubKeyRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumType", wireType)
+			}
+			m.QuorumType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field QuorumHash", wireType)
 			}
@@ -2437,7 +4735,7 @@ func (m *PubKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ThresholdPubKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *SignedProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2460,17 +4758,17 @@ func (m *ThresholdPubKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ThresholdPubKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignedProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ThresholdPubKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignedProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2480,29 +4778,30 @@ func (m *ThresholdPubKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuorumHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2512,26 +4811,78 @@ func (m *ThresholdPubKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuorumHash = append(m.QuorumHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.QuorumHash == nil {
-				m.QuorumHash = []byte{}
+			if m.Error == nil {
+				m.Error = &RemoteSignerError{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2553,7 +4904,7 @@ func (m *ThresholdPubKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProTxHashRequest) Unmarshal(dAtA []byte) error {
+func (m *PingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2576,44 +4927,12 @@ func (m *ProTxHashRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProTxHashRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProTxHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2635,7 +4954,7 @@ func (m *ProTxHashRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PubKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdatePrivateKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2658,17 +4977,17 @@ func (m *PubKeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PubKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdatePrivateKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdatePrivateKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2678,30 +4997,29 @@ func (m *PubKeyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2711,83 +5029,31 @@ func (m *PubKeyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Error == nil {
-				m.Error = &RemoteSignerError{}
-			}
-			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.QuorumHash = append(m.QuorumHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.QuorumHash == nil {
+				m.QuorumHash = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ThresholdPubKeyResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ThresholdPubKeyResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ThresholdPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2797,28 +5063,29 @@ func (m *ThresholdPubKeyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PrivateKey = append(m.PrivateKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrivateKey == nil {
+				m.PrivateKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPublicKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2845,13 +5112,29 @@ func (m *ThresholdPubKeyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Error == nil {
-				m.Error = &RemoteSignerError{}
-			}
-			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ThresholdPublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2873,7 +5156,7 @@ func (m *ThresholdPubKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProTxHashResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdatePrivateKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2896,47 +5179,13 @@ func (m *ProTxHashResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProTxHashResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdatePrivateKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProTxHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdatePrivateKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProTxHash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProTxHash = append(m.ProTxHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProTxHash == nil {
-				m.ProTxHash = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
@@ -2993,7 +5242,7 @@ func (m *ProTxHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignVoteRequest) Unmarshal(dAtA []byte) error {
+func (m *QuorumHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3013,52 +5262,16 @@ func (m *SignVoteRequest) Unmarshal(dAtA []byte) error {
 				break
 			}
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SignVoteRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Vote == nil {
-				m.Vote = &types.Vote{}
-			}
-			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuorumHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuorumHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -3090,26 +5303,7 @@ func (m *SignVoteRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuorumType", wireType)
-			}
-			m.QuorumType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.QuorumType |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field QuorumHash", wireType)
 			}
@@ -3164,7 +5358,7 @@ func (m *SignVoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignedVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QuorumHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3187,17 +5381,17 @@ func (m *SignedVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignedVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuorumHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignedVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuorumHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3207,25 +5401,11 @@ func (m *SignedVoteResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
@@ -3283,7 +5463,7 @@ func (m *SignedVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *FirstQuorumHashRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3306,49 +5486,13 @@ func (m *SignProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FirstQuorumHashRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FirstQuorumHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Proposal == nil {
-				m.Proposal = &types.Proposal{}
-			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -3380,59 +5524,6 @@ func (m *SignProposalRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuorumType", wireType)
-			}
-			m.QuorumType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.QuorumType |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuorumHash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.QuorumHash = append(m.QuorumHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.QuorumHash == nil {
-				m.QuorumHash = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3454,7 +5545,7 @@ func (m *SignProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignedProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *FirstQuorumHashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3477,17 +5568,17 @@ func (m *SignedProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignedProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FirstQuorumHashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignedProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FirstQuorumHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3497,23 +5588,24 @@ func (m *SignedProposalResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.QuorumHash = append(m.QuorumHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.QuorumHash == nil {
+				m.QuorumHash = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
@@ -3573,7 +5665,7 @@ func (m *SignedProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PingRequest) Unmarshal(dAtA []byte) error {
+func (m *QuorumHashesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3596,12 +5688,44 @@ func (m *PingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuorumHashesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuorumHashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3623,7 +5747,7 @@ func (m *PingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PingResponse) Unmarshal(dAtA []byte) error {
+func (m *QuorumHashesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3646,12 +5770,80 @@ func (m *PingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuorumHashesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuorumHashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuorumHashes = append(m.QuorumHashes, make([]byte, postIndex-iNdEx))
+			copy(m.QuorumHashes[len(m.QuorumHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &RemoteSignerError{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -4122,6 +6314,286 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Message_ThresholdPubKeyResponse{v}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePrivateKeyRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UpdatePrivateKeyRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_UpdatePrivateKeyRequest{v}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePrivateKeyResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UpdatePrivateKeyResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_UpdatePrivateKeyResponse{v}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumHeightRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &QuorumHeightRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_QuorumHeightRequest{v}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumHeightResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &QuorumHeightResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_QuorumHeightResponse{v}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstQuorumHashRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &FirstQuorumHashRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_FirstQuorumHashRequest{v}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstQuorumHashResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &FirstQuorumHashResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_FirstQuorumHashResponse{v}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumHashesRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &QuorumHashesRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_QuorumHashesRequest{v}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumHashesResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &QuorumHashesResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_QuorumHashesResponse{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
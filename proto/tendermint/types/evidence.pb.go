@@ -30,6 +30,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Evidence struct {
 	// Types that are valid to be assigned to Sum:
 	//	*Evidence_DuplicateVoteEvidence
+	//	*Evidence_LightClientAttackEvidence
+	//	*Evidence_DuplicateStateVoteEvidence
 	Sum isEvidence_Sum `protobuf_oneof:"sum"`
 }
 
@@ -75,8 +77,16 @@ type isEvidence_Sum interface {
 type Evidence_DuplicateVoteEvidence struct {
 	DuplicateVoteEvidence *DuplicateVoteEvidence `protobuf:"bytes,1,opt,name=duplicate_vote_evidence,json=duplicateVoteEvidence,proto3,oneof" json:"duplicate_vote_evidence,omitempty"`
 }
+type Evidence_LightClientAttackEvidence struct {
+	LightClientAttackEvidence *LightClientAttackEvidence `protobuf:"bytes,2,opt,name=light_client_attack_evidence,json=lightClientAttackEvidence,proto3,oneof" json:"light_client_attack_evidence,omitempty"`
+}
+type Evidence_DuplicateStateVoteEvidence struct {
+	DuplicateStateVoteEvidence *DuplicateStateVoteEvidence `protobuf:"bytes,3,opt,name=duplicate_state_vote_evidence,json=duplicateStateVoteEvidence,proto3,oneof" json:"duplicate_state_vote_evidence,omitempty"`
+}
 
-func (*Evidence_DuplicateVoteEvidence) isEvidence_Sum() {}
+func (*Evidence_DuplicateVoteEvidence) isEvidence_Sum()      {}
+func (*Evidence_LightClientAttackEvidence) isEvidence_Sum()  {}
+func (*Evidence_DuplicateStateVoteEvidence) isEvidence_Sum() {}
 
 func (m *Evidence) GetSum() isEvidence_Sum {
 	if m != nil {
@@ -92,10 +102,26 @@ func (m *Evidence) GetDuplicateVoteEvidence() *DuplicateVoteEvidence {
 	return nil
 }
 
+func (m *Evidence) GetLightClientAttackEvidence() *LightClientAttackEvidence {
+	if x, ok := m.GetSum().(*Evidence_LightClientAttackEvidence); ok {
+		return x.LightClientAttackEvidence
+	}
+	return nil
+}
+
+func (m *Evidence) GetDuplicateStateVoteEvidence() *DuplicateStateVoteEvidence {
+	if x, ok := m.GetSum().(*Evidence_DuplicateStateVoteEvidence); ok {
+		return x.DuplicateStateVoteEvidence
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Evidence) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Evidence_DuplicateVoteEvidence)(nil),
+		(*Evidence_LightClientAttackEvidence)(nil),
+		(*Evidence_DuplicateStateVoteEvidence)(nil),
 	}
 }
 
@@ -176,6 +202,146 @@ func (m *DuplicateVoteEvidence) GetTimestamp() time.Time {
 	return time.Time{}
 }
 
+// DuplicateStateVoteEvidence contains evidence of a validator signed two conflicting
+// state IDs at the same height.
+type DuplicateStateVoteEvidence struct {
+	VoteA            *Vote     `protobuf:"bytes,1,opt,name=vote_a,json=voteA,proto3" json:"vote_a,omitempty"`
+	VoteB            *Vote     `protobuf:"bytes,2,opt,name=vote_b,json=voteB,proto3" json:"vote_b,omitempty"`
+	TotalVotingPower int64     `protobuf:"varint,3,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+	ValidatorPower   int64     `protobuf:"varint,4,opt,name=validator_power,json=validatorPower,proto3" json:"validator_power,omitempty"`
+	Timestamp        time.Time `protobuf:"bytes,5,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+}
+
+func (m *DuplicateStateVoteEvidence) Reset()         { *m = DuplicateStateVoteEvidence{} }
+func (m *DuplicateStateVoteEvidence) String() string { return proto.CompactTextString(m) }
+func (*DuplicateStateVoteEvidence) ProtoMessage()    {}
+func (*DuplicateStateVoteEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6825fabc78e0a168, []int{2}
+}
+func (m *DuplicateStateVoteEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DuplicateStateVoteEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DuplicateStateVoteEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DuplicateStateVoteEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicateStateVoteEvidence.Merge(m, src)
+}
+func (m *DuplicateStateVoteEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *DuplicateStateVoteEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicateStateVoteEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DuplicateStateVoteEvidence proto.InternalMessageInfo
+
+func (m *DuplicateStateVoteEvidence) GetVoteA() *Vote {
+	if m != nil {
+		return m.VoteA
+	}
+	return nil
+}
+
+func (m *DuplicateStateVoteEvidence) GetVoteB() *Vote {
+	if m != nil {
+		return m.VoteB
+	}
+	return nil
+}
+
+func (m *DuplicateStateVoteEvidence) GetTotalVotingPower() int64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
+func (m *DuplicateStateVoteEvidence) GetValidatorPower() int64 {
+	if m != nil {
+		return m.ValidatorPower
+	}
+	return 0
+}
+
+func (m *DuplicateStateVoteEvidence) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+// LightClientAttackEvidence contains evidence of a quorum signing a block that
+// conflicts with the block committed at the same height.
+type LightClientAttackEvidence struct {
+	ConflictingBlock *LightBlock `protobuf:"bytes,1,opt,name=conflicting_block,json=conflictingBlock,proto3" json:"conflicting_block,omitempty"`
+	TotalVotingPower int64       `protobuf:"varint,2,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+	Timestamp        time.Time   `protobuf:"bytes,3,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+}
+
+func (m *LightClientAttackEvidence) Reset()         { *m = LightClientAttackEvidence{} }
+func (m *LightClientAttackEvidence) String() string { return proto.CompactTextString(m) }
+func (*LightClientAttackEvidence) ProtoMessage()    {}
+func (*LightClientAttackEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6825fabc78e0a168, []int{3}
+}
+func (m *LightClientAttackEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientAttackEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientAttackEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientAttackEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientAttackEvidence.Merge(m, src)
+}
+func (m *LightClientAttackEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientAttackEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientAttackEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientAttackEvidence proto.InternalMessageInfo
+
+func (m *LightClientAttackEvidence) GetConflictingBlock() *LightBlock {
+	if m != nil {
+		return m.ConflictingBlock
+	}
+	return nil
+}
+
+func (m *LightClientAttackEvidence) GetTotalVotingPower() int64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
+func (m *LightClientAttackEvidence) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
 type EvidenceList struct {
 	Evidence []Evidence `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence"`
 }
@@ -184,7 +350,7 @@ func (m *EvidenceList) Reset()         { *m = EvidenceList{} }
 func (m *EvidenceList) String() string { return proto.CompactTextString(m) }
 func (*EvidenceList) ProtoMessage()    {}
 func (*EvidenceList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6825fabc78e0a168, []int{2}
+	return fileDescriptor_6825fabc78e0a168, []int{4}
 }
 func (m *EvidenceList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -223,38 +389,47 @@ func (m *EvidenceList) GetEvidence() []Evidence {
 func init() {
 	proto.RegisterType((*Evidence)(nil), "tendermint.types.Evidence")
 	proto.RegisterType((*DuplicateVoteEvidence)(nil), "tendermint.types.DuplicateVoteEvidence")
+	proto.RegisterType((*DuplicateStateVoteEvidence)(nil), "tendermint.types.DuplicateStateVoteEvidence")
+	proto.RegisterType((*LightClientAttackEvidence)(nil), "tendermint.types.LightClientAttackEvidence")
 	proto.RegisterType((*EvidenceList)(nil), "tendermint.types.EvidenceList")
 }
 
 func init() { proto.RegisterFile("tendermint/types/evidence.proto", fileDescriptor_6825fabc78e0a168) }
 
 var fileDescriptor_6825fabc78e0a168 = []byte{
-	// 385 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x3d, 0x4f, 0xf2, 0x50,
-	0x14, 0xc7, 0x5b, 0x0a, 0x84, 0xe7, 0x3e, 0x46, 0x49, 0x23, 0xda, 0x10, 0xd3, 0x12, 0x16, 0x18,
-	0xf4, 0x36, 0xd1, 0xc1, 0xc5, 0xc5, 0x46, 0x13, 0x07, 0x06, 0x6d, 0x0c, 0x83, 0x0b, 0x69, 0xe9,
-	0xb5, 0xde, 0xa4, 0xed, 0x6d, 0xda, 0x53, 0x8c, 0xdf, 0x82, 0x8f, 0xc5, 0xc8, 0xe8, 0xa4, 0x06,
-	0xbe, 0x88, 0xe9, 0xed, 0x0b, 0x84, 0x92, 0xb8, 0x34, 0xb7, 0xe7, 0xff, 0x3b, 0xef, 0x07, 0x69,
-	0x40, 0x02, 0x87, 0x44, 0x3e, 0x0d, 0x40, 0x87, 0x8f, 0x90, 0xc4, 0x3a, 0x99, 0x51, 0x87, 0x04,
-	0x53, 0x82, 0xc3, 0x88, 0x01, 0x93, 0xdb, 0x1b, 0x00, 0x73, 0xa0, 0x7b, 0xec, 0x32, 0x97, 0x71,
-	0x51, 0x4f, 0x5f, 0x19, 0xd7, 0xd5, 0x5c, 0xc6, 0x5c, 0x8f, 0xe8, 0xfc, 0xcf, 0x4e, 0x5e, 0x75,
-	0xa0, 0x3e, 0x89, 0xc1, 0xf2, 0xc3, 0x1c, 0x38, 0xab, 0x64, 0xe2, 0xdf, 0x5c, 0xed, 0x55, 0xd4,
-	0x99, 0xe5, 0x51, 0xc7, 0x02, 0x16, 0x65, 0x44, 0x1f, 0x50, 0xeb, 0x3e, 0x2f, 0x4d, 0xb6, 0xd0,
-	0xa9, 0x93, 0x84, 0x1e, 0x9d, 0x5a, 0x40, 0x26, 0x33, 0x06, 0x64, 0x52, 0x54, 0xad, 0x88, 0x3d,
-	0x71, 0xf8, 0xff, 0x72, 0x80, 0x77, 0xcb, 0xc6, 0x77, 0x85, 0xc3, 0x98, 0x01, 0x29, 0x22, 0x3d,
-	0x08, 0x66, 0xc7, 0xd9, 0x27, 0x18, 0x0d, 0x24, 0xc5, 0x89, 0xdf, 0x9f, 0xd7, 0x50, 0x67, 0xaf,
-	0xa7, 0x7c, 0x81, 0x9a, 0x3c, 0xb3, 0x95, 0xa7, 0x3c, 0xa9, 0xa6, 0x4c, 0x79, 0xb3, 0x91, 0x52,
-	0xb7, 0x25, 0x6e, 0x2b, 0xb5, 0xbf, 0x71, 0x43, 0x3e, 0x47, 0x32, 0x30, 0xb0, 0xbc, 0xb4, 0x3b,
-	0x1a, 0xb8, 0x93, 0x90, 0xbd, 0x93, 0x48, 0x91, 0x7a, 0xe2, 0x50, 0x32, 0xdb, 0x5c, 0x19, 0x73,
-	0xe1, 0x31, 0xb5, 0xcb, 0x03, 0x74, 0x54, 0x8e, 0x2b, 0x47, 0xeb, 0x1c, 0x3d, 0x2c, 0xcd, 0x19,
-	0x68, 0xa0, 0x7f, 0xe5, 0x5e, 0x94, 0x06, 0x2f, 0xa4, 0x8b, 0xb3, 0xcd, 0xe1, 0x62, 0x73, 0xf8,
-	0xb9, 0x20, 0x8c, 0xd6, 0xe2, 0x4b, 0x13, 0xe6, 0xdf, 0x9a, 0x68, 0x6e, 0xdc, 0xfa, 0x23, 0x74,
-	0x50, 0x0c, 0x61, 0x44, 0x63, 0x90, 0x6f, 0x50, 0x6b, 0x6b, 0xfa, 0x12, 0x0f, 0x59, 0xe9, 0xad,
-	0x9c, 0x6b, 0x3d, 0x0d, 0x69, 0x96, 0x1e, 0xc6, 0xd3, 0x62, 0xa5, 0x8a, 0xcb, 0x95, 0x2a, 0xfe,
-	0xac, 0x54, 0x71, 0xbe, 0x56, 0x85, 0xe5, 0x5a, 0x15, 0x3e, 0xd7, 0xaa, 0xf0, 0x72, 0xed, 0x52,
-	0x78, 0x4b, 0x6c, 0x3c, 0x65, 0xbe, 0xbe, 0x7d, 0x1d, 0x9b, 0x67, 0x76, 0x84, 0xbb, 0x97, 0x63,
-	0x37, 0xb9, 0xfd, 0xea, 0x37, 0x00, 0x00, 0xff, 0xff, 0x25, 0x83, 0x8b, 0x10, 0xdc, 0x02, 0x00,
-	0x00,
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xf5, 0x47, 0x53, 0x85, 0x05, 0x41, 0x58, 0x51, 0x48, 0xad, 0xe0, 0x54, 0xb9, 0x14, 0x89,
+	0x62, 0x4b, 0x70, 0xe0, 0xc2, 0xa5, 0x06, 0x24, 0x90, 0x72, 0x00, 0x83, 0x7a, 0xe0, 0x62, 0xf9,
+	0x63, 0xeb, 0xae, 0x6a, 0x7b, 0x4d, 0x3c, 0x09, 0xe2, 0x5f, 0xe4, 0xc2, 0x7f, 0xea, 0xb1, 0x37,
+	0x7a, 0x02, 0x94, 0xfc, 0x11, 0xe4, 0xf1, 0x57, 0xa8, 0x6b, 0x90, 0xb8, 0xf6, 0x62, 0xad, 0xe7,
+	0xbd, 0xd9, 0x99, 0xf7, 0x34, 0x3b, 0x64, 0x0c, 0x2c, 0x09, 0xd8, 0x2c, 0xe6, 0x09, 0x98, 0xf0,
+	0x35, 0x65, 0x99, 0xc9, 0x16, 0x3c, 0x60, 0x89, 0xcf, 0x8c, 0x74, 0x26, 0x40, 0xd0, 0x41, 0x43,
+	0x30, 0x90, 0xa0, 0xdd, 0x0b, 0x45, 0x28, 0x10, 0x34, 0xf3, 0x53, 0xc1, 0xd3, 0xc6, 0xa1, 0x10,
+	0x61, 0xc4, 0x4c, 0xfc, 0xf3, 0xe6, 0xc7, 0x26, 0xf0, 0x98, 0x65, 0xe0, 0xc6, 0x69, 0x49, 0x18,
+	0xb5, 0x2a, 0xe1, 0xb7, 0x44, 0xf7, 0x5a, 0xe8, 0xc2, 0x8d, 0x78, 0xe0, 0x82, 0x98, 0x15, 0x8c,
+	0xc9, 0x77, 0x85, 0xf4, 0x5f, 0x97, 0xbd, 0x51, 0x97, 0x3c, 0x08, 0xe6, 0x69, 0xc4, 0x7d, 0x17,
+	0x98, 0xb3, 0x10, 0xc0, 0x9c, 0xaa, 0xed, 0xa1, 0xbc, 0x27, 0x3f, 0xba, 0xf9, 0x74, 0xdf, 0xb8,
+	0xdc, 0xb7, 0xf1, 0xaa, 0x4a, 0x38, 0x12, 0xc0, 0xaa, 0x9b, 0xde, 0x48, 0xf6, 0x4e, 0x70, 0x15,
+	0x40, 0x13, 0x32, 0x8a, 0x78, 0x78, 0x02, 0x8e, 0x1f, 0x71, 0x96, 0x80, 0xe3, 0x02, 0xb8, 0xfe,
+	0x69, 0x53, 0x47, 0xc1, 0x3a, 0x8f, 0xdb, 0x75, 0xa6, 0x79, 0xd6, 0x4b, 0x4c, 0x3a, 0xc4, 0x9c,
+	0x8d, 0x5a, 0xbb, 0x51, 0x17, 0x48, 0x3f, 0x93, 0x87, 0x8d, 0xa4, 0x0c, 0xda, 0xc2, 0x54, 0x2c,
+	0x78, 0xf0, 0x17, 0x61, 0x1f, 0xa0, 0xad, 0x4e, 0x0b, 0x3a, 0x51, 0xab, 0x47, 0xd4, 0x6c, 0x1e,
+	0x4f, 0x96, 0x0a, 0xd9, 0xb9, 0xd2, 0x1c, 0xfa, 0x84, 0x6c, 0x63, 0x0f, 0x6e, 0xe9, 0xea, 0xfd,
+	0x76, 0xf1, 0x9c, 0x6f, 0xf7, 0x72, 0xd6, 0x61, 0x4d, 0xf7, 0x86, 0xca, 0xbf, 0xe9, 0x16, 0x3d,
+	0x20, 0x14, 0x04, 0xb8, 0x51, 0xae, 0x93, 0x27, 0xa1, 0x93, 0x8a, 0x2f, 0x6c, 0x86, 0x32, 0x55,
+	0x7b, 0x80, 0xc8, 0x11, 0x02, 0xef, 0xf2, 0x38, 0xdd, 0x27, 0x77, 0xea, 0x91, 0x28, 0xa9, 0x5b,
+	0x48, 0xbd, 0x5d, 0x87, 0x0b, 0xa2, 0x45, 0x6e, 0xd4, 0xb3, 0x37, 0xec, 0x61, 0x23, 0x9a, 0x51,
+	0x4c, 0xa7, 0x51, 0x4d, 0xa7, 0xf1, 0xb1, 0x62, 0x58, 0xfd, 0xb3, 0x1f, 0x63, 0x69, 0xf9, 0x73,
+	0x2c, 0xdb, 0x4d, 0xda, 0xe4, 0x9b, 0x42, 0xb4, 0x6e, 0x5b, 0xaf, 0xaf, 0x2f, 0x17, 0x32, 0xd9,
+	0xed, 0x9c, 0x6f, 0xfa, 0x96, 0xdc, 0xf5, 0x45, 0x72, 0x1c, 0x71, 0x1f, 0xfb, 0xf6, 0x22, 0xe1,
+	0x9f, 0x96, 0x0e, 0x8d, 0x3a, 0xde, 0x89, 0x95, 0x73, 0xec, 0xc1, 0x46, 0x1a, 0x46, 0x3a, 0x3c,
+	0x50, 0x3a, 0x3c, 0xf8, 0x43, 0x9a, 0xfa, 0x7f, 0xd2, 0xa6, 0xe4, 0x56, 0x25, 0x64, 0xca, 0x33,
+	0xa0, 0x2f, 0x48, 0x7f, 0x63, 0xa7, 0xa8, 0x78, 0x65, 0x4b, 0x43, 0xfd, 0x94, 0xb6, 0xf2, 0x2b,
+	0xed, 0x3a, 0xc3, 0x7a, 0x7f, 0xb6, 0xd2, 0xe5, 0xf3, 0x95, 0x2e, 0xff, 0x5a, 0xe9, 0xf2, 0x72,
+	0xad, 0x4b, 0xe7, 0x6b, 0x5d, 0xba, 0x58, 0xeb, 0xd2, 0xa7, 0xe7, 0x21, 0x87, 0x93, 0xb9, 0x67,
+	0xf8, 0x22, 0x36, 0x37, 0x97, 0x5e, 0x73, 0x2c, 0x76, 0xeb, 0xe5, 0x85, 0xe8, 0x6d, 0x63, 0xfc,
+	0xd9, 0xef, 0x01, 0x00, 0x80, 0xb8, 0x5a, 0x9c, 0xb3, 0x05, 0x00, 0x00,
 }
 
 func (m *Evidence) Marshal() (dAtA []byte, err error) {
@@ -310,6 +485,48 @@ func (m *Evidence_DuplicateVoteEvidence) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Evidence_LightClientAttackEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Evidence_LightClientAttackEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LightClientAttackEvidence != nil {
+		{
+			size, err := m.LightClientAttackEvidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Evidence_DuplicateStateVoteEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Evidence_DuplicateStateVoteEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DuplicateStateVoteEvidence != nil {
+		{
+			size, err := m.DuplicateStateVoteEvidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *DuplicateVoteEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -330,12 +547,77 @@ func (m *DuplicateVoteEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintEvidence(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	if m.ValidatorPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.ValidatorPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VoteB != nil {
+		{
+			size, err := m.VoteB.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.VoteA != nil {
+		{
+			size, err := m.VoteA.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DuplicateStateVoteEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DuplicateStateVoteEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DuplicateStateVoteEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n2
-	i = encodeVarintEvidence(dAtA, i, uint64(n2))
+	i -= n7
+	i = encodeVarintEvidence(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x2a
 	if m.ValidatorPower != 0 {
@@ -375,6 +657,54 @@ func (m *DuplicateVoteEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LightClientAttackEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientAttackEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientAttackEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintEvidence(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x1a
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ConflictingBlock != nil {
+		{
+			size, err := m.ConflictingBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EvidenceList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -447,6 +777,30 @@ func (m *Evidence_DuplicateVoteEvidence) Size() (n int) {
 	}
 	return n
 }
+func (m *Evidence_LightClientAttackEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LightClientAttackEvidence != nil {
+		l = m.LightClientAttackEvidence.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+func (m *Evidence_DuplicateStateVoteEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DuplicateStateVoteEvidence != nil {
+		l = m.DuplicateStateVoteEvidence.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
 func (m *DuplicateVoteEvidence) Size() (n int) {
 	if m == nil {
 		return 0
@@ -472,17 +826,60 @@ func (m *DuplicateVoteEvidence) Size() (n int) {
 	return n
 }
 
-func (m *EvidenceList) Size() (n int) {
+func (m *DuplicateStateVoteEvidence) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Evidence) > 0 {
-		for _, e := range m.Evidence {
-			l = e.Size()
-			n += 1 + l + sovEvidence(uint64(l))
-		}
+	if m.VoteA != nil {
+		l = m.VoteA.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.VoteB != nil {
+		l = m.VoteB.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovEvidence(uint64(m.TotalVotingPower))
+	}
+	if m.ValidatorPower != 0 {
+		n += 1 + sovEvidence(uint64(m.ValidatorPower))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovEvidence(uint64(l))
+	return n
+}
+
+func (m *LightClientAttackEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConflictingBlock != nil {
+		l = m.ConflictingBlock.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovEvidence(uint64(m.TotalVotingPower))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovEvidence(uint64(l))
+	return n
+}
+
+func (m *EvidenceList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 1 + l + sovEvidence(uint64(l))
+		}
 	}
 	return n
 }
@@ -557,6 +954,76 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Evidence_DuplicateVoteEvidence{v}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LightClientAttackEvidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LightClientAttackEvidence{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Evidence_LightClientAttackEvidence{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateStateVoteEvidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DuplicateStateVoteEvidence{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Evidence_DuplicateStateVoteEvidence{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvidence(dAtA[iNdEx:])
@@ -771,6 +1238,337 @@ func (m *DuplicateVoteEvidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DuplicateStateVoteEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DuplicateStateVoteEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DuplicateStateVoteEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VoteA == nil {
+				m.VoteA = &Vote{}
+			}
+			if err := m.VoteA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VoteB == nil {
+				m.VoteB = &Vote{}
+			}
+			if err := m.VoteB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPower", wireType)
+			}
+			m.ValidatorPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LightClientAttackEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientAttackEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientAttackEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConflictingBlock == nil {
+				m.ConflictingBlock = &LightBlock{}
+			}
+			if err := m.ConflictingBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EvidenceList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -108,12 +108,28 @@ func (c *Local) NumUnconfirmedTxs(ctx context.Context) (*ctypes.ResultUnconfirme
 	return core.NumUnconfirmedTxs(c.ctx)
 }
 
+func (c *Local) UnconfirmedTx(ctx context.Context, hash []byte) (*ctypes.ResultUnconfirmedTx, error) {
+	return core.UnconfirmedTx(c.ctx, hash)
+}
+
+func (c *Local) UnconfirmedTxsPage(
+	ctx context.Context,
+	cursor int64,
+	limit *int,
+) (*ctypes.ResultUnconfirmedTxsPage, error) {
+	return core.UnconfirmedTxsPage(c.ctx, cursor, limit)
+}
+
 func (c *Local) CheckTx(ctx context.Context, tx types.Tx) (*ctypes.ResultCheckTx, error) {
 	return core.CheckTx(c.ctx, tx)
 }
 
 func (c *Local) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
-	return core.NetInfo(c.ctx)
+	return core.NetInfo(c.ctx, false)
+}
+
+func (c *Local) NetInfoDetailed(ctx context.Context) (*ctypes.ResultNetInfo, error) {
+	return core.NetInfo(c.ctx, true)
 }
 
 func (c *Local) DumpConsensusState(ctx context.Context) (*ctypes.ResultDumpConsensusState, error) {
@@ -117,7 +117,11 @@ func (c Client) CheckTx(ctx context.Context, tx types.Tx) (*ctypes.ResultCheckTx
 }
 
 func (c Client) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
-	return core.NetInfo(&rpctypes.Context{})
+	return core.NetInfo(&rpctypes.Context{}, false)
+}
+
+func (c Client) NetInfoDetailed(ctx context.Context) (*ctypes.ResultNetInfo, error) {
+	return core.NetInfo(&rpctypes.Context{}, true)
 }
 
 func (c Client) ConsensusState(ctx context.Context) (*ctypes.ResultConsensusState, error) {
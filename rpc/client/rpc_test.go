@@ -129,6 +129,17 @@ func TestNetInfo(t *testing.T) {
 	}
 }
 
+func TestNetInfoDetailed(t *testing.T) {
+	for i, c := range GetClients() {
+		nc, ok := c.(client.NetworkClient)
+		require.True(t, ok, "%d", i)
+		netinfo, err := nc.NetInfoDetailed(context.Background())
+		require.Nil(t, err, "%d: %+v", i, err)
+		assert.True(t, netinfo.Listening)
+		assert.Equal(t, 0, len(netinfo.Peers))
+	}
+}
+
 func TestDumpConsensusState(t *testing.T) {
 	for i, c := range GetClients() {
 		// FIXME: fix server so it doesn't panic on invalid input
@@ -398,6 +409,50 @@ func TestNumUnconfirmedTxs(t *testing.T) {
 	mempool.Flush()
 }
 
+func TestUnconfirmedTx(t *testing.T) {
+	mempool := node.Mempool()
+
+	var txs types.Txs
+	for i := 0; i < 2; i++ {
+		_, _, tx := MakeTxKV()
+		ch := make(chan *abci.Response, 1)
+		err := mempool.CheckTx(tx, func(resp *abci.Response) { ch <- resp }, mempl.TxInfo{})
+		require.NoError(t, err)
+
+		// wait for tx to arrive in mempoool.
+		select {
+		case <-ch:
+		case <-time.After(5 * time.Second):
+			t.Error("Timed out waiting for CheckTx callback")
+		}
+		txs = append(txs, tx)
+	}
+
+	for _, c := range GetClients() {
+		mc := c.(client.MempoolClient)
+		res, err := mc.UnconfirmedTx(context.Background(), txs[0].Hash())
+		require.NoError(t, err)
+		assert.EqualValues(t, txs[0].Hash(), res.Hash)
+		assert.Equal(t, txs[0], res.Tx)
+		assert.EqualValues(t, 1, res.GasWanted)
+		assert.Empty(t, res.Senders)
+
+		// the page following the first tx starts with the second one
+		limit := 1
+		page, err := mc.UnconfirmedTxsPage(context.Background(), res.Seq, &limit)
+		require.NoError(t, err)
+		require.Equal(t, 1, page.Count)
+		assert.Equal(t, txs[1], page.Txs[0].Tx)
+		assert.Greater(t, page.Txs[0].Seq, res.Seq)
+		assert.Equal(t, page.Txs[0].Seq, page.NextCursor)
+
+		_, err = mc.UnconfirmedTx(context.Background(), types.Tx("missing").Hash())
+		assert.Error(t, err)
+	}
+
+	mempool.Flush()
+}
+
 func TestCheckTx(t *testing.T) {
 	mempool := node.Mempool()
 
@@ -7,9 +7,11 @@ import (
 	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/consensus"
 	"github.com/tendermint/tendermint/crypto"
+	dashcore "github.com/tendermint/tendermint/dashcore/rpc"
 	"github.com/tendermint/tendermint/libs/log"
 	mempl "github.com/tendermint/tendermint/mempool"
 	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/quorum"
 	"github.com/tendermint/tendermint/proxy"
 	sm "github.com/tendermint/tendermint/state"
 	"github.com/tendermint/tendermint/state/indexer"
@@ -62,6 +64,11 @@ type peers interface {
 	AddPrivatePeerIDs([]string) error
 	DialPeersAsync([]string) error
 	Peers() p2p.IPeerSet
+	PeerTrustScore(p2p.ID) (int, bool)
+}
+
+type validatorConnections interface {
+	Status() quorum.Status
 }
 
 // Environment contains objects and interfaces used by the RPC. It is expected
@@ -88,6 +95,13 @@ type Environment struct {
 	EventBus         *types.EventBus // thread safe
 	Mempool          mempl.Mempool
 
+	// only set when the node is connected to Dash Core, with a client whose
+	// calls are single short attempts which don't affect signing
+	DashCoreRPCClient dashcore.Client
+	ChainLockProvider sm.ChainLockProvider
+	// only set when the node is a validator connecting to its quorum
+	ValidatorConnections validatorConnections
+
 	Logger log.Logger
 
 	Config cfg.RPCConfig
@@ -1,13 +1,32 @@
 package core
 
 import (
+	"fmt"
+
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
 )
 
 // Health gets node health. Returns empty result (200 OK) on success, no
 // response - in case of an error.
+// If the node is connected to Dash Core, an error is returned when Dash Core
+// is unreachable or the masternode is not a member of the active quorum.
 // More: https://docs.tendermint.com/master/rpc/#/Info/health
 func Health(ctx *rpctypes.Context) (*ctypes.ResultHealth, error) {
+	info := coreInfo()
+	if info == nil {
+		return &ctypes.ResultHealth{}, nil
+	}
+
+	if !info.Reachable {
+		return nil, fmt.Errorf("dash core is unreachable: %s", info.Error)
+	}
+	if env.ProTxHash != nil && !info.QuorumMember {
+		if info.Error != "" {
+			return nil, fmt.Errorf("unable to check quorum membership: %s", info.Error)
+		}
+		return nil, fmt.Errorf("masternode %s is not a member of the active quorum %s",
+			env.ProTxHash.String(), info.QuorumHash.String())
+	}
 	return &ctypes.ResultHealth{}, nil
 }
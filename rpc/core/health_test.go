@@ -0,0 +1,92 @@
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dashevo/dashd-go/btcjson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+	dashcore "github.com/tendermint/tendermint/dashcore/rpc"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+type stubConsensus struct {
+	Consensus
+	state sm.State
+}
+
+func (c stubConsensus) GetState() sm.State { return c.state }
+
+type stubCoreClient struct {
+	dashcore.Client
+	pingErr error
+	members []btcjson.QuorumMember
+}
+
+func (c stubCoreClient) Ping() error { return c.pingErr }
+
+func (c stubCoreClient) MasternodeStatus() (*btcjson.MasternodeStatusResult, error) {
+	return &btcjson.MasternodeStatusResult{State: "READY", Status: "Ready"}, nil
+}
+
+func (c stubCoreClient) QuorumInfo(btcjson.LLMQType, crypto.QuorumHash) (*btcjson.QuorumInfoResult, error) {
+	return &btcjson.QuorumInfoResult{Members: c.members}, nil
+}
+
+func TestHealthDashCore(t *testing.T) {
+	proTxHash := crypto.RandProTxHash()
+	quorumHash := crypto.RandQuorumHash()
+	prevEnv := env
+	env = &Environment{
+		ProTxHash: &proTxHash,
+		ConsensusState: stubConsensus{state: sm.State{
+			LastCoreChainLockedBlockHeight: 10,
+			Validators: &types.ValidatorSet{
+				QuorumType: btcjson.LLMQType_5_60,
+				QuorumHash: quorumHash,
+			},
+		}},
+	}
+	t.Cleanup(func() { env = prevEnv })
+
+	// not connected to dash core
+	_, err := Health(&rpctypes.Context{})
+	require.NoError(t, err)
+
+	member := btcjson.QuorumMember{ProTxHash: proTxHash.String(), Valid: true}
+	testCases := []struct {
+		name   string
+		client stubCoreClient
+		isErr  bool
+	}{
+		{"healthy", stubCoreClient{members: []btcjson.QuorumMember{member}}, false},
+		{"unreachable", stubCoreClient{pingErr: errors.New("connection refused")}, true},
+		{"not a quorum member", stubCoreClient{}, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			env.DashCoreRPCClient = tc.client
+			_, err := Health(&rpctypes.Context{})
+			if tc.isErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	env.DashCoreRPCClient = stubCoreClient{members: []btcjson.QuorumMember{member}}
+	info := coreInfo()
+	require.NotNil(t, info)
+	assert.Equal(t, quorumHash, info.QuorumHash)
+	assert.True(t, info.Reachable)
+	assert.True(t, info.QuorumMember)
+	assert.EqualValues(t, 10, info.LastChainLockHeight)
+	assert.Equal(t, "READY", info.MasternodeState)
+}
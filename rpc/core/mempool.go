@@ -147,6 +147,65 @@ func UnconfirmedTxs(ctx *rpctypes.Context, limitPtr *int) (*ctypes.ResultUnconfi
 		Txs:        txs}, nil
 }
 
+// UnconfirmedTx gets the unconfirmed transaction with the given hash, along
+// with its gas wanted, the height it entered the mempool at and the peers it
+// was received from.
+// More: https://docs.tendermint.com/master/rpc/#/Info/unconfirmed_tx
+func UnconfirmedTx(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultUnconfirmedTx, error) {
+	if len(hash) != mempl.TxKeySize {
+		return nil, fmt.Errorf("invalid tx hash size %d, expected %d", len(hash), mempl.TxKeySize)
+	}
+	var key [mempl.TxKeySize]byte
+	copy(key[:], hash)
+
+	tx, ok := env.Mempool.GetUnconfirmedTx(key)
+	if !ok {
+		return nil, fmt.Errorf("tx (%X) not found in the mempool", hash)
+	}
+	return resultUnconfirmedTx(tx), nil
+}
+
+// UnconfirmedTxsPage gets a page of unconfirmed transactions, in the order
+// they were added to the mempool, following the one with the given sequence
+// number (cursor), or from the first one if the cursor is 0. The page ends
+// with the cursor of the next page, if there may be one. Paging goes on even
+// if the tx of the cursor left the mempool since.
+// More: https://docs.tendermint.com/master/rpc/#/Info/unconfirmed_txs_page
+func UnconfirmedTxsPage(ctx *rpctypes.Context, cursor int64, limitPtr *int) (*ctypes.ResultUnconfirmedTxsPage, error) {
+	// reuse per_page validator
+	limit := validatePerPage(limitPtr)
+
+	if cursor < 0 {
+		return nil, fmt.Errorf("cursor can't be negative, got %d", cursor)
+	}
+	txs := env.Mempool.UnconfirmedTxsAfter(cursor, limit)
+
+	result := &ctypes.ResultUnconfirmedTxsPage{
+		Count:      len(txs),
+		Total:      env.Mempool.Size(),
+		TotalBytes: env.Mempool.TxsBytes(),
+		Txs:        make([]*ctypes.ResultUnconfirmedTx, len(txs)),
+	}
+	for i, tx := range txs {
+		result.Txs[i] = resultUnconfirmedTx(tx)
+	}
+	if len(txs) == limit {
+		result.NextCursor = result.Txs[len(txs)-1].Seq
+	}
+	return result, nil
+}
+
+func resultUnconfirmedTx(tx *mempl.UnconfirmedTx) *ctypes.ResultUnconfirmedTx {
+	return &ctypes.ResultUnconfirmedTx{
+		Hash:      tx.Tx.Hash(),
+		Tx:        tx.Tx,
+		Seq:       tx.Seq,
+		Height:    tx.Height,
+		GasWanted: tx.GasWanted,
+		Senders:   tx.Senders,
+	}
+}
+
 // NumUnconfirmedTxs gets number of unconfirmed transactions.
 // More: https://docs.tendermint.com/master/rpc/#/Info/num_unconfirmed_txs
 func NumUnconfirmedTxs(ctx *rpctypes.Context) (*ctypes.ResultUnconfirmedTxs, error) {
@@ -6,13 +6,15 @@ import (
 	"strings"
 
 	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/quorum"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
 )
 
-// NetInfo returns network info.
+// NetInfo returns network info. The traffic of the channels of the peers is
+// included if detailed is true.
 // More: https://docs.tendermint.com/master/rpc/#/Info/net_info
-func NetInfo(ctx *rpctypes.Context) (*ctypes.ResultNetInfo, error) {
+func NetInfo(ctx *rpctypes.Context, detailed bool) (*ctypes.ResultNetInfo, error) {
 	peersList := env.P2PPeers.Peers().List()
 	peers := make([]ctypes.Peer, 0, len(peersList))
 	for _, peer := range peersList {
@@ -20,22 +22,59 @@ func NetInfo(ctx *rpctypes.Context) (*ctypes.ResultNetInfo, error) {
 		if !ok {
 			return nil, fmt.Errorf("peer.NodeInfo() is not DefaultNodeInfo")
 		}
-		peers = append(peers, ctypes.Peer{
+		status := peer.Status()
+		if !detailed {
+			for i := range status.Channels {
+				status.Channels[i].Traffic = nil
+			}
+		}
+		p := ctypes.Peer{
 			NodeInfo:         nodeInfo,
 			IsOutbound:       peer.IsOutbound(),
-			ConnectionStatus: peer.Status(),
+			ConnectionStatus: status,
 			RemoteIP:         peer.RemoteIP().String(),
-		})
+		}
+		if score, ok := env.P2PPeers.PeerTrustScore(peer.ID()); ok {
+			p.TrustScore = &score
+		}
+		peers = append(peers, p)
 	}
 	// TODO: Should we include PersistentPeers and Seeds in here?
 	// PRO: useful info
 	// CON: privacy
-	return &ctypes.ResultNetInfo{
+	result := &ctypes.ResultNetInfo{
 		Listening: env.P2PTransport.IsListening(),
 		Listeners: env.P2PTransport.Listeners(),
 		NPeers:    len(peers),
 		Peers:     peers,
-	}, nil
+	}
+	if env.ValidatorConnections != nil {
+		result.Quorum = quorumConnectivity(env.ValidatorConnections.Status())
+	}
+	return result, nil
+}
+
+func quorumConnectivity(status quorum.Status) *ctypes.QuorumConnectivity {
+	connectivity := &ctypes.QuorumConnectivity{
+		QuorumHash: status.QuorumHash,
+		IsMember:   status.IsMember,
+		Members:    make([]ctypes.QuorumMember, 0, len(status.Members)),
+	}
+	for _, mb := range status.Members {
+		member := ctypes.QuorumMember{
+			ProTxHash: mb.ProTxHash,
+			Connected: mb.Connected,
+		}
+		if mb.Address != nil {
+			member.NodeID = mb.Address.ID
+			member.Address = mb.Address.DialString()
+		}
+		if mb.Connected {
+			connectivity.NConnected++
+		}
+		connectivity.Members = append(connectivity.Members, member)
+	}
+	return connectivity
 }
 
 // UnsafeDialSeeds dials the given seeds (comma-separated id@IP:PORT).
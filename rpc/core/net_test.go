@@ -5,10 +5,17 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
 
 	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/conn"
+	"github.com/tendermint/tendermint/p2p/mock"
+	"github.com/tendermint/tendermint/p2p/quorum"
+	"github.com/tendermint/tendermint/p2p/trust"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
 )
 
@@ -85,3 +92,120 @@ func TestUnsafeDialPeers(t *testing.T) {
 		}
 	}
 }
+
+func TestNetInfoTrustScore(t *testing.T) {
+	trustStore := trust.NewTrustMetricStore(dbm.NewMemDB(), trust.DefaultConfig())
+	sw := p2p.MakeSwitch(cfg.DefaultP2PConfig(), 1, "testing", "123.123.123", nil,
+		func(n int, sw *p2p.Switch) *p2p.Switch {
+			p2p.SwitchTrustMetricStore(trustStore)(sw)
+			return sw
+		})
+	env.P2PPeers = sw
+	env.P2PTransport = &testTransport{}
+
+	trackedPeer, untrackedPeer := mock.NewPeer(nil), mock.NewPeer(nil)
+	p2p.AddPeerToSwitchPeerSet(sw, trackedPeer)
+	p2p.AddPeerToSwitchPeerSet(sw, untrackedPeer)
+	sw.MarkPeerAsGood(trackedPeer)
+
+	res, err := NetInfo(&rpctypes.Context{}, false)
+	require.NoError(t, err)
+	require.Len(t, res.Peers, 2)
+	for _, peer := range res.Peers {
+		if peer.NodeInfo.ID() == trackedPeer.ID() {
+			require.NotNil(t, peer.TrustScore)
+			assert.Equal(t, 100, *peer.TrustScore)
+		} else {
+			assert.Nil(t, peer.TrustScore)
+		}
+	}
+}
+
+// testStatusPeer is a peer whose connection has one channel with traffic.
+type testStatusPeer struct {
+	*mock.Peer
+}
+
+func (p testStatusPeer) Status() p2p.ConnectionStatus {
+	return p2p.ConnectionStatus{
+		Channels: []conn.ChannelStatus{{
+			ID:            0x20,
+			SendQueueSize: 3,
+			Traffic:       &conn.ChannelTraffic{MsgsSent: 10, BytesSent: 1000, SendRate: 100},
+		}},
+	}
+}
+
+func TestNetInfoDetailed(t *testing.T) {
+	sw := p2p.MakeSwitch(cfg.DefaultP2PConfig(), 1, "testing", "123.123.123", nil,
+		func(n int, sw *p2p.Switch) *p2p.Switch { return sw })
+	env.P2PPeers = sw
+	env.P2PTransport = &testTransport{}
+	p2p.AddPeerToSwitchPeerSet(sw, testStatusPeer{mock.NewPeer(nil)})
+
+	// the traffic of the channels is only included in the detailed mode
+	res, err := NetInfo(&rpctypes.Context{}, false)
+	require.NoError(t, err)
+	require.Len(t, res.Peers, 1)
+	require.Len(t, res.Peers[0].ConnectionStatus.Channels, 1)
+	assert.Equal(t, 3, res.Peers[0].ConnectionStatus.Channels[0].SendQueueSize)
+	assert.Nil(t, res.Peers[0].ConnectionStatus.Channels[0].Traffic)
+
+	res, err = NetInfo(&rpctypes.Context{}, true)
+	require.NoError(t, err)
+	require.Len(t, res.Peers, 1)
+	require.Len(t, res.Peers[0].ConnectionStatus.Channels, 1)
+	assert.Equal(t,
+		&conn.ChannelTraffic{MsgsSent: 10, BytesSent: 1000, SendRate: 100},
+		res.Peers[0].ConnectionStatus.Channels[0].Traffic)
+}
+
+type testValidatorConnections quorum.Status
+
+func (vc testValidatorConnections) Status() quorum.Status { return quorum.Status(vc) }
+
+func TestNetInfoQuorum(t *testing.T) {
+	sw := p2p.MakeSwitch(cfg.DefaultP2PConfig(), 1, "testing", "123.123.123", nil,
+		func(n int, sw *p2p.Switch) *p2p.Switch { return sw })
+	env.P2PPeers = sw
+	env.P2PTransport = &testTransport{}
+	t.Cleanup(func() { env.ValidatorConnections = nil })
+
+	res, err := NetInfo(&rpctypes.Context{}, false)
+	require.NoError(t, err)
+	assert.Nil(t, res.Quorum)
+
+	addr, err := p2p.NewNetAddressString("d51fb70907db1c6c2d5237e78379b25cf1a37ab4@127.0.0.1:26656")
+	require.NoError(t, err)
+	status := quorum.Status{
+		QuorumHash: crypto.RandQuorumHash(),
+		IsMember:   true,
+		Members: []quorum.MemberStatus{
+			{ProTxHash: crypto.RandProTxHash(), Address: addr, Connected: true},
+			{ProTxHash: crypto.RandProTxHash()},
+		},
+	}
+	env.ValidatorConnections = testValidatorConnections(status)
+
+	res, err = NetInfo(&rpctypes.Context{}, false)
+	require.NoError(t, err)
+	require.NotNil(t, res.Quorum)
+	assert.Equal(t, status.QuorumHash, res.Quorum.QuorumHash)
+	assert.True(t, res.Quorum.IsMember)
+	assert.Equal(t, 1, res.Quorum.NConnected)
+	assert.Equal(t, []ctypes.QuorumMember{
+		{
+			ProTxHash: status.Members[0].ProTxHash,
+			NodeID:    addr.ID,
+			Address:   "127.0.0.1:26656",
+			Connected: true,
+		},
+		{ProTxHash: status.Members[1].ProTxHash},
+	}, res.Quorum.Members)
+}
+
+type testTransport struct{}
+
+func (testTransport) Listeners() []string    { return nil }
+func (testTransport) IsListening() bool      { return false }
+func (testTransport) NodeInfo() p2p.NodeInfo { return p2p.DefaultNodeInfo{} }
@@ -1,6 +1,8 @@
 package core
 
 import (
+	"bytes"
+	"encoding/hex"
 	"time"
 
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
@@ -73,11 +75,72 @@ func Status(ctx *rpctypes.Context) (*ctypes.ResultStatus, error) {
 			CatchingUp:          env.ConsensusReactor.WaitSync(),
 		},
 		ValidatorInfo: validatorInfo,
+		CoreInfo:      coreInfo(),
 	}
 
 	return result, nil
 }
 
+// coreInfo returns the state of the Dash Core node backing this node, or nil
+// if the node is not connected to Dash Core.
+func coreInfo() *ctypes.CoreInfo {
+	if env.DashCoreRPCClient == nil {
+		return nil
+	}
+
+	info := &ctypes.CoreInfo{}
+	state := env.ConsensusState.GetState()
+	info.LastChainLockHeight = state.LastCoreChainLockedBlockHeight
+	if env.ChainLockProvider != nil {
+		if chainLock := env.ChainLockProvider.BestChainLock(); chainLock != nil &&
+			chainLock.CoreBlockHeight > info.LastChainLockHeight {
+			info.LastChainLockHeight = chainLock.CoreBlockHeight
+		}
+	}
+	if state.Validators != nil {
+		info.QuorumType = state.Validators.QuorumType
+		info.QuorumHash = state.Validators.QuorumHash
+	}
+
+	if err := env.DashCoreRPCClient.Ping(); err != nil {
+		info.Error = err.Error()
+		return info
+	}
+	info.Reachable = true
+
+	// a full node is not a masternode, so core has no status to return
+	if env.ProTxHash == nil {
+		return info
+	}
+
+	status, err := env.DashCoreRPCClient.MasternodeStatus()
+	if err != nil {
+		info.Error = err.Error()
+		return info
+	}
+	info.MasternodeProTxHash = status.ProTxHash
+	info.MasternodeState = status.State
+	info.MasternodeStatus = status.Status
+
+	if len(info.QuorumHash) == 0 {
+		return info
+	}
+	quorum, err := env.DashCoreRPCClient.QuorumInfo(info.QuorumType, info.QuorumHash)
+	if err != nil {
+		info.Error = err.Error()
+		return info
+	}
+	for _, member := range quorum.Members {
+		proTxHash, err := hex.DecodeString(member.ProTxHash)
+		if err == nil && bytes.Equal(proTxHash, *env.ProTxHash) {
+			info.QuorumMember = member.Valid
+			break
+		}
+	}
+
+	return info
+}
+
 func validatorAtHeight(h int64) *types.Validator {
 	vals, err := env.StateStore.LoadValidators(h)
 	if err != nil {
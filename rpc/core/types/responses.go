@@ -82,11 +82,31 @@ type ValidatorInfo struct {
 	VotingPower int64            `json:"voting_power"`
 }
 
+// Info about the Dash Core node backing the node
+type CoreInfo struct {
+	Reachable bool   `json:"reachable"`
+	Error     string `json:"error,omitempty"`
+
+	// Height of the best chain lock seen
+	LastChainLockHeight uint32 `json:"last_chain_lock_height"`
+
+	MasternodeProTxHash string `json:"masternode_pro_tx_hash"`
+	MasternodeState     string `json:"masternode_state"`
+	MasternodeStatus    string `json:"masternode_status"`
+
+	// Active quorum and whether the node is a member of it
+	QuorumType   btcjson.LLMQType  `json:"quorum_type"`
+	QuorumHash   crypto.QuorumHash `json:"quorum_hash"`
+	QuorumMember bool              `json:"quorum_member"`
+}
+
 // Node Status
 type ResultStatus struct {
 	NodeInfo      p2p.DefaultNodeInfo `json:"node_info"`
 	SyncInfo      SyncInfo            `json:"sync_info"`
 	ValidatorInfo ValidatorInfo       `json:"validator_info"`
+	// Only set when the node is connected to Dash Core
+	CoreInfo *CoreInfo `json:"core_info,omitempty"`
 }
 
 // Is TxIndexing enabled
@@ -103,6 +123,25 @@ type ResultNetInfo struct {
 	Listeners []string `json:"listeners"`
 	NPeers    int      `json:"n_peers"`
 	Peers     []Peer   `json:"peers"`
+	// only set when the node connects to the other members of its quorum
+	Quorum *QuorumConnectivity `json:"quorum,omitempty"`
+}
+
+// Connectivity of the node to the other members of the active quorum
+type QuorumConnectivity struct {
+	QuorumHash crypto.QuorumHash `json:"quorum_hash"`
+	IsMember   bool              `json:"is_member"`
+	NConnected int               `json:"n_connected"`
+	Members    []QuorumMember    `json:"members"`
+}
+
+// Another member of the active quorum
+type QuorumMember struct {
+	ProTxHash crypto.ProTxHash `json:"pro_tx_hash"`
+	// empty until the address of the member is resolved
+	NodeID    p2p.ID `json:"node_id"`
+	Address   string `json:"address"`
+	Connected bool   `json:"connected"`
 }
 
 // Log from dialing seeds
@@ -121,6 +160,9 @@ type Peer struct {
 	IsOutbound       bool                 `json:"is_outbound"`
 	ConnectionStatus p2p.ConnectionStatus `json:"connection_status"`
 	RemoteIP         string               `json:"remote_ip"`
+	// TrustScore is the trust score of the peer, between 0 and 100, if its
+	// trust is tracked.
+	TrustScore *int `json:"trust_score,omitempty"`
 }
 
 // Validators for a height.
@@ -214,6 +256,26 @@ type ResultUnconfirmedTxs struct {
 	Txs        []types.Tx `json:"txs"`
 }
 
+// Mempool tx, with the details of its entry in the mempool
+type ResultUnconfirmedTx struct {
+	Hash      bytes.HexBytes `json:"hash"`
+	Tx        types.Tx       `json:"tx"`
+	Seq       int64          `json:"seq"`
+	Height    int64          `json:"height"`
+	GasWanted int64          `json:"gas_wanted"`
+	Senders   []p2p.ID       `json:"senders"`
+}
+
+// Page of mempool txs, in the order they were added to the mempool
+type ResultUnconfirmedTxsPage struct {
+	Count      int                    `json:"n_txs"`
+	Total      int                    `json:"total"`
+	TotalBytes int64                  `json:"total_bytes"`
+	Txs        []*ResultUnconfirmedTx `json:"txs"`
+	// sequence number of the last tx of the page, to get the next page with
+	NextCursor int64 `json:"next_cursor,omitempty"`
+}
+
 // Info abci msg
 type ResultABCIInfo struct {
 	Response abci.ResponseInfo `json:"response"`
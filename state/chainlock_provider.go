@@ -0,0 +1,191 @@
+package state
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dashevo/dashd-go/btcjson"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
+	dashcore "github.com/tendermint/tendermint/dashcore/rpc"
+	"github.com/tendermint/tendermint/libs/service"
+	tmsync "github.com/tendermint/tendermint/libs/sync"
+	"github.com/tendermint/tendermint/types"
+)
+
+// ChainLockProvider provides the freshest verified chain lock known to the node.
+// The BlockExecutor consults it when building a proposal and the ABCI application
+// did not return a newer chain lock in EndBlock.
+type ChainLockProvider interface {
+	// BestChainLock returns the best verified chain lock, or nil if there is none
+	BestChainLock() *types.CoreChainLock
+}
+
+// CoreChainLockProvider is a ChainLockProvider that polls Dash Core for its
+// best chain lock, or receives it as a notification, and keeps it once its
+// signature is verified against the LLMQ that signed it.
+type CoreChainLockProvider struct {
+	service.BaseService
+
+	client       dashcore.Client
+	quorumType   btcjson.LLMQType
+	pollInterval time.Duration
+
+	mtx           tmsync.RWMutex
+	bestChainLock *types.CoreChainLock
+}
+
+var _ ChainLockProvider = (*CoreChainLockProvider)(nil)
+
+// NewCoreChainLockProvider returns a new CoreChainLockProvider. quorumType is
+// the LLMQ type Dash Core uses to sign chain locks. A zero pollInterval
+// disables polling: the best chain lock is fetched once, then only taken from
+// the notifications.
+func NewCoreChainLockProvider(
+	client dashcore.Client,
+	quorumType btcjson.LLMQType,
+	pollInterval time.Duration,
+) *CoreChainLockProvider {
+	p := &CoreChainLockProvider{
+		client:       client,
+		quorumType:   quorumType,
+		pollInterval: pollInterval,
+	}
+	p.BaseService = *service.NewBaseService(nil, "CoreChainLockProvider", p)
+	return p
+}
+
+// OnStart implements service.Service by starting the polling routine.
+func (p *CoreChainLockProvider) OnStart() error {
+	go p.pollRoutine()
+	return nil
+}
+
+// BestChainLock implements ChainLockProvider.
+func (p *CoreChainLockProvider) BestChainLock() *types.CoreChainLock {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	if p.bestChainLock == nil {
+		return nil
+	}
+	chainLock := p.bestChainLock.Copy()
+	return &chainLock
+}
+
+// pollRoutine polls Dash Core for its best chain lock and also takes the chain
+// locks that Dash Core pushes as notifications. It drains the notifications
+// even if polling is disabled.
+func (p *CoreChainLockProvider) pollRoutine() {
+	var tick <-chan time.Time
+	if p.pollInterval > 0 {
+		ticker := time.NewTicker(p.pollInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	p.update()
+	for {
+		select {
+		case <-tick:
+			p.update()
+		case notification := <-p.client.Notifications():
+			if n, ok := notification.(dashcore.ChainLockNotification); ok {
+				chainLock := n.ChainLock
+				p.process(&chainLock)
+			}
+		case <-p.Quit():
+			return
+		}
+	}
+}
+
+// update fetches the best chain lock from Dash Core and processes it.
+func (p *CoreChainLockProvider) update() {
+	res, err := p.client.GetBestChainLock()
+	if err != nil {
+		p.Logger.Debug("unable to get best chain lock from core", "err", err)
+		return
+	}
+
+	chainLock, err := chainLockFromBestChainLockResult(res)
+	if err != nil {
+		p.Logger.Error("core returned malformed chain lock", "err", err)
+		return
+	}
+	p.process(chainLock)
+}
+
+// process keeps the chain lock if it is newer than the current one and its
+// signature is valid.
+func (p *CoreChainLockProvider) process(chainLock *types.CoreChainLock) {
+	if best := p.BestChainLock(); best != nil && best.CoreBlockHeight >= chainLock.CoreBlockHeight {
+		return
+	}
+
+	if err := p.VerifyChainLock(chainLock); err != nil {
+		p.Logger.Error("invalid chain lock received from core",
+			"height", chainLock.CoreBlockHeight, "err", err)
+		return
+	}
+
+	p.mtx.Lock()
+	p.bestChainLock = chainLock
+	p.mtx.Unlock()
+
+	p.Logger.Debug("new best chain lock", "height", chainLock.CoreBlockHeight)
+}
+
+// VerifyChainLock verifies the chain lock signature against the public key of
+// the quorum Dash Core selected to sign it.
+func (p *CoreChainLockProvider) VerifyChainLock(chainLock *types.CoreChainLock) error {
+	if err := chainLock.ValidateBasic(); err != nil {
+		return err
+	}
+
+	selected, err := p.client.QuorumSelectQuorum(p.quorumType, chainLock.RequestID())
+	if err != nil {
+		return fmt.Errorf("unable to select chain lock quorum: %w", err)
+	}
+	quorumHash, err := hex.DecodeString(selected.QuorumHash)
+	if err != nil {
+		return fmt.Errorf("malformed quorum hash %s: %w", selected.QuorumHash, err)
+	}
+
+	quorumInfo, err := p.client.QuorumInfo(p.quorumType, quorumHash)
+	if err != nil {
+		return fmt.Errorf("unable to get chain lock quorum info: %w", err)
+	}
+	quorumPublicKey, err := hex.DecodeString(quorumInfo.QuorumPublicKey)
+	if err != nil {
+		return fmt.Errorf("malformed quorum public key %s: %w", quorumInfo.QuorumPublicKey, err)
+	}
+	if len(quorumPublicKey) != bls12381.PubKeySize {
+		return fmt.Errorf("quorum public key has wrong size %d", len(quorumPublicKey))
+	}
+
+	signID := chainLock.SignID(p.quorumType, crypto.QuorumHash(quorumHash))
+	if !bls12381.PubKey(quorumPublicKey).VerifySignatureDigest(signID, chainLock.Signature) {
+		return errors.New("chain lock signature is invalid")
+	}
+	return nil
+}
+
+func chainLockFromBestChainLockResult(res *dashcore.BestChainLockResult) (*types.CoreChainLock, error) {
+	blockHash, err := hex.DecodeString(res.BlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("malformed block hash %s: %w", res.BlockHash, err)
+	}
+	signature, err := hex.DecodeString(res.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("malformed signature %s: %w", res.Signature, err)
+	}
+	chainLock := &types.CoreChainLock{
+		CoreBlockHeight: res.Height,
+		CoreBlockHash:   blockHash,
+		Signature:       signature,
+	}
+	return chainLock, chainLock.ValidateBasic()
+}
@@ -0,0 +1,50 @@
+package state_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dashevo/dashd-go/btcjson"
+	"github.com/stretchr/testify/require"
+
+	dashcore "github.com/tendermint/tendermint/dashcore/rpc"
+	"github.com/tendermint/tendermint/libs/log"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+// notifyingClient is a Dash Core client which only delivers notifications.
+type notifyingClient struct {
+	dashcore.Client
+	notifications chan dashcore.Notification
+}
+
+func (c notifyingClient) Notifications() <-chan dashcore.Notification {
+	return c.notifications
+}
+
+func (c notifyingClient) GetBestChainLock() (*dashcore.BestChainLockResult, error) {
+	return nil, errors.New("dash core is unavailable")
+}
+
+// TestCoreChainLockProviderDrainsNotifications ensures the notifications are
+// consumed even if polling is disabled.
+func TestCoreChainLockProviderDrainsNotifications(t *testing.T) {
+	client := notifyingClient{notifications: make(chan dashcore.Notification)}
+	provider := sm.NewCoreChainLockProvider(client, btcjson.LLMQType_5_60, 0)
+	provider.SetLogger(log.TestingLogger())
+	require.NoError(t, provider.Start())
+	defer provider.Stop() //nolint:errcheck // ignore for tests
+
+	for i := 0; i < 10; i++ {
+		// an invalid chain lock, which isn't verified against Dash Core
+		notification := dashcore.ChainLockNotification{ChainLock: types.CoreChainLock{}}
+		select {
+		case client.notifications <- notification:
+		case <-time.After(time.Second):
+			t.Fatal("the notifications are not consumed")
+		}
+	}
+	require.Nil(t, provider.BestChainLock())
+}
@@ -43,6 +43,8 @@ type BlockExecutor struct {
 	evpool  EvidencePool
 	// the next core chain lock that we can propose
 	NextCoreChainLock *types.CoreChainLock
+	// provides chain locks when the application did not return a newer one
+	chainLockProvider ChainLockProvider
 
 	logger log.Logger
 
@@ -66,6 +68,14 @@ func BlockExecutorWithAppHashSize(size int) BlockExecutorOption {
 	}
 }
 
+// BlockExecutorWithChainLockProvider is used to propose chain locks coming
+// from the provider when the application did not return a newer one
+func BlockExecutorWithChainLockProvider(provider ChainLockProvider) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.chainLockProvider = provider
+	}
+}
+
 // NewBlockExecutor returns a new BlockExecutor with a NopEventBus.
 // Call SetEventBus to provide one.
 func NewBlockExecutor(
@@ -130,12 +140,7 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 
 	txs := blockExec.mempool.ReapMaxBytesMaxGas(maxDataBytes, maxGas)
 
-	nextCoreChainLock := blockExec.NextCoreChainLock
-
-	if nextCoreChainLock != nil &&
-		nextCoreChainLock.CoreBlockHeight <= state.LastCoreChainLockedBlockHeight {
-		nextCoreChainLock = nil
-	}
+	nextCoreChainLock := blockExec.proposedCoreChainLock(state)
 
 	// Pass proposed app version only if it's higher than current network app version
 	if proposedAppVersion <= state.Version.Consensus.App {
@@ -153,6 +158,28 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 	)
 }
 
+// proposedCoreChainLock returns the chain lock to include in the next proposal,
+// or nil if there is none newer than the last chain locked height.
+// The chain lock returned by the application in EndBlock takes precedence over
+// the one from the chain lock provider.
+func (blockExec *BlockExecutor) proposedCoreChainLock(state State) *types.CoreChainLock {
+	nextCoreChainLock := blockExec.NextCoreChainLock
+	if nextCoreChainLock != nil &&
+		nextCoreChainLock.CoreBlockHeight > state.LastCoreChainLockedBlockHeight {
+		return nextCoreChainLock
+	}
+
+	if blockExec.chainLockProvider == nil {
+		return nil
+	}
+	nextCoreChainLock = blockExec.chainLockProvider.BestChainLock()
+	if nextCoreChainLock != nil &&
+		nextCoreChainLock.CoreBlockHeight > state.LastCoreChainLockedBlockHeight {
+		return nextCoreChainLock
+	}
+	return nil
+}
+
 // ValidateBlock validates the given block against the given state.
 // If the block is invalid, it returns an error.
 // Validation does not mutate state, but does require historical information from the stateDB,
@@ -473,3 +473,50 @@ func makeStateID(lastAppHash []byte) types.StateID {
 		LastAppHash: h,
 	}
 }*/
+
+type staticChainLockProvider struct {
+	chainLock *types.CoreChainLock
+}
+
+func (p staticChainLockProvider) BestChainLock() *types.CoreChainLock {
+	return p.chainLock
+}
+
+// TestCreateProposalBlockChainLockProvider ensures chain locks from the provider
+// are proposed unless the application returned a newer one.
+func TestCreateProposalBlockChainLockProvider(t *testing.T) {
+	state, stateDB, _ := makeState(1, 1)
+	stateStore := sm.NewStore(stateDB)
+	proposerProTxHash := state.Validators.Validators[0].ProTxHash
+	commit := types.NewCommit(0, 0, types.BlockID{}, types.StateID{}, nil, nil, nil)
+
+	providedChainLock := types.NewMockChainLock(state.LastCoreChainLockedBlockHeight + 2)
+	blockExec := sm.NewBlockExecutor(
+		stateStore,
+		log.TestingLogger(),
+		nil,
+		nil,
+		mmock.Mempool{},
+		sm.EmptyEvidencePool{},
+		nil,
+		sm.BlockExecutorWithChainLockProvider(staticChainLockProvider{&providedChainLock}),
+	)
+
+	block, _ := blockExec.CreateProposalBlock(1, state, commit, proposerProTxHash, 0)
+	require.NotNil(t, block.CoreChainLock)
+	assert.EqualValues(t, providedChainLock.CoreBlockHeight, block.CoreChainLock.CoreBlockHeight)
+	assert.EqualValues(t, providedChainLock.CoreBlockHeight, block.Header.CoreChainLockedHeight)
+
+	// the chain lock returned by the application takes precedence
+	appChainLock := types.NewMockChainLock(state.LastCoreChainLockedBlockHeight + 1)
+	blockExec.NextCoreChainLock = &appChainLock
+	block, _ = blockExec.CreateProposalBlock(1, state, commit, proposerProTxHash, 0)
+	require.NotNil(t, block.CoreChainLock)
+	assert.EqualValues(t, appChainLock.CoreBlockHeight, block.CoreChainLock.CoreBlockHeight)
+
+	// chain locks that are not newer than the last chain locked height are not proposed
+	state.LastCoreChainLockedBlockHeight = providedChainLock.CoreBlockHeight
+	block, _ = blockExec.CreateProposalBlock(1, state, commit, proposerProTxHash, 0)
+	assert.Nil(t, block.CoreChainLock)
+	assert.EqualValues(t, state.LastCoreChainLockedBlockHeight, block.Header.CoreChainLockedHeight)
+}
@@ -82,6 +82,10 @@ func (idx *BlockerIndexer) Index(bh types.EventDataNewBlockHeader) error {
 // one or more block heights. In the case of height queries, i.e. block.height=H,
 // if the height is indexed, that height alone will be returned. An error and
 // nil slice is returned. Otherwise, a non-nil slice and nil error is returned.
+//
+// Queries with OR and NOT operators are broken into their conjunctions of
+// conditions, the matching heights of which are combined: OR is a union and
+// NOT the complement within all the indexed heights.
 func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
 	results := make([]int64, 0)
 	select {
@@ -91,11 +95,114 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 	default:
 	}
 
-	conditions, err := q.Conditions()
+	expr, err := q.Expression()
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse query conditions: %w", err)
 	}
 
+	filteredHeights, err := idx.matchExpression(ctx, expr)
+	if err != nil {
+		return nil, err
+	}
+
+	// fetch matching heights
+	results = make([]int64, 0, len(filteredHeights))
+	for _, hBz := range filteredHeights {
+		h := int64FromBytes(hBz)
+
+		ok, err := idx.Has(h)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			results = append(results, h)
+		}
+
+		select {
+		case <-ctx.Done():
+			break
+
+		default:
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
+
+	return results, nil
+}
+
+// matchExpression returns all matching heights that meet a given query
+// expression.
+func (idx *BlockerIndexer) matchExpression(ctx context.Context, expr *query.Expression) (map[string][]byte, error) {
+	if conditions, ok := expr.Conditions(); ok {
+		return idx.matchConditions(ctx, conditions)
+	}
+
+	switch expr.Kind {
+	case query.ExprAnd:
+		var filteredHeights map[string][]byte
+		for i, operand := range expr.Operands {
+			tmpHeights, err := idx.matchExpression(ctx, operand)
+			if err != nil {
+				return nil, err
+			}
+
+			if i == 0 {
+				filteredHeights = tmpHeights
+			} else {
+				for k := range filteredHeights {
+					if tmpHeights[k] == nil {
+						delete(filteredHeights, k)
+					}
+				}
+			}
+
+			// Ignore any remaining operands if there is no match so far.
+			if len(filteredHeights) == 0 {
+				break
+			}
+		}
+		return filteredHeights, nil
+
+	case query.ExprOr:
+		filteredHeights := make(map[string][]byte)
+		for _, operand := range expr.Operands {
+			tmpHeights, err := idx.matchExpression(ctx, operand)
+			if err != nil {
+				return nil, err
+			}
+
+			for k, v := range tmpHeights {
+				filteredHeights[k] = v
+			}
+		}
+		return filteredHeights, nil
+
+	case query.ExprNot:
+		tmpHeights, err := idx.matchExpression(ctx, expr.Operands[0])
+		if err != nil {
+			return nil, err
+		}
+
+		filteredHeights, err := idx.matchAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for k := range tmpHeights {
+			delete(filteredHeights, k)
+		}
+		return filteredHeights, nil
+
+	default:
+		return nil, fmt.Errorf("unknown kind of expression %v", expr.Kind)
+	}
+}
+
+// matchConditions returns all matching heights that meet all the given
+// conditions.
+func (idx *BlockerIndexer) matchConditions(ctx context.Context, conditions []query.Condition) (map[string][]byte, error) {
+	filteredHeights := make(map[string][]byte)
+
 	// If there is an exact height query, return the result immediately
 	// (if it exists).
 	height, ok := lookForHeight(conditions)
@@ -106,14 +213,14 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 		}
 
 		if ok {
-			return []int64{height}, nil
+			heightBz := int64ToBytes(height)
+			filteredHeights[string(heightBz)] = heightBz
 		}
 
-		return results, nil
+		return filteredHeights, nil
 	}
 
 	var heightsInitialized bool
-	filteredHeights := make(map[string][]byte)
 
 	// conditions to skip because they're handled before "everything else"
 	skipIndexes := make([]int, 0)
@@ -184,30 +291,37 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 		}
 	}
 
-	// fetch matching heights
-	results = make([]int64, 0, len(filteredHeights))
-	for _, hBz := range filteredHeights {
-		h := int64FromBytes(hBz)
+	return filteredHeights, nil
+}
 
-		ok, err := idx.Has(h)
-		if err != nil {
-			return nil, err
-		}
-		if ok {
-			results = append(results, h)
-		}
+// matchAll returns all the indexed heights.
+func (idx *BlockerIndexer) matchAll(ctx context.Context) (map[string][]byte, error) {
+	heights := make(map[string][]byte)
 
-		select {
-		case <-ctx.Done():
-			break
+	prefix, err := orderedcode.Append(nil, types.BlockHeightKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create prefix key: %w", err)
+	}
 
-		default:
+	it, err := dbm.IteratePrefix(idx.store, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create prefix iterator: %w", err)
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		heights[string(it.Value())] = it.Value()
+
+		if err := ctx.Err(); err != nil {
+			break
 		}
 	}
 
-	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
 
-	return results, nil
+	return heights, nil
 }
 
 // matchRange returns all matching block heights that match a given QueryRange
@@ -128,6 +128,30 @@ func TestBlockIndexer(t *testing.T) {
 			q:       query.MustParse("begin_event.proposer CONTAINS 'FCAA001'"),
 			results: []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
 		},
+		"block.height = 3 OR end_event.foo >= 8": {
+			q:       query.MustParse("block.height = 3 OR end_event.foo >= 8"),
+			results: []int64{1, 3, 8, 10},
+		},
+		"end_event.foo <= 4 OR end_event.foo <= 6": {
+			q:       query.MustParse("end_event.foo <= 4 OR end_event.foo <= 6"),
+			results: []int64{2, 4, 6},
+		},
+		"NOT end_event.foo EXISTS": {
+			q:       query.MustParse("NOT end_event.foo EXISTS"),
+			results: []int64{3, 5, 7, 9, 11},
+		},
+		"NOT begin_event.proposer = 'FCAA001'": {
+			q:       query.MustParse("NOT begin_event.proposer = 'FCAA001'"),
+			results: []int64{},
+		},
+		"(block.height < 4 OR block.height > 9) AND NOT end_event.foo EXISTS": {
+			q:       query.MustParse("(block.height < 4 OR block.height > 9) AND NOT end_event.foo EXISTS"),
+			results: []int64{3, 11},
+		},
+		"NOT (block.height > 2 AND end_event.foo <= 8)": {
+			q:       query.MustParse("NOT (block.height > 2 AND end_event.foo <= 8)"),
+			results: []int64{1, 2, 3, 5, 7, 9, 10, 11},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -0,0 +1,328 @@
+// Package psql implements the tx and block indexers on a PostgreSQL database,
+// so that the indexed events can be searched with SQL and joined with other
+// data. See schema.go for the tables the events are stored in.
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	_ "github.com/lib/pq" // registers the postgres driver
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/state/indexer"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/types"
+)
+
+const driverName = "postgres"
+
+var (
+	_ txindex.TxIndexer    = (*TxIndex)(nil)
+	_ indexer.BlockIndexer = (*BlockIndexer)(nil)
+)
+
+// EventSink stores the blocks and tx results of a chain, along with their
+// events, in a PostgreSQL database. Its TxIndexer and BlockIndexer share the
+// same database connection.
+type EventSink struct {
+	db      *sql.DB
+	chainID string
+}
+
+// NewEventSink connects to the PostgreSQL database at connStr, creates the
+// schema of the sink if needed, and returns the sink of the given chain.
+func NewEventSink(connStr, chainID string) (*EventSink, error) {
+	db, err := sql.Open(driverName, connStr)
+	if err != nil {
+		return nil, err
+	}
+	es, err := newEventSink(db, chainID)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return es, nil
+}
+
+func newEventSink(db *sql.DB, chainID string) (*EventSink, error) {
+	if _, err := db.Exec(schema); err != nil {
+		return nil, fmt.Errorf("failed to create schema: %w", err)
+	}
+	return &EventSink{db: db, chainID: chainID}, nil
+}
+
+// DB returns the database of the sink.
+func (es *EventSink) DB() *sql.DB {
+	return es.db
+}
+
+// Close closes the database of the sink.
+func (es *EventSink) Close() error {
+	return es.db.Close()
+}
+
+// TxIndexer returns the tx indexer of the sink.
+func (es *EventSink) TxIndexer() *TxIndex {
+	return &TxIndex{es: es}
+}
+
+// BlockIndexer returns the block indexer of the sink.
+func (es *EventSink) BlockIndexer() *BlockIndexer {
+	return &BlockIndexer{es: es}
+}
+
+//-----------------------------------------------------------------------------
+
+// TxIndex implements a tx indexer on an EventSink. The block of the txs must
+// be indexed first.
+type TxIndex struct {
+	es *EventSink
+}
+
+// AddBatch indexes a batch of transactions in a single database transaction.
+// Txs which are already indexed are skipped.
+func (txi *TxIndex) AddBatch(b *txindex.Batch) error {
+	return runInTransaction(txi.es.db, func(dbtx *sql.Tx) error {
+		for _, result := range b.Ops {
+			if err := txi.indexTx(dbtx, result); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Index indexes a single transaction. It is skipped if it is already indexed.
+func (txi *TxIndex) Index(result *abci.TxResult) error {
+	return runInTransaction(txi.es.db, func(dbtx *sql.Tx) error {
+		return txi.indexTx(dbtx, result)
+	})
+}
+
+func (txi *TxIndex) indexTx(dbtx *sql.Tx, result *abci.TxResult) error {
+	var blockID int64
+	err := dbtx.QueryRow(`SELECT rowid FROM blocks WHERE height = $1 AND chain_id = $2;`,
+		result.Height, txi.es.chainID).Scan(&blockID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("block %d of tx %d is not indexed", result.Height, result.Index)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to look up block %d: %w", result.Height, err)
+	}
+
+	rawBytes, err := proto.Marshal(result)
+	if err != nil {
+		return err
+	}
+	hash := fmt.Sprintf("%X", types.Tx(result.Tx).Hash())
+
+	txID, err := queryWithID(dbtx, `
+INSERT INTO tx_results (block_id, index, tx_hash, tx_result) VALUES ($1, $2, $3, $4)
+  ON CONFLICT DO NOTHING
+  RETURNING rowid;`, blockID, result.Index, hash, rawBytes)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil // already indexed
+	}
+	if err != nil {
+		return fmt.Errorf("failed to index tx %X: %w", hash, err)
+	}
+
+	events := append([]abci.Event{
+		makeIndexedEvent(types.TxHashKey, hash),
+		makeIndexedEvent(types.TxHeightKey, strconv.FormatInt(result.Height, 10)),
+	}, result.Result.Events...)
+	return insertEvents(dbtx, blockID, txID, events)
+}
+
+// Get returns the transaction with the given hash, or nil if it is not
+// indexed.
+func (txi *TxIndex) Get(hash []byte) (*abci.TxResult, error) {
+	if len(hash) == 0 {
+		return nil, txindex.ErrorEmptyHash
+	}
+
+	var rawBytes []byte
+	err := txi.es.db.QueryRow(`
+SELECT tx_result FROM tx_results
+  JOIN blocks ON blocks.rowid = tx_results.block_id
+  WHERE tx_hash = $1 AND chain_id = $2;`, fmt.Sprintf("%X", hash), txi.es.chainID).Scan(&rawBytes)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	txResult := new(abci.TxResult)
+	if err := proto.Unmarshal(rawBytes, txResult); err != nil {
+		return nil, fmt.Errorf("error reading TxResult: %v", err)
+	}
+	return txResult, nil
+}
+
+// Search returns the transactions matching the query, ordered by height and
+// index.
+func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	expr, err := q.Expression()
+	if err != nil {
+		return nil, fmt.Errorf("error during parsing conditions from query: %w", err)
+	}
+
+	sqlQuery, args := txSearchSQL(txi.es.chainID, expr)
+	rows, err := txi.es.db.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := make([]*abci.TxResult, 0)
+	for rows.Next() {
+		var rawBytes []byte
+		if err := rows.Scan(&rawBytes); err != nil {
+			return nil, err
+		}
+		txResult := new(abci.TxResult)
+		if err := proto.Unmarshal(rawBytes, txResult); err != nil {
+			return nil, fmt.Errorf("error reading TxResult: %v", err)
+		}
+		results = append(results, txResult)
+	}
+	return results, rows.Err()
+}
+
+//-----------------------------------------------------------------------------
+
+// BlockIndexer implements a block indexer on an EventSink.
+type BlockIndexer struct {
+	es *EventSink
+}
+
+// Has returns true if the given height has been indexed.
+func (idx *BlockIndexer) Has(height int64) (bool, error) {
+	var has bool
+	err := idx.es.db.QueryRow(`SELECT EXISTS (SELECT 1 FROM blocks WHERE height = $1 AND chain_id = $2);`,
+		height, idx.es.chainID).Scan(&has)
+	return has, err
+}
+
+// Index indexes the block and its BeginBlock and EndBlock events. It is
+// skipped if the block is already indexed.
+func (idx *BlockIndexer) Index(bh types.EventDataNewBlockHeader) error {
+	height := bh.Header.Height
+	return runInTransaction(idx.es.db, func(dbtx *sql.Tx) error {
+		blockID, err := queryWithID(dbtx, `
+INSERT INTO blocks (height, chain_id, created_at) VALUES ($1, $2, $3)
+  ON CONFLICT DO NOTHING
+  RETURNING rowid;`, height, idx.es.chainID, bh.Header.Time)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil // already indexed
+		}
+		if err != nil {
+			return fmt.Errorf("failed to index block %d: %w", height, err)
+		}
+
+		events := []abci.Event{makeIndexedEvent(types.BlockHeightKey, strconv.FormatInt(height, 10))}
+		events = append(events, bh.ResultBeginBlock.Events...)
+		events = append(events, bh.ResultEndBlock.Events...)
+		return insertEvents(dbtx, blockID, 0, events)
+	})
+}
+
+// Search returns the heights of the blocks whose BeginBlock and EndBlock
+// events match the query, in increasing order.
+func (idx *BlockIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
+	expr, err := q.Expression()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse query conditions: %w", err)
+	}
+
+	sqlQuery, args := blockSearchSQL(idx.es.chainID, expr)
+	rows, err := idx.es.db.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := make([]int64, 0)
+	for rows.Next() {
+		var height int64
+		if err := rows.Scan(&height); err != nil {
+			return nil, err
+		}
+		results = append(results, height)
+	}
+	return results, rows.Err()
+}
+
+//-----------------------------------------------------------------------------
+
+// runInTransaction runs f in a database transaction, which is committed if f
+// succeeds and rolled back otherwise.
+func runInTransaction(db *sql.DB, f func(dbtx *sql.Tx) error) error {
+	dbtx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := f(dbtx); err != nil {
+		_ = dbtx.Rollback()
+		return err
+	}
+	return dbtx.Commit()
+}
+
+// queryWithID runs an INSERT query returning the rowid of the inserted row.
+// It returns sql.ErrNoRows if no row was inserted.
+func queryWithID(dbtx *sql.Tx, query string, args ...interface{}) (int64, error) {
+	var id int64
+	err := dbtx.QueryRow(query, args...).Scan(&id)
+	return id, err
+}
+
+// insertEvents inserts the events of a block, or of a tx if txID is not 0,
+// along with their indexed attributes. Events with an empty type are skipped.
+func insertEvents(dbtx *sql.Tx, blockID, txID int64, events []abci.Event) error {
+	nullTxID := sql.NullInt64{Int64: txID, Valid: txID != 0}
+	for _, event := range events {
+		if event.Type == "" {
+			continue
+		}
+
+		eventID, err := queryWithID(dbtx, `INSERT INTO events (block_id, tx_id, type) VALUES ($1, $2, $3) RETURNING rowid;`,
+			blockID, nullTxID, event.Type)
+		if err != nil {
+			return fmt.Errorf("failed to index event %q: %w", event.Type, err)
+		}
+
+		for _, attr := range event.Attributes {
+			if !attr.Index || len(attr.Key) == 0 {
+				continue
+			}
+			compositeKey := event.Type + "." + string(attr.Key)
+			if _, err := dbtx.Exec(`
+INSERT INTO attributes (event_id, key, composite_key, value) VALUES ($1, $2, $3, $4);`,
+				eventID, string(attr.Key), compositeKey, string(attr.Value)); err != nil {
+				return fmt.Errorf("failed to index event attribute %q: %w", compositeKey, err)
+			}
+		}
+	}
+	return nil
+}
+
+// makeIndexedEvent returns the event holding the reserved composite key, like
+// "tx.hash", with the given value.
+func makeIndexedEvent(compositeKey, value string) abci.Event {
+	i := strings.Index(compositeKey, ".")
+	return abci.Event{
+		Type: compositeKey[:i],
+		Attributes: []abci.EventAttribute{
+			{Key: []byte(compositeKey[i+1:]), Value: []byte(value), Index: true},
+		},
+	}
+}
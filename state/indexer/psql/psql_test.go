@@ -0,0 +1,394 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/types"
+)
+
+const testChainID = "test-chain"
+
+// The tests run against the PostgreSQL server of the connection string of
+// testConnEnv, if set, or an embedded server otherwise. The embedded server
+// uses the binaries of the directory of testBinEnv, if set, or downloads them
+// on the first run.
+const (
+	testConnEnv = "TM_PSQL_TEST_CONN"
+	testBinEnv  = "PG_BIN"
+)
+
+var (
+	// testConnStr is the connection string of the PostgreSQL server, without
+	// a database name.
+	testConnStr string
+	testDBs     int32
+)
+
+// TestMain runs the tests against a PostgreSQL server. The tests are skipped
+// when the embedded server can't be started, e.g. when its binaries can't be
+// downloaded.
+func TestMain(m *testing.M) {
+	if connStr := os.Getenv(testConnEnv); connStr != "" {
+		testConnStr = connStr
+		os.Exit(m.Run())
+	}
+
+	code, err := runWithPostgres(m)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "skipping the psql tests, failed to run PostgreSQL: %v\n"+
+			"set %s to the connection string of a server, or %s to the directory of local PostgreSQL binaries\n",
+			err, testConnEnv, testBinEnv)
+		os.Exit(0)
+	}
+	os.Exit(code)
+}
+
+func runWithPostgres(m *testing.M) (int, error) {
+	dir, err := ioutil.TempDir("", "psql")
+	if err != nil {
+		return 0, err
+	}
+	defer os.RemoveAll(dir)
+
+	port, err := freePort()
+	if err != nil {
+		return 0, err
+	}
+	config := embeddedpostgres.DefaultConfig().
+		Port(port).
+		RuntimePath(filepath.Join(dir, "runtime")).
+		Logger(ioutil.Discard)
+	if binDir := os.Getenv(testBinEnv); binDir != "" {
+		config = config.BinariesPath(binDir)
+	}
+	pg := embeddedpostgres.NewDatabase(config)
+	if err := pg.Start(); err != nil {
+		return 0, err
+	}
+	defer pg.Stop() // nolint:errcheck // ignore for tests
+
+	testConnStr = fmt.Sprintf("host=localhost port=%d user=postgres password=postgres sslmode=disable", port)
+	return m.Run(), nil
+}
+
+func freePort() (uint32, error) {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+	return uint32(l.Addr().(*net.TCPAddr).Port), nil
+}
+
+// newTestEventSink returns an event sink on a new database of the test server,
+// dropped at the end of the test.
+func newTestEventSink(t *testing.T) *EventSink {
+	db, err := sql.Open(driverName, testConnStr+" dbname=postgres")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	dbName := fmt.Sprintf("test_%d", atomic.AddInt32(&testDBs, 1))
+	_, err = db.Exec("CREATE DATABASE " + dbName)
+	require.NoError(t, err)
+
+	es, err := NewEventSink(testConnStr+" dbname="+dbName, testChainID)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, es.Close())
+		_, err := db.Exec("DROP DATABASE " + dbName)
+		assert.NoError(t, err)
+	})
+	return es
+}
+
+func indexTestBlock(t *testing.T, es *EventSink, height int64, blockTime time.Time, events ...abci.Event) {
+	require.NoError(t, es.BlockIndexer().Index(types.EventDataNewBlockHeader{
+		Header:           types.Header{Height: height, Time: blockTime},
+		ResultBeginBlock: abci.ResponseBeginBlock{Events: events},
+	}))
+}
+
+func makeEvent(eventType string, attrs ...string) abci.Event {
+	event := abci.Event{Type: eventType}
+	for i := 0; i < len(attrs); i += 2 {
+		event.Attributes = append(event.Attributes, abci.EventAttribute{
+			Key: []byte(attrs[i]), Value: []byte(attrs[i+1]), Index: true,
+		})
+	}
+	return event
+}
+
+func TestBlockIndexerIndex(t *testing.T) {
+	es := newTestEventSink(t)
+	blockTime := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	require.NoError(t, es.BlockIndexer().Index(types.EventDataNewBlockHeader{
+		Header: types.Header{Height: 3, Time: blockTime},
+		ResultBeginBlock: abci.ResponseBeginBlock{
+			Events: []abci.Event{
+				makeEvent("begin_event", "proposer", "FCAA001"),
+				// events without a type are not indexed
+				makeEvent("", "foo", "bar"),
+			},
+		},
+		ResultEndBlock: abci.ResponseEndBlock{
+			Events: []abci.Event{
+				// attributes without the index flag are not indexed
+				{Type: "end_event", Attributes: []abci.EventAttribute{{Key: []byte("foo"), Value: []byte("100")}}},
+			},
+		},
+	}))
+	// a block which is already indexed is skipped
+	indexTestBlock(t, es, 3, blockTime, makeEvent("begin_event", "proposer", "FCAA002"))
+
+	rows, err := es.DB().Query(`SELECT height, type, key, value FROM block_events ORDER BY type, key;`)
+	require.NoError(t, err)
+	defer rows.Close()
+	var events []string
+	for rows.Next() {
+		var (
+			height         int64
+			eventType, key string
+			value          sql.NullString
+		)
+		require.NoError(t, rows.Scan(&height, &eventType, &key, &value))
+		assert.EqualValues(t, 3, height)
+		events = append(events, fmt.Sprintf("%s.%s=%s", eventType, key, value.String))
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, []string{"begin_event.proposer=FCAA001", "block.height=3"}, events)
+
+	has, err := es.BlockIndexer().Has(3)
+	require.NoError(t, err)
+	assert.True(t, has)
+	has, err = es.BlockIndexer().Has(4)
+	require.NoError(t, err)
+	assert.False(t, has)
+}
+
+func TestBlockIndexerSearch(t *testing.T) {
+	es := newTestEventSink(t)
+	blockTime := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	for height := int64(1); height <= 5; height++ {
+		indexTestBlock(t, es, height, blockTime,
+			makeEvent("begin_event", "foo", fmt.Sprint(height*100), "proposer", fmt.Sprintf("FCAA00%d", height%2)))
+	}
+
+	testCases := []struct {
+		q       string
+		heights []int64
+	}{
+		{"block.height = 3", []int64{3}},
+		{"block.height > 2 AND begin_event.foo <= 400", []int64{3, 4}},
+		{"begin_event.proposer = 'FCAA001'", []int64{1, 3, 5}},
+		{"begin_event.proposer = 'FCAA001' OR block.height = 2", []int64{1, 2, 3, 5}},
+		{"NOT begin_event.proposer CONTAINS '001'", []int64{2, 4}},
+		{"begin_event.bar EXISTS", []int64{}},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.q, func(t *testing.T) {
+			heights, err := es.BlockIndexer().Search(context.Background(), query.MustParse(tc.q))
+			require.NoError(t, err)
+			assert.Equal(t, tc.heights, heights)
+		})
+	}
+}
+
+func TestTxIndex(t *testing.T) {
+	es := newTestEventSink(t)
+	txi := es.TxIndexer()
+
+	txResult := &abci.TxResult{
+		Height: 3,
+		Index:  0,
+		Tx:     types.Tx("HELLO WORLD"),
+		Result: abci.ResponseDeliverTx{
+			Code: abci.CodeTypeOK,
+			Events: []abci.Event{
+				{Type: "account", Attributes: []abci.EventAttribute{
+					{Key: []byte("number"), Value: []byte("1"), Index: true},
+					{Key: []byte("owner"), Value: []byte("Ivan"), Index: false},
+				}},
+			},
+		},
+	}
+
+	// the txs of blocks which are not indexed are rejected
+	require.Error(t, txi.Index(txResult))
+
+	indexTestBlock(t, es, 3, time.Now())
+	batch := txindex.NewBatch(1)
+	require.NoError(t, batch.Add(txResult))
+	require.NoError(t, txi.AddBatch(batch))
+	// a tx which is already indexed is skipped
+	require.NoError(t, txi.Index(txResult))
+
+	loaded, err := txi.Get(types.Tx(txResult.Tx).Hash())
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(txResult, loaded))
+
+	loaded, err = txi.Get([]byte{1, 2})
+	require.NoError(t, err)
+	assert.Nil(t, loaded)
+
+	_, err = txi.Get(nil)
+	assert.Equal(t, txindex.ErrorEmptyHash, err)
+
+	for _, q := range []string{
+		"tx.height = 3 AND account.number = 1",
+		fmt.Sprintf("tx.hash = '%X'", types.Tx(txResult.Tx).Hash()),
+		"account.number >= 1",
+	} {
+		results, err := txi.Search(context.Background(), query.MustParse(q))
+		require.NoError(t, err, q)
+		require.Len(t, results, 1, q)
+		assert.True(t, proto.Equal(txResult, results[0]), q)
+	}
+
+	for _, q := range []string{
+		"tx.height = 4",
+		"account.number = 2",
+		// the attributes without the index flag are not indexed
+		"account.owner = 'Ivan'",
+	} {
+		results, err := txi.Search(context.Background(), query.MustParse(q))
+		require.NoError(t, err, q)
+		assert.Empty(t, results, q)
+	}
+}
+
+func TestTxIndexSearchCastValues(t *testing.T) {
+	es := newTestEventSink(t)
+	txi := es.TxIndexer()
+	indexTestBlock(t, es, 1, time.Now())
+
+	values := []string{"2021-01-02", "2021-03-04T05:06:07Z", "2021-13-45", "1.5", "1.5.6", "abc"}
+	batch := txindex.NewBatch(int64(len(values)))
+	for i, value := range values {
+		require.NoError(t, batch.Add(&abci.TxResult{
+			Height: 1,
+			Index:  uint32(i),
+			Tx:     types.Tx(fmt.Sprintf("tx%d", i)),
+			Result: abci.ResponseDeliverTx{Events: []abci.Event{makeEvent("transfer", "value", value)}},
+		}))
+	}
+	require.NoError(t, txi.AddBatch(batch))
+
+	// the values which can't be cast, even if they look like a date or a
+	// number, don't match and don't make the query fail
+	testCases := []struct {
+		q       string
+		indexes []uint32
+	}{
+		{"transfer.value < DATE 2021-02-01", []uint32{0}},
+		{"transfer.value > TIME 2021-01-01T00:00:00Z", []uint32{0, 1}},
+		{"transfer.value > 1", []uint32{3}},
+		{"transfer.value = 'abc'", []uint32{5}},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.q, func(t *testing.T) {
+			results, err := txi.Search(context.Background(), query.MustParse(tc.q))
+			require.NoError(t, err)
+			indexes := make([]uint32, 0, len(results))
+			for _, result := range results {
+				indexes = append(indexes, result.Index)
+			}
+			assert.Equal(t, tc.indexes, indexes)
+		})
+	}
+}
+
+func TestSearchSQL(t *testing.T) {
+	date := time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		q        string
+		block    bool
+		where    []string
+		args     []interface{}
+		notWhere string
+	}{
+		{
+			q:     "tx.hash = 'abcd'",
+			where: []string{"chain_id = $1", "tx_hash = $2"},
+			args:  []interface{}{testChainID, "ABCD"},
+		},
+		{
+			q:     "tx.height >= 3 AND tx.height < 5",
+			where: []string{"height >= $2", "height < $3"},
+			args:  []interface{}{testChainID, int64(3), int64(5)},
+		},
+		{
+			q:     "account.owner CONTAINS 'Iv' AND account.id EXISTS",
+			where: []string{"composite_key = $2", "strpos(value, $3) > 0", "composite_key = $4", "tx_id IS NOT NULL"},
+			args:  []interface{}{testChainID, "account.owner", "Iv", "account.id"},
+		},
+		{
+			q:     "transfer.amount > 1.5 AND transfer.date < DATE 2021-01-02",
+			where: []string{numericValue + " > $3", timeValue + " < $5"},
+			args:  []interface{}{testChainID, "transfer.amount", 1.5, "transfer.date", date},
+		},
+		{
+			q:        "block.height = 5 AND begin_event.proposer = 'FCAA001'",
+			block:    true,
+			where:    []string{"height = $2", "composite_key = $3", "tx_id IS NULL", "value = $4"},
+			args:     []interface{}{testChainID, int64(5), "begin_event.proposer", "FCAA001"},
+			notWhere: "tx_id IS NOT NULL",
+		},
+		{
+			q:     "tx.height = 3 OR account.owner = 'Ivan' AND NOT account.id EXISTS",
+			where: []string{"(height = $2 OR (tx_results.rowid IN (", "AND NOT (tx_results.rowid IN ("},
+			args:  []interface{}{testChainID, int64(3), "account.owner", "Ivan", "account.id"},
+		},
+		{
+			q:     "NOT (block.height < 3 OR block.height > 5)",
+			block: true,
+			where: []string{"AND NOT ((height < $2 OR height > $3))"},
+			args:  []interface{}{testChainID, int64(3), int64(5)},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.q, func(t *testing.T) {
+			expr, err := query.MustParse(tc.q).Expression()
+			require.NoError(t, err)
+
+			var (
+				sqlQuery string
+				args     []interface{}
+			)
+			if tc.block {
+				sqlQuery, args = blockSearchSQL(testChainID, expr)
+			} else {
+				sqlQuery, args = txSearchSQL(testChainID, expr)
+			}
+			for _, where := range tc.where {
+				assert.Contains(t, sqlQuery, where)
+			}
+			if tc.notWhere != "" {
+				assert.NotContains(t, sqlQuery, tc.notWhere)
+			}
+			assert.Equal(t, tc.args, args)
+		})
+	}
+}
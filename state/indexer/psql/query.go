@@ -0,0 +1,143 @@
+package psql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/types"
+)
+
+// The attribute values are stored as strings, so they are cast for the
+// conditions with a number or time operand. Values which can't be cast are
+// NULL and never match. The regular expressions skip most of them cheaply,
+// and timestamptz_or_null (see schema.go) the invalid dates, like 2021-13-45.
+const (
+	numericValue = `(CASE WHEN value ~ '^-?[0-9]+(\.[0-9]+)?$' THEN value::numeric END)`
+	timeValue    = `(CASE WHEN value ~ '^[0-9]{4}-[0-9]{2}-[0-9]{2}' THEN timestamptz_or_null(value) END)`
+)
+
+var sqlOperators = map[query.Operator]string{
+	query.OpLessEqual:    "<=",
+	query.OpGreaterEqual: ">=",
+	query.OpLess:         "<",
+	query.OpGreater:      ">",
+	query.OpEqual:        "=",
+}
+
+// queryArgs holds the arguments of a query being built.
+type queryArgs []interface{}
+
+// add appends an argument and returns its placeholder.
+func (args *queryArgs) add(arg interface{}) string {
+	*args = append(*args, arg)
+	return "$" + strconv.Itoa(len(*args))
+}
+
+// txSearchSQL returns the query selecting the tx results of the chain which
+// match the query expression, and its arguments.
+func txSearchSQL(chainID string, expr *query.Expression) (string, []interface{}) {
+	var args queryArgs
+	where := "chain_id = " + args.add(chainID) + " AND " + expressionSQL(expr, func(c query.Condition) string {
+		switch {
+		case c.CompositeKey == types.TxHashKey && c.Op == query.OpEqual:
+			return "tx_hash = " + args.add(strings.ToUpper(fmt.Sprint(c.Operand)))
+		case c.CompositeKey == types.TxHeightKey && isHeightCondition(c):
+			return "height " + sqlOperators[c.Op] + " " + args.add(c.Operand)
+		default:
+			return "tx_results.rowid IN (" + attributeSQL("tx_id", c, &args) + ")"
+		}
+	})
+
+	return `SELECT tx_result FROM tx_results
+  JOIN blocks ON blocks.rowid = tx_results.block_id
+  WHERE ` + where + `
+  ORDER BY height, index;`, args
+}
+
+// blockSearchSQL returns the query selecting the heights of the blocks of the
+// chain which match the query expression, and its arguments.
+func blockSearchSQL(chainID string, expr *query.Expression) (string, []interface{}) {
+	var args queryArgs
+	where := "chain_id = " + args.add(chainID) + " AND " + expressionSQL(expr, func(c query.Condition) string {
+		if c.CompositeKey == types.BlockHeightKey && isHeightCondition(c) {
+			return "height " + sqlOperators[c.Op] + " " + args.add(c.Operand)
+		}
+		return "rowid IN (" + attributeSQL("block_id", c, &args) + ")"
+	})
+
+	return `SELECT height FROM blocks
+  WHERE ` + where + `
+  ORDER BY height;`, args
+}
+
+// expressionSQL returns the condition of a WHERE clause matching the query
+// expression, given the one of each of its conditions. The conditions are
+// built in the order of the query, so that their arguments are too.
+func expressionSQL(expr *query.Expression, conditionSQL func(query.Condition) string) string {
+	switch expr.Kind {
+	case query.ExprCondition:
+		return conditionSQL(expr.Condition)
+	case query.ExprNot:
+		return "NOT (" + expressionSQL(expr.Operands[0], conditionSQL) + ")"
+	}
+
+	separator := " AND "
+	if expr.Kind == query.ExprOr {
+		separator = " OR "
+	}
+	operands := make([]string, 0, len(expr.Operands))
+	for _, operand := range expr.Operands {
+		operands = append(operands, expressionSQL(operand, conditionSQL))
+	}
+	return "(" + strings.Join(operands, separator) + ")"
+}
+
+// isHeightCondition returns true if the condition compares a height with a
+// number, which can be done on the height column of the blocks.
+func isHeightCondition(c query.Condition) bool {
+	_, isInt := c.Operand.(int64)
+	_, isOp := sqlOperators[c.Op]
+	return isInt && isOp
+}
+
+// attributeSQL returns the query selecting the ids in idColumn of the events
+// with an attribute matching the condition. The events of the txs are
+// excluded when selecting block ids.
+func attributeSQL(idColumn string, c query.Condition, args *queryArgs) string {
+	where := []string{"composite_key = " + args.add(c.CompositeKey)}
+	if idColumn == "block_id" {
+		where = append(where, "tx_id IS NULL")
+	} else {
+		where = append(where, idColumn+" IS NOT NULL")
+	}
+	if cond := valueSQL(c, args); cond != "" {
+		where = append(where, cond)
+	}
+
+	return "SELECT " + idColumn + " FROM events JOIN attributes ON attributes.event_id = events.rowid WHERE " +
+		strings.Join(where, " AND ")
+}
+
+// valueSQL returns the condition on the attribute value, or an empty string
+// if the condition only needs the attribute to exist.
+func valueSQL(c query.Condition, args *queryArgs) string {
+	switch c.Op {
+	case query.OpExists:
+		return ""
+	case query.OpContains:
+		return "strpos(value, " + args.add(fmt.Sprint(c.Operand)) + ") > 0"
+	}
+
+	op := sqlOperators[c.Op]
+	switch operand := c.Operand.(type) {
+	case int64, float64:
+		return numericValue + " " + op + " " + args.add(operand)
+	case time.Time:
+		return timeValue + " " + op + " " + args.add(operand)
+	default:
+		return "value " + op + " " + args.add(fmt.Sprint(operand))
+	}
+}
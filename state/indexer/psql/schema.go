@@ -0,0 +1,80 @@
+package psql
+
+// schema creates the tables of the event sink if they do not exist yet.
+//
+// Every block is a row of blocks, and every tx result a row of tx_results
+// pointing to its block. The events of a block have a NULL tx_id, while the
+// events of a tx point to both the tx and its block. Only the attributes with
+// the index flag set are stored, under their composite key "type.key". The
+// reserved block.height, tx.hash and tx.height keys are stored as the
+// attributes of a "block" or "tx" event, so that they can be queried like any
+// other attribute.
+//
+// The block_events and tx_events views join the events of the blocks and txs
+// with their attributes, for analytics.
+//
+// The timestamptz_or_null function casts the attribute values compared with a
+// time, so that a value which looks like a date but isn't one doesn't make the
+// whole query fail.
+const schema = `
+CREATE TABLE IF NOT EXISTS blocks (
+  rowid      BIGSERIAL PRIMARY KEY,
+  height     BIGINT NOT NULL,
+  chain_id   VARCHAR NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  UNIQUE (height, chain_id)
+);
+
+CREATE TABLE IF NOT EXISTS tx_results (
+  rowid     BIGSERIAL PRIMARY KEY,
+  block_id  BIGINT NOT NULL REFERENCES blocks(rowid),
+  index     INTEGER NOT NULL,
+  tx_hash   VARCHAR NOT NULL,
+  tx_result BYTEA NOT NULL,
+  UNIQUE (block_id, index)
+);
+
+CREATE INDEX IF NOT EXISTS idx_tx_results_tx_hash ON tx_results(tx_hash);
+
+CREATE TABLE IF NOT EXISTS events (
+  rowid    BIGSERIAL PRIMARY KEY,
+  block_id BIGINT NOT NULL REFERENCES blocks(rowid),
+  tx_id    BIGINT NULL REFERENCES tx_results(rowid),
+  type     VARCHAR NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_events_block_id ON events(block_id);
+CREATE INDEX IF NOT EXISTS idx_events_tx_id ON events(tx_id);
+
+CREATE TABLE IF NOT EXISTS attributes (
+  event_id      BIGINT NOT NULL REFERENCES events(rowid),
+  key           VARCHAR NOT NULL,
+  composite_key VARCHAR NOT NULL,
+  value         VARCHAR NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_attributes_event_id ON attributes(event_id);
+CREATE INDEX IF NOT EXISTS idx_attributes_composite_key_value ON attributes(composite_key, value);
+
+CREATE OR REPLACE FUNCTION timestamptz_or_null(value VARCHAR) RETURNS TIMESTAMPTZ AS $$
+BEGIN
+  RETURN value::timestamptz;
+EXCEPTION WHEN others THEN
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql STABLE;
+
+CREATE OR REPLACE VIEW block_events AS
+  SELECT blocks.rowid AS block_id, height, chain_id, type, key, composite_key, value
+  FROM blocks
+  JOIN events ON events.block_id = blocks.rowid AND events.tx_id IS NULL
+  JOIN attributes ON attributes.event_id = events.rowid;
+
+CREATE OR REPLACE VIEW tx_events AS
+  SELECT blocks.rowid AS block_id, height, chain_id, tx_results.rowid AS tx_id,
+    index, tx_hash, type, key, composite_key, value
+  FROM blocks
+  JOIN tx_results ON tx_results.block_id = blocks.rowid
+  JOIN events ON events.tx_id = tx_results.rowid
+  JOIN attributes ON attributes.event_id = events.rowid;
+`
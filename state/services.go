@@ -54,7 +54,8 @@ type EmptyEvidencePool struct{}
 func (EmptyEvidencePool) PendingEvidence(maxBytes int64) (ev []types.Evidence, size int64) {
 	return nil, 0
 }
-func (EmptyEvidencePool) AddEvidence(types.Evidence) error                { return nil }
-func (EmptyEvidencePool) Update(State, types.EvidenceList)                {}
-func (EmptyEvidencePool) CheckEvidence(evList types.EvidenceList) error   { return nil }
-func (EmptyEvidencePool) ReportConflictingVotes(voteA, voteB *types.Vote) {}
+func (EmptyEvidencePool) AddEvidence(types.Evidence) error                     { return nil }
+func (EmptyEvidencePool) Update(State, types.EvidenceList)                     {}
+func (EmptyEvidencePool) CheckEvidence(evList types.EvidenceList) error        { return nil }
+func (EmptyEvidencePool) ReportConflictingVotes(voteA, voteB *types.Vote)      {}
+func (EmptyEvidencePool) ReportConflictingStateVotes(voteA, voteB *types.Vote) {}
@@ -0,0 +1,89 @@
+package txindex
+
+import (
+	"errors"
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+// BlockStore is the part of the block store the committed blocks are loaded
+// from.
+type BlockStore interface {
+	Base() int64
+	Height() int64
+	LoadBlock(height int64) *types.Block
+}
+
+// ABCIResponsesStore is the part of the state store the ABCI responses of the
+// committed blocks are loaded from.
+type ABCIResponsesStore interface {
+	LoadABCIResponses(height int64) (*tmstate.ABCIResponses, error)
+}
+
+// BlockEventsLoader loads the events of the committed blocks and of their txs
+// from the stores, the same as the event bus publishes them, to index the
+// blocks again or the ones a sink missed.
+type BlockEventsLoader struct {
+	blockStore         BlockStore
+	abciResponsesStore ABCIResponsesStore
+}
+
+// NewBlockEventsLoader returns a new BlockEventsLoader loading the blocks and
+// their ABCI responses from the given stores.
+func NewBlockEventsLoader(blockStore BlockStore, abciResponsesStore ABCIResponsesStore) *BlockEventsLoader {
+	return &BlockEventsLoader{blockStore: blockStore, abciResponsesStore: abciResponsesStore}
+}
+
+// Base returns the height of the first block which wasn't pruned.
+func (l *BlockEventsLoader) Base() int64 {
+	return l.blockStore.Base()
+}
+
+// Height returns the height of the last committed block.
+func (l *BlockEventsLoader) Height() int64 {
+	return l.blockStore.Height()
+}
+
+// Load returns the events of the block at the given height, along with the
+// results of its txs.
+func (l *BlockEventsLoader) Load(height int64) (types.EventDataNewBlockHeader, *Batch, error) {
+	block := l.blockStore.LoadBlock(height)
+	if block == nil {
+		return types.EventDataNewBlockHeader{}, nil, errors.New("block not found")
+	}
+	abciResponses, err := l.abciResponsesStore.LoadABCIResponses(height)
+	if err != nil {
+		return types.EventDataNewBlockHeader{}, nil, err
+	}
+	if len(abciResponses.DeliverTxs) != len(block.Txs) {
+		return types.EventDataNewBlockHeader{}, nil, fmt.Errorf("block has %d txs but %d DeliverTx responses",
+			len(block.Txs), len(abciResponses.DeliverTxs))
+	}
+
+	header := types.EventDataNewBlockHeader{
+		Header: block.Header,
+		NumTxs: int64(len(block.Txs)),
+	}
+	if abciResponses.BeginBlock != nil {
+		header.ResultBeginBlock = *abciResponses.BeginBlock
+	}
+	if abciResponses.EndBlock != nil {
+		header.ResultEndBlock = *abciResponses.EndBlock
+	}
+
+	batch := NewBatch(header.NumTxs)
+	for i, tx := range block.Txs {
+		if err := batch.Add(&abci.TxResult{
+			Height: height,
+			Index:  uint32(i),
+			Tx:     tx,
+			Result: *abciResponses.DeliverTxs[i],
+		}); err != nil {
+			return types.EventDataNewBlockHeader{}, nil, err
+		}
+	}
+	return header, batch, nil
+}
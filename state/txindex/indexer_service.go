@@ -2,7 +2,14 @@ package txindex
 
 import (
 	"context"
+	"encoding/binary"
+	"io"
+	"sync"
+	"time"
 
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/libs/service"
 	"github.com/tendermint/tendermint/state/indexer"
 	"github.com/tendermint/tendermint/types"
@@ -12,27 +19,109 @@ import (
 
 const (
 	subscriber = "IndexerService"
+
+	defaultRetryBackoffMin = time.Second
+	defaultRetryBackoffMax = time.Minute
+
+	// defaultMaxQueuedBlocks is the number of blocks a sink keeps in memory
+	// while it is behind. The blocks received when its queue is full are
+	// loaded from the stores later, once the sink caught up.
+	defaultMaxQueuedBlocks = 100
 )
 
+// Sink is a named pair of transaction and block indexers, which the
+// IndexerService indexes every block into.
+type Sink struct {
+	Name         string
+	TxIndexer    TxIndexer
+	BlockIndexer indexer.BlockIndexer
+	// Closer closes the storage of the indexers, if it has to be
+	Closer io.Closer
+}
+
 // IndexerService connects event bus, transaction and block indexers together in
 // order to index transactions and blocks coming from the event bus.
+//
+// Every sink indexes the blocks in its own routine, from its own bounded
+// queue, so that a failing sink neither blocks the event bus nor the other
+// sinks. A sink which fails to index a block retries it with an exponential
+// backoff until it succeeds, and the blocks received meanwhile wait in its
+// queue. With catch up enabled (see WithCatchUp), every sink saves the height
+// of the last block it indexed, and indexes the blocks it missed, because its
+// queue was full or the node was stopped, from the stores.
 type IndexerService struct {
 	service.BaseService
 
-	txIdxr    TxIndexer
-	blockIdxr indexer.BlockIndexer
-	eventBus  *types.EventBus
+	sinks    []*sinkQueue
+	eventBus *types.EventBus
+	metrics  *Metrics
+
+	retryBackoffMin time.Duration
+	retryBackoffMax time.Duration
+	maxQueuedBlocks int
+
+	loader     *BlockEventsLoader
+	progressDB dbm.DB
+}
+
+// IndexerServiceOption sets an optional parameter on the IndexerService.
+type IndexerServiceOption func(*IndexerService)
+
+// WithMetrics sets the metrics.
+func WithMetrics(metrics *Metrics) IndexerServiceOption {
+	return func(is *IndexerService) { is.metrics = metrics }
+}
+
+// WithRetryBackoff sets the delays between the attempts of a sink to index a
+// block, which start at min and double up to max.
+func WithRetryBackoff(min, max time.Duration) IndexerServiceOption {
+	return func(is *IndexerService) {
+		is.retryBackoffMin = min
+		is.retryBackoffMax = max
+	}
+}
+
+// WithMaxQueuedBlocks sets the number of blocks a sink keeps in memory while
+// it is behind.
+func WithMaxQueuedBlocks(max int) IndexerServiceOption {
+	return func(is *IndexerService) { is.maxQueuedBlocks = max }
+}
+
+// WithCatchUp makes the sinks save the height of the last block they indexed
+// in db, and index the blocks they missed with loader. Without it, the blocks
+// received when the queue of a sink is full are lost.
+func WithCatchUp(loader *BlockEventsLoader, db dbm.DB) IndexerServiceOption {
+	return func(is *IndexerService) {
+		is.loader = loader
+		is.progressDB = db
+	}
 }
 
 // NewIndexerService returns a new service instance.
 func NewIndexerService(
-	txIdxr TxIndexer,
-	blockIdxr indexer.BlockIndexer,
+	sinks []Sink,
 	eventBus *types.EventBus,
+	options ...IndexerServiceOption,
 ) *IndexerService {
 
-	is := &IndexerService{txIdxr: txIdxr, blockIdxr: blockIdxr, eventBus: eventBus}
+	is := &IndexerService{
+		eventBus:        eventBus,
+		metrics:         NopMetrics(),
+		retryBackoffMin: defaultRetryBackoffMin,
+		retryBackoffMax: defaultRetryBackoffMax,
+		maxQueuedBlocks: defaultMaxQueuedBlocks,
+	}
 	is.BaseService = *service.NewBaseService(nil, "IndexerService", is)
+	for _, option := range options {
+		option(is)
+	}
+	for _, sink := range sinks {
+		is.sinks = append(is.sinks, &sinkQueue{
+			Sink:      sink,
+			maxBlocks: is.maxQueuedBlocks,
+			signal:    make(chan struct{}, 1),
+		})
+	}
 	return is
 }
 
@@ -55,6 +144,21 @@ func (is *IndexerService) OnStart() error {
 		return err
 	}
 
+	if is.loader != nil {
+		height := is.loader.Height()
+		for _, sink := range is.sinks {
+			indexed, err := loadIndexedHeight(is.progressDB, sink.Name)
+			if err != nil {
+				return err
+			}
+			// catch up with the blocks committed while the node was stopped
+			sink.setProgress(indexed, height)
+		}
+	}
+	for _, sink := range is.sinks {
+		go is.indexRoutine(sink)
+	}
+
 	go func() {
 		for {
 			msg := <-blockHeadersSub.Out()
@@ -76,16 +180,8 @@ func (is *IndexerService) OnStart() error {
 				}
 			}
 
-			if err := is.blockIdxr.Index(eventDataHeader); err != nil {
-				is.Logger.Error("failed to index block", "height", height, "err", err)
-			} else {
-				is.Logger.Info("indexed block", "height", height)
-			}
-
-			if err = is.txIdxr.AddBatch(batch); err != nil {
-				is.Logger.Error("failed to index block txs", "height", height, "err", err)
-			} else {
-				is.Logger.Debug("indexed block txs", "height", height, "num_txs", eventDataHeader.NumTxs)
+			for _, sink := range is.sinks {
+				is.metrics.Lag.With("sink", sink.Name).Set(float64(sink.push(eventDataHeader, batch)))
 			}
 		}
 	}()
@@ -98,3 +194,255 @@ func (is *IndexerService) OnStop() {
 		_ = is.eventBus.UnsubscribeAll(context.Background(), subscriber)
 	}
 }
+
+// indexRoutine indexes the blocks into the sink, in order, until the service
+// stops.
+func (is *IndexerService) indexRoutine(sink *sinkQueue) {
+	logger := is.Logger.With("sink", sink.Name)
+	for {
+		block, missed, ok := sink.next()
+		if !ok {
+			select {
+			case <-sink.signal:
+				continue
+			case <-is.Quit():
+				return
+			}
+		}
+
+		if missed != 0 {
+			if is.loader == nil {
+				logger.Error("sink missed blocks, reindex them with the reindex-event command",
+					"from", missed, "to", sink.skipMissed())
+				continue
+			}
+			if base := is.loader.Base(); missed < base {
+				logger.Error("sink missed pruned blocks, skipping them", "from", missed, "to", base-1)
+				sink.indexed(base - 1)
+				continue
+			}
+			header, batch, err := is.loader.Load(missed)
+			if err != nil {
+				// the ABCI responses of the last block are missing until it is
+				// replayed, after a crash
+				logger.Error("failed to load missed block", "height", missed, "err", err)
+				select {
+				case <-sink.signal:
+				case <-time.After(is.retryBackoffMax):
+				case <-is.Quit():
+					return
+				}
+				continue
+			}
+			block = queuedBlock{header: header, batch: batch}
+		}
+
+		if !is.indexWithRetries(logger, sink, block) {
+			return
+		}
+		height := block.header.Header.Height
+		is.metrics.Lag.With("sink", sink.Name).Set(float64(sink.indexed(height)))
+		if is.progressDB != nil {
+			if err := saveIndexedHeight(is.progressDB, sink.Name, height); err != nil {
+				logger.Error("failed to save indexed height", "height", height, "err", err)
+			}
+		}
+	}
+}
+
+// indexWithRetries indexes the block into the sink, retrying with an
+// exponential backoff until it succeeds. It returns false if the service
+// stopped before.
+func (is *IndexerService) indexWithRetries(logger log.Logger, sink *sinkQueue, block queuedBlock) bool {
+	backoff := is.retryBackoffMin
+	for {
+		if sink.index(logger, block) {
+			return true
+		}
+		is.metrics.Failures.With("sink", sink.Name).Add(1)
+
+		logger.Info("retrying to index block", "height", block.header.Header.Height, "in", backoff)
+		select {
+		case <-time.After(backoff):
+		case <-is.Quit():
+			return false
+		}
+		if backoff *= 2; backoff > is.retryBackoffMax {
+			backoff = is.retryBackoffMax
+		}
+	}
+}
+
+//-----------------------------------------------------------------------------
+
+// queuedBlock is a block waiting to be indexed, along with its txs.
+type queuedBlock struct {
+	header types.EventDataNewBlockHeader
+	batch  *Batch
+}
+
+func (qb queuedBlock) height() int64 {
+	return qb.header.Header.Height
+}
+
+// sinkQueue is a sink along with the blocks waiting to be indexed into it.
+//
+// The queue holds up to maxBlocks consecutive blocks. The blocks pushed when
+// it is full are missed, and the sink indexes them from the stores once it
+// indexed the queued ones.
+type sinkQueue struct {
+	Sink
+
+	maxBlocks int
+	signal    chan struct{}
+
+	mtx           sync.Mutex
+	blocks        []queuedBlock
+	indexedHeight int64 // height of the last indexed block, 0 if none yet
+	latestHeight  int64 // height of the last block pushed, even if missed
+}
+
+// setProgress sets the height of the last block indexed into the sink and of
+// the last committed block.
+func (sq *sinkQueue) setProgress(indexed, latest int64) {
+	sq.mtx.Lock()
+	sq.indexedHeight, sq.latestHeight = indexed, latest
+	sq.mtx.Unlock()
+	sq.notify()
+}
+
+// push adds a block to the queue, unless it is full, and returns the number
+// of blocks waiting to be indexed.
+func (sq *sinkQueue) push(header types.EventDataNewBlockHeader, batch *Batch) int64 {
+	sq.mtx.Lock()
+	if len(sq.blocks) < sq.maxBlocks {
+		sq.blocks = append(sq.blocks, queuedBlock{header: header, batch: batch})
+	}
+	if header.Header.Height > sq.latestHeight {
+		sq.latestHeight = header.Header.Height
+	}
+	lag := sq.lag()
+	sq.mtx.Unlock()
+
+	sq.notify()
+	return lag
+}
+
+func (sq *sinkQueue) notify() {
+	select {
+	case sq.signal <- struct{}{}:
+	default:
+	}
+}
+
+// next returns the next block to index: the first block of the queue if it
+// follows the last indexed one, or else the height of the block the sink
+// missed, to load from the stores. It returns false if there is no block to
+// index.
+func (sq *sinkQueue) next() (queuedBlock, int64, bool) {
+	sq.mtx.Lock()
+	defer sq.mtx.Unlock()
+
+	if sq.indexedHeight == 0 {
+		// nothing was indexed yet, the sink starts from the first block it gets
+		if len(sq.blocks) == 0 {
+			return queuedBlock{}, 0, false
+		}
+		return sq.blocks[0], 0, true
+	}
+
+	// drop the blocks indexed from the stores meanwhile
+	for len(sq.blocks) > 0 && sq.blocks[0].height() <= sq.indexedHeight {
+		sq.popFront()
+	}
+	switch {
+	case len(sq.blocks) > 0 && sq.blocks[0].height() == sq.indexedHeight+1:
+		return sq.blocks[0], 0, true
+	case sq.indexedHeight < sq.latestHeight:
+		return queuedBlock{}, sq.indexedHeight + 1, true
+	default:
+		return queuedBlock{}, 0, false
+	}
+}
+
+// indexed records that the block at the given height was indexed, removes it
+// from the queue and returns the number of blocks waiting to be indexed.
+func (sq *sinkQueue) indexed(height int64) int64 {
+	sq.mtx.Lock()
+	defer sq.mtx.Unlock()
+	sq.indexedHeight = height
+	if len(sq.blocks) > 0 && sq.blocks[0].height() == height {
+		sq.popFront()
+	}
+	return sq.lag()
+}
+
+// skipMissed skips the blocks the sink missed up to the first queued block,
+// or the last pushed one, and returns the height of the last skipped block.
+func (sq *sinkQueue) skipMissed() int64 {
+	sq.mtx.Lock()
+	defer sq.mtx.Unlock()
+	if len(sq.blocks) > 0 {
+		sq.indexedHeight = sq.blocks[0].height() - 1
+	} else {
+		sq.indexedHeight = sq.latestHeight
+	}
+	return sq.indexedHeight
+}
+
+func (sq *sinkQueue) popFront() {
+	sq.blocks[0] = queuedBlock{}
+	sq.blocks = sq.blocks[1:]
+}
+
+// lag returns the number of blocks waiting to be indexed. mtx must be held by
+// the caller.
+func (sq *sinkQueue) lag() int64 {
+	if sq.indexedHeight == 0 {
+		return int64(len(sq.blocks))
+	}
+	return sq.latestHeight - sq.indexedHeight
+}
+
+// index indexes the block and its txs into the sink. It returns false if
+// either failed.
+func (sq *sinkQueue) index(logger log.Logger, block queuedBlock) bool {
+	height := block.header.Header.Height
+
+	if err := sq.BlockIndexer.Index(block.header); err != nil {
+		logger.Error("failed to index block", "height", height, "err", err)
+		return false
+	}
+	logger.Info("indexed block", "height", height)
+
+	if err := sq.TxIndexer.AddBatch(block.batch); err != nil {
+		logger.Error("failed to index block txs", "height", height, "err", err)
+		return false
+	}
+	logger.Debug("indexed block txs", "height", height, "num_txs", block.header.NumTxs)
+	return true
+}
+
+//-----------------------------------------------------------------------------
+
+func indexedHeightKey(sinkName string) []byte {
+	return []byte("indexedHeightKey:" + sinkName)
+}
+
+// loadIndexedHeight returns the height of the last block indexed into the
+// sink with the given name, or 0 if none was.
+func loadIndexedHeight(db dbm.DB, sinkName string) (int64, error) {
+	bz, err := db.Get(indexedHeightKey(sinkName))
+	if err != nil || len(bz) == 0 {
+		return 0, err
+	}
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+// saveIndexedHeight saves the height of the last block indexed into the sink
+// with the given name.
+func saveIndexedHeight(db dbm.DB, sinkName string, height int64) error {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return db.Set(indexedHeightKey(sinkName), bz)
+}
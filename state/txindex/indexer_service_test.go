@@ -1,6 +1,9 @@
 package txindex_test
 
 import (
+	"errors"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -9,6 +12,8 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	"github.com/tendermint/tendermint/state/indexer"
 	blockidxkv "github.com/tendermint/tendermint/state/indexer/block/kv"
 	"github.com/tendermint/tendermint/state/txindex"
 	"github.com/tendermint/tendermint/state/txindex/kv"
@@ -32,7 +37,9 @@ func TestIndexerServiceIndexesBlocks(t *testing.T) {
 	txIndexer := kv.NewTxIndex(store)
 	blockIndexer := blockidxkv.New(db.NewPrefixDB(store, []byte("block_events")))
 
-	service := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus)
+	service := txindex.NewIndexerService([]txindex.Sink{
+		{Name: "kv", TxIndexer: txIndexer, BlockIndexer: blockIndexer},
+	}, eventBus)
 	service.SetLogger(log.TestingLogger())
 	err = service.Start()
 	require.NoError(t, err)
@@ -79,3 +86,226 @@ func TestIndexerServiceIndexesBlocks(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, txResult2, res)
 }
+
+// failingBlockIndexer fails to index the blocks until it is fixed.
+type failingBlockIndexer struct {
+	*blockidxkv.BlockerIndexer
+
+	mtx      sync.Mutex
+	failures int
+	fixed    bool
+}
+
+func (idx *failingBlockIndexer) Index(bh types.EventDataNewBlockHeader) error {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+	if !idx.fixed {
+		idx.failures++
+		return errors.New("sink is down")
+	}
+	return idx.BlockerIndexer.Index(bh)
+}
+
+func (idx *failingBlockIndexer) failed() int {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+	return idx.failures
+}
+
+func (idx *failingBlockIndexer) fix() {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+	idx.fixed = true
+}
+
+// indexed returns true if the blocks up to the given height and their txs
+// were indexed.
+func indexed(t *testing.T, blockIndexer indexer.BlockIndexer, txIndexer txindex.TxIndexer, height int64) bool {
+	for h := int64(1); h <= height; h++ {
+		ok, err := blockIndexer.Has(h)
+		require.NoError(t, err)
+		if !ok {
+			return false
+		}
+		res, err := txIndexer.Get(types.Tx(fmt.Sprintf("tx%d", h)).Hash())
+		require.NoError(t, err)
+		if res == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func TestIndexerServiceRetriesFailingSink(t *testing.T) {
+	eventBus := types.NewEventBus()
+	eventBus.SetLogger(log.TestingLogger())
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	store := db.NewMemDB()
+	txIndexer := kv.NewTxIndex(store)
+	blockIndexer := blockidxkv.New(db.NewPrefixDB(store, []byte("block_events")))
+
+	failingStore := db.NewMemDB()
+	failingTxIndexer := kv.NewTxIndex(failingStore)
+	failingBlockIndexer := &failingBlockIndexer{
+		BlockerIndexer: blockidxkv.New(db.NewPrefixDB(failingStore, []byte("block_events"))),
+	}
+
+	service := txindex.NewIndexerService([]txindex.Sink{
+		{Name: "failing", TxIndexer: failingTxIndexer, BlockIndexer: failingBlockIndexer},
+		{Name: "kv", TxIndexer: txIndexer, BlockIndexer: blockIndexer},
+	}, eventBus, txindex.WithRetryBackoff(10*time.Millisecond, 20*time.Millisecond))
+	service.SetLogger(log.TestingLogger())
+	require.NoError(t, service.Start())
+	t.Cleanup(func() {
+		if err := service.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	// the failing sink holds up neither the event bus nor the other sink
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, eventBus.PublishEventNewBlockHeader(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: height},
+			NumTxs: int64(1),
+		}))
+		require.NoError(t, eventBus.PublishEventTx(types.EventDataTx{TxResult: abci.TxResult{
+			Height: height,
+			Tx:     types.Tx(fmt.Sprintf("tx%d", height)),
+		}}))
+	}
+
+	require.Eventually(t, func() bool {
+		return indexed(t, blockIndexer, txIndexer, 3)
+	}, time.Second, 10*time.Millisecond)
+	for height := int64(1); height <= 3; height++ {
+		ok, err := failingBlockIndexer.Has(height)
+		require.NoError(t, err)
+		require.False(t, ok)
+	}
+
+	// the failing sink retries the first block until it succeeds, then catches up
+	require.Eventually(t, func() bool { return failingBlockIndexer.failed() > 1 }, time.Second, 10*time.Millisecond)
+	failingBlockIndexer.fix()
+	require.Eventually(t, func() bool {
+		return indexed(t, failingBlockIndexer, failingTxIndexer, 3)
+	}, time.Second, 10*time.Millisecond)
+}
+
+// testStore is a block store which holds the ABCI responses of its blocks.
+type testStore struct {
+	mtx    sync.Mutex
+	blocks []*types.Block
+}
+
+func (s *testStore) Base() int64 { return 1 }
+
+func (s *testStore) Height() int64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return int64(len(s.blocks))
+}
+
+func (s *testStore) LoadBlock(height int64) *types.Block {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if height < 1 || height > int64(len(s.blocks)) {
+		return nil
+	}
+	return s.blocks[height-1]
+}
+
+func (s *testStore) LoadABCIResponses(height int64) (*tmstate.ABCIResponses, error) {
+	block := s.LoadBlock(height)
+	if block == nil {
+		return nil, errors.New("not found")
+	}
+	responses := &tmstate.ABCIResponses{
+		BeginBlock: &abci.ResponseBeginBlock{},
+		EndBlock:   &abci.ResponseEndBlock{},
+	}
+	for range block.Txs {
+		responses.DeliverTxs = append(responses.DeliverTxs, &abci.ResponseDeliverTx{})
+	}
+	return responses, nil
+}
+
+// commit stores the next block, with a single tx, and publishes its events
+// on the event bus, if any.
+func (s *testStore) commit(t *testing.T, eventBus *types.EventBus) {
+	s.mtx.Lock()
+	height := int64(len(s.blocks) + 1)
+	tx := types.Tx(fmt.Sprintf("tx%d", height))
+	s.blocks = append(s.blocks, &types.Block{Header: types.Header{Height: height}, Data: types.Data{Txs: types.Txs{tx}}})
+	s.mtx.Unlock()
+
+	if eventBus == nil {
+		return
+	}
+	require.NoError(t, eventBus.PublishEventNewBlockHeader(types.EventDataNewBlockHeader{
+		Header: types.Header{Height: height},
+		NumTxs: int64(1),
+	}))
+	require.NoError(t, eventBus.PublishEventTx(types.EventDataTx{TxResult: abci.TxResult{Height: height, Tx: tx}}))
+}
+
+func TestIndexerServiceCatchesUpMissedBlocks(t *testing.T) {
+	eventBus := types.NewEventBus()
+	eventBus.SetLogger(log.TestingLogger())
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	store := db.NewMemDB()
+	txIndexer := kv.NewTxIndex(store)
+	blockIndexer := &failingBlockIndexer{
+		BlockerIndexer: blockidxkv.New(db.NewPrefixDB(store, []byte("block_events"))),
+	}
+	sinks := []txindex.Sink{{Name: "kv", TxIndexer: txIndexer, BlockIndexer: blockIndexer}}
+
+	blocks := &testStore{}
+	progressDB := db.NewMemDB()
+	newService := func() *txindex.IndexerService {
+		service := txindex.NewIndexerService(sinks, eventBus,
+			txindex.WithRetryBackoff(10*time.Millisecond, 20*time.Millisecond),
+			txindex.WithMaxQueuedBlocks(1),
+			txindex.WithCatchUp(txindex.NewBlockEventsLoader(blocks, blocks), progressDB))
+		service.SetLogger(log.TestingLogger())
+		require.NoError(t, service.Start())
+		return service
+	}
+	service := newService()
+
+	// only the first block fits in the queue of the failing sink, the others
+	// are loaded from the store once it is fixed
+	for height := int64(1); height <= 5; height++ {
+		blocks.commit(t, eventBus)
+	}
+	require.Eventually(t, func() bool { return blockIndexer.failed() > 1 }, time.Second, 10*time.Millisecond)
+	blockIndexer.fix()
+	require.Eventually(t, func() bool {
+		return indexed(t, blockIndexer, txIndexer, 5)
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, service.Stop())
+
+	// the blocks committed while the node was stopped are indexed on restart
+	blocks.commit(t, nil)
+	blocks.commit(t, nil)
+	service = newService()
+	t.Cleanup(func() {
+		if err := service.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	require.Eventually(t, func() bool {
+		return indexed(t, blockIndexer, txIndexer, 7)
+	}, time.Second, 10*time.Millisecond)
+}
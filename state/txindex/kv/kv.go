@@ -167,6 +167,10 @@ func (txi *TxIndex) indexEvents(result *abci.TxResult, hash []byte, store dbm.Ba
 // performing a full scan. Results from querying indexes are then intersected
 // and returned to the caller, in no particular order.
 //
+// Queries with OR and NOT operators are broken into their conjunctions of
+// conditions, the results of which are combined: OR is a union and NOT the
+// complement within all the indexed txs. Every tx is returned once.
+//
 // Search will exit early and return any result fetched so far,
 // when a message is received on the context chan.
 func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
@@ -177,15 +181,105 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 	default:
 	}
 
-	var hashesInitialized bool
-	filteredHashes := make(map[string][]byte)
-
-	// get a list of conditions (like "tx.height > 5")
-	conditions, err := q.Conditions()
+	expr, err := q.Expression()
 	if err != nil {
 		return nil, fmt.Errorf("error during parsing conditions from query: %w", err)
 	}
 
+	filteredHashes, err := txi.matchExpression(ctx, expr)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*abci.TxResult, 0, len(filteredHashes))
+	for _, h := range filteredHashes {
+		res, err := txi.Get(h)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Tx{%X}: %w", h, err)
+		}
+		results = append(results, res)
+
+		// Potentially exit early.
+		select {
+		case <-ctx.Done():
+			break
+		default:
+		}
+	}
+
+	return results, nil
+}
+
+// matchExpression returns all matching txs by hash that meet a given query
+// expression.
+func (txi *TxIndex) matchExpression(ctx context.Context, expr *query.Expression) (map[string][]byte, error) {
+	if conditions, ok := expr.Conditions(); ok {
+		return txi.matchConditions(ctx, conditions)
+	}
+
+	switch expr.Kind {
+	case query.ExprAnd:
+		var filteredHashes map[string][]byte
+		for i, operand := range expr.Operands {
+			tmpHashes, err := txi.matchExpression(ctx, operand)
+			if err != nil {
+				return nil, err
+			}
+
+			if i == 0 {
+				filteredHashes = tmpHashes
+			} else {
+				for k := range filteredHashes {
+					if tmpHashes[k] == nil {
+						delete(filteredHashes, k)
+					}
+				}
+			}
+
+			// Ignore any remaining operands if there is no match so far.
+			if len(filteredHashes) == 0 {
+				break
+			}
+		}
+		return filteredHashes, nil
+
+	case query.ExprOr:
+		filteredHashes := make(map[string][]byte)
+		for _, operand := range expr.Operands {
+			tmpHashes, err := txi.matchExpression(ctx, operand)
+			if err != nil {
+				return nil, err
+			}
+
+			for k, v := range tmpHashes {
+				filteredHashes[k] = v
+			}
+		}
+		return filteredHashes, nil
+
+	case query.ExprNot:
+		tmpHashes, err := txi.matchExpression(ctx, expr.Operands[0])
+		if err != nil {
+			return nil, err
+		}
+
+		filteredHashes := txi.matchAll(ctx)
+		for k := range tmpHashes {
+			delete(filteredHashes, k)
+		}
+		return filteredHashes, nil
+
+	default:
+		return nil, fmt.Errorf("unknown kind of expression %v", expr.Kind)
+	}
+}
+
+// matchConditions returns all matching txs by hash that meet all the given
+// conditions.
+func (txi *TxIndex) matchConditions(ctx context.Context, conditions []query.Condition) (map[string][]byte, error) {
+	var hashesInitialized bool
+	filteredHashes := make(map[string][]byte)
+
 	// if there is a hash condition, return the result immediately
 	hash, ok, err := lookForHash(conditions)
 	if err != nil {
@@ -194,12 +288,11 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 		res, err := txi.Get(hash)
 		switch {
 		case err != nil:
-			return []*abci.TxResult{}, fmt.Errorf("error while retrieving the result: %w", err)
-		case res == nil:
-			return []*abci.TxResult{}, nil
-		default:
-			return []*abci.TxResult{res}, nil
+			return nil, fmt.Errorf("error while retrieving the result: %w", err)
+		case res != nil:
+			filteredHashes[string(hash)] = hash
 		}
+		return filteredHashes, nil
 	}
 
 	// conditions to skip because they're handled before "everything else"
@@ -251,13 +344,22 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 		}
 	}
 
-	results := make([]*abci.TxResult, 0, len(filteredHashes))
-	for _, h := range filteredHashes {
-		res, err := txi.Get(h)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get Tx{%X}: %w", h, err)
-		}
-		results = append(results, res)
+	return filteredHashes, nil
+}
+
+// matchAll returns all the indexed txs by hash, which are all indexed by
+// height.
+func (txi *TxIndex) matchAll(ctx context.Context) map[string][]byte {
+	hashes := make(map[string][]byte)
+
+	it, err := dbm.IteratePrefix(txi.store, startKey(types.TxHeightKey))
+	if err != nil {
+		panic(err)
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		hashes[string(it.Value())] = it.Value()
 
 		// Potentially exit early.
 		select {
@@ -266,8 +368,11 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 		default:
 		}
 	}
+	if err := it.Error(); err != nil {
+		panic(err)
+	}
 
-	return results, nil
+	return hashes
 }
 
 func lookForHash(conditions []query.Condition) (hash []byte, ok bool, err error) {
@@ -313,6 +313,55 @@ func TestTxSearchMultipleTxs(t *testing.T) {
 	require.Len(t, results, 3)
 }
 
+func TestTxSearchWithOrAndNot(t *testing.T) {
+	indexer := NewTxIndex(db.NewMemDB())
+
+	owners := []string{"Ivan", "Igor", "Vlad", "Ivan"}
+	for i, owner := range owners {
+		txResult := txResultWithEvents([]abci.Event{
+			{Type: "account", Attributes: []abci.EventAttribute{
+				{Key: []byte("number"), Value: []byte(fmt.Sprint(i + 1)), Index: true},
+				{Key: []byte("owner"), Value: []byte(owner), Index: true},
+			}},
+		})
+		txResult.Tx = types.Tx(fmt.Sprintf("tx%d", i+1))
+		txResult.Height = int64(i/2 + 1)
+		txResult.Index = uint32(i % 2)
+		require.NoError(t, indexer.Index(txResult))
+	}
+
+	testCases := []struct {
+		q   string
+		txs []string
+	}{
+		{"account.owner = 'Ivan' OR account.owner = 'Vlad'", []string{"tx1", "tx3", "tx4"}},
+		// every tx is returned once
+		{"account.owner = 'Ivan' OR account.number <= 2", []string{"tx1", "tx2", "tx4"}},
+		{"NOT account.owner = 'Ivan'", []string{"tx2", "tx3"}},
+		{"NOT account.owner EXISTS", nil},
+		{"NOT account.owner CONTAINS 'I' AND tx.height = 2", []string{"tx3"}},
+		{"(account.owner = 'Igor' OR account.number > 3) AND NOT tx.height = 1", []string{"tx4"}},
+		{"account.owner = 'Igor' OR account.number > 3 AND NOT tx.height = 2", []string{"tx2"}},
+		{"NOT (account.number >= 2 AND account.number <= 3)", []string{"tx1", "tx4"}},
+		{fmt.Sprintf("tx.hash = '%X' OR account.number = 3", types.Tx("tx1").Hash()), []string{"tx1", "tx3"}},
+		{"NOT NOT account.owner = 'Vlad'", []string{"tx3"}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.q, func(t *testing.T) {
+			results, err := indexer.Search(context.Background(), query.MustParse(tc.q))
+			require.NoError(t, err)
+
+			var txs []string
+			for _, txr := range results {
+				txs = append(txs, string(txr.Tx))
+			}
+			assert.ElementsMatch(t, tc.txs, txs)
+		})
+	}
+}
+
 func txResultWithEvents(events []abci.Event) *abci.TxResult {
 	tx := types.Tx("HELLO WORLD")
 	return &abci.TxResult{
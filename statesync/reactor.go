@@ -6,6 +6,7 @@ import (
 	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/behaviour"
 	"github.com/tendermint/tendermint/config"
 	tmsync "github.com/tendermint/tendermint/libs/sync"
 	"github.com/tendermint/tendermint/p2p"
@@ -38,6 +39,9 @@ type Reactor struct {
 	// snapshots and chunks into the sync.
 	mtx    tmsync.RWMutex
 	syncer *syncer
+
+	// reports the behaviour of the peers to the Switch
+	reporter behaviour.Reporter
 }
 
 // NewReactor creates a new state sync reactor.
@@ -58,6 +62,13 @@ func NewReactor(
 	return r
 }
 
+// SetSwitch implements p2p.Reactor by setting the Switch the behaviour of the
+// peers is reported to.
+func (r *Reactor) SetSwitch(sw *p2p.Switch) {
+	r.Switch = sw
+	r.reporter = behaviour.NewSwitchReporter(sw)
+}
+
 // GetChannels implements p2p.Reactor.
 func (r *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 	return []*p2p.ChannelDescriptor{
@@ -99,6 +110,13 @@ func (r *Reactor) RemovePeer(peer p2p.Peer, reason interface{}) {
 	}
 }
 
+// reportPeer reports the behaviour of a peer to the Switch.
+func (r *Reactor) reportPeer(pb behaviour.PeerBehaviour) {
+	if err := r.reporter.Report(pb); err != nil {
+		r.Logger.Debug("Error reporting peer", "err", err)
+	}
+}
+
 // Receive implements p2p.Reactor.
 func (r *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 	if !r.IsRunning() {
@@ -108,13 +126,13 @@ func (r *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 	msg, err := decodeMsg(msgBytes)
 	if err != nil {
 		r.Logger.Error("Error decoding message", "src", src, "chId", chID, "err", err)
-		r.Switch.StopPeerForError(src, err)
+		r.reportPeer(behaviour.BadMessageFromPeer(src, err.Error()))
 		return
 	}
 	err = validateMsg(msg)
 	if err != nil {
 		r.Logger.Error("Invalid message", "peer", src, "msg", msg, "err", err)
-		r.Switch.StopPeerForError(src, err)
+		r.reportPeer(behaviour.BadMessageFromPeer(src, err.Error()))
 		return
 	}
 
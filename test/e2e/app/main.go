@@ -304,6 +304,8 @@ func setupCoreServer(cfg *Config) (*mockcoreserver.JRPCServer, error) {
 		mockcoreserver.WithQuorumInfoMethod(coreServer, mockcoreserver.Endless),
 		mockcoreserver.WithQuorumSignMethod(coreServer, mockcoreserver.Endless),
 		mockcoreserver.WithQuorumVerifyMethod(coreServer, mockcoreserver.Endless),
+		mockcoreserver.WithQuorumSelectQuorumMethod(coreServer, mockcoreserver.Endless),
+		mockcoreserver.WithGetBestChainLockMethod(coreServer, mockcoreserver.Endless),
 		mockcoreserver.WithMasternodeMethod(coreServer, mockcoreserver.Endless),
 		mockcoreserver.WithGetNetworkInfoMethod(coreServer, mockcoreserver.Endless),
 		mockcoreserver.WithPingMethod(coreServer, mockcoreserver.Endless),
@@ -2,20 +2,27 @@ package mockcoreserver
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"strconv"
 
 	"github.com/dashevo/dashd-go/btcjson"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/bls12381"
+	dashcore "github.com/tendermint/tendermint/dashcore/rpc"
 	"github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/types"
 )
 
+var errNoChainLock = errors.New("unable to find any chainlock")
+
 // CoreServer is an interface of a mock core-server
 type CoreServer interface {
 	QuorumInfo(cmd btcjson.QuorumCmd) btcjson.QuorumInfoResult
 	QuorumSign(cmd btcjson.QuorumCmd) btcjson.QuorumSignResult
 	QuorumVerify(cmd btcjson.QuorumCmd) btcjson.QuorumVerifyResult
+	QuorumSelectQuorum(cmd btcjson.QuorumCmd) btcjson.QuorumSelectQuorumResult
+	GetBestChainLock() (dashcore.BestChainLockResult, error)
 	MasternodeStatus(cmd btcjson.MasternodeCmd) btcjson.MasternodeStatusResult
 	GetNetworkInfo(cmd btcjson.GetNetworkInfoCmd) btcjson.GetNetworkInfoResult
 	Ping(cmd btcjson.PingCmd) error
@@ -26,6 +33,8 @@ type MockCoreServer struct {
 	ChainID  string
 	LLMQType btcjson.LLMQType
 	FilePV   *privval.FilePV
+	// ChainLock is returned as the best chain lock if set
+	ChainLock *types.CoreChainLock
 }
 
 // QuorumInfo returns a quorum-info result
@@ -160,6 +169,35 @@ func (c *MockCoreServer) QuorumVerify(cmd btcjson.QuorumCmd) btcjson.QuorumVerif
 	return res
 }
 
+// QuorumSelectQuorum returns a quorum-selectquorum result
+func (c *MockCoreServer) QuorumSelectQuorum(_ btcjson.QuorumCmd) btcjson.QuorumSelectQuorumResult {
+	quorumHash, err := c.FilePV.GetFirstQuorumHash()
+	if err != nil {
+		panic(err)
+	}
+	proTxHash, err := c.FilePV.GetProTxHash()
+	if err != nil {
+		panic(err)
+	}
+	return btcjson.QuorumSelectQuorumResult{
+		QuorumHash:      quorumHash.String(),
+		RecoveryMembers: []string{proTxHash.String()},
+	}
+}
+
+// GetBestChainLock returns a getbestchainlock result
+func (c *MockCoreServer) GetBestChainLock() (dashcore.BestChainLockResult, error) {
+	if c.ChainLock == nil {
+		return dashcore.BestChainLockResult{}, errNoChainLock
+	}
+	return dashcore.BestChainLockResult{
+		BlockHash:  hex.EncodeToString(c.ChainLock.CoreBlockHash),
+		Height:     c.ChainLock.CoreBlockHeight,
+		Signature:  hex.EncodeToString(c.ChainLock.Signature),
+		KnownBlock: true,
+	}, nil
+}
+
 // MasternodeStatus returns a masternode-status result
 func (c *MockCoreServer) MasternodeStatus(_ btcjson.MasternodeCmd) btcjson.MasternodeStatusResult {
 	proTxHash, err := c.FilePV.GetProTxHash()
@@ -183,11 +221,13 @@ func (c *MockCoreServer) Ping(cmd btcjson.PingCmd) error {
 
 // StaticCoreServer is a mock of core-server with static result data
 type StaticCoreServer struct {
-	QuorumInfoResult       btcjson.QuorumInfoResult
-	QuorumSignResult       btcjson.QuorumSignResult
-	QuorumVerifyResult     btcjson.QuorumVerifyResult
-	MasternodeStatusResult btcjson.MasternodeStatusResult
-	GetNetworkInfoResult   btcjson.GetNetworkInfoResult
+	QuorumInfoResult         btcjson.QuorumInfoResult
+	QuorumSignResult         btcjson.QuorumSignResult
+	QuorumVerifyResult       btcjson.QuorumVerifyResult
+	QuorumSelectQuorumResult btcjson.QuorumSelectQuorumResult
+	GetBestChainLockResult   *dashcore.BestChainLockResult
+	MasternodeStatusResult   btcjson.MasternodeStatusResult
+	GetNetworkInfoResult     btcjson.GetNetworkInfoResult
 }
 
 // QuorumInfo returns constant quorum-info result
@@ -205,6 +245,19 @@ func (c *StaticCoreServer) QuorumVerify(_ btcjson.QuorumCmd) btcjson.QuorumVerif
 	return c.QuorumVerifyResult
 }
 
+// QuorumSelectQuorum returns constant quorum-selectquorum result
+func (c *StaticCoreServer) QuorumSelectQuorum(_ btcjson.QuorumCmd) btcjson.QuorumSelectQuorumResult {
+	return c.QuorumSelectQuorumResult
+}
+
+// GetBestChainLock returns constant getbestchainlock result
+func (c *StaticCoreServer) GetBestChainLock() (dashcore.BestChainLockResult, error) {
+	if c.GetBestChainLockResult == nil {
+		return dashcore.BestChainLockResult{}, errNoChainLock
+	}
+	return *c.GetBestChainLockResult, nil
+}
+
 // MasternodeStatus returns constant masternode-status result
 func (c *StaticCoreServer) MasternodeStatus(_ btcjson.MasternodeCmd) btcjson.MasternodeStatusResult {
 	return c.MasternodeStatusResult
@@ -80,6 +80,39 @@ func WithQuorumVerifyMethod(cs CoreServer, times int) MethodFunc {
 	}
 }
 
+// WithQuorumSelectQuorumMethod ...
+func WithQuorumSelectQuorumMethod(cs CoreServer, times int) MethodFunc {
+	call := OnMethod(func(req btcjson.Request) (interface{}, error) {
+		cmd := btcjson.QuorumCmd{}
+		err := unmarshalCmd(req, &cmd.SubCmd, &cmd.LLMQType, &cmd.RequestID)
+		if err != nil {
+			return nil, err
+		}
+		return cs.QuorumSelectQuorum(cmd), nil
+	})
+	return func(srv *JRPCServer) {
+		srv.
+			On("quorum selectquorum").
+			Expect(And(Debug())).
+			Times(times).
+			Respond(call, JSONContentType())
+	}
+}
+
+// WithGetBestChainLockMethod ...
+func WithGetBestChainLockMethod(cs CoreServer, times int) MethodFunc {
+	call := OnMethod(func(req btcjson.Request) (interface{}, error) {
+		return cs.GetBestChainLock()
+	})
+	return func(srv *JRPCServer) {
+		srv.
+			On("getbestchainlock").
+			Expect(And(Debug())).
+			Times(times).
+			Respond(call, JSONContentType())
+	}
+}
+
 // WithMasternodeMethod ...
 func WithMasternodeMethod(cs CoreServer, times int) MethodFunc {
 	call := OnMethod(func(req btcjson.Request) (interface{}, error) {
@@ -8,14 +8,18 @@ import (
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	dashcore "github.com/tendermint/tendermint/dashcore/rpc"
 
 	"github.com/dashevo/dashd-go/btcjson"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/privval"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
 )
 
 func TestServer(t *testing.T) {
@@ -136,3 +140,59 @@ func TestGetPubKey(t *testing.T) {
 	assert.True(t, pubKey.Equals(bls12381.PubKey(b)))
 	srv.Stop(ctx)
 }
+
+func TestCoreChainLockProvider(t *testing.T) {
+	addr := "localhost:19998"
+	ctx := context.Background()
+	srv := NewJRPCServer(addr, "/")
+	quorumType := btcjson.LLMQType_5_60
+	quorumHash := crypto.RandQuorumHash()
+	privKey := bls12381.GenPrivKey()
+	chainLock := types.NewMockChainLock(1000)
+	signature, err := privKey.SignDigest(chainLock.SignID(quorumType, quorumHash))
+	require.NoError(t, err)
+	chainLock.Signature = signature
+	cs := &StaticCoreServer{
+		QuorumInfoResult: btcjson.QuorumInfoResult{
+			Height:          1010,
+			Type:            "llmq_5_60",
+			QuorumHash:      quorumHash.String(),
+			QuorumPublicKey: privKey.PubKey().HexString(),
+		},
+		QuorumSelectQuorumResult: btcjson.QuorumSelectQuorumResult{
+			QuorumHash: quorumHash.String(),
+		},
+		GetBestChainLockResult: &dashcore.BestChainLockResult{
+			BlockHash:  hex.EncodeToString(chainLock.CoreBlockHash),
+			Height:     chainLock.CoreBlockHeight,
+			Signature:  hex.EncodeToString(chainLock.Signature),
+			KnownBlock: true,
+		},
+	}
+	srv = WithMethods(
+		srv,
+		WithQuorumInfoMethod(cs, Endless),
+		WithQuorumSelectQuorumMethod(cs, Endless),
+		WithGetBestChainLockMethod(cs, Endless),
+	)
+	go func() {
+		srv.Start()
+	}()
+	defer srv.Stop(ctx)
+
+	dashCoreRPCClient, err := dashcore.NewRPCClient(addr, "root", "root")
+	require.NoError(t, err)
+	provider := sm.NewCoreChainLockProvider(dashCoreRPCClient, quorumType, 10*time.Millisecond)
+
+	assert.NoError(t, provider.VerifyChainLock(&chainLock))
+	invalidChainLock := chainLock.Copy()
+	invalidChainLock.CoreBlockHeight++
+	assert.Error(t, provider.VerifyChainLock(&invalidChainLock))
+
+	require.NoError(t, provider.Start())
+	defer provider.Stop() //nolint:errcheck // ignore for tests
+	assert.Eventually(t, func() bool {
+		best := provider.BestChainLock()
+		return best != nil && best.CoreBlockHeight == chainLock.CoreBlockHeight
+	}, time.Second, 10*time.Millisecond)
+}
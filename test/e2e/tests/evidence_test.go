@@ -25,6 +25,10 @@ func TestEvidence_Misbehavior(t *testing.T) {
 					if bytes.Equal(evidence.VoteA.ValidatorProTxHash, node.ProTxHash) {
 						nodeEvidence = evidence
 					}
+				case *types.DuplicateStateVoteEvidence:
+					if bytes.Equal(evidence.VoteA.ValidatorProTxHash, node.ProTxHash) {
+						nodeEvidence = evidence
+					}
 				default:
 					t.Fatalf("unexpected evidence type %T", evidence)
 				}
@@ -41,6 +45,8 @@ func TestEvidence_Misbehavior(t *testing.T) {
 			switch misbehavior {
 			case "double-prevote":
 				require.IsType(t, &types.DuplicateVoteEvidence{}, nodeEvidence, "unexpected evidence type")
+			case "double-state-vote":
+				require.IsType(t, &types.DuplicateStateVoteEvidence{}, nodeEvidence, "unexpected evidence type")
 			default:
 				t.Fatalf("unknown misbehavior %v", misbehavior)
 			}
@@ -6,13 +6,15 @@ import (
 
 	tmcon "github.com/tendermint/tendermint/consensus"
 	cstypes "github.com/tendermint/tendermint/consensus/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	"github.com/tendermint/tendermint/types"
 )
 
 // MisbehaviorList encompasses a list of all possible behaviors
 var MisbehaviorList = map[string]Misbehavior{
-	"double-prevote": DoublePrevoteMisbehavior(),
+	"double-prevote":    DoublePrevoteMisbehavior(),
+	"double-state-vote": DoubleStateVoteMisbehavior(),
 }
 
 type Misbehavior struct {
@@ -124,6 +126,40 @@ func DoublePrevoteMisbehavior() Misbehavior {
 	return b
 }
 
+// DoubleStateVoteMisbehavior will make a node prevote the proposal block with a state ID
+// that conflicts with the state ID of its precommit in the same height.
+func DoubleStateVoteMisbehavior() Misbehavior {
+	b := DefaultMisbehavior()
+	b.Name = "double-state-vote"
+	b.EnterPrevote = func(cs *State, height int64, round int32) {
+		// without a valid proposal block there is no state to vote for
+		if cs.LockedBlock != nil || cs.ProposalBlock == nil || cs.sw == nil {
+			defaultEnterPrevote(cs, height, round)
+			return
+		}
+		if err := cs.blockExec.ValidateBlock(cs.state, cs.ProposalBlock); err != nil {
+			defaultEnterPrevote(cs, height, round)
+			return
+		}
+
+		stateID := types.StateID{LastAppHash: tmhash.Sum(cs.state.AppHash)}
+		prevote, err := cs.signVoteWithStateID(
+			tmproto.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header(), stateID)
+		if err != nil {
+			cs.Logger.Error("enterPrevote: Unable to sign block", "err", err)
+			return
+		}
+
+		// the prevote is not added to our own votes, otherwise our precommit with the
+		// actual state ID would be rejected as conflicting and never gossiped
+		cs.Logger.Info("Sending prevote with conflicting state ID")
+		for _, peer := range cs.sw.Peers().List() {
+			peer.Send(VoteChannel, tmcon.MustEncode(&tmcon.VoteMessage{Vote: prevote}))
+		}
+	}
+	return b
+}
+
 // DEFAULTS
 
 func defaultEnterPropose(cs *State, height int64, round int32) {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 
+	"github.com/tendermint/tendermint/behaviour"
 	tmcon "github.com/tendermint/tendermint/consensus"
 	cstypes "github.com/tendermint/tendermint/consensus/types"
 	"github.com/tendermint/tendermint/libs/bits"
@@ -34,6 +35,8 @@ const (
 
 	blocksToContributeToBecomeGoodPeer = 10000
 	votesToContributeToBecomeGoodPeer  = 10000
+
+	maxVoteBatchSize = 500
 )
 
 //-----------------------------------------------------------------------------
@@ -48,6 +51,12 @@ type Reactor struct {
 	waitSync bool
 	eventBus *types.EventBus
 
+	// votes received from peers waiting to be verified in a batch
+	voteQueue chan peerVote
+
+	// reports the behaviour of the peers to the Switch
+	reporter behaviour.Reporter
+
 	Metrics *tmcon.Metrics
 }
 
@@ -57,9 +66,10 @@ type ReactorOption func(*Reactor)
 // consensusState.
 func NewReactor(consensusState *State, waitSync bool, options ...ReactorOption) *Reactor {
 	conR := &Reactor{
-		conS:     consensusState,
-		waitSync: waitSync,
-		Metrics:  tmcon.NopMetrics(),
+		conS:      consensusState,
+		waitSync:  waitSync,
+		voteQueue: make(chan peerVote, msgQueueSize),
+		Metrics:   tmcon.NopMetrics(),
 	}
 	conR.BaseReactor = *p2p.NewBaseReactor("Consensus", conR)
 
@@ -70,6 +80,13 @@ func NewReactor(consensusState *State, waitSync bool, options ...ReactorOption)
 	return conR
 }
 
+// SetSwitch implements Reactor by setting the Switch the behaviour of the
+// peers is reported to.
+func (conR *Reactor) SetSwitch(sw *p2p.Switch) {
+	conR.Switch = sw
+	conR.reporter = behaviour.NewSwitchReporter(sw)
+}
+
 // OnStart implements BaseService by subscribing to events, which later will be
 // broadcasted to other peers and starting state if we're not in fast sync.
 func (conR *Reactor) OnStart() error {
@@ -78,6 +95,10 @@ func (conR *Reactor) OnStart() error {
 	// start routine that computes peer statistics for evaluating peer quality
 	go conR.peerStatsRoutine()
 
+	if conR.conS.config.VoteBatchWindow > 0 {
+		go conR.voteBatchRoutine()
+	}
+
 	conR.subscribeToBroadcastEvents()
 
 	if !conR.WaitSync() {
@@ -232,13 +253,13 @@ func (conR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 	msg, err := decodeMsg(msgBytes)
 	if err != nil {
 		conR.Logger.Error("Error decoding message", "src", src, "chId", chID, "err", err)
-		conR.Switch.StopPeerForError(src, err)
+		conR.reportPeer(behaviour.BadMessageFromPeer(src, err.Error()))
 		return
 	}
 
 	if err = msg.ValidateBasic(); err != nil {
 		conR.Logger.Error("Peer sent us invalid msg", "peer", src, "msg", msg, "err", err)
-		conR.Switch.StopPeerForError(src, err)
+		conR.reportPeer(behaviour.BadMessageFromPeer(src, err.Error()))
 		return
 	}
 
@@ -259,7 +280,7 @@ func (conR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 			conR.conS.mtx.Unlock()
 			if err = msg.ValidateHeight(initialHeight); err != nil {
 				conR.Logger.Error("Peer sent us invalid msg", "peer", src, "msg", msg, "err", err)
-				conR.Switch.StopPeerForError(src, err)
+				conR.reportPeer(behaviour.BadMessageFromPeer(src, err.Error()))
 				return
 			}
 			ps.ApplyNewRoundStepMessage(msg)
@@ -280,7 +301,7 @@ func (conR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 			// Peer claims to have a maj23 for some BlockID at H,R,S,
 			err := votes.SetPeerMaj23(msg.Round, msg.Type, ps.peer.ID(), msg.BlockID)
 			if err != nil {
-				conR.Switch.StopPeerForError(src, err)
+				conR.reportPeer(behaviour.BadMessageFromPeer(src, err.Error()))
 				return
 			}
 			// Respond with a VoteSetBitsMessage showing which votes we have.
@@ -339,6 +360,17 @@ func (conR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 			ps.EnsureVoteBitArrays(height-1, lastCommitSize)
 			ps.SetHasVote(msg.Vote)
 
+			if cs.config.VoteBatchWindow > 0 {
+				// block the peer's receive routine when the votes are coming
+				// faster than they are verified, rather than drop the vote: the
+				// peer has marked it as sent to us and won't gossip it again
+				select {
+				case conR.voteQueue <- peerVote{msg.Vote, src}:
+				case <-src.Quit():
+				case <-conR.Quit():
+				}
+				return
+			}
 			cs.peerMsgQueue <- msgInfo{msg, src.ID()}
 
 		default:
@@ -871,11 +903,11 @@ func (conR *Reactor) peerStatsRoutine() {
 			switch msg.Msg.(type) {
 			case *tmcon.VoteMessage:
 				if numVotes := ps.RecordVote(); numVotes%votesToContributeToBecomeGoodPeer == 0 {
-					conR.Switch.MarkPeerAsGood(peer)
+					conR.reportPeer(behaviour.ConsensusVote(peer.ID(), "contributed votes"))
 				}
 			case *tmcon.BlockPartMessage:
 				if numParts := ps.RecordBlockPart(); numParts%blocksToContributeToBecomeGoodPeer == 0 {
-					conR.Switch.MarkPeerAsGood(peer)
+					conR.reportPeer(behaviour.BlockPart(peer.ID(), "contributed block parts"))
 				}
 			}
 		case <-conR.conS.Quit():
@@ -887,6 +919,126 @@ func (conR *Reactor) peerStatsRoutine() {
 	}
 }
 
+// peerVote is a vote received from a peer.
+type peerVote struct {
+	vote *types.Vote
+	peer p2p.Peer
+}
+
+// voteBatchRoutine collects the votes received from peers for VoteBatchWindow, or until
+// there are maxVoteBatchSize of them, and verifies their signatures in a single batch
+// before passing them on to the consensus state.
+func (conR *Reactor) voteBatchRoutine() {
+	window := conR.conS.config.VoteBatchWindow
+	for {
+		var batch []peerVote
+		select {
+		case pv := <-conR.voteQueue:
+			batch = append(batch, pv)
+		case <-conR.conS.Quit():
+			return
+		case <-conR.Quit():
+			return
+		}
+
+		timer := time.NewTimer(window)
+	COLLECT:
+		for len(batch) < maxVoteBatchSize {
+			select {
+			case pv := <-conR.voteQueue:
+				batch = append(batch, pv)
+			case <-timer.C:
+				break COLLECT
+			case <-conR.conS.Quit():
+				timer.Stop()
+				return
+			case <-conR.Quit():
+				timer.Stop()
+				return
+			}
+		}
+		timer.Stop()
+
+		for _, pv := range conR.verifyVoteBatch(batch) {
+			select {
+			case conR.conS.peerMsgQueue <- msgInfo{&tmcon.VoteMessage{Vote: pv.vote}, pv.peer.ID()}:
+			case <-conR.conS.Quit():
+				return
+			case <-conR.Quit():
+				return
+			}
+		}
+	}
+}
+
+// verifyVoteBatch verifies the signatures of the votes of the batch in aggregate, and one
+// by one if that fails, to find the invalid ones. Peers that sent invalid votes are stopped.
+// It returns the votes to pass on to the consensus state. Votes which can't be verified
+// here, like votes for other heights, are passed on unverified; the consensus state
+// verifies them when it adds them.
+func (conR *Reactor) verifyVoteBatch(batch []peerVote) []peerVote {
+	cs := conR.conS
+	cs.mtx.RLock()
+	height, chainID := cs.Height, cs.state.ChainID
+	validators, lastValidators := cs.Validators, cs.LastValidators
+	cs.mtx.RUnlock()
+
+	var (
+		verifier = types.NewVoteBatchVerifier(chainID)
+		batched  = make([]int, 0, len(batch)) // indexes of the votes added to the verifier
+		invalid  = make([]bool, len(batch))
+	)
+	for i, pv := range batch {
+		var valSet *types.ValidatorSet
+		switch {
+		case pv.vote.Height == height:
+			valSet = validators
+		case pv.vote.Height == height-1 && pv.vote.Type == tmproto.PrecommitType:
+			valSet = lastValidators
+		}
+		if valSet == nil || !valSet.HasPublicKeys {
+			continue
+		}
+		_, val := valSet.GetByIndex(pv.vote.ValidatorIndex)
+		if val == nil || val.PubKey == nil {
+			continue
+		}
+		err := verifier.Add(pv.vote, valSet.QuorumType, valSet.QuorumHash, val.PubKey, val.ProTxHash)
+		if err != nil {
+			conR.punishVotePeer(pv, err)
+			invalid[i] = true
+			continue
+		}
+		batched = append(batched, i)
+	}
+	for i, err := range verifier.Verify() {
+		if err != nil {
+			conR.punishVotePeer(batch[batched[i]], err)
+			invalid[batched[i]] = true
+		}
+	}
+
+	votes := make([]peerVote, 0, len(batch))
+	for i, pv := range batch {
+		if !invalid[i] {
+			votes = append(votes, pv)
+		}
+	}
+	return votes
+}
+
+func (conR *Reactor) punishVotePeer(pv peerVote, err error) {
+	conR.Logger.Error("Peer sent us invalid vote", "peer", pv.peer, "vote", pv.vote, "err", err)
+	conR.reportPeer(behaviour.BadMessageFromPeer(pv.peer, err.Error()))
+}
+
+// reportPeer reports the behaviour of a peer to the Switch.
+func (conR *Reactor) reportPeer(pb behaviour.PeerBehaviour) {
+	if err := conR.reporter.Report(pb); err != nil {
+		conR.Logger.Debug("Error reporting peer", "err", err)
+	}
+}
+
 // String returns a string representation of the Reactor.
 // NOTE: For now, it is just a hard-coded string to avoid accessing unprotected shared variables.
 // TODO: improve!
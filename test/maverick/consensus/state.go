@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"os"
 	"reflect"
+	"runtime"
 	"runtime/debug"
 	"sync"
 	"time"
@@ -102,6 +103,9 @@ type State struct {
 	// for reporting metrics
 	metrics *tmcon.Metrics
 
+	// recovers the threshold signatures of commits
+	sigAggregator types.SignatureAggregator
+
 	// misbehaviors mapped for each height (can't have more than one misbehavior per height)
 	misbehaviors map[int64]Misbehavior
 
@@ -158,6 +162,10 @@ func NewState(
 	for _, option := range options {
 		option(cs)
 	}
+	if cs.sigAggregator == nil {
+		cs.sigAggregator = tmcon.NewSignatureAggregator(runtime.NumCPU(), cs.metrics)
+	}
+	cs.Votes.SetSignatureAggregator(cs.sigAggregator)
 	return cs
 }
 
@@ -472,6 +480,8 @@ type txNotifier interface {
 type evidencePool interface {
 	// reports conflicting votes to the evidence pool to be processed into evidence
 	ReportConflictingVotes(voteA, voteB *types.Vote)
+	// reports votes with conflicting state IDs to the evidence pool to be processed into evidence
+	ReportConflictingStateVotes(voteA, voteB *types.Vote)
 }
 
 //----------------------------------------
@@ -494,6 +504,11 @@ func StateMetrics(metrics *tmcon.Metrics) StateOption {
 	return func(cs *State) { cs.metrics = metrics }
 }
 
+// StateSignatureAggregator sets the aggregator recovering the threshold signatures of commits.
+func StateSignatureAggregator(aggregator types.SignatureAggregator) StateOption {
+	return func(cs *State) { cs.sigAggregator = aggregator }
+}
+
 // String returns a string.
 func (cs *State) String() string {
 	// better not to access shared variables
@@ -907,6 +922,9 @@ func (cs *State) updateToState(state sm.State) {
 	cs.ValidBlock = nil
 	cs.ValidBlockParts = nil
 	cs.Votes = cstypes.NewHeightVoteSet(state.ChainID, height, validators)
+	if cs.sigAggregator != nil {
+		cs.Votes.SetSignatureAggregator(cs.sigAggregator)
+	}
 	cs.CommitRound = -1
 	cs.LastValidators = state.LastValidators
 	cs.TriggeredTimeoutPrecommit = false
@@ -1200,14 +1218,10 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 	if err := cs.wal.FlushAndSync(); err != nil {
 		cs.Logger.Error("Error flushing to disk")
 	}
-	proposedChainLockHeight := cs.state.LastCoreChainLockedBlockHeight
-	if cs.blockExec.NextCoreChainLock != nil && cs.blockExec.NextCoreChainLock.CoreBlockHeight > proposedChainLockHeight {
-		proposedChainLockHeight = cs.blockExec.NextCoreChainLock.CoreBlockHeight
-	}
 
 	// Make proposal
 	propBlockID := types.BlockID{Hash: block.Hash(), PartSetHeader: blockParts.Header()}
-	proposal := types.NewProposal(height, proposedChainLockHeight, round, cs.ValidRound, propBlockID)
+	proposal := types.NewProposal(height, block.Header.CoreChainLockedHeight, round, cs.ValidRound, propBlockID)
 	p := proposal.ToProto()
 	if _, err := cs.privValidator.SignProposal(
 		cs.state.ChainID, cs.Validators.QuorumType, cs.Validators.QuorumHash, p,
@@ -1752,6 +1766,25 @@ func (cs *State) tryAddVote(vote *types.Vote, peerID p2p.ID) (bool, error) {
 				"VoteB", voteErr.VoteB,
 			)
 			return added, err
+		} else if voteErr, ok := err.(*types.ErrVoteConflictingStateVotes); ok {
+			if bytes.Equal(vote.ValidatorProTxHash, cs.privValidatorProTxHash) {
+				cs.Logger.Error(
+					"test found conflicting state vote from ourselves. Did you unsafe_reset a validator?",
+					"height",
+					vote.Height,
+					"round",
+					vote.Round,
+					"type",
+					vote.Type)
+				return added, err
+			}
+			// report votes with conflicting state IDs to the evidence pool
+			cs.evpool.ReportConflictingStateVotes(voteErr.VoteA, voteErr.VoteB)
+			cs.Logger.Debug("Found and sent conflicting state votes to the evidence pool",
+				"VoteA", voteErr.VoteA,
+				"VoteB", voteErr.VoteB,
+			)
+			return added, err
 		} else if err == types.ErrVoteNonDeterministicSignature {
 			cs.Logger.Debug("Vote has non-deterministic signature", "err", err)
 		} else {
@@ -1775,6 +1808,16 @@ func (cs *State) signVote(
 	msgType tmproto.SignedMsgType,
 	hash []byte,
 	header types.PartSetHeader,
+) (*types.Vote, error) {
+	return cs.signVoteWithStateID(msgType, hash, header, types.StateID{LastAppHash: cs.state.AppHash})
+}
+
+// signVoteWithStateID signs a vote for the given state ID instead of the current state
+func (cs *State) signVoteWithStateID(
+	msgType tmproto.SignedMsgType,
+	hash []byte,
+	header types.PartSetHeader,
+	stateID types.StateID,
 ) (*types.Vote, error) {
 	// Flush the WAL. Otherwise, we may not recompute the same vote to sign,
 	// and the privValidator will refuse to sign anything.
@@ -1795,7 +1838,7 @@ func (cs *State) signVote(
 		Round:              cs.Round,
 		Type:               msgType,
 		BlockID:            types.BlockID{Hash: hash, PartSetHeader: header},
-		StateID:            types.StateID{LastAppHash: cs.state.AppHash},
+		StateID:            stateID,
 	}
 
 	// if hash is nil no need to send the state id
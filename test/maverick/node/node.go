@@ -36,6 +36,8 @@ import (
 	mempl "github.com/tendermint/tendermint/mempool"
 	"github.com/tendermint/tendermint/p2p"
 	"github.com/tendermint/tendermint/p2p/pex"
+	"github.com/tendermint/tendermint/p2p/quorum"
+	"github.com/tendermint/tendermint/p2p/trust"
 	"github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
 	rpccore "github.com/tendermint/tendermint/rpc/core"
@@ -45,6 +47,7 @@ import (
 	"github.com/tendermint/tendermint/state/indexer"
 	blockidxkv "github.com/tendermint/tendermint/state/indexer/block/kv"
 	blockidxnull "github.com/tendermint/tendermint/state/indexer/block/null"
+	"github.com/tendermint/tendermint/state/indexer/psql"
 	"github.com/tendermint/tendermint/state/txindex"
 	"github.com/tendermint/tendermint/state/txindex/kv"
 	"github.com/tendermint/tendermint/state/txindex/null"
@@ -152,30 +155,87 @@ func DefaultNewNode(
 
 }
 
-// MetricsProvider returns a consensus, p2p and mempool Metrics.
-type MetricsProvider func(chainID string) (*consensus.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics)
+// MetricsProvider returns a consensus, p2p, mempool, state and indexer Metrics.
+type MetricsProvider func(chainID string) (
+	*consensus.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *txindex.Metrics)
 
 // DefaultMetricsProvider returns Metrics build using Prometheus client library
 // if Prometheus is enabled. Otherwise, it returns no-op Metrics.
 func DefaultMetricsProvider(config *cfg.InstrumentationConfig) MetricsProvider {
-	return func(chainID string) (*consensus.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics) {
+	return func(chainID string) (*consensus.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *txindex.Metrics) {
 		if config.Prometheus {
 			return consensus.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				p2p.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				mempl.PrometheusMetrics(config.Namespace, "chain_id", chainID),
-				sm.PrometheusMetrics(config.Namespace, "chain_id", chainID)
+				sm.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+				txindex.PrometheusMetrics(config.Namespace, "chain_id", chainID)
 		}
-		return consensus.NopMetrics(), p2p.NopMetrics(), mempl.NopMetrics(), sm.NopMetrics()
+		return consensus.NopMetrics(), p2p.NopMetrics(), mempl.NopMetrics(), sm.NopMetrics(), txindex.NopMetrics()
 	}
 }
 
 // DefaultDashCoreRPCClient returns RPC client for the Dash Core node
-func DefaultDashCoreRPCClient(config *cfg.Config) (dashcore.Client, error) {
-	return dashcore.NewRPCClient(
+// wrapped with timeouts, retries and a circuit breaker
+func DefaultDashCoreRPCClient(config *cfg.Config, logger log.Logger) (dashcore.Client, error) {
+	rpcClient, err := dashcore.NewRPCClient(
 		config.PrivValidatorCoreRPCHost,
 		config.BaseConfig.PrivValidatorCoreRPCUsername,
 		config.BaseConfig.PrivValidatorCoreRPCPassword,
+		dashcore.RPCClientWithLogger(logger),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := dashcore.NopMetrics()
+	if config.Instrumentation.Prometheus {
+		metrics = dashcore.PrometheusMetrics(config.Instrumentation.Namespace)
+	}
+	return dashcore.NewResilientClient(
+		rpcClient,
+		dashcore.ResilientClientWithTimeout(config.PrivValidatorCoreRPCTimeout),
+		dashcore.ResilientClientWithMethodTimeout(dashcore.MethodQuorumSign, config.PrivValidatorCoreRPCSignTimeout),
+		dashcore.ResilientClientWithRetries(
+			config.PrivValidatorCoreRPCMaxRetries,
+			config.PrivValidatorCoreRPCRetryBackoff,
+		),
+		dashcore.ResilientClientWithCircuitBreaker(
+			config.PrivValidatorCoreRPCBreakerThreshold,
+			config.PrivValidatorCoreRPCBreakerCooldown,
+		),
+		dashcore.ResilientClientWithMetrics(metrics),
+		dashcore.ResilientClientWithLogger(logger),
+	), nil
+}
+
+// auxDashCoreRPCTimeout bounds the calls of the auxDashCoreRPCClient.
+const auxDashCoreRPCTimeout = 2 * time.Second
+
+// auxDashCoreRPCClient returns an RPC client for the Dash Core node for the
+// calls which must not delay or disturb signing, e.g. the ones made on behalf
+// of peers. Each call is a single attempt bounded by a short timeout, and its
+// failures don't open the circuit breaker of the client used for signing.
+func auxDashCoreRPCClient(config *cfg.Config, logger log.Logger) (dashcore.Client, error) {
+	rpcClient, err := dashcore.NewRPCClient(
+		config.PrivValidatorCoreRPCHost,
+		config.BaseConfig.PrivValidatorCoreRPCUsername,
+		config.BaseConfig.PrivValidatorCoreRPCPassword,
+		dashcore.RPCClientWithLogger(logger),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return dashcore.NewResilientClient(
+		rpcClient,
+		dashcore.ResilientClientWithTimeout(auxDashCoreRPCTimeout),
+		dashcore.ResilientClientWithRetries(0, 0),
+		dashcore.ResilientClientWithCircuitBreaker(
+			config.PrivValidatorCoreRPCBreakerThreshold,
+			config.PrivValidatorCoreRPCBreakerCooldown,
+		),
+		dashcore.ResilientClientWithLogger(logger),
+	), nil
 }
 
 // Option sets a parameter for the node.
@@ -276,7 +336,10 @@ type Node struct {
 	indexerService    *txindex.IndexerService
 	prometheusSrv     *http.Server
 
-	dashCoreRPCClient dashcore.Client
+	dashCoreRPCClient    dashcore.Client
+	dashCoreAuxRPCClient dashcore.Client              // for the calls on behalf of peers and RPC clients
+	validatorConns       *quorum.ValidatorConnManager // connects to the other members of the active quorum
+	trustStore           *trust.MetricStore           // trust metrics of the peers
 }
 
 func initDBs(
@@ -321,31 +384,59 @@ func createAndStartEventBus(logger log.Logger) (*types.EventBus, error) {
 
 func createAndStartIndexerService(
 	config *cfg.Config,
+	chainID string,
 	dbProvider DBProvider,
+	blockStore *store.BlockStore,
+	stateStore sm.Store,
+	stateDB dbm.DB,
 	eventBus *types.EventBus,
 	logger log.Logger,
+	metrics *txindex.Metrics,
 ) (*txindex.IndexerService, txindex.TxIndexer, indexer.BlockIndexer, error) {
 
-	var (
-		txIndexer    txindex.TxIndexer
-		blockIndexer indexer.BlockIndexer
-	)
+	var sinks []txindex.Sink
+	for _, name := range config.TxIndex.Indexer {
+		switch name {
+		case "kv":
+			store, err := dbProvider(&DBContext{"tx_index", config})
+			if err != nil {
+				return nil, nil, nil, err
+			}
 
-	switch config.TxIndex.Indexer {
-	case "kv":
-		store, err := dbProvider(&DBContext{"tx_index", config})
-		if err != nil {
-			return nil, nil, nil, err
+			sinks = append(sinks, txindex.Sink{
+				Name:         name,
+				TxIndexer:    kv.NewTxIndex(store),
+				BlockIndexer: blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events"))),
+				Closer:       store,
+			})
+		case "psql":
+			es, err := psql.NewEventSink(config.TxIndex.PsqlConn, chainID)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("creating psql indexer: %w", err)
+			}
+
+			sinks = append(sinks, txindex.Sink{
+				Name:         name,
+				TxIndexer:    es.TxIndexer(),
+				BlockIndexer: es.BlockIndexer(),
+				Closer:       es,
+			})
 		}
+	}
 
-		txIndexer = kv.NewTxIndex(store)
-		blockIndexer = blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")))
-	default:
-		txIndexer = &null.TxIndex{}
-		blockIndexer = &blockidxnull.BlockerIndexer{}
+	// the first indexer serves the searches of the RPC
+	var (
+		txIndexer    txindex.TxIndexer    = &null.TxIndex{}
+		blockIndexer indexer.BlockIndexer = &blockidxnull.BlockerIndexer{}
+	)
+	if len(sinks) > 0 {
+		txIndexer, blockIndexer = sinks[0].TxIndexer, sinks[0].BlockIndexer
 	}
 
-	indexerService := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus)
+	// the sinks catch up from the stores with the blocks they missed
+	indexerService := txindex.NewIndexerService(sinks, eventBus,
+		txindex.WithMetrics(metrics),
+		txindex.WithCatchUp(txindex.NewBlockEventsLoader(blockStore, stateStore), stateDB))
 	indexerService.SetLogger(logger.With("module", "txindex"))
 
 	if err := indexerService.Start(); err != nil {
@@ -399,19 +490,34 @@ func createMempoolAndMempoolReactor(
 	proxyApp proxy.AppConns,
 	state sm.State,
 	memplMetrics *mempl.Metrics,
+	eventBus *types.EventBus,
 	logger log.Logger,
-) (*mempl.Reactor, *mempl.CListMempool) {
-
-	mempool := mempl.NewCListMempool(
-		config.Mempool,
-		proxyApp.Mempool(),
-		state.LastBlockHeight,
-		mempl.WithMetrics(memplMetrics),
-		mempl.WithPreCheck(sm.TxPreCheck(state)),
-		mempl.WithPostCheck(sm.TxPostCheck(state)),
-	)
+) (*mempl.Reactor, mempl.GossipMempool) {
+
+	var mempool mempl.GossipMempool
+	switch config.Mempool.Type {
+	case cfg.MempoolTypePriority:
+		mempool = mempl.NewPriorityMempool(
+			config.Mempool,
+			proxyApp.Mempool(),
+			state.LastBlockHeight,
+			mempl.WithPriorityMetrics(memplMetrics),
+			mempl.WithPriorityPreCheck(sm.TxPreCheck(state)),
+			mempl.WithPriorityPostCheck(sm.TxPostCheck(state)),
+		)
+	default:
+		mempool = mempl.NewCListMempool(
+			config.Mempool,
+			proxyApp.Mempool(),
+			state.LastBlockHeight,
+			mempl.WithMetrics(memplMetrics),
+			mempl.WithPreCheck(sm.TxPreCheck(state)),
+			mempl.WithPostCheck(sm.TxPostCheck(state)),
+		)
+	}
+	mempool.SetEventBus(eventBus)
 	mempoolLogger := logger.With("module", "mempool")
-	mempoolReactor := mempl.NewReactor(config.Mempool, mempool)
+	mempoolReactor := mempl.NewReactor(config.Mempool, mempool, mempl.WithReactorMetrics(memplMetrics))
 	mempoolReactor.SetLogger(mempoolLogger)
 
 	if config.Consensus.WaitForTxs() {
@@ -487,7 +593,7 @@ func createConsensusReactor(config *cfg.Config,
 	state sm.State,
 	blockExec *sm.BlockExecutor,
 	blockStore sm.BlockStore,
-	mempool *mempl.CListMempool,
+	mempool mempl.Mempool,
 	evidencePool *evidence.Pool,
 	privValidator types.PrivValidator,
 	csMetrics *consensus.Metrics,
@@ -529,11 +635,16 @@ func createTransport(
 ) {
 	var (
 		mConnConfig = p2p.MConnConfig(config.P2P)
-		transport   = p2p.NewMultiplexTransport(nodeInfo, *nodeKey, mConnConfig)
+		transport   *p2p.MultiplexTransport
 		connFilters = []p2p.ConnFilterFunc{}
 		peerFilters = []p2p.PeerFilterFunc{}
 	)
 
+	transport = p2p.NewMultiplexTransport(nodeInfo, *nodeKey, mConnConfig)
+	if config.P2P.Transport == p2p.TransportStream {
+		p2p.MultiplexTransportStreams()(transport)
+	}
+
 	if !config.P2P.AllowDuplicateIP {
 		connFilters = append(connFilters, p2p.ConnDuplicateIPFilter())
 	}
@@ -589,9 +700,20 @@ func createTransport(
 	return transport, peerFilters
 }
 
+func createTrustMetricStore(config *cfg.Config, dbProvider DBProvider, logger log.Logger) (*trust.MetricStore, error) {
+	trustHistoryDB, err := dbProvider(&DBContext{"trusthistory", config})
+	if err != nil {
+		return nil, err
+	}
+	trustStore := trust.NewTrustMetricStore(trustHistoryDB, trust.DefaultConfig())
+	trustStore.SetLogger(logger)
+	return trustStore, nil
+}
+
 func createSwitch(config *cfg.Config,
 	transport p2p.Transport,
 	p2pMetrics *p2p.Metrics,
+	trustStore *trust.MetricStore,
 	peerFilters []p2p.PeerFilterFunc,
 	mempoolReactor *mempl.Reactor,
 	bcReactor p2p.Reactor,
@@ -607,6 +729,7 @@ func createSwitch(config *cfg.Config,
 		transport,
 		p2p.WithMetrics(p2pMetrics),
 		p2p.SwitchPeerFilters(peerFilters...),
+		p2p.SwitchTrustMetricStore(trustStore),
 	)
 	sw.SetLogger(p2pLogger)
 	sw.AddReactor("MEMPOOL", mempoolReactor)
@@ -770,6 +893,8 @@ func NewNode(config *cfg.Config,
 		return nil, err
 	}
 
+	csMetrics, p2pMetrics, memplMetrics, smMetrics, txindexMetrics := metricsProvider(genDoc.ChainID)
+
 	// EventBus and IndexerService must be started before the handshake because
 	// we might need to index the txs of the replayed block as this might not have happened
 	// when the node stopped last time (i.e. the node stopped after it saved the block
@@ -781,9 +906,14 @@ func NewNode(config *cfg.Config,
 
 	indexerService, txIndexer, blockIndexer, err := createAndStartIndexerService(
 		config,
+		genDoc.ChainID,
 		dbProvider,
+		blockStore,
+		stateStore,
+		stateDB,
 		eventBus,
 		logger,
+		txindexMetrics,
 	)
 	if err != nil {
 		return nil, err
@@ -805,7 +935,7 @@ func NewNode(config *cfg.Config,
 				llmqType = btcjson.LLMQType_100_67
 			}*/
 		if dashCoreRPCClient == nil {
-			rpcClient, err := DefaultDashCoreRPCClient(config)
+			rpcClient, err := DefaultDashCoreRPCClient(config, logger.With("module", "dashcore"))
 			if err != nil {
 				return nil, fmt.Errorf("failed to create Dash Core RPC client %w", err)
 			}
@@ -859,9 +989,10 @@ func NewNode(config *cfg.Config,
 			logger.Info("Connected to Private Validator through listen address")
 		}
 	default:
-		privValidator = privval.LoadOrGenFilePV(
+		privValidator = privval.LoadOrGenFilePVWithPassphrase(
 			config.PrivValidatorKeyFile(),
 			config.PrivValidatorStateFile(),
+			privval.DefaultKeyPassphrase(config.PrivValidatorKeyPassphraseFile()),
 		)
 		proTxHash, err := privValidator.GetProTxHash()
 		if err != nil {
@@ -883,6 +1014,16 @@ func NewNode(config *cfg.Config,
 
 	weAreOnlyValidator = onlyValidatorIsUs(state, proTxHashP)
 
+	// Dash Core is called on behalf of the peers and RPC clients through a
+	// client of its own, so that these calls can't delay or disturb signing
+	var dashCoreAuxRPCClient dashcore.Client
+	if config.PrivValidatorCoreRPCHost != "" {
+		dashCoreAuxRPCClient, err = auxDashCoreRPCClient(config, logger.With("module", "dashcore"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Dash Core RPC client %w", err)
+		}
+	}
+
 	// Determine whether we should attempt state sync.
 	stateSync := config.StateSync.Enable && !weAreOnlyValidator
 	if stateSync && state.LastBlockHeight > 0 {
@@ -924,14 +1065,13 @@ func NewNode(config *cfg.Config,
 
 	logNodeStartupInfo(state, proTxHashP, logger, consensusLogger)
 
-	csMetrics, p2pMetrics, memplMetrics, smMetrics := metricsProvider(genDoc.ChainID)
-
 	// Make Mempool Reactor
 	mempoolReactor, mempool := createMempoolAndMempoolReactor(
 		config,
 		proxyApp,
 		state,
 		memplMetrics,
+		eventBus,
 		logger,
 	)
 
@@ -1011,10 +1151,35 @@ func NewNode(config *cfg.Config,
 	// Setup Transport.
 	transport, peerFilters := createTransport(config, nodeInfo, nodeKey, proxyApp)
 
+	// Masternodes prove their proTxHash to the peers with their operator key,
+	// the claims of the peers are verified against the masternode list
+	var (
+		proTxHashSigner   p2p.ProTxHashSigner
+		proTxHashVerifier p2p.ProTxHashVerifier
+	)
+	if dashCoreAuxRPCClient != nil {
+		proTxHashVerifier = quorum.NewProTxHashVerifier(
+			dashCoreAuxRPCClient,
+			quorum.WithLogger(logger.With("module", "p2p")),
+		)
+	}
+	if proTxHashP != nil && config.PrivValidatorOperatorKeyFile() != "" {
+		operatorKey, err := quorum.LoadOperatorKey(config.PrivValidatorOperatorKeyFile())
+		if err != nil {
+			return nil, fmt.Errorf("failed to load operator key: %w", err)
+		}
+		proTxHashSigner = quorum.NewProTxHashSigner(operatorKey)
+	}
+	p2p.MultiplexTransportProTxHashAuth(proTxHashSigner, proTxHashVerifier)(transport)
+
 	// Setup Switch.
 	p2pLogger := logger.With("module", "p2p")
+	trustStore, err := createTrustMetricStore(config, dbProvider, p2pLogger.With("module", "trust"))
+	if err != nil {
+		return nil, fmt.Errorf("could not create trust metric store: %w", err)
+	}
 	sw := createSwitch(
-		config, transport, p2pMetrics, peerFilters, mempoolReactor, bcReactor,
+		config, transport, p2pMetrics, trustStore, peerFilters, mempoolReactor, bcReactor,
 		stateSyncReactor, consensusReactor, evidenceReactor, nodeInfo, nodeKey, p2pLogger,
 	)
 
@@ -1028,6 +1193,25 @@ func NewNode(config *cfg.Config,
 		return nil, fmt.Errorf("could not add peer ids from unconditional_peer_ids field: %w", err)
 	}
 
+	// Validators connect to the other members of the active quorum, which are
+	// resolved from the masternode list of Dash Core
+	var validatorConns *quorum.ValidatorConnManager
+	if config.P2P.ValidatorConnections && config.PrivValidatorCoreRPCHost != "" && proTxHashP != nil {
+		activeValidators := func() (*types.ValidatorSet, error) {
+			state, err := stateStore.Load()
+			return state.Validators, err
+		}
+		validatorConns = quorum.NewValidatorConnManager(
+			*proTxHashP,
+			sw,
+			eventBus,
+			activeValidators,
+			dashCoreRPCClient,
+			uint16(config.P2P.ValidatorP2PPort),
+		)
+		validatorConns.SetLogger(p2pLogger.With("module", "quorum"))
+	}
+
 	addrBook, err := createAddrBookAndSetOnSwitch(config, sw, p2pLogger, nodeKey)
 	if err != nil {
 		return nil, fmt.Errorf("could not create addrbook: %w", err)
@@ -1090,7 +1274,10 @@ func NewNode(config *cfg.Config,
 		blockIndexer:     blockIndexer,
 		eventBus:         eventBus,
 
-		dashCoreRPCClient: dashCoreRPCClient,
+		dashCoreRPCClient:    dashCoreRPCClient,
+		dashCoreAuxRPCClient: dashCoreAuxRPCClient,
+		validatorConns:       validatorConns,
+		trustStore:           trustStore,
 	}
 	node.BaseService = *service.NewBaseService(logger, "Node", node)
 
@@ -1146,6 +1333,14 @@ func (n *Node) OnStart() error {
 		if err != nil {
 			return fmt.Errorf("init mempool WAL: %w", err)
 		}
+		if err := n.mempool.ReplayWAL(n.blockStore); err != nil {
+			return fmt.Errorf("replay mempool WAL: %w", err)
+		}
+	}
+
+	// The trust metric store records the behaviour of the peers of the switch
+	if err := n.trustStore.Start(); err != nil {
+		return fmt.Errorf("failed to start trust metric store: %w", err)
 	}
 
 	// Start the switch (the P2P server).
@@ -1154,6 +1349,12 @@ func (n *Node) OnStart() error {
 		return err
 	}
 
+	if n.validatorConns != nil {
+		if err := n.validatorConns.Start(); err != nil {
+			return fmt.Errorf("failed to start validator connection manager: %w", err)
+		}
+	}
+
 	// Always connect to persistent peers
 	err = n.sw.DialPeersAsync(splitAndTrimEmpty(n.config.P2P.PersistentPeers, ",", " "))
 	if err != nil {
@@ -1200,11 +1401,21 @@ func (n *Node) OnStop() {
 		n.Logger.Error("Error closing indexerService", "err", err)
 	}
 
+	if n.validatorConns != nil {
+		if err := n.validatorConns.Stop(); err != nil {
+			n.Logger.Error("Error closing validator connection manager", "err", err)
+		}
+	}
+
 	// now stop the reactors
 	if err := n.sw.Stop(); err != nil {
 		n.Logger.Error("Error closing switch", "err", err)
 	}
 
+	if err := n.trustStore.Stop(); err != nil {
+		n.Logger.Error("Error closing trust metric store", "err", err)
+	}
+
 	// stop mempool WAL
 	if n.config.Mempool.WalEnabled() {
 		n.mempool.CloseWAL()
@@ -1271,6 +1482,13 @@ func (n *Node) ConfigureRPC() error {
 		env.ProTxHash = &proTxHash
 	}
 
+	if n.config.PrivValidatorCoreRPCHost != "" {
+		env.DashCoreRPCClient = n.dashCoreAuxRPCClient
+	}
+	if n.validatorConns != nil {
+		env.ValidatorConnections = n.validatorConns
+	}
+
 	rpccore.SetEnvironment(&env)
 	return nil
 }
@@ -1553,9 +1771,14 @@ func makeNodeInfo(
 		Other: p2p.DefaultNodeInfoOther{
 			TxIndex:    txIndexerStatus,
 			RPCAddress: config.RPC.ListenAddress,
+			Transport:  config.P2P.Transport,
 		},
 	}
 
+	if config.Mempool.PullGossip {
+		nodeInfo.Channels = append(nodeInfo.Channels, mempl.MempoolAnnounceChannel)
+	}
+
 	if config.P2P.PexReactor {
 		nodeInfo.Channels = append(nodeInfo.Channels, pex.PexChannel)
 	}
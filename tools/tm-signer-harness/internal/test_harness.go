@@ -3,6 +3,7 @@ package internal
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"net"
 	"os"
 	"os/signal"
@@ -11,6 +12,7 @@ import (
 	"github.com/dashevo/dashd-go/btcjson"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 
 	"github.com/tendermint/tendermint/crypto/ed25519"
 
@@ -28,19 +30,26 @@ import (
 
 // Test harness error codes (which act as exit codes when the test harness fails).
 const (
-	NoError                    int = iota // 0
-	ErrInvalidParameters                  // 1
-	ErrMaxAcceptRetriesReached            // 2
-	ErrFailedToLoadGenesisFile            // 3
-	ErrFailedToCreateListener             // 4
-	ErrFailedToStartListener              // 5
-	ErrInterrupted                        // 6
-	ErrOther                              // 7
-	ErrTestPublicKeyFailed                // 8
-	ErrTestSignProposalFailed             // 9
-	ErrTestSignVoteFailed                 // 10
+	NoError                         int = iota // 0
+	ErrInvalidParameters                       // 1
+	ErrMaxAcceptRetriesReached                 // 2
+	ErrFailedToLoadGenesisFile                 // 3
+	ErrFailedToCreateListener                  // 4
+	ErrFailedToStartListener                   // 5
+	ErrInterrupted                             // 6
+	ErrOther                                   // 7
+	ErrTestPublicKeyFailed                     // 8
+	ErrTestSignProposalFailed                  // 9
+	ErrTestSignVoteFailed                      // 10
+	ErrTestThresholdPublicKeyFailed            // 11
+	ErrTestQuorumsFailed                       // 12
+	ErrTestUpdatePrivateKeyFailed              // 13
 )
 
+// updatePrivateKeyHeight is the height from which the key staged by
+// TestUpdatePrivateKey is used, which is never reached.
+const updatePrivateKeyHeight = math.MaxInt64
+
 var voteTypes = []tmproto.SignedMsgType{tmproto.PrevoteType, tmproto.PrecommitType}
 
 // TestHarnessError allows us to keep track of which exit code should be used
@@ -65,6 +74,8 @@ type TestHarness struct {
 	logger           log.Logger
 	exitWhenComplete bool
 	exitCode         int
+
+	testUpdatePrivateKey bool
 }
 
 // TestHarnessConfig provides configuration to set up a remote signer test
@@ -72,9 +83,10 @@ type TestHarness struct {
 type TestHarnessConfig struct {
 	BindAddr string
 
-	KeyFile     string
-	StateFile   string
-	GenesisFile string
+	KeyFile           string
+	KeyPassphraseFile string // Holds the passphrase of an encrypted key file, if set.
+	StateFile         string
+	GenesisFile       string
 
 	AcceptDeadline time.Duration
 	ConnDeadline   time.Duration
@@ -83,6 +95,10 @@ type TestHarnessConfig struct {
 	SecretConnKey ed25519.PrivKey
 
 	ExitWhenComplete bool // Whether or not to call os.Exit when the harness has completed.
+
+	// Whether or not to stage a throwaway private key on the remote signer,
+	// which keeps it afterwards.
+	TestUpdatePrivateKey bool
 }
 
 // timeoutError can be used to check if an error returned from the netp package
@@ -98,9 +114,10 @@ func NewTestHarness(logger log.Logger, cfg TestHarnessConfig) (*TestHarness, err
 	keyFile := ExpandPath(cfg.KeyFile)
 	stateFile := ExpandPath(cfg.StateFile)
 	logger.Info("Loading private validator configuration", "keyFile", keyFile, "stateFile", stateFile)
-	// NOTE: LoadFilePV ultimately calls os.Exit on failure. No error will be
-	// returned if this call fails.
-	fpv := privval.LoadFilePV(keyFile, stateFile)
+	// NOTE: LoadFilePVWithPassphrase ultimately calls os.Exit on failure. No
+	// error will be returned if this call fails.
+	fpv := privval.LoadFilePVWithPassphrase(keyFile, stateFile,
+		privval.DefaultKeyPassphrase(ExpandPath(cfg.KeyPassphraseFile)))
 
 	genesisFile := ExpandPath(cfg.GenesisFile)
 	logger.Info("Loading chain ID from genesis file", "genesisFile", genesisFile)
@@ -130,6 +147,8 @@ func NewTestHarness(logger log.Logger, cfg TestHarnessConfig) (*TestHarness, err
 		logger:           logger,
 		exitWhenComplete: cfg.ExitWhenComplete,
 		exitCode:         0,
+
+		testUpdatePrivateKey: cfg.TestUpdatePrivateKey,
 	}, nil
 }
 
@@ -182,6 +201,14 @@ func (th *TestHarness) Run() {
 		th.Shutdown(err)
 		return
 	}
+	if err := th.TestThresholdPublicKey(); err != nil {
+		th.Shutdown(err)
+		return
+	}
+	if err := th.TestQuorums(); err != nil {
+		th.Shutdown(err)
+		return
+	}
 	if err := th.TestSignProposal(); err != nil {
 		th.Shutdown(err)
 		return
@@ -190,6 +217,12 @@ func (th *TestHarness) Run() {
 		th.Shutdown(err)
 		return
 	}
+	if th.testUpdatePrivateKey {
+		if err := th.TestUpdatePrivateKey(); err != nil {
+			th.Shutdown(err)
+			return
+		}
+	}
 	th.logger.Info("SUCCESS! All tests passed.")
 	th.Shutdown(nil)
 }
@@ -216,6 +249,78 @@ func (th *TestHarness) TestPublicKey() error {
 	return nil
 }
 
+// TestThresholdPublicKey validates that the threshold public key of the quorum
+// reported by the remote signer matches the one we've configured locally.
+func (th *TestHarness) TestThresholdPublicKey() error {
+	th.logger.Info("TEST: Threshold public key of remote signer")
+	fpvk, err := th.fpv.GetThresholdPublicKey(th.quorumHash)
+	if err != nil {
+		return err
+	}
+	th.logger.Info("Local", "thresholdPublicKey", fpvk)
+	sck, err := th.signerClient.GetThresholdPublicKey(th.quorumHash)
+	if err != nil {
+		th.logger.Error("FAILED: Getting threshold public key", "err", err)
+		return newTestHarnessError(ErrTestThresholdPublicKeyFailed, err, "")
+	}
+	th.logger.Info("Remote", "thresholdPublicKey", sck)
+	if !bytes.Equal(fpvk.Bytes(), sck.Bytes()) {
+		th.logger.Error("FAILED: Local and remote threshold public keys do not match")
+		return newTestHarnessError(ErrTestThresholdPublicKeyFailed, nil, "")
+	}
+	return nil
+}
+
+// TestQuorums validates that the remote signer lists the quorum of the
+// genesis file, and that it reports the same activation heights of quorums as
+// we've configured locally.
+func (th *TestHarness) TestQuorums() error {
+	th.logger.Info("TEST: Quorums of remote signer")
+	quorumHashes, err := th.signerClient.GetQuorumHashes()
+	if err != nil {
+		th.logger.Error("FAILED: Listing quorums", "err", err)
+		return newTestHarnessError(ErrTestQuorumsFailed, err, "")
+	}
+	th.logger.Info("Remote", "quorumHashes", quorumHashes)
+	if !containsQuorumHash(quorumHashes, th.quorumHash) {
+		th.logger.Error("FAILED: Remote signer doesn't list the quorum of the genesis file")
+		return newTestHarnessError(ErrTestQuorumsFailed, nil, fmt.Sprintf("quorumHash=%s", th.quorumHash))
+	}
+
+	firstQuorumHash, err := th.signerClient.GetFirstQuorumHash()
+	if err != nil {
+		th.logger.Error("FAILED: Getting first quorum hash", "err", err)
+		return newTestHarnessError(ErrTestQuorumsFailed, err, "")
+	}
+	if !containsQuorumHash(quorumHashes, firstQuorumHash) {
+		th.logger.Error("FAILED: First quorum hash is not listed", "quorumHash", firstQuorumHash)
+		return newTestHarnessError(ErrTestQuorumsFailed, nil, fmt.Sprintf("quorumHash=%s", firstQuorumHash))
+	}
+
+	localQuorumHashes, err := th.fpv.GetQuorumHashes()
+	if err != nil {
+		return err
+	}
+	for _, quorumHash := range localQuorumHashes {
+		localHeight, err := th.fpv.GetHeight(quorumHash)
+		if err != nil {
+			// no activation height configured locally
+			continue
+		}
+		height, err := th.signerClient.GetHeight(quorumHash)
+		if err != nil {
+			th.logger.Error("FAILED: Getting height of quorum", "quorumHash", quorumHash, "err", err)
+			return newTestHarnessError(ErrTestQuorumsFailed, err, fmt.Sprintf("quorumHash=%s", quorumHash))
+		}
+		if height != localHeight {
+			th.logger.Error("FAILED: Local and remote heights of quorum do not match",
+				"quorumHash", quorumHash, "local", localHeight, "remote", height)
+			return newTestHarnessError(ErrTestQuorumsFailed, nil, fmt.Sprintf("quorumHash=%s", quorumHash))
+		}
+	}
+	return nil
+}
+
 // TestSignProposal makes sure the remote signer can successfully sign
 // proposals.
 func (th *TestHarness) TestSignProposal() error {
@@ -328,6 +433,58 @@ func (th *TestHarness) TestSignVote() error {
 	return nil
 }
 
+// TestUpdatePrivateKey makes sure the remote signer accepts a key staged for
+// an upcoming quorum, and reports it afterwards. The staged key is random and
+// belongs to a random quorum, from a height which is never reached, so it is
+// never used to sign anything. As the remote signer keeps it though, this test
+// only runs if it is enabled in the TestHarnessConfig.
+func (th *TestHarness) TestUpdatePrivateKey() error {
+	th.logger.Info("TEST: Staging a private key for an upcoming quorum")
+	quorumHash := crypto.RandQuorumHash()
+	privKey := bls12381.GenPrivKey()
+	thresholdPublicKey := bls12381.GenPrivKey().PubKey()
+	th.signerClient.UpdatePrivateKey(privKey, quorumHash, thresholdPublicKey, updatePrivateKeyHeight)
+
+	sck, err := th.signerClient.GetPubKey(quorumHash)
+	if err != nil {
+		th.logger.Error("FAILED: Getting public key of staged quorum", "err", err)
+		return newTestHarnessError(ErrTestUpdatePrivateKeyFailed, err, "")
+	}
+	if !bytes.Equal(privKey.PubKey().Bytes(), sck.Bytes()) {
+		th.logger.Error("FAILED: Staged and remote public keys do not match")
+		return newTestHarnessError(ErrTestUpdatePrivateKeyFailed, nil, "public key mismatch")
+	}
+	sck, err = th.signerClient.GetThresholdPublicKey(quorumHash)
+	if err != nil {
+		th.logger.Error("FAILED: Getting threshold public key of staged quorum", "err", err)
+		return newTestHarnessError(ErrTestUpdatePrivateKeyFailed, err, "")
+	}
+	if !bytes.Equal(thresholdPublicKey.Bytes(), sck.Bytes()) {
+		th.logger.Error("FAILED: Staged and remote threshold public keys do not match")
+		return newTestHarnessError(ErrTestUpdatePrivateKeyFailed, nil, "threshold public key mismatch")
+	}
+	height, err := th.signerClient.GetHeight(quorumHash)
+	if err != nil {
+		th.logger.Error("FAILED: Getting height of staged quorum", "err", err)
+		return newTestHarnessError(ErrTestUpdatePrivateKeyFailed, err, "")
+	}
+	if height != updatePrivateKeyHeight {
+		th.logger.Error("FAILED: Staged and remote heights do not match", "remote", height)
+		return newTestHarnessError(ErrTestUpdatePrivateKeyFailed, nil, "height mismatch")
+	}
+	th.logger.Info("Successfully staged private key", "quorumHash", quorumHash)
+	return nil
+}
+
+func containsQuorumHash(quorumHashes []crypto.QuorumHash, quorumHash crypto.QuorumHash) bool {
+	for _, qh := range quorumHashes {
+		if bytes.Equal(qh, quorumHash) {
+			return true
+		}
+	}
+	return false
+}
+
 // Shutdown will kill the test harness and attempt to close all open sockets
 // gracefully. If the supplied error is nil, it is assumed that the exit code
 // should be 0. If err is not nil, it will exit with an exit code related to the
@@ -430,6 +587,12 @@ func (e *TestHarnessError) Error() string {
 		msg = "Proposal signing validation test failed"
 	case ErrTestSignVoteFailed:
 		msg = "Vote signing validation test failed"
+	case ErrTestThresholdPublicKeyFailed:
+		msg = "Threshold public key validation test failed"
+	case ErrTestQuorumsFailed:
+		msg = "Quorums validation test failed"
+	case ErrTestUpdatePrivateKeyFailed:
+		msg = "Private key update test failed"
 	default:
 		msg = "Unknown error"
 	}
@@ -119,6 +119,28 @@ func TestRemoteSignerTestHarnessSuccessfulRun(t *testing.T) {
 	)
 }
 
+func TestRemoteSignerTestHarnessUpdatePrivateKey(t *testing.T) {
+	cfg := makeConfig(t, 100, 3)
+	cfg.TestUpdatePrivateKey = true
+	harnessTestWithConfig(
+		t,
+		cfg,
+		func(th *TestHarness) *privval.SignerServer {
+			privKey, err := th.fpv.Key.PrivateKeyForQuorumHash(th.quorumHash)
+			if err != nil {
+				panic(err)
+			}
+			thresholdPublicKey, err := th.fpv.Key.ThresholdPublicKeyForQuorumHash(th.quorumHash)
+			if err != nil {
+				panic(err)
+			}
+			return newMockSignerServer(t, th, privKey, th.fpv.Key.ProTxHash, th.quorumHash, thresholdPublicKey,
+				false, false)
+		},
+		NoError,
+	)
+}
+
 func TestRemoteSignerPublicKeyCheckFailed(t *testing.T) {
 	harnessTest(
 		t,
@@ -134,6 +156,21 @@ func TestRemoteSignerPublicKeyCheckFailed(t *testing.T) {
 	)
 }
 
+func TestRemoteSignerThresholdPublicKeyCheckFailed(t *testing.T) {
+	harnessTest(
+		t,
+		func(th *TestHarness) *privval.SignerServer {
+			privKey, err := th.fpv.Key.PrivateKeyForQuorumHash(th.quorumHash)
+			if err != nil {
+				panic(err)
+			}
+			return newMockSignerServer(t, th, privKey, th.fpv.Key.ProTxHash, th.quorumHash,
+				bls12381.GenPrivKey().PubKey(), false, false)
+		},
+		ErrTestThresholdPublicKeyFailed,
+	)
+}
+
 func TestRemoteSignerProposalSigningFailed(t *testing.T) {
 	harnessTest(
 		t,
@@ -199,7 +236,15 @@ func newMockSignerServer(
 
 // For running relatively standard tests.
 func harnessTest(t *testing.T, signerServerMaker func(th *TestHarness) *privval.SignerServer, expectedExitCode int) {
-	cfg := makeConfig(t, 100, 3)
+	harnessTestWithConfig(t, makeConfig(t, 100, 3), signerServerMaker, expectedExitCode)
+}
+
+func harnessTestWithConfig(
+	t *testing.T,
+	cfg TestHarnessConfig,
+	signerServerMaker func(th *TestHarness) *privval.SignerServer,
+	expectedExitCode int,
+) {
 	defer cleanup(cfg)
 
 	th, err := NewTestHarness(log.TestingLogger(), cfg)
@@ -32,6 +32,8 @@ var (
 	flagBindAddr      string
 	flagTMHome        string
 	flagKeyOutputPath string
+	flagKeyPassphrase string
+	flagUpdatePrivKey bool
 )
 
 // Command line commands
@@ -67,6 +69,14 @@ Use "tm-signer-harness help <command>" for more information about that command.`
 		"The number of attempts to listen for incoming connections")
 	runCmd.StringVar(&flagBindAddr, "addr", defaultBindAddr, "Bind to this address for the testing")
 	runCmd.StringVar(&flagTMHome, "tmhome", defaultTMHome, "Path to the Tendermint home directory")
+	runCmd.StringVar(&flagKeyPassphrase,
+		"key-passphrase",
+		"",
+		"Path to the file holding the passphrase of an encrypted private validator key")
+	runCmd.BoolVar(&flagUpdatePrivKey,
+		"test-update-private-key",
+		false,
+		"Also test staging a throwaway private key on the remote signer, which keeps it afterwards")
 	runCmd.Usage = func() {
 		fmt.Println(`Runs the remote signer test harness for Tendermint.
 
@@ -84,6 +94,10 @@ Flags:`)
 		defaultExtractKeyOutput,
 		"Path to which signing key should be written")
 	extractKeyCmd.StringVar(&flagTMHome, "tmhome", defaultTMHome, "Path to the Tendermint home directory")
+	extractKeyCmd.StringVar(&flagKeyPassphrase,
+		"key-passphrase",
+		"",
+		"Path to the file holding the passphrase of an encrypted private validator key")
 	extractKeyCmd.Usage = func() {
 		fmt.Println(`Extracts a signing key from a local Tendermint instance for use in the remote
 signer under test.
@@ -107,18 +121,21 @@ Usage:
 	}
 }
 
-func runTestHarness(acceptRetries int, bindAddr, tmhome string) {
+func runTestHarness(acceptRetries int, bindAddr, tmhome, keyPassphraseFile string, updatePrivKey bool) {
 	tmhome = internal.ExpandPath(tmhome)
 	cfg := internal.TestHarnessConfig{
-		BindAddr:         bindAddr,
-		KeyFile:          filepath.Join(tmhome, "config", "priv_validator_key.json"),
-		StateFile:        filepath.Join(tmhome, "data", "priv_validator_state.json"),
-		GenesisFile:      filepath.Join(tmhome, "config", "genesis.json"),
-		AcceptDeadline:   time.Duration(defaultAcceptDeadline) * time.Second,
-		AcceptRetries:    acceptRetries,
-		ConnDeadline:     time.Duration(defaultConnDeadline) * time.Second,
-		SecretConnKey:    ed25519.GenPrivKey(),
-		ExitWhenComplete: true,
+		BindAddr:          bindAddr,
+		KeyFile:           filepath.Join(tmhome, "config", "priv_validator_key.json"),
+		KeyPassphraseFile: keyPassphraseFile,
+		StateFile:         filepath.Join(tmhome, "data", "priv_validator_state.json"),
+		GenesisFile:       filepath.Join(tmhome, "config", "genesis.json"),
+		AcceptDeadline:    time.Duration(defaultAcceptDeadline) * time.Second,
+		AcceptRetries:     acceptRetries,
+		ConnDeadline:      time.Duration(defaultConnDeadline) * time.Second,
+		SecretConnKey:     ed25519.GenPrivKey(),
+		ExitWhenComplete:  true,
+
+		TestUpdatePrivateKey: updatePrivKey,
 	}
 	harness, err := internal.NewTestHarness(logger, cfg)
 	if err != nil {
@@ -131,10 +148,11 @@ func runTestHarness(acceptRetries int, bindAddr, tmhome string) {
 	harness.Run()
 }
 
-func extractKey(tmhome, outputPath string) {
+func extractKey(tmhome, keyPassphraseFile, outputPath string) {
 	keyFile := filepath.Join(internal.ExpandPath(tmhome), "config", "priv_validator_key.json")
 	stateFile := filepath.Join(internal.ExpandPath(tmhome), "data", "priv_validator_state.json")
-	fpv := privval.LoadFilePV(keyFile, stateFile)
+	fpv := privval.LoadFilePVWithPassphrase(keyFile, stateFile,
+		privval.DefaultKeyPassphrase(internal.ExpandPath(keyPassphraseFile)))
 	quorumHash, _ := fpv.GetFirstQuorumHash()
 	privKey, _ := fpv.Key.PrivateKeyForQuorumHash(quorumHash)
 	pkb := privKey.Bytes()
@@ -175,13 +193,13 @@ func main() {
 			fmt.Printf("Error parsing flags: %v\n", err)
 			os.Exit(1)
 		}
-		runTestHarness(flagAcceptRetries, flagBindAddr, flagTMHome)
+		runTestHarness(flagAcceptRetries, flagBindAddr, flagTMHome, flagKeyPassphrase, flagUpdatePrivKey)
 	case "extract_key":
 		if err := extractKeyCmd.Parse(os.Args[2:]); err != nil {
 			fmt.Printf("Error parsing flags: %v\n", err)
 			os.Exit(1)
 		}
-		extractKey(flagTMHome, flagKeyOutputPath)
+		extractKey(flagTMHome, flagKeyPassphrase, flagKeyOutputPath)
 	case "version":
 		fmt.Println(version.TMCoreSemVer)
 	default:
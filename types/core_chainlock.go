@@ -5,7 +5,10 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/dashevo/dashd-go/btcjson"
+
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
@@ -60,6 +63,16 @@ func (cl CoreChainLock) RequestID() []byte {
 	return crypto.Sha256(crypto.Sha256(s))
 }
 
+// SignID returns the signID that the given quorum signs for this Chain Lock
+func (cl CoreChainLock) SignID(quorumType btcjson.LLMQType, quorumHash crypto.QuorumHash) []byte {
+	return crypto.SignID(
+		quorumType,
+		bls12381.ReverseBytes(quorumHash),
+		bls12381.ReverseBytes(cl.RequestID()),
+		bls12381.ReverseBytes(cl.CoreBlockHash),
+	)
+}
+
 // ValidateBasic performs stateless validation on a Chain Lock returning an error
 // if any validation fails.
 // It does not verify the signature
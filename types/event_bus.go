@@ -191,6 +191,39 @@ func (b *EventBus) PublishEventTx(data EventDataTx) error {
 	return b.pubsub.PublishWithEvents(ctx, data, events)
 }
 
+// PublishEventMempoolTxAdded publishes the addition of a tx to the mempool,
+// along with the hash of the tx (TxHashKey).
+func (b *EventBus) PublishEventMempoolTxAdded(data EventDataMempoolTx) error {
+	return b.publishMempoolTx(EventMempoolTxAdded, data)
+}
+
+// PublishEventMempoolTxEvicted publishes the eviction of a tx from the mempool,
+// along with the hash of the tx (TxHashKey).
+func (b *EventBus) PublishEventMempoolTxEvicted(data EventDataMempoolTx) error {
+	return b.publishMempoolTx(EventMempoolTxEvicted, data)
+}
+
+// PublishEventMempoolTxRejected publishes the rejection of a tx by the
+// mempool, along with the hash of the tx (TxHashKey).
+func (b *EventBus) PublishEventMempoolTxRejected(data EventDataMempoolTx) error {
+	return b.publishMempoolTx(EventMempoolTxRejected, data)
+}
+
+// PublishEventMempoolTxRemoved publishes the removal of a committed or no
+// longer valid tx from the mempool, along with the hash of the tx (TxHashKey).
+func (b *EventBus) PublishEventMempoolTxRemoved(data EventDataMempoolTx) error {
+	return b.publishMempoolTx(EventMempoolTxRemoved, data)
+}
+
+func (b *EventBus) publishMempoolTx(eventType string, data EventDataMempoolTx) error {
+	// no explicit deadline for publishing events
+	ctx := context.Background()
+	return b.pubsub.PublishWithEvents(ctx, data, map[string][]string{
+		EventTypeKey: {eventType},
+		TxHashKey:    {data.Hash.String()},
+	})
+}
+
 func (b *EventBus) PublishEventNewRoundStep(data EventDataRoundState) error {
 	return b.Publish(EventNewRoundStep, data)
 }
@@ -271,6 +304,22 @@ func (NopEventBus) PublishEventTx(data EventDataTx) error {
 	return nil
 }
 
+func (NopEventBus) PublishEventMempoolTxAdded(data EventDataMempoolTx) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventMempoolTxEvicted(data EventDataMempoolTx) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventMempoolTxRejected(data EventDataMempoolTx) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventMempoolTxRemoved(data EventDataMempoolTx) error {
+	return nil
+}
+
 func (NopEventBus) PublishEventNewRoundStep(data EventDataRoundState) error {
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
 	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
@@ -37,6 +38,14 @@ const (
 	EventValidBlock       = "ValidBlock"
 	EventVote             = "Vote"
 	EventCommit           = "Commit"
+
+	// Mempool events.
+	// These are triggered from the mempool when txs enter or leave it, or are
+	// rejected by it.
+	EventMempoolTxAdded    = "MempoolTxAdded"
+	EventMempoolTxEvicted  = "MempoolTxEvicted"
+	EventMempoolTxRejected = "MempoolTxRejected"
+	EventMempoolTxRemoved  = "MempoolTxRemoved"
 )
 
 // ENCODING / DECODING
@@ -57,6 +66,7 @@ func init() {
 	tmjson.RegisterType(EventDataVote{}, "tendermint/event/Vote")
 	tmjson.RegisterType(EventDataValidatorSetUpdates{}, "tendermint/event/ValidatorSetUpdates")
 	tmjson.RegisterType(EventDataString(""), "tendermint/event/ProposalString")
+	tmjson.RegisterType(EventDataMempoolTx{}, "tendermint/event/MempoolTx")
 }
 
 // Most event messages are basic types (a block, a transaction)
@@ -130,6 +140,30 @@ type EventDataValidatorSetUpdates struct {
 	ValidatorUpdates []*Validator `json:"validator_updates"`
 }
 
+// Reasons for a tx to leave the mempool, or to be rejected by it.
+const (
+	// MempoolTxExpired is the reason of txs evicted after the TTL of the mempool.
+	MempoolTxExpired = "expired"
+	// MempoolTxLowPriority is the reason of txs evicted to make room for a tx
+	// with a higher priority.
+	MempoolTxLowPriority = "low_priority"
+	// MempoolTxCommitted is the reason of txs removed once committed in a block.
+	MempoolTxCommitted = "committed"
+	// MempoolTxRecheckFailed is the reason of txs removed after they became
+	// invalid when rechecked.
+	MempoolTxRecheckFailed = "recheck_failed"
+	// MempoolTxMempoolFull is the reason of txs rejected by a full mempool.
+	MempoolTxMempoolFull = "mempool_full"
+)
+
+// EventDataMempoolTx is the data of the mempool events of a tx.
+type EventDataMempoolTx struct {
+	Hash tmbytes.HexBytes `json:"hash"`
+	// sender given by the app in CheckTx, if any
+	Sender string `json:"sender,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
 // PUBSUB
 
 const (
@@ -150,6 +184,10 @@ const (
 var (
 	EventQueryCompleteProposal    = QueryForEvent(EventCompleteProposal)
 	EventQueryLock                = QueryForEvent(EventLock)
+	EventQueryMempoolTxAdded      = QueryForEvent(EventMempoolTxAdded)
+	EventQueryMempoolTxEvicted    = QueryForEvent(EventMempoolTxEvicted)
+	EventQueryMempoolTxRejected   = QueryForEvent(EventMempoolTxRejected)
+	EventQueryMempoolTxRemoved    = QueryForEvent(EventMempoolTxRemoved)
 	EventQueryNewBlock            = QueryForEvent(EventNewBlock)
 	EventQueryNewBlockHeader      = QueryForEvent(EventNewBlockHeader)
 	EventQueryNewEvidence         = QueryForEvent(EventNewEvidence)
@@ -186,3 +224,11 @@ type BlockEventPublisher interface {
 type TxEventPublisher interface {
 	PublishEventTx(EventDataTx) error
 }
+
+// MempoolEventPublisher publishes the events of txs in the mempool
+type MempoolEventPublisher interface {
+	PublishEventMempoolTxAdded(EventDataMempoolTx) error
+	PublishEventMempoolTxEvicted(EventDataMempoolTx) error
+	PublishEventMempoolTxRejected(EventDataMempoolTx) error
+	PublishEventMempoolTxRemoved(EventDataMempoolTx) error
+}
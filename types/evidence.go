@@ -184,6 +184,310 @@ func DuplicateVoteEvidenceFromProto(pb *tmproto.DuplicateVoteEvidence) (*Duplica
 
 //------------------------------------------------------------------------------------------
 
+// DuplicateStateVoteEvidence contains evidence of a single validator signing two conflicting
+// state IDs at the same height. Unlike block votes, the state signature does not depend on the
+// round or the type of the vote, so the votes may come from different rounds and steps.
+type DuplicateStateVoteEvidence struct {
+	VoteA *Vote `json:"vote_a"`
+	VoteB *Vote `json:"vote_b"`
+
+	// abci specific information
+	TotalVotingPower int64
+	ValidatorPower   int64
+	Timestamp        time.Time
+}
+
+var _ Evidence = &DuplicateStateVoteEvidence{}
+
+// NewDuplicateStateVoteEvidence creates DuplicateStateVoteEvidence with right ordering given
+// two conflicting votes. If one of the votes is nil, evidence returned is nil as well
+func NewDuplicateStateVoteEvidence(
+	vote1, vote2 *Vote,
+	blockTime time.Time,
+	valSet *ValidatorSet,
+) *DuplicateStateVoteEvidence {
+	var voteA, voteB *Vote
+	if vote1 == nil || vote2 == nil || valSet == nil {
+		return nil
+	}
+	idx, val := valSet.GetByProTxHash(vote1.ValidatorProTxHash)
+	if idx == -1 {
+		return nil
+	}
+
+	if strings.Compare(vote1.StateID.Key(), vote2.StateID.Key()) == -1 {
+		voteA = vote1
+		voteB = vote2
+	} else {
+		voteA = vote2
+		voteB = vote1
+	}
+	return &DuplicateStateVoteEvidence{
+		VoteA:            voteA,
+		VoteB:            voteB,
+		TotalVotingPower: valSet.TotalVotingPower(),
+		ValidatorPower:   val.VotingPower,
+		Timestamp:        blockTime,
+	}
+}
+
+// ABCI returns the application relevant representation of the evidence
+func (dse *DuplicateStateVoteEvidence) ABCI() []abci.Evidence {
+	return []abci.Evidence{{
+		Type: abci.EvidenceType_DUPLICATE_STATE_VOTE,
+		Validator: abci.Validator{
+			ProTxHash: dse.VoteA.ValidatorProTxHash,
+			Power:     dse.ValidatorPower,
+		},
+		Height:           dse.VoteA.Height,
+		Time:             dse.Timestamp,
+		TotalVotingPower: dse.TotalVotingPower,
+	}}
+}
+
+// Bytes returns the proto-encoded evidence as a byte array.
+func (dse *DuplicateStateVoteEvidence) Bytes() []byte {
+	pbe := dse.ToProto()
+	bz, err := pbe.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// Hash returns the hash of the evidence.
+func (dse *DuplicateStateVoteEvidence) Hash() []byte {
+	return tmhash.Sum(dse.Bytes())
+}
+
+// Height returns the height of the infraction
+func (dse *DuplicateStateVoteEvidence) Height() int64 {
+	return dse.VoteA.Height
+}
+
+// String returns a string representation of the evidence.
+func (dse *DuplicateStateVoteEvidence) String() string {
+	return fmt.Sprintf("DuplicateStateVoteEvidence{VoteA: %v, VoteB: %v}", dse.VoteA, dse.VoteB)
+}
+
+// Time returns the time of the infraction
+func (dse *DuplicateStateVoteEvidence) Time() time.Time {
+	return dse.Timestamp
+}
+
+// ValidateBasic performs basic validation.
+func (dse *DuplicateStateVoteEvidence) ValidateBasic() error {
+	if dse == nil {
+		return errors.New("empty duplicate state vote evidence")
+	}
+
+	if dse.VoteA == nil || dse.VoteB == nil {
+		return fmt.Errorf("one or both of the votes are empty %v, %v", dse.VoteA, dse.VoteB)
+	}
+	if err := dse.VoteA.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid VoteA: %w", err)
+	}
+	if err := dse.VoteB.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid VoteB: %w", err)
+	}
+	// Votes for nil don't sign the state
+	if dse.VoteA.BlockID.IsZero() || dse.VoteB.BlockID.IsZero() {
+		return errors.New("one or both of the votes are for nil and carry no state signature")
+	}
+	// Enforce Votes are lexicographically sorted on stateID
+	if strings.Compare(dse.VoteA.StateID.Key(), dse.VoteB.StateID.Key()) >= 0 {
+		return errors.New("duplicate state votes in invalid order")
+	}
+	return nil
+}
+
+// ToProto encodes DuplicateStateVoteEvidence to protobuf
+func (dse *DuplicateStateVoteEvidence) ToProto() *tmproto.DuplicateStateVoteEvidence {
+	voteB := dse.VoteB.ToProto()
+	voteA := dse.VoteA.ToProto()
+	tp := tmproto.DuplicateStateVoteEvidence{
+		VoteA:            voteA,
+		VoteB:            voteB,
+		TotalVotingPower: dse.TotalVotingPower,
+		ValidatorPower:   dse.ValidatorPower,
+		Timestamp:        dse.Timestamp,
+	}
+	return &tp
+}
+
+// DuplicateStateVoteEvidenceFromProto decodes protobuf into DuplicateStateVoteEvidence
+func DuplicateStateVoteEvidenceFromProto(pb *tmproto.DuplicateStateVoteEvidence) (*DuplicateStateVoteEvidence, error) {
+	if pb == nil {
+		return nil, errors.New("nil duplicate state vote evidence")
+	}
+
+	vA, err := VoteFromProto(pb.VoteA)
+	if err != nil {
+		return nil, err
+	}
+
+	vB, err := VoteFromProto(pb.VoteB)
+	if err != nil {
+		return nil, err
+	}
+
+	dse := &DuplicateStateVoteEvidence{
+		VoteA:            vA,
+		VoteB:            vB,
+		TotalVotingPower: pb.TotalVotingPower,
+		ValidatorPower:   pb.ValidatorPower,
+		Timestamp:        pb.Timestamp,
+	}
+
+	return dse, dse.ValidateBasic()
+}
+
+//------------------------------------------------------------------------------------------
+
+// LightClientAttackEvidence contains evidence of a quorum signing a block that conflicts
+// with the block committed at the same height. The conflicting block carries a valid
+// threshold signature of the quorum, so at least the threshold of its members signed both
+// blocks. As threshold signatures do not reveal the signers, every member of the quorum is
+// considered byzantine.
+type LightClientAttackEvidence struct {
+	ConflictingBlock *LightBlock `json:"conflicting_block"`
+
+	// abci specific information
+	TotalVotingPower int64     // total voting power of the validator set at the height of the conflicting block
+	Timestamp        time.Time // timestamp of the block committed at the height of the conflicting block
+}
+
+var _ Evidence = &LightClientAttackEvidence{}
+
+// NewLightClientAttackEvidence creates LightClientAttackEvidence for the conflicting block
+// given the time of the block committed at the same height. If the block is nil, evidence
+// returned is nil as well
+func NewLightClientAttackEvidence(conflictingBlock *LightBlock, blockTime time.Time) *LightClientAttackEvidence {
+	if conflictingBlock == nil || conflictingBlock.ValidatorSet == nil {
+		return nil
+	}
+	return &LightClientAttackEvidence{
+		ConflictingBlock: conflictingBlock,
+		TotalVotingPower: conflictingBlock.ValidatorSet.TotalVotingPower(),
+		Timestamp:        blockTime,
+	}
+}
+
+// ABCI returns the application relevant representation of the evidence: one
+// abci.Evidence for every member of the quorum that signed the conflicting block
+func (l *LightClientAttackEvidence) ABCI() []abci.Evidence {
+	abciEv := make([]abci.Evidence, len(l.ConflictingBlock.ValidatorSet.Validators))
+	for idx, val := range l.ConflictingBlock.ValidatorSet.Validators {
+		abciEv[idx] = abci.Evidence{
+			Type: abci.EvidenceType_LIGHT_CLIENT_ATTACK,
+			Validator: abci.Validator{
+				ProTxHash: val.ProTxHash,
+				Power:     val.VotingPower,
+			},
+			Height:           l.Height(),
+			Time:             l.Timestamp,
+			TotalVotingPower: l.TotalVotingPower,
+		}
+	}
+	return abciEv
+}
+
+// Bytes returns the proto-encoded evidence as a byte array
+func (l *LightClientAttackEvidence) Bytes() []byte {
+	pbe, err := l.ToProto()
+	if err != nil {
+		panic(err)
+	}
+	bz, err := pbe.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// Hash returns the hash of the header of the conflicting block. Evidence of the
+// same conflicting block is considered the same evidence.
+func (l *LightClientAttackEvidence) Hash() []byte {
+	return tmhash.Sum(l.ConflictingBlock.Hash())
+}
+
+// Height returns the height of the conflicting block
+func (l *LightClientAttackEvidence) Height() int64 {
+	return l.ConflictingBlock.Height
+}
+
+// String returns a string representation of the evidence.
+func (l *LightClientAttackEvidence) String() string {
+	return fmt.Sprintf("LightClientAttackEvidence{ConflictingBlock: %v, QuorumHash: %v}",
+		l.ConflictingBlock.String(), l.ConflictingBlock.Commit.QuorumHash)
+}
+
+// Time returns the time of the block committed at the height of the conflicting block
+func (l *LightClientAttackEvidence) Time() time.Time {
+	return l.Timestamp
+}
+
+// ValidateBasic performs basic validation such that the evidence is consistent
+// and can now be used for verification.
+func (l *LightClientAttackEvidence) ValidateBasic() error {
+	if l == nil {
+		return errors.New("empty light client attack evidence")
+	}
+	if l.ConflictingBlock == nil {
+		return errors.New("conflicting block is nil")
+	}
+	if l.ConflictingBlock.SignedHeader == nil {
+		return errors.New("conflicting block is missing signed header")
+	}
+	if err := l.ConflictingBlock.ValidateBasic(l.ConflictingBlock.ChainID); err != nil {
+		return fmt.Errorf("invalid conflicting light block: %w", err)
+	}
+	if !bytes.Equal(l.ConflictingBlock.Commit.QuorumHash, l.ConflictingBlock.ValidatorSet.QuorumHash) {
+		return fmt.Errorf("conflicting block is signed by quorum %X, its validator set is quorum %X",
+			l.ConflictingBlock.Commit.QuorumHash, l.ConflictingBlock.ValidatorSet.QuorumHash)
+	}
+	if l.TotalVotingPower <= 0 {
+		return errors.New("negative or zero total voting power")
+	}
+	return nil
+}
+
+// ToProto encodes LightClientAttackEvidence to protobuf
+func (l *LightClientAttackEvidence) ToProto() (*tmproto.LightClientAttackEvidence, error) {
+	conflictingBlock, err := l.ConflictingBlock.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return &tmproto.LightClientAttackEvidence{
+		ConflictingBlock: conflictingBlock,
+		TotalVotingPower: l.TotalVotingPower,
+		Timestamp:        l.Timestamp,
+	}, nil
+}
+
+// LightClientAttackEvidenceFromProto decodes protobuf into LightClientAttackEvidence
+func LightClientAttackEvidenceFromProto(lpb *tmproto.LightClientAttackEvidence) (*LightClientAttackEvidence, error) {
+	if lpb == nil {
+		return nil, errors.New("empty light client attack evidence")
+	}
+
+	conflictingBlock, err := LightBlockFromProto(lpb.ConflictingBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &LightClientAttackEvidence{
+		ConflictingBlock: conflictingBlock,
+		TotalVotingPower: lpb.TotalVotingPower,
+		Timestamp:        lpb.Timestamp,
+	}
+
+	return l, l.ValidateBasic()
+}
+
+//------------------------------------------------------------------------------------------
+
 // EvidenceList is a list of Evidence. Evidences is not a word.
 type EvidenceList []Evidence
 
@@ -237,6 +541,25 @@ func EvidenceToProto(evidence Evidence) (*tmproto.Evidence, error) {
 			},
 		}, nil
 
+	case *DuplicateStateVoteEvidence:
+		pbev := evi.ToProto()
+		return &tmproto.Evidence{
+			Sum: &tmproto.Evidence_DuplicateStateVoteEvidence{
+				DuplicateStateVoteEvidence: pbev,
+			},
+		}, nil
+
+	case *LightClientAttackEvidence:
+		pbev, err := evi.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		return &tmproto.Evidence{
+			Sum: &tmproto.Evidence_LightClientAttackEvidence{
+				LightClientAttackEvidence: pbev,
+			},
+		}, nil
+
 	default:
 		return nil, fmt.Errorf("toproto: evidence is not recognized: %T", evi)
 	}
@@ -252,6 +575,10 @@ func EvidenceFromProto(evidence *tmproto.Evidence) (Evidence, error) {
 	switch evi := evidence.Sum.(type) {
 	case *tmproto.Evidence_DuplicateVoteEvidence:
 		return DuplicateVoteEvidenceFromProto(evi.DuplicateVoteEvidence)
+	case *tmproto.Evidence_DuplicateStateVoteEvidence:
+		return DuplicateStateVoteEvidenceFromProto(evi.DuplicateStateVoteEvidence)
+	case *tmproto.Evidence_LightClientAttackEvidence:
+		return LightClientAttackEvidenceFromProto(evi.LightClientAttackEvidence)
 	default:
 		return nil, errors.New("evidence is not recognized")
 	}
@@ -259,6 +586,8 @@ func EvidenceFromProto(evidence *tmproto.Evidence) (Evidence, error) {
 
 func init() {
 	tmjson.RegisterType(&DuplicateVoteEvidence{}, "tendermint/DuplicateVoteEvidence")
+	tmjson.RegisterType(&DuplicateStateVoteEvidence{}, "tendermint/DuplicateStateVoteEvidence")
+	tmjson.RegisterType(&LightClientAttackEvidence{}, "tendermint/LightClientAttackEvidence")
 }
 
 //-------------------------------------------- ERRORS --------------------------------------
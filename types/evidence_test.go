@@ -114,6 +114,108 @@ func TestMockEvidenceValidateBasic(t *testing.T) {
 	assert.Nil(t, goodEvidence.ValidateBasic())
 }
 
+func randomDuplicateStateVoteEvidence(t *testing.T) *DuplicateStateVoteEvidence {
+	quorumHash := crypto.RandQuorumHash()
+	quorumType := btcjson.LLMQType_5_60
+	val := NewMockPVForQuorum(quorumHash)
+	pubKey, err := val.GetPubKey(quorumHash)
+	require.NoError(t, err)
+	valSet := NewValidatorSet([]*Validator{val.ExtractIntoValidator(quorumHash)}, pubKey, quorumType, quorumHash, true)
+	blockID := makeBlockID([]byte("blockhash"), 1000, []byte("partshash"))
+	stateID := makeStateID(tmhash.Sum([]byte("statehash")))
+	stateID2 := makeStateID(tmhash.Sum([]byte("statehash2")))
+	const chainID = "mychain"
+	ev := NewDuplicateStateVoteEvidence(
+		makeVote(t, val, chainID, 0, 10, quorumType, quorumHash, 2, 1, blockID, stateID),
+		makeVote(t, val, chainID, 0, 10, quorumType, quorumHash, 3, 2, blockID, stateID2),
+		defaultVoteTime,
+		valSet,
+	)
+	require.NotNil(t, ev)
+	return ev
+}
+
+func TestDuplicateStateVoteEvidenceValidation(t *testing.T) {
+	testCases := []struct {
+		testName         string
+		malleateEvidence func(*DuplicateStateVoteEvidence)
+		expectErr        bool
+	}{
+		{"Good DuplicateStateVoteEvidence", func(ev *DuplicateStateVoteEvidence) {}, false},
+		{"Nil vote", func(ev *DuplicateStateVoteEvidence) { ev.VoteA = nil }, true},
+		{"Vote for nil", func(ev *DuplicateStateVoteEvidence) {
+			ev.VoteB.BlockID = BlockID{}
+			ev.VoteB.StateID = StateID{}
+		}, true},
+		{"Wrong order", func(ev *DuplicateStateVoteEvidence) { ev.VoteA, ev.VoteB = ev.VoteB, ev.VoteA }, true},
+		{"Same state", func(ev *DuplicateStateVoteEvidence) { ev.VoteB.StateID = ev.VoteA.StateID }, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			ev := randomDuplicateStateVoteEvidence(t)
+			tc.malleateEvidence(ev)
+			assert.Equal(t, tc.expectErr, ev.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
+func randomLightClientAttackEvidence(t *testing.T) *LightClientAttackEvidence {
+	const height int64 = 10
+	voteSet, valSet, privVals := randVoteSet(height, 1, tmproto.PrecommitType, 4)
+	header := makeHeaderRandom()
+	header.ChainID = voteSet.ChainID()
+	header.Height = height
+	header.ValidatorsHash = valSet.Hash()
+	blockID := makeBlockID(header.Hash(), 1, tmhash.Sum([]byte("partshash")))
+	commit, err := MakeCommit(blockID, makeStateIDRandom(), height, 1, voteSet, privVals)
+	require.NoError(t, err)
+
+	return NewLightClientAttackEvidence(&LightBlock{
+		SignedHeader: &SignedHeader{Header: header, Commit: commit},
+		ValidatorSet: valSet,
+	}, defaultVoteTime)
+}
+
+func TestLightClientAttackEvidence(t *testing.T) {
+	ev := randomLightClientAttackEvidence(t)
+	require.NoError(t, ev.ValidateBasic())
+	assert.Equal(t, ev.ConflictingBlock.Height, ev.Height())
+	assert.Equal(t, defaultVoteTime, ev.Time())
+	assert.Equal(t, ev.ConflictingBlock.ValidatorSet.TotalVotingPower(), ev.TotalVotingPower)
+
+	// every member of the quorum is punished
+	abciEv := ev.ABCI()
+	require.Len(t, abciEv, ev.ConflictingBlock.ValidatorSet.Size())
+	for idx, val := range ev.ConflictingBlock.ValidatorSet.Validators {
+		assert.EqualValues(t, val.ProTxHash, abciEv[idx].Validator.ProTxHash)
+	}
+}
+
+func TestLightClientAttackEvidenceValidation(t *testing.T) {
+	testCases := []struct {
+		testName         string
+		malleateEvidence func(*LightClientAttackEvidence)
+		expectErr        bool
+	}{
+		{"Good LightClientAttackEvidence", func(ev *LightClientAttackEvidence) {}, false},
+		{"Nil conflicting block", func(ev *LightClientAttackEvidence) { ev.ConflictingBlock = nil }, true},
+		{"Nil signed header", func(ev *LightClientAttackEvidence) { ev.ConflictingBlock.SignedHeader = nil }, true},
+		{"Different quorum", func(ev *LightClientAttackEvidence) {
+			ev.ConflictingBlock.Commit.QuorumHash = crypto.RandQuorumHash()
+		}, true},
+		{"Zero total voting power", func(ev *LightClientAttackEvidence) { ev.TotalVotingPower = 0 }, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			ev := randomLightClientAttackEvidence(t)
+			tc.malleateEvidence(ev)
+			assert.Equal(t, tc.expectErr, ev.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
 func makeVote(
 	t *testing.T, val PrivValidator, chainID string,
 	valIndex int32, height int64, quorumType btcjson.LLMQType,
@@ -200,6 +302,10 @@ func TestEvidenceProto(t *testing.T) {
 		{"DuplicateVoteEvidence nil voteB", &DuplicateVoteEvidence{VoteA: v, VoteB: nil}, false, true},
 		{"DuplicateVoteEvidence nil voteA", &DuplicateVoteEvidence{VoteA: nil, VoteB: v}, false, true},
 		{"DuplicateVoteEvidence success", &DuplicateVoteEvidence{VoteA: v2, VoteB: v}, false, false},
+		{"DuplicateStateVoteEvidence empty fail", &DuplicateStateVoteEvidence{}, false, true},
+		{"DuplicateStateVoteEvidence success", randomDuplicateStateVoteEvidence(t), false, false},
+		{"LightClientAttackEvidence empty fail", &LightClientAttackEvidence{}, false, true},
+		{"LightClientAttackEvidence success", randomLightClientAttackEvidence(t), false, false},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -187,6 +187,19 @@ func (pv *MockPV) GetFirstQuorumHash() (crypto.QuorumHash, error) {
 	return nil, nil
 }
 
+// GetQuorumHashes returns the hashes of all the quorums the validator has keys of.
+func (pv *MockPV) GetQuorumHashes() ([]crypto.QuorumHash, error) {
+	quorumHashes := make([]crypto.QuorumHash, 0, len(pv.PrivateKeys))
+	for quorumHashString := range pv.PrivateKeys {
+		quorumHash, err := hex.DecodeString(quorumHashString)
+		if err != nil {
+			return nil, err
+		}
+		quorumHashes = append(quorumHashes, quorumHash)
+	}
+	return quorumHashes, nil
+}
+
 // GetThresholdPublicKey ...
 func (pv *MockPV) GetThresholdPublicKey(quorumHash crypto.QuorumHash) (crypto.PubKey, error) {
 	return pv.PrivateKeys[quorumHash.String()].ThresholdPublicKey, nil
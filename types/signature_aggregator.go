@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
+)
+
+// ThresholdRecoveryRequest holds the signature shares of the precommits for a block
+// to recover the threshold block and state signatures of its commit from.
+type ThresholdRecoveryRequest struct {
+	QuorumSize         int // number of members of the quorum
+	ThresholdPublicKey crypto.PubKey
+
+	BlockSignID []byte
+	BlockShares *bls12381.SignatureShares
+	StateSignID []byte
+	StateShares *bls12381.SignatureShares // nil if the precommits are for nil
+}
+
+// ThresholdRecoveryResult holds the recovered and verified threshold signatures.
+type ThresholdRecoveryResult struct {
+	BlockSignature []byte
+	StateSignature []byte
+	Err            error
+}
+
+// SignatureAggregator recovers threshold signatures from signature shares. Recovering a
+// threshold signature of a large quorum is expensive, so implementations should not do it
+// on the goroutine of the caller.
+type SignatureAggregator interface {
+	// RecoverThresholdSigs starts the recovery of the threshold signatures of the request.
+	// The result is sent on the returned channel exactly once.
+	RecoverThresholdSigs(req ThresholdRecoveryRequest) <-chan ThresholdRecoveryResult
+}
+
+// RecoverThresholdSigs recovers the threshold block and state signatures of the request and
+// verifies them against the threshold public key.
+func RecoverThresholdSigs(req ThresholdRecoveryRequest) ThresholdRecoveryResult {
+	var res ThresholdRecoveryResult
+	thresholdBlockSig, err := req.BlockShares.Recover()
+	if err != nil {
+		return ThresholdRecoveryResult{Err: fmt.Errorf("error recovering threshold block sig: %v", err)}
+	}
+	if !req.ThresholdPublicKey.VerifySignatureDigest(req.BlockSignID, thresholdBlockSig) {
+		return ThresholdRecoveryResult{Err: fmt.Errorf("recovered incorrect threshold signature %X voteSetCount %d",
+			thresholdBlockSig, req.BlockShares.Len())}
+	}
+	res.BlockSignature = thresholdBlockSig
+
+	// if the vote is voting for nil, then we do not care to recover the state signature
+	if req.StateShares == nil {
+		return res
+	}
+	thresholdStateSig, err := req.StateShares.Recover()
+	if err != nil {
+		return ThresholdRecoveryResult{Err: fmt.Errorf("error recovering threshold state sig: %v", err)}
+	}
+	if !req.ThresholdPublicKey.VerifySignatureDigest(req.StateSignID, thresholdStateSig) {
+		return ThresholdRecoveryResult{Err: fmt.Errorf("recovered incorrect state threshold signature %X voteSetCount %d",
+			thresholdStateSig, req.StateShares.Len())}
+	}
+	res.StateSignature = thresholdStateSig
+	return res
+}
+
+// goroutineSignatureAggregator recovers every request on a goroutine of its own.
+type goroutineSignatureAggregator struct{}
+
+// RecoverThresholdSigs implements SignatureAggregator.
+func (goroutineSignatureAggregator) RecoverThresholdSigs(req ThresholdRecoveryRequest) <-chan ThresholdRecoveryResult {
+	result := make(chan ThresholdRecoveryResult, 1)
+	go func() {
+		result <- RecoverThresholdSigs(req)
+	}()
+	return result
+}
+
+// DefaultSignatureAggregator is used by vote sets which were not given an aggregator.
+var DefaultSignatureAggregator SignatureAggregator = goroutineSignatureAggregator{}
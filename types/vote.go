@@ -62,6 +62,24 @@ func NewConflictingVoteError(vote1, vote2 *Vote) *ErrVoteConflictingVotes {
 	}
 }
 
+// ErrVoteConflictingStateVotes is returned when a validator signed two different
+// state IDs at the same height.
+type ErrVoteConflictingStateVotes struct {
+	VoteA *Vote
+	VoteB *Vote
+}
+
+func (err *ErrVoteConflictingStateVotes) Error() string {
+	return fmt.Sprintf("conflicting state votes from validator %X", err.VoteA.ValidatorProTxHash)
+}
+
+func NewConflictingStateVoteError(vote1, vote2 *Vote) *ErrVoteConflictingStateVotes {
+	return &ErrVoteConflictingStateVotes{
+		VoteA: vote1,
+		VoteB: vote2,
+	}
+}
+
 // Address is hex bytes.
 type Address = crypto.Address
 
@@ -79,6 +97,9 @@ type Vote struct {
 	ValidatorIndex     int32                 `json:"validator_index"`
 	BlockSignature     []byte                `json:"block_signature"`
 	StateSignature     []byte                `json:"state_signature"`
+
+	// signatures already verified by a VoteBatchVerifier
+	verified []verifiedSignature
 }
 
 // VoteBlockSignBytes returns the proto-encoding of the canonicalized Vote, for
@@ -248,10 +269,34 @@ func (vote *Vote) Verify(
 	if len(pubKey.Bytes()) != bls12381.PubKeySize {
 		return nil, nil, ErrVoteInvalidValidatorPubKeySize
 	}
-	v := vote.ToProto()
-	voteBlockSignBytes := VoteBlockSignBytes(chainID, v)
+	signID, stateSignID := vote.signIDs(chainID, quorumType, quorumHash)
+
+	if !vote.isVerified(pubKey, signID, vote.BlockSignature) &&
+		!pubKey.VerifySignatureDigest(signID, vote.BlockSignature) {
+		return nil, nil, fmt.Errorf(
+			"%s proTxHash %s pubKey %v vote %v sign bytes %s block signature %s", ErrVoteInvalidBlockSignature.Error(),
+			proTxHash, pubKey, vote, hex.EncodeToString(VoteBlockSignBytes(chainID, vote.ToProto())),
+			hex.EncodeToString(vote.BlockSignature))
+	}
+
+	// we must verify the stateID but only if the blockID isn't nil
+	if vote.BlockID.Hash != nil {
+		if !vote.isVerified(pubKey, stateSignID, vote.StateSignature) &&
+			!pubKey.VerifySignatureDigest(stateSignID, vote.StateSignature) {
+			return nil, nil, ErrVoteInvalidStateSignature
+		}
+	} else if vote.StateSignature != nil {
+		return nil, nil, ErrVoteStateSignatureShouldBeNil
+	}
 
-	blockMessageHash := crypto.Sha256(voteBlockSignBytes)
+	return signID, stateSignID, nil
+}
+
+// signIDs returns the IDs signed by the block and the state signatures of the vote. The
+// state sign ID is nil if the vote is for nil.
+func (vote *Vote) signIDs(chainID string, quorumType btcjson.LLMQType, quorumHash []byte) ([]byte, []byte) {
+	v := vote.ToProto()
+	blockMessageHash := crypto.Sha256(VoteBlockSignBytes(chainID, v))
 
 	blockRequestID := VoteBlockRequestID(vote)
 
@@ -265,35 +310,21 @@ func (vote *Vote) Verify(
 	// fmt.Printf("block vote verify sign ID %s (%d - %s  - %s  - %s)\n", hex.EncodeToString(signID), quorumType,
 	//	hex.EncodeToString(quorumHash), hex.EncodeToString(blockRequestID), hex.EncodeToString(blockMessageHash))
 
-	if !pubKey.VerifySignatureDigest(signID, vote.BlockSignature) {
-		return nil, nil, fmt.Errorf(
-			"%s proTxHash %s pubKey %v vote %v sign bytes %s block signature %s", ErrVoteInvalidBlockSignature.Error(),
-			proTxHash, pubKey, vote, hex.EncodeToString(voteBlockSignBytes), hex.EncodeToString(vote.BlockSignature))
+	if vote.BlockID.Hash == nil {
+		return signID, nil
 	}
+	stateMessageHash := crypto.Sha256(VoteStateSignBytes(chainID, v))
 
-	stateSignID := []byte(nil)
-	// we must verify the stateID but only if the blockID isn't nil
-	if vote.BlockID.Hash != nil {
-		voteStateSignBytes := VoteStateSignBytes(chainID, v)
-		stateMessageHash := crypto.Sha256(voteStateSignBytes)
-
-		stateRequestID := VoteStateRequestID(vote)
-
-		stateSignID = crypto.SignID(
-			quorumType, bls12381.ReverseBytes(quorumHash), bls12381.ReverseBytes(stateRequestID),
-			bls12381.ReverseBytes(stateMessageHash))
+	stateRequestID := VoteStateRequestID(vote)
 
-		// fmt.Printf("state vote verify sign ID %s (%d - %s  - %s  - %s)\n", hex.EncodeToString(stateSignID), quorumType,
-		//	hex.EncodeToString(quorumHash), hex.EncodeToString(stateRequestID), hex.EncodeToString(stateMessageHash))
+	stateSignID := crypto.SignID(
+		quorumType, bls12381.ReverseBytes(quorumHash), bls12381.ReverseBytes(stateRequestID),
+		bls12381.ReverseBytes(stateMessageHash))
 
-		if !pubKey.VerifySignatureDigest(stateSignID, vote.StateSignature) {
-			return nil, nil, ErrVoteInvalidStateSignature
-		}
-	} else if vote.StateSignature != nil {
-		return nil, nil, ErrVoteStateSignatureShouldBeNil
-	}
+	// fmt.Printf("state vote verify sign ID %s (%d - %s  - %s  - %s)\n", hex.EncodeToString(stateSignID), quorumType,
+	//	hex.EncodeToString(quorumHash), hex.EncodeToString(stateRequestID), hex.EncodeToString(stateMessageHash))
 
-	return signID, stateSignID, nil
+	return signID, stateSignID
 }
 
 // ValidateBasic performs basic validation.
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/dashevo/dashd-go/btcjson"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
+)
+
+// verifiedSignature is a signature of a vote verified by a VoteBatchVerifier, along with
+// what it was verified against.
+type verifiedSignature struct {
+	pubKey    []byte
+	signID    []byte
+	signature []byte
+}
+
+// isVerified returns true if the signature of the given sign ID by the given public key
+// was verified in a batch already.
+func (vote *Vote) isVerified(pubKey crypto.PubKey, signID []byte, signature []byte) bool {
+	for _, v := range vote.verified {
+		if bytes.Equal(v.signID, signID) && bytes.Equal(v.signature, signature) &&
+			bytes.Equal(v.pubKey, pubKey.Bytes()) {
+			return true
+		}
+	}
+	return false
+}
+
+// batchVote is a vote added to a VoteBatchVerifier with what to verify it against.
+type batchVote struct {
+	vote        *Vote
+	quorumType  btcjson.LLMQType
+	quorumHash  []byte
+	pubKey      crypto.PubKey
+	proTxHash   crypto.ProTxHash
+	signID      []byte
+	stateSignID []byte
+}
+
+// VoteBatchVerifier verifies the signatures of many votes at once. Votes whose signatures
+// are valid are marked as such, so that Vote.Verify doesn't verify them again when they
+// are added to a vote set.
+type VoteBatchVerifier struct {
+	chainID  string
+	votes    []batchVote
+	verifier *bls12381.BatchVerifier
+}
+
+// NewVoteBatchVerifier returns an empty VoteBatchVerifier for votes of the given chain.
+func NewVoteBatchVerifier(chainID string) *VoteBatchVerifier {
+	return &VoteBatchVerifier{
+		chainID:  chainID,
+		verifier: bls12381.NewBatchVerifier(),
+	}
+}
+
+// Add adds the vote of the validator with the given public key and pro_tx_hash to the
+// batch. It returns an error, and doesn't add the vote, if the vote is invalid regardless
+// of its signatures.
+func (b *VoteBatchVerifier) Add(
+	vote *Vote, quorumType btcjson.LLMQType, quorumHash []byte,
+	pubKey crypto.PubKey, proTxHash crypto.ProTxHash) error {
+	if !bytes.Equal(proTxHash, vote.ValidatorProTxHash) {
+		return ErrVoteInvalidValidatorProTxHash
+	}
+	if len(pubKey.Bytes()) != bls12381.PubKeySize {
+		return ErrVoteInvalidValidatorPubKeySize
+	}
+	if vote.BlockID.Hash == nil && vote.StateSignature != nil {
+		return ErrVoteStateSignatureShouldBeNil
+	}
+	signID, stateSignID := vote.signIDs(b.chainID, quorumType, quorumHash)
+	if err := b.verifier.Add(pubKey, signID, vote.BlockSignature); err != nil {
+		return ErrVoteInvalidBlockSignature
+	}
+	if stateSignID != nil {
+		if err := b.verifier.Add(pubKey, stateSignID, vote.StateSignature); err != nil {
+			return ErrVoteInvalidStateSignature
+		}
+	}
+	b.votes = append(b.votes, batchVote{
+		vote:        vote,
+		quorumType:  quorumType,
+		quorumHash:  quorumHash,
+		pubKey:      pubKey,
+		proTxHash:   proTxHash,
+		signID:      signID,
+		stateSignID: stateSignID,
+	})
+	return nil
+}
+
+// Len returns the number of votes in the batch.
+func (b *VoteBatchVerifier) Len() int {
+	return len(b.votes)
+}
+
+// Verify verifies the signatures of all the votes of the batch in aggregate. If that
+// fails, the votes are verified one by one to find the invalid ones. It returns the error
+// of each vote, in the order they were added, nil if the vote is valid.
+func (b *VoteBatchVerifier) Verify() []error {
+	errs := make([]error, len(b.votes))
+	batchValid := b.verifier.Verify()
+	for i, bv := range b.votes {
+		if !batchValid {
+			_, _, errs[i] = bv.vote.Verify(b.chainID, bv.quorumType, bv.quorumHash, bv.pubKey, bv.proTxHash)
+			if errs[i] != nil {
+				continue
+			}
+		}
+		verified := []verifiedSignature{{bv.pubKey.Bytes(), bv.signID, bv.vote.BlockSignature}}
+		if bv.stateSignID != nil {
+			verified = append(verified, verifiedSignature{bv.pubKey.Bytes(), bv.stateSignID, bv.vote.StateSignature})
+		}
+		bv.vote.verified = verified
+	}
+	return errs
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+func TestVoteBatchVerifier(t *testing.T) {
+	const chainID = "test_chain_id"
+	valSet, privVals := GenerateValidatorSet(4)
+	blockID := makeBlockID(tmhash.Sum([]byte("block")), 1, tmhash.Sum([]byte("parts")))
+	stateID := StateID{LastAppHash: crypto.CRandBytes(32)}
+
+	votes := make([]*Vote, len(privVals))
+	for i, privVal := range privVals {
+		bID := blockID
+		if i == 0 {
+			bID = BlockID{} // nil votes don't sign the state
+		}
+		vote, err := MakeVote(1, bID, stateID, valSet, privVal, chainID)
+		require.NoError(t, err)
+		votes[i] = vote
+	}
+
+	newVerifier := func(votes []*Vote) *VoteBatchVerifier {
+		verifier := NewVoteBatchVerifier(chainID)
+		for _, vote := range votes {
+			_, val := valSet.GetByIndex(vote.ValidatorIndex)
+			require.NoError(t, verifier.Add(vote, valSet.QuorumType, valSet.QuorumHash, val.PubKey, val.ProTxHash))
+		}
+		return verifier
+	}
+
+	// all valid
+	verifier := newVerifier(votes)
+	assert.Equal(t, len(votes), verifier.Len())
+	for _, err := range verifier.Verify() {
+		assert.NoError(t, err)
+	}
+	for _, vote := range votes {
+		assert.NotEmpty(t, vote.verified)
+	}
+
+	// a vote with the block signature of another validator
+	badVotes := make([]*Vote, len(votes))
+	for i, vote := range votes {
+		badVotes[i] = vote.Copy()
+		badVotes[i].verified = nil
+	}
+	badVotes[2].BlockSignature = votes[3].BlockSignature
+	errs := newVerifier(badVotes).Verify()
+	for i, err := range errs {
+		if i == 2 {
+			assert.Error(t, err)
+			assert.Empty(t, badVotes[i].verified)
+		} else {
+			assert.NoError(t, err)
+			assert.NotEmpty(t, badVotes[i].verified)
+		}
+	}
+
+	// signatures verified in a batch are not verified against other keys
+	_, val := valSet.GetByIndex(votes[1].ValidatorIndex)
+	assert.True(t, votes[1].isVerified(val.PubKey, votes[1].verified[0].signID, votes[1].BlockSignature))
+	_, other := valSet.GetByIndex(votes[2].ValidatorIndex)
+	assert.False(t, votes[1].isVerified(other.PubKey, votes[1].verified[0].signID, votes[1].BlockSignature))
+
+	// votes invalid regardless of their signatures are not added
+	verifier = NewVoteBatchVerifier(chainID)
+	_, val = valSet.GetByIndex(votes[1].ValidatorIndex)
+	err := verifier.Add(votes[1], valSet.QuorumType, valSet.QuorumHash, val.PubKey, crypto.RandProTxHash())
+	assert.Equal(t, ErrVoteInvalidValidatorProTxHash, err)
+	assert.Zero(t, verifier.Len())
+}
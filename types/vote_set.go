@@ -78,6 +78,9 @@ type VoteSet struct {
 	thresholdStateSig []byte                 // If a 2/3 majority is seen, recover the state sig
 	votesByBlock      map[string]*blockVotes // string(blockHash|blockParts) -> blockVotes
 	peerMaj23s        map[P2PID]BlockID      // Maj23 for each peer
+
+	aggregator    SignatureAggregator            // Recovers the threshold sigs off the caller's goroutine
+	thresholdSigs <-chan ThresholdRecoveryResult // Pending recovery of the threshold sigs
 }
 
 // NewVoteSet constructs a new VoteSet struct used to accumulate votes for given height/round.
@@ -102,9 +105,18 @@ func NewVoteSet(chainID string, height int64, round int32,
 		stateMaj23:    nil,
 		votesByBlock:  make(map[string]*blockVotes, valSet.Size()),
 		peerMaj23s:    make(map[P2PID]BlockID),
+		aggregator:    DefaultSignatureAggregator,
 	}
 }
 
+// SetSignatureAggregator sets the aggregator recovering the threshold signatures once
+// the precommits of the vote set reach a 2/3 majority.
+func (voteSet *VoteSet) SetSignatureAggregator(aggregator SignatureAggregator) {
+	voteSet.mtx.Lock()
+	defer voteSet.mtx.Unlock()
+	voteSet.aggregator = aggregator
+}
+
 func (voteSet *VoteSet) ChainID() string {
 	return voteSet.chainID
 }
@@ -305,6 +317,9 @@ func (voteSet *VoteSet) addVerifiedVote(
 
 	// Add vote to votesByBlock
 	votesByBlock.addVerifiedVote(vote, votingPower)
+	if voteSet.signedMsgType == tmproto.PrecommitType {
+		votesByBlock.addSignatureShares(vote)
+	}
 
 	// If we just crossed the quorum threshold and have 2/3 majority...
 	if origSum < quorum && quorum <= votesByBlock.sum {
@@ -318,14 +333,9 @@ func (voteSet *VoteSet) addVerifiedVote(
 			voteSet.stateMaj23 = &stateMaj23StateID
 			if voteSet.signedMsgType == tmproto.PrecommitType {
 				if len(votesByBlock.votes) > 1 {
-					err := voteSet.recoverThresholdSigsAndVerify(votesByBlock, signID, stateSignID)
-					if err != nil {
-						// fmt.Printf("error %v quorum %d\n", err, quorum)
-						// for i, vote := range votesByBlock.votes {
-						// 	fmt.Printf("vote %d %v\n", i, vote)
-						// }
-						panic(fmt.Errorf("failed recovering or verifying threshold signature: %v", err))
-					}
+					// the signatures are needed only once the commit is made
+					voteSet.thresholdSigs = voteSet.aggregator.RecoverThresholdSigs(
+						voteSet.thresholdRecoveryRequest(votesByBlock, signID, stateSignID))
 				} else {
 					// there is only 1 validator
 					voteSet.thresholdBlockSig = vote.BlockSignature
@@ -344,57 +354,38 @@ func (voteSet *VoteSet) addVerifiedVote(
 	return true, conflicting
 }
 
-func (voteSet *VoteSet) recoverThresholdSigsAndVerify(blockVotes *blockVotes, signID []byte, stateSignID []byte) error {
-	err := voteSet.recoverThresholdSigs(blockVotes)
-	if err != nil {
-		return err
-	}
-	verified := voteSet.valSet.ThresholdPublicKey.VerifySignatureDigest(signID, voteSet.thresholdBlockSig)
-	if !verified {
-		thresholdBlockSig := voteSet.thresholdBlockSig
-		return fmt.Errorf("recovered incorrect threshold signature %X voteSetCount %d",
-			thresholdBlockSig, len(blockVotes.votes))
-	}
-	if voteSet.thresholdStateSig != nil {
-		verified = voteSet.valSet.ThresholdPublicKey.VerifySignatureDigest(stateSignID, voteSet.thresholdStateSig)
-		if !verified {
-			thresholdStateSig := voteSet.thresholdStateSig
-			return fmt.Errorf("recovered incorrect state threshold signature %X voteSetCount %d",
-				thresholdStateSig, len(blockVotes.votes))
-		}
+func (voteSet *VoteSet) thresholdRecoveryRequest(
+	blockVotes *blockVotes,
+	signID []byte,
+	stateSignID []byte,
+) ThresholdRecoveryRequest {
+	req := ThresholdRecoveryRequest{
+		QuorumSize:         voteSet.valSet.Size(),
+		ThresholdPublicKey: voteSet.valSet.ThresholdPublicKey,
+		BlockSignID:        signID,
+		BlockShares:        blockVotes.blockShares.Copy(),
+		StateSignID:        stateSignID,
+	}
+	// if the vote is voting for nil, then we do not care to recover the state signature
+	if voteSet.maj23 != nil && voteSet.maj23.Hash != nil {
+		req.StateShares = blockVotes.stateShares.Copy()
 	}
-	return nil
+	return req
 }
 
-func (voteSet *VoteSet) recoverThresholdSigs(blockVotes *blockVotes) error {
-	if len(blockVotes.votes) < 2 {
-		return fmt.Errorf("attempting to recover a threshold signature with only 1 vote")
+// waitThresholdSigs waits for the pending recovery of the threshold signatures, if any.
+// Panics if they couldn't be recovered or verified.
+func (voteSet *VoteSet) waitThresholdSigs() {
+	if voteSet.thresholdSigs == nil {
+		return
 	}
-	var blockSigs [][]byte
-	var stateSigs [][]byte
-	var blsIDs [][]byte
-	for _, vote := range blockVotes.votes {
-		if vote != nil {
-			blockSigs = append(blockSigs, vote.BlockSignature)
-			stateSigs = append(stateSigs, vote.StateSignature)
-			blsIDs = append(blsIDs, vote.ValidatorProTxHash)
-		}
+	res := <-voteSet.thresholdSigs
+	voteSet.thresholdSigs = nil
+	if res.Err != nil {
+		panic(fmt.Errorf("failed recovering or verifying threshold signature: %v", res.Err))
 	}
-	thresholdBlockSig, err := bls12381.RecoverThresholdSignatureFromShares(blockSigs, blsIDs)
-	if err != nil {
-		return fmt.Errorf("error recovering threshold block sig: %v", err)
-	}
-	voteSet.thresholdBlockSig = thresholdBlockSig
-
-	if voteSet.maj23 != nil && voteSet.maj23.Hash != nil {
-		// if the vote is voting for nil, then we do not care to recover the state signature
-		thresholdStateSig, err := bls12381.RecoverThresholdSignatureFromShares(stateSigs, blsIDs)
-		if err != nil {
-			return fmt.Errorf("error recovering threshold state sig: %v", err)
-		}
-		voteSet.thresholdStateSig = thresholdStateSig
-	}
-	return nil
+	voteSet.thresholdBlockSig = res.BlockSignature
+	voteSet.thresholdStateSig = res.StateSignature
 }
 
 // If a peer claims that it has 2/3 majority for given blockKey, call this.
@@ -705,6 +696,8 @@ func (voteSet *VoteSet) MakeCommit() *Commit {
 		panic("Cannot MakeCommit() unless a stateMaj23 has been set")
 	}
 
+	voteSet.waitThresholdSigs()
+
 	if voteSet.thresholdBlockSig == nil {
 		panic("Cannot MakeCommit() unless a thresholdBlockSig has been created")
 	}
@@ -733,10 +726,12 @@ func (voteSet *VoteSet) MakeCommit() *Commit {
 	2. A peer claims to have a 2/3 majority w/ blockKey (peerMaj23=true)
 */
 type blockVotes struct {
-	peerMaj23 bool           // peer claims to have maj23
-	bitArray  *bits.BitArray // valIndex -> hasVote?
-	votes     []*Vote        // valIndex -> *Vote
-	sum       int64          // vote sum
+	peerMaj23   bool                      // peer claims to have maj23
+	bitArray    *bits.BitArray            // valIndex -> hasVote?
+	votes       []*Vote                   // valIndex -> *Vote
+	sum         int64                     // vote sum
+	blockShares *bls12381.SignatureShares // block signature shares of the precommits
+	stateShares *bls12381.SignatureShares // state signature shares of the precommits
 }
 
 func newBlockVotes(peerMaj23 bool, numValidators int) *blockVotes {
@@ -748,6 +743,21 @@ func newBlockVotes(peerMaj23 bool, numValidators int) *blockVotes {
 	}
 }
 
+// addSignatureShares caches the deserialized signature shares of a verified precommit,
+// so that only the interpolation is left once the precommits reach a 2/3 majority.
+// Signatures of verified votes are well-formed, so errors are not expected here. Still,
+// if one occurs, the share is left out of the recovery.
+func (vs *blockVotes) addSignatureShares(vote *Vote) {
+	if vs.blockShares == nil {
+		vs.blockShares = bls12381.NewSignatureShares(len(vs.votes))
+		vs.stateShares = bls12381.NewSignatureShares(len(vs.votes))
+	}
+	_ = vs.blockShares.Add(vote.BlockSignature, vote.ValidatorProTxHash)
+	if vote.BlockID.Hash != nil {
+		_ = vs.stateShares.Add(vote.StateSignature, vote.ValidatorProTxHash)
+	}
+}
+
 func (vs *blockVotes) addVerifiedVote(vote *Vote, votingPower int64) {
 	valIndex := vote.ValidatorIndex
 	if existing := vs.votes[valIndex]; existing == nil {
@@ -449,6 +449,51 @@ func TestVoteSet_MakeCommit(t *testing.T) {
 	}
 }
 
+type countingSignatureAggregator struct {
+	requests []ThresholdRecoveryRequest
+}
+
+func (a *countingSignatureAggregator) RecoverThresholdSigs(req ThresholdRecoveryRequest) <-chan ThresholdRecoveryResult {
+	a.requests = append(a.requests, req)
+	return DefaultSignatureAggregator.RecoverThresholdSigs(req)
+}
+
+func TestVoteSet_MakeCommitWithSignatureAggregator(t *testing.T) {
+	height, round := int64(1), int32(0)
+	voteSet, valSet, privValidators := randVoteSet(height, round, tmproto.PrecommitType, 10)
+	aggregator := &countingSignatureAggregator{}
+	voteSet.SetSignatureAggregator(aggregator)
+
+	voteProto := &Vote{
+		ValidatorProTxHash: nil,
+		ValidatorIndex:     -1,
+		Height:             height,
+		Round:              round,
+		Type:               tmproto.PrecommitType,
+		BlockID:            BlockID{crypto.CRandBytes(32), PartSetHeader{123, crypto.CRandBytes(32)}},
+		StateID:            StateID{crypto.CRandBytes(32)},
+	}
+	for i := int32(0); i < 10; i++ {
+		pvProTxHash, err := privValidators[i].GetProTxHash()
+		require.NoError(t, err)
+		_, err = signAddVote(privValidators[i], withValidator(voteProto, pvProTxHash, i), voteSet)
+		require.NoError(t, err)
+	}
+
+	// the recovery is started once, when +2/3 is reached
+	require.Len(t, aggregator.requests, 1)
+	assert.Equal(t, 10, aggregator.requests[0].QuorumSize)
+	assert.Equal(t, 7, aggregator.requests[0].BlockShares.Len())
+	assert.Equal(t, 7, aggregator.requests[0].StateShares.Len())
+
+	commit := voteSet.MakeCommit()
+	require.NoError(t, commit.ValidateBasic())
+	assert.True(t, valSet.ThresholdPublicKey.VerifySignatureDigest(
+		aggregator.requests[0].BlockSignID, commit.ThresholdBlockSignature))
+	assert.True(t, valSet.ThresholdPublicKey.VerifySignatureDigest(
+		aggregator.requests[0].StateSignID, commit.ThresholdStateSignature))
+}
+
 // NOTE: privValidators are in order
 func randVoteSet(
 	height int64,